@@ -25,6 +25,13 @@ var AdminSubCommands []string = []string{
 	"create-project",
 	"update-project",
 	"delete-project",
+	"undelete-project",
+	"list-outbox-events",
+	"replay-outbox-events",
+	"list-role-bindings",
+	"set-role-binding",
+	"delete-role-binding",
+	"list-audit-events",
 }
 
 func init() {
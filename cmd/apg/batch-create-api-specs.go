@@ -0,0 +1,92 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var BatchCreateApiSpecsInput rpcpb.BatchCreateApiSpecsRequest
+
+var BatchCreateApiSpecsFromFile string
+
+var BatchCreateApiSpecsInputRequests []string
+
+func init() {
+	RegistryServiceCmd.AddCommand(BatchCreateApiSpecsCmd)
+
+	BatchCreateApiSpecsCmd.Flags().StringVar(&BatchCreateApiSpecsInput.Parent, "parent", "", "Required. The parent of the specs. Resource IDs...")
+
+	BatchCreateApiSpecsCmd.Flags().StringArrayVar(&BatchCreateApiSpecsInputRequests, "requests", []string{}, "Required. The requests for the specs to create....")
+
+	BatchCreateApiSpecsCmd.Flags().StringVar(&BatchCreateApiSpecsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var BatchCreateApiSpecsCmd = &cobra.Command{
+	Use:   "batch-create-api-specs",
+	Short: "BatchCreateApiSpecs creates multiple specs in a...",
+	Long:  "BatchCreateApiSpecs creates multiple specs in a single transaction.  Requests that fail are reported in the results without preventing the  others...",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if BatchCreateApiSpecsFromFile == "" {
+
+			cmd.MarkFlagRequired("parent")
+
+			cmd.MarkFlagRequired("requests")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if BatchCreateApiSpecsFromFile != "" {
+			in, err = os.Open(BatchCreateApiSpecsFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &BatchCreateApiSpecsInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		// unmarshal JSON strings into slice of structs
+		for _, item := range BatchCreateApiSpecsInputRequests {
+			tmp := rpcpb.CreateApiSpecRequest{}
+			err = jsonpb.UnmarshalString(item, &tmp)
+			if err != nil {
+				return
+			}
+
+			BatchCreateApiSpecsInput.Requests = append(BatchCreateApiSpecsInput.Requests, &tmp)
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "BatchCreateApiSpecs", &BatchCreateApiSpecsInput)
+		}
+		resp, err := RegistryClient.BatchCreateApiSpecs(ctx, &BatchCreateApiSpecsInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
@@ -0,0 +1,92 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var BatchCreateApiVersionsInput rpcpb.BatchCreateApiVersionsRequest
+
+var BatchCreateApiVersionsFromFile string
+
+var BatchCreateApiVersionsInputRequests []string
+
+func init() {
+	RegistryServiceCmd.AddCommand(BatchCreateApiVersionsCmd)
+
+	BatchCreateApiVersionsCmd.Flags().StringVar(&BatchCreateApiVersionsInput.Parent, "parent", "", "Required. The parent of the versions. Resource...")
+
+	BatchCreateApiVersionsCmd.Flags().StringArrayVar(&BatchCreateApiVersionsInputRequests, "requests", []string{}, "Required. The requests for the versions to...")
+
+	BatchCreateApiVersionsCmd.Flags().StringVar(&BatchCreateApiVersionsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var BatchCreateApiVersionsCmd = &cobra.Command{
+	Use:   "batch-create-api-versions",
+	Short: "BatchCreateApiVersions creates multiple versions...",
+	Long:  "BatchCreateApiVersions creates multiple versions in a single transaction.  Requests that fail are reported in the results without preventing the ...",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if BatchCreateApiVersionsFromFile == "" {
+
+			cmd.MarkFlagRequired("parent")
+
+			cmd.MarkFlagRequired("requests")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if BatchCreateApiVersionsFromFile != "" {
+			in, err = os.Open(BatchCreateApiVersionsFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &BatchCreateApiVersionsInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		// unmarshal JSON strings into slice of structs
+		for _, item := range BatchCreateApiVersionsInputRequests {
+			tmp := rpcpb.CreateApiVersionRequest{}
+			err = jsonpb.UnmarshalString(item, &tmp)
+			if err != nil {
+				return
+			}
+
+			BatchCreateApiVersionsInput.Requests = append(BatchCreateApiVersionsInput.Requests, &tmp)
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "BatchCreateApiVersions", &BatchCreateApiVersionsInput)
+		}
+		resp, err := RegistryClient.BatchCreateApiVersions(ctx, &BatchCreateApiVersionsInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
@@ -0,0 +1,92 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var BatchCreateApisInput rpcpb.BatchCreateApisRequest
+
+var BatchCreateApisFromFile string
+
+var BatchCreateApisInputRequests []string
+
+func init() {
+	RegistryServiceCmd.AddCommand(BatchCreateApisCmd)
+
+	BatchCreateApisCmd.Flags().StringVar(&BatchCreateApisInput.Parent, "parent", "", "Required. The parent of the APIs. Resource IDs in...")
+
+	BatchCreateApisCmd.Flags().StringArrayVar(&BatchCreateApisInputRequests, "requests", []string{}, "Required. The requests for the APIs to create....")
+
+	BatchCreateApisCmd.Flags().StringVar(&BatchCreateApisFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var BatchCreateApisCmd = &cobra.Command{
+	Use:   "batch-create-apis",
+	Short: "BatchCreateApis creates multiple APIs in a single...",
+	Long:  "BatchCreateApis creates multiple APIs in a single transaction.  Requests that fail are reported in the results without preventing the  others from...",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if BatchCreateApisFromFile == "" {
+
+			cmd.MarkFlagRequired("parent")
+
+			cmd.MarkFlagRequired("requests")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if BatchCreateApisFromFile != "" {
+			in, err = os.Open(BatchCreateApisFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &BatchCreateApisInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		// unmarshal JSON strings into slice of structs
+		for _, item := range BatchCreateApisInputRequests {
+			tmp := rpcpb.CreateApiRequest{}
+			err = jsonpb.UnmarshalString(item, &tmp)
+			if err != nil {
+				return
+			}
+
+			BatchCreateApisInput.Requests = append(BatchCreateApisInput.Requests, &tmp)
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "BatchCreateApis", &BatchCreateApisInput)
+		}
+		resp, err := RegistryClient.BatchCreateApis(ctx, &BatchCreateApisInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
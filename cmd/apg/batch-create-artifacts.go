@@ -0,0 +1,92 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var BatchCreateArtifactsInput rpcpb.BatchCreateArtifactsRequest
+
+var BatchCreateArtifactsFromFile string
+
+var BatchCreateArtifactsInputRequests []string
+
+func init() {
+	RegistryServiceCmd.AddCommand(BatchCreateArtifactsCmd)
+
+	BatchCreateArtifactsCmd.Flags().StringVar(&BatchCreateArtifactsInput.Parent, "parent", "", "Required. The parent of the artifacts. Resource...")
+
+	BatchCreateArtifactsCmd.Flags().StringArrayVar(&BatchCreateArtifactsInputRequests, "requests", []string{}, "Required. The requests for the artifacts to...")
+
+	BatchCreateArtifactsCmd.Flags().StringVar(&BatchCreateArtifactsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var BatchCreateArtifactsCmd = &cobra.Command{
+	Use:   "batch-create-artifacts",
+	Short: "BatchCreateArtifacts creates multiple artifacts...",
+	Long:  "BatchCreateArtifacts creates multiple artifacts in a single transaction.  Requests that fail are reported in the results without preventing the ...",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if BatchCreateArtifactsFromFile == "" {
+
+			cmd.MarkFlagRequired("parent")
+
+			cmd.MarkFlagRequired("requests")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if BatchCreateArtifactsFromFile != "" {
+			in, err = os.Open(BatchCreateArtifactsFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &BatchCreateArtifactsInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		// unmarshal JSON strings into slice of structs
+		for _, item := range BatchCreateArtifactsInputRequests {
+			tmp := rpcpb.CreateArtifactRequest{}
+			err = jsonpb.UnmarshalString(item, &tmp)
+			if err != nil {
+				return
+			}
+
+			BatchCreateArtifactsInput.Requests = append(BatchCreateArtifactsInput.Requests, &tmp)
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "BatchCreateArtifacts", &BatchCreateArtifactsInput)
+		}
+		resp, err := RegistryClient.BatchCreateArtifacts(ctx, &BatchCreateArtifactsInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
@@ -0,0 +1,92 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var BatchDeleteApiSpecsInput rpcpb.BatchDeleteApiSpecsRequest
+
+var BatchDeleteApiSpecsFromFile string
+
+var BatchDeleteApiSpecsInputRequests []string
+
+func init() {
+	RegistryServiceCmd.AddCommand(BatchDeleteApiSpecsCmd)
+
+	BatchDeleteApiSpecsCmd.Flags().StringVar(&BatchDeleteApiSpecsInput.Parent, "parent", "", "Required. The parent of the specs. Resource IDs...")
+
+	BatchDeleteApiSpecsCmd.Flags().StringArrayVar(&BatchDeleteApiSpecsInputRequests, "requests", []string{}, "Required. The requests for the specs to delete,...")
+
+	BatchDeleteApiSpecsCmd.Flags().StringVar(&BatchDeleteApiSpecsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var BatchDeleteApiSpecsCmd = &cobra.Command{
+	Use:   "batch-delete-api-specs",
+	Short: "BatchDeleteApiSpecs removes multiple specs in a...",
+	Long:  "BatchDeleteApiSpecs removes multiple specs in a single transaction.  Requests that fail are reported in the results without preventing the  others...",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if BatchDeleteApiSpecsFromFile == "" {
+
+			cmd.MarkFlagRequired("parent")
+
+			cmd.MarkFlagRequired("requests")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if BatchDeleteApiSpecsFromFile != "" {
+			in, err = os.Open(BatchDeleteApiSpecsFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &BatchDeleteApiSpecsInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		// unmarshal JSON strings into slice of structs
+		for _, item := range BatchDeleteApiSpecsInputRequests {
+			tmp := rpcpb.DeleteApiSpecRequest{}
+			err = jsonpb.UnmarshalString(item, &tmp)
+			if err != nil {
+				return
+			}
+
+			BatchDeleteApiSpecsInput.Requests = append(BatchDeleteApiSpecsInput.Requests, &tmp)
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "BatchDeleteApiSpecs", &BatchDeleteApiSpecsInput)
+		}
+		resp, err := RegistryClient.BatchDeleteApiSpecs(ctx, &BatchDeleteApiSpecsInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
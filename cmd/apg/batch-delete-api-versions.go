@@ -0,0 +1,92 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var BatchDeleteApiVersionsInput rpcpb.BatchDeleteApiVersionsRequest
+
+var BatchDeleteApiVersionsFromFile string
+
+var BatchDeleteApiVersionsInputRequests []string
+
+func init() {
+	RegistryServiceCmd.AddCommand(BatchDeleteApiVersionsCmd)
+
+	BatchDeleteApiVersionsCmd.Flags().StringVar(&BatchDeleteApiVersionsInput.Parent, "parent", "", "Required. The parent of the versions. Resource...")
+
+	BatchDeleteApiVersionsCmd.Flags().StringArrayVar(&BatchDeleteApiVersionsInputRequests, "requests", []string{}, "Required. The requests for the versions to...")
+
+	BatchDeleteApiVersionsCmd.Flags().StringVar(&BatchDeleteApiVersionsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var BatchDeleteApiVersionsCmd = &cobra.Command{
+	Use:   "batch-delete-api-versions",
+	Short: "BatchDeleteApiVersions removes multiple versions...",
+	Long:  "BatchDeleteApiVersions removes multiple versions in a single transaction.  Requests that fail are reported in the results without preventing the ...",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if BatchDeleteApiVersionsFromFile == "" {
+
+			cmd.MarkFlagRequired("parent")
+
+			cmd.MarkFlagRequired("requests")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if BatchDeleteApiVersionsFromFile != "" {
+			in, err = os.Open(BatchDeleteApiVersionsFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &BatchDeleteApiVersionsInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		// unmarshal JSON strings into slice of structs
+		for _, item := range BatchDeleteApiVersionsInputRequests {
+			tmp := rpcpb.DeleteApiVersionRequest{}
+			err = jsonpb.UnmarshalString(item, &tmp)
+			if err != nil {
+				return
+			}
+
+			BatchDeleteApiVersionsInput.Requests = append(BatchDeleteApiVersionsInput.Requests, &tmp)
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "BatchDeleteApiVersions", &BatchDeleteApiVersionsInput)
+		}
+		resp, err := RegistryClient.BatchDeleteApiVersions(ctx, &BatchDeleteApiVersionsInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
@@ -0,0 +1,92 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var BatchDeleteApisInput rpcpb.BatchDeleteApisRequest
+
+var BatchDeleteApisFromFile string
+
+var BatchDeleteApisInputRequests []string
+
+func init() {
+	RegistryServiceCmd.AddCommand(BatchDeleteApisCmd)
+
+	BatchDeleteApisCmd.Flags().StringVar(&BatchDeleteApisInput.Parent, "parent", "", "Required. The parent of the APIs. Resource IDs in...")
+
+	BatchDeleteApisCmd.Flags().StringArrayVar(&BatchDeleteApisInputRequests, "requests", []string{}, "Required. The requests for the APIs to delete,...")
+
+	BatchDeleteApisCmd.Flags().StringVar(&BatchDeleteApisFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var BatchDeleteApisCmd = &cobra.Command{
+	Use:   "batch-delete-apis",
+	Short: "BatchDeleteApis removes multiple APIs in a single...",
+	Long:  "BatchDeleteApis removes multiple APIs in a single transaction.  Requests that fail are reported in the results without preventing the  others from...",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if BatchDeleteApisFromFile == "" {
+
+			cmd.MarkFlagRequired("parent")
+
+			cmd.MarkFlagRequired("requests")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if BatchDeleteApisFromFile != "" {
+			in, err = os.Open(BatchDeleteApisFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &BatchDeleteApisInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		// unmarshal JSON strings into slice of structs
+		for _, item := range BatchDeleteApisInputRequests {
+			tmp := rpcpb.DeleteApiRequest{}
+			err = jsonpb.UnmarshalString(item, &tmp)
+			if err != nil {
+				return
+			}
+
+			BatchDeleteApisInput.Requests = append(BatchDeleteApisInput.Requests, &tmp)
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "BatchDeleteApis", &BatchDeleteApisInput)
+		}
+		resp, err := RegistryClient.BatchDeleteApis(ctx, &BatchDeleteApisInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
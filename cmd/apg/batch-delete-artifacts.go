@@ -0,0 +1,92 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var BatchDeleteArtifactsInput rpcpb.BatchDeleteArtifactsRequest
+
+var BatchDeleteArtifactsFromFile string
+
+var BatchDeleteArtifactsInputRequests []string
+
+func init() {
+	RegistryServiceCmd.AddCommand(BatchDeleteArtifactsCmd)
+
+	BatchDeleteArtifactsCmd.Flags().StringVar(&BatchDeleteArtifactsInput.Parent, "parent", "", "Required. The parent of the artifacts. Resource...")
+
+	BatchDeleteArtifactsCmd.Flags().StringArrayVar(&BatchDeleteArtifactsInputRequests, "requests", []string{}, "Required. The requests for the artifacts to...")
+
+	BatchDeleteArtifactsCmd.Flags().StringVar(&BatchDeleteArtifactsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var BatchDeleteArtifactsCmd = &cobra.Command{
+	Use:   "batch-delete-artifacts",
+	Short: "BatchDeleteArtifacts removes multiple artifacts...",
+	Long:  "BatchDeleteArtifacts removes multiple artifacts in a single transaction.  Requests that fail are reported in the results without preventing the ...",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if BatchDeleteArtifactsFromFile == "" {
+
+			cmd.MarkFlagRequired("parent")
+
+			cmd.MarkFlagRequired("requests")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if BatchDeleteArtifactsFromFile != "" {
+			in, err = os.Open(BatchDeleteArtifactsFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &BatchDeleteArtifactsInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		// unmarshal JSON strings into slice of structs
+		for _, item := range BatchDeleteArtifactsInputRequests {
+			tmp := rpcpb.DeleteArtifactRequest{}
+			err = jsonpb.UnmarshalString(item, &tmp)
+			if err != nil {
+				return
+			}
+
+			BatchDeleteArtifactsInput.Requests = append(BatchDeleteArtifactsInput.Requests, &tmp)
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "BatchDeleteArtifacts", &BatchDeleteArtifactsInput)
+		}
+		resp, err := RegistryClient.BatchDeleteArtifacts(ctx, &BatchDeleteArtifactsInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
@@ -0,0 +1,79 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var BatchGetApiSpecsInput rpcpb.BatchGetApiSpecsRequest
+
+var BatchGetApiSpecsFromFile string
+
+func init() {
+	RegistryServiceCmd.AddCommand(BatchGetApiSpecsCmd)
+
+	BatchGetApiSpecsCmd.Flags().StringVar(&BatchGetApiSpecsInput.Parent, "parent", "", "Required. The parent of the specs. Resource IDs...")
+
+	BatchGetApiSpecsCmd.Flags().StringSliceVar(&BatchGetApiSpecsInput.Names, "names", []string{}, "Required. The names of the specs to retrieve,...")
+
+	BatchGetApiSpecsCmd.Flags().StringVar(&BatchGetApiSpecsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var BatchGetApiSpecsCmd = &cobra.Command{
+	Use:   "batch-get-api-specs",
+	Short: "BatchGetApiSpecs returns multiple specified specs.",
+	Long:  "BatchGetApiSpecs returns multiple specified specs.",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if BatchGetApiSpecsFromFile == "" {
+
+			cmd.MarkFlagRequired("parent")
+
+			cmd.MarkFlagRequired("names")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if BatchGetApiSpecsFromFile != "" {
+			in, err = os.Open(BatchGetApiSpecsFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &BatchGetApiSpecsInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "BatchGetApiSpecs", &BatchGetApiSpecsInput)
+		}
+		resp, err := RegistryClient.BatchGetApiSpecs(ctx, &BatchGetApiSpecsInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
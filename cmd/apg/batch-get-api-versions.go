@@ -0,0 +1,79 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var BatchGetApiVersionsInput rpcpb.BatchGetApiVersionsRequest
+
+var BatchGetApiVersionsFromFile string
+
+func init() {
+	RegistryServiceCmd.AddCommand(BatchGetApiVersionsCmd)
+
+	BatchGetApiVersionsCmd.Flags().StringVar(&BatchGetApiVersionsInput.Parent, "parent", "", "Required. The parent of the versions. Resource...")
+
+	BatchGetApiVersionsCmd.Flags().StringSliceVar(&BatchGetApiVersionsInput.Names, "names", []string{}, "Required. The names of the versions to retrieve,...")
+
+	BatchGetApiVersionsCmd.Flags().StringVar(&BatchGetApiVersionsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var BatchGetApiVersionsCmd = &cobra.Command{
+	Use:   "batch-get-api-versions",
+	Short: "BatchGetApiVersions returns multiple specified...",
+	Long:  "BatchGetApiVersions returns multiple specified versions.",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if BatchGetApiVersionsFromFile == "" {
+
+			cmd.MarkFlagRequired("parent")
+
+			cmd.MarkFlagRequired("names")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if BatchGetApiVersionsFromFile != "" {
+			in, err = os.Open(BatchGetApiVersionsFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &BatchGetApiVersionsInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "BatchGetApiVersions", &BatchGetApiVersionsInput)
+		}
+		resp, err := RegistryClient.BatchGetApiVersions(ctx, &BatchGetApiVersionsInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
@@ -0,0 +1,79 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var BatchGetApisInput rpcpb.BatchGetApisRequest
+
+var BatchGetApisFromFile string
+
+func init() {
+	RegistryServiceCmd.AddCommand(BatchGetApisCmd)
+
+	BatchGetApisCmd.Flags().StringVar(&BatchGetApisInput.Parent, "parent", "", "Required. The parent of the APIs. Resource IDs in...")
+
+	BatchGetApisCmd.Flags().StringSliceVar(&BatchGetApisInput.Names, "names", []string{}, "Required. The names of the APIs to retrieve,...")
+
+	BatchGetApisCmd.Flags().StringVar(&BatchGetApisFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var BatchGetApisCmd = &cobra.Command{
+	Use:   "batch-get-apis",
+	Short: "BatchGetApis returns multiple specified APIs.",
+	Long:  "BatchGetApis returns multiple specified APIs.",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if BatchGetApisFromFile == "" {
+
+			cmd.MarkFlagRequired("parent")
+
+			cmd.MarkFlagRequired("names")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if BatchGetApisFromFile != "" {
+			in, err = os.Open(BatchGetApisFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &BatchGetApisInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "BatchGetApis", &BatchGetApisInput)
+		}
+		resp, err := RegistryClient.BatchGetApis(ctx, &BatchGetApisInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
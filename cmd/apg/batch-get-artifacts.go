@@ -0,0 +1,79 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var BatchGetArtifactsInput rpcpb.BatchGetArtifactsRequest
+
+var BatchGetArtifactsFromFile string
+
+func init() {
+	RegistryServiceCmd.AddCommand(BatchGetArtifactsCmd)
+
+	BatchGetArtifactsCmd.Flags().StringVar(&BatchGetArtifactsInput.Parent, "parent", "", "Required. The parent of the artifacts. Resource...")
+
+	BatchGetArtifactsCmd.Flags().StringSliceVar(&BatchGetArtifactsInput.Names, "names", []string{}, "Required. The names of the artifacts to retrieve,...")
+
+	BatchGetArtifactsCmd.Flags().StringVar(&BatchGetArtifactsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var BatchGetArtifactsCmd = &cobra.Command{
+	Use:   "batch-get-artifacts",
+	Short: "BatchGetArtifacts returns multiple specified...",
+	Long:  "BatchGetArtifacts returns multiple specified artifacts.",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if BatchGetArtifactsFromFile == "" {
+
+			cmd.MarkFlagRequired("parent")
+
+			cmd.MarkFlagRequired("names")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if BatchGetArtifactsFromFile != "" {
+			in, err = os.Open(BatchGetArtifactsFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &BatchGetArtifactsInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "BatchGetArtifacts", &BatchGetArtifactsInput)
+		}
+		resp, err := RegistryClient.BatchGetArtifacts(ctx, &BatchGetArtifactsInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
@@ -0,0 +1,92 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var BatchReplaceArtifactsInput rpcpb.BatchReplaceArtifactsRequest
+
+var BatchReplaceArtifactsFromFile string
+
+var BatchReplaceArtifactsInputRequests []string
+
+func init() {
+	RegistryServiceCmd.AddCommand(BatchReplaceArtifactsCmd)
+
+	BatchReplaceArtifactsCmd.Flags().StringVar(&BatchReplaceArtifactsInput.Parent, "parent", "", "Required. The parent of the artifacts. Resource...")
+
+	BatchReplaceArtifactsCmd.Flags().StringArrayVar(&BatchReplaceArtifactsInputRequests, "requests", []string{}, "Required. The requests for the artifacts to...")
+
+	BatchReplaceArtifactsCmd.Flags().StringVar(&BatchReplaceArtifactsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var BatchReplaceArtifactsCmd = &cobra.Command{
+	Use:   "batch-replace-artifacts",
+	Short: "BatchReplaceArtifacts replaces multiple artifacts...",
+	Long:  "BatchReplaceArtifacts replaces multiple artifacts in a single transaction.  Requests that fail are reported in the results without preventing the ...",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if BatchReplaceArtifactsFromFile == "" {
+
+			cmd.MarkFlagRequired("parent")
+
+			cmd.MarkFlagRequired("requests")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if BatchReplaceArtifactsFromFile != "" {
+			in, err = os.Open(BatchReplaceArtifactsFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &BatchReplaceArtifactsInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		// unmarshal JSON strings into slice of structs
+		for _, item := range BatchReplaceArtifactsInputRequests {
+			tmp := rpcpb.ReplaceArtifactRequest{}
+			err = jsonpb.UnmarshalString(item, &tmp)
+			if err != nil {
+				return
+			}
+
+			BatchReplaceArtifactsInput.Requests = append(BatchReplaceArtifactsInput.Requests, &tmp)
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "BatchReplaceArtifacts", &BatchReplaceArtifactsInput)
+		}
+		resp, err := RegistryClient.BatchReplaceArtifacts(ctx, &BatchReplaceArtifactsInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
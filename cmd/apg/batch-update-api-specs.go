@@ -0,0 +1,92 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var BatchUpdateApiSpecsInput rpcpb.BatchUpdateApiSpecsRequest
+
+var BatchUpdateApiSpecsFromFile string
+
+var BatchUpdateApiSpecsInputRequests []string
+
+func init() {
+	RegistryServiceCmd.AddCommand(BatchUpdateApiSpecsCmd)
+
+	BatchUpdateApiSpecsCmd.Flags().StringVar(&BatchUpdateApiSpecsInput.Parent, "parent", "", "Required. The parent of the specs. Resource IDs...")
+
+	BatchUpdateApiSpecsCmd.Flags().StringArrayVar(&BatchUpdateApiSpecsInputRequests, "requests", []string{}, "Required. The requests for the specs to update,...")
+
+	BatchUpdateApiSpecsCmd.Flags().StringVar(&BatchUpdateApiSpecsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var BatchUpdateApiSpecsCmd = &cobra.Command{
+	Use:   "batch-update-api-specs",
+	Short: "BatchUpdateApiSpecs modifies multiple specs in a...",
+	Long:  "BatchUpdateApiSpecs modifies multiple specs in a single transaction.  Requests that fail are reported in the results without preventing the  others...",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if BatchUpdateApiSpecsFromFile == "" {
+
+			cmd.MarkFlagRequired("parent")
+
+			cmd.MarkFlagRequired("requests")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if BatchUpdateApiSpecsFromFile != "" {
+			in, err = os.Open(BatchUpdateApiSpecsFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &BatchUpdateApiSpecsInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		// unmarshal JSON strings into slice of structs
+		for _, item := range BatchUpdateApiSpecsInputRequests {
+			tmp := rpcpb.UpdateApiSpecRequest{}
+			err = jsonpb.UnmarshalString(item, &tmp)
+			if err != nil {
+				return
+			}
+
+			BatchUpdateApiSpecsInput.Requests = append(BatchUpdateApiSpecsInput.Requests, &tmp)
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "BatchUpdateApiSpecs", &BatchUpdateApiSpecsInput)
+		}
+		resp, err := RegistryClient.BatchUpdateApiSpecs(ctx, &BatchUpdateApiSpecsInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
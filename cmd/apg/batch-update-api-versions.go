@@ -0,0 +1,92 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var BatchUpdateApiVersionsInput rpcpb.BatchUpdateApiVersionsRequest
+
+var BatchUpdateApiVersionsFromFile string
+
+var BatchUpdateApiVersionsInputRequests []string
+
+func init() {
+	RegistryServiceCmd.AddCommand(BatchUpdateApiVersionsCmd)
+
+	BatchUpdateApiVersionsCmd.Flags().StringVar(&BatchUpdateApiVersionsInput.Parent, "parent", "", "Required. The parent of the versions. Resource...")
+
+	BatchUpdateApiVersionsCmd.Flags().StringArrayVar(&BatchUpdateApiVersionsInputRequests, "requests", []string{}, "Required. The requests for the versions to...")
+
+	BatchUpdateApiVersionsCmd.Flags().StringVar(&BatchUpdateApiVersionsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var BatchUpdateApiVersionsCmd = &cobra.Command{
+	Use:   "batch-update-api-versions",
+	Short: "BatchUpdateApiVersions modifies multiple versions...",
+	Long:  "BatchUpdateApiVersions modifies multiple versions in a single transaction.  Requests that fail are reported in the results without preventing the ...",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if BatchUpdateApiVersionsFromFile == "" {
+
+			cmd.MarkFlagRequired("parent")
+
+			cmd.MarkFlagRequired("requests")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if BatchUpdateApiVersionsFromFile != "" {
+			in, err = os.Open(BatchUpdateApiVersionsFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &BatchUpdateApiVersionsInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		// unmarshal JSON strings into slice of structs
+		for _, item := range BatchUpdateApiVersionsInputRequests {
+			tmp := rpcpb.UpdateApiVersionRequest{}
+			err = jsonpb.UnmarshalString(item, &tmp)
+			if err != nil {
+				return
+			}
+
+			BatchUpdateApiVersionsInput.Requests = append(BatchUpdateApiVersionsInput.Requests, &tmp)
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "BatchUpdateApiVersions", &BatchUpdateApiVersionsInput)
+		}
+		resp, err := RegistryClient.BatchUpdateApiVersions(ctx, &BatchUpdateApiVersionsInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
@@ -0,0 +1,92 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var BatchUpdateApisInput rpcpb.BatchUpdateApisRequest
+
+var BatchUpdateApisFromFile string
+
+var BatchUpdateApisInputRequests []string
+
+func init() {
+	RegistryServiceCmd.AddCommand(BatchUpdateApisCmd)
+
+	BatchUpdateApisCmd.Flags().StringVar(&BatchUpdateApisInput.Parent, "parent", "", "Required. The parent of the APIs. Resource IDs in...")
+
+	BatchUpdateApisCmd.Flags().StringArrayVar(&BatchUpdateApisInputRequests, "requests", []string{}, "Required. The requests for the APIs to update,...")
+
+	BatchUpdateApisCmd.Flags().StringVar(&BatchUpdateApisFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var BatchUpdateApisCmd = &cobra.Command{
+	Use:   "batch-update-apis",
+	Short: "BatchUpdateApis modifies multiple APIs in a...",
+	Long:  "BatchUpdateApis modifies multiple APIs in a single transaction.  Requests that fail are reported in the results without preventing the  others from...",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if BatchUpdateApisFromFile == "" {
+
+			cmd.MarkFlagRequired("parent")
+
+			cmd.MarkFlagRequired("requests")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if BatchUpdateApisFromFile != "" {
+			in, err = os.Open(BatchUpdateApisFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &BatchUpdateApisInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		// unmarshal JSON strings into slice of structs
+		for _, item := range BatchUpdateApisInputRequests {
+			tmp := rpcpb.UpdateApiRequest{}
+			err = jsonpb.UnmarshalString(item, &tmp)
+			if err != nil {
+				return
+			}
+
+			BatchUpdateApisInput.Requests = append(BatchUpdateApisInput.Requests, &tmp)
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "BatchUpdateApis", &BatchUpdateApisInput)
+		}
+		resp, err := RegistryClient.BatchUpdateApis(ctx, &BatchUpdateApisInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
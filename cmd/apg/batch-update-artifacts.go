@@ -0,0 +1,92 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var BatchUpdateArtifactsInput rpcpb.BatchUpdateArtifactsRequest
+
+var BatchUpdateArtifactsFromFile string
+
+var BatchUpdateArtifactsInputRequests []string
+
+func init() {
+	RegistryServiceCmd.AddCommand(BatchUpdateArtifactsCmd)
+
+	BatchUpdateArtifactsCmd.Flags().StringVar(&BatchUpdateArtifactsInput.Parent, "parent", "", "Required. The parent of the artifacts. Resource...")
+
+	BatchUpdateArtifactsCmd.Flags().StringArrayVar(&BatchUpdateArtifactsInputRequests, "requests", []string{}, "Required. The requests for the artifacts to...")
+
+	BatchUpdateArtifactsCmd.Flags().StringVar(&BatchUpdateArtifactsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var BatchUpdateArtifactsCmd = &cobra.Command{
+	Use:   "batch-update-artifacts",
+	Short: "BatchUpdateArtifacts modifies the labels and...",
+	Long:  "BatchUpdateArtifacts modifies the labels and annotations of multiple  artifacts in a single transaction. Requests that fail are reported in the ...",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if BatchUpdateArtifactsFromFile == "" {
+
+			cmd.MarkFlagRequired("parent")
+
+			cmd.MarkFlagRequired("requests")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if BatchUpdateArtifactsFromFile != "" {
+			in, err = os.Open(BatchUpdateArtifactsFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &BatchUpdateArtifactsInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		// unmarshal JSON strings into slice of structs
+		for _, item := range BatchUpdateArtifactsInputRequests {
+			tmp := rpcpb.UpdateArtifactRequest{}
+			err = jsonpb.UnmarshalString(item, &tmp)
+			if err != nil {
+				return
+			}
+
+			BatchUpdateArtifactsInput.Requests = append(BatchUpdateArtifactsInput.Requests, &tmp)
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "BatchUpdateArtifacts", &BatchUpdateArtifactsInput)
+		}
+		resp, err := RegistryClient.BatchUpdateArtifacts(ctx, &BatchUpdateArtifactsInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
@@ -51,6 +51,8 @@ func init() {
 
 	CreateApiDeploymentCmd.Flags().StringArrayVar(&CreateApiDeploymentInputApiDeploymentAnnotations, "api_deployment.annotations", []string{}, "key=value pairs. Annotations attach non-identifying metadata to...")
 
+	CreateApiDeploymentCmd.Flags().StringVar(&CreateApiDeploymentInput.ApiDeployment.Etag, "api_deployment.etag", "", "A checksum computed by the server from the stored...")
+
 	CreateApiDeploymentCmd.Flags().StringVar(&CreateApiDeploymentInput.ApiDeploymentId, "api_deployment_id", "", "Required. The ID to use for the deployment, which...")
 
 	CreateApiDeploymentCmd.Flags().StringVar(&CreateApiDeploymentFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
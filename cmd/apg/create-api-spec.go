@@ -47,6 +47,8 @@ func init() {
 
 	CreateApiSpecCmd.Flags().StringArrayVar(&CreateApiSpecInputApiSpecAnnotations, "api_spec.annotations", []string{}, "key=value pairs. Annotations attach non-identifying metadata to...")
 
+	CreateApiSpecCmd.Flags().StringVar(&CreateApiSpecInput.ApiSpec.Etag, "api_spec.etag", "", "A checksum computed by the server from the stored...")
+
 	CreateApiSpecCmd.Flags().StringVar(&CreateApiSpecInput.ApiSpecId, "api_spec_id", "", "Required. The ID to use for the spec, which will...")
 
 	CreateApiSpecCmd.Flags().StringVar(&CreateApiSpecFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
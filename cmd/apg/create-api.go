@@ -47,6 +47,8 @@ func init() {
 
 	CreateApiCmd.Flags().StringArrayVar(&CreateApiInputApiAnnotations, "api.annotations", []string{}, "key=value pairs. Annotations attach non-identifying metadata to...")
 
+	CreateApiCmd.Flags().StringVar(&CreateApiInput.Api.Etag, "api.etag", "", "A checksum computed by the server from the stored...")
+
 	CreateApiCmd.Flags().StringVar(&CreateApiInput.ApiId, "api_id", "", "Required. The ID to use for the api, which will...")
 
 	CreateApiCmd.Flags().StringVar(&CreateApiFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
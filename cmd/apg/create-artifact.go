@@ -12,12 +12,18 @@ import (
 	"os"
 
 	rpcpb "github.com/apigee/registry/rpc"
+
+	"strings"
 )
 
 var CreateArtifactInput rpcpb.CreateArtifactRequest
 
 var CreateArtifactFromFile string
 
+var CreateArtifactInputArtifactLabels []string
+
+var CreateArtifactInputArtifactAnnotations []string
+
 func init() {
 	RegistryServiceCmd.AddCommand(CreateArtifactCmd)
 
@@ -31,6 +37,12 @@ func init() {
 
 	CreateArtifactCmd.Flags().BytesHexVar(&CreateArtifactInput.Artifact.Contents, "artifact.contents", []byte{}, "Input only. The contents of the artifact. ...")
 
+	CreateArtifactCmd.Flags().StringArrayVar(&CreateArtifactInputArtifactLabels, "artifact.labels", []string{}, "key=value pairs. Labels attach identifying metadata to resources....")
+
+	CreateArtifactCmd.Flags().StringArrayVar(&CreateArtifactInputArtifactAnnotations, "artifact.annotations", []string{}, "key=value pairs. Annotations attach non-identifying metadata to...")
+
+	CreateArtifactCmd.Flags().StringVar(&CreateArtifactInput.Artifact.Etag, "artifact.etag", "", "A checksum computed by the server from the stored...")
+
 	CreateArtifactCmd.Flags().StringVar(&CreateArtifactInput.ArtifactId, "artifact_id", "", "Required. The ID to use for the artifact, which...")
 
 	CreateArtifactCmd.Flags().StringVar(&CreateArtifactFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
@@ -69,6 +81,32 @@ var CreateArtifactCmd = &cobra.Command{
 
 		}
 
+		if len(CreateArtifactInputArtifactLabels) > 0 {
+			CreateArtifactInput.Artifact.Labels = make(map[string]string)
+		}
+		for _, item := range CreateArtifactInputArtifactLabels {
+			split := strings.Split(item, "=")
+			if len(split) < 2 {
+				err = fmt.Errorf("Invalid map item: %q", item)
+				return
+			}
+
+			CreateArtifactInput.Artifact.Labels[split[0]] = split[1]
+		}
+
+		if len(CreateArtifactInputArtifactAnnotations) > 0 {
+			CreateArtifactInput.Artifact.Annotations = make(map[string]string)
+		}
+		for _, item := range CreateArtifactInputArtifactAnnotations {
+			split := strings.Split(item, "=")
+			if len(split) < 2 {
+				err = fmt.Errorf("Invalid map item: %q", item)
+				return
+			}
+
+			CreateArtifactInput.Artifact.Annotations[split[0]] = split[1]
+		}
+
 		if Verbose {
 			printVerboseInput("Registry", "CreateArtifact", &CreateArtifactInput)
 		}
@@ -29,6 +29,10 @@ func init() {
 
 	CreateProjectCmd.Flags().StringVar(&CreateProjectInput.Project.Description, "project.description", "", "A detailed description.")
 
+	CreateProjectCmd.Flags().Int32Var(&CreateProjectInput.Project.ArtifactRevisionLimit, "project.artifact_revision_limit", 0, "The maximum number of revisions to retain for...")
+
+	CreateProjectCmd.Flags().StringVar(&CreateProjectInput.Project.Etag, "project.etag", "", "A checksum computed by the server from the stored...")
+
 	CreateProjectCmd.Flags().StringVar(&CreateProjectInput.ProjectId, "project_id", "", "The ID to use for the project, which will become...")
 
 	CreateProjectCmd.Flags().StringVar(&CreateProjectFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
@@ -23,6 +23,8 @@ func init() {
 
 	DeleteApiDeploymentCmd.Flags().BoolVar(&DeleteApiDeploymentInput.Force, "force", false, "If set to true, any child resources will also be...")
 
+	DeleteApiDeploymentCmd.Flags().StringVar(&DeleteApiDeploymentInput.Etag, "etag", "", "The etag of the deployment, as returned by a...")
+
 	DeleteApiDeploymentCmd.Flags().StringVar(&DeleteApiDeploymentFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
 
 }
@@ -0,0 +1,75 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var DeleteArtifactRevisionInput rpcpb.DeleteArtifactRevisionRequest
+
+var DeleteArtifactRevisionFromFile string
+
+func init() {
+	RegistryServiceCmd.AddCommand(DeleteArtifactRevisionCmd)
+
+	DeleteArtifactRevisionCmd.Flags().StringVar(&DeleteArtifactRevisionInput.Name, "name", "", "Required. The name of the artifact revision to be...")
+
+	DeleteArtifactRevisionCmd.Flags().StringVar(&DeleteArtifactRevisionFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var DeleteArtifactRevisionCmd = &cobra.Command{
+	Use:   "delete-artifact-revision",
+	Short: "DeleteArtifactRevision deletes a revision of an...",
+	Long:  "DeleteArtifactRevision deletes a revision of an artifact.",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if DeleteArtifactRevisionFromFile == "" {
+
+			cmd.MarkFlagRequired("name")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if DeleteArtifactRevisionFromFile != "" {
+			in, err = os.Open(DeleteArtifactRevisionFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &DeleteArtifactRevisionInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "DeleteArtifactRevision", &DeleteArtifactRevisionInput)
+		}
+		resp, err := RegistryClient.DeleteArtifactRevision(ctx, &DeleteArtifactRevisionInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
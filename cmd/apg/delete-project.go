@@ -23,6 +23,8 @@ func init() {
 
 	DeleteProjectCmd.Flags().BoolVar(&DeleteProjectInput.Force, "force", false, "If set to true, any child resources will also be...")
 
+	DeleteProjectCmd.Flags().StringVar(&DeleteProjectInput.Etag, "etag", "", "The etag of the project, as returned by a...")
+
 	DeleteProjectCmd.Flags().StringVar(&DeleteProjectFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
 
 }
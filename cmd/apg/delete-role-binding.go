@@ -0,0 +1,70 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var DeleteRoleBindingInput rpcpb.DeleteRoleBindingRequest
+
+var DeleteRoleBindingFromFile string
+
+func init() {
+	AdminServiceCmd.AddCommand(DeleteRoleBindingCmd)
+
+	DeleteRoleBindingCmd.Flags().StringVar(&DeleteRoleBindingInput.Project, "project", "", "The project of the binding to delete.  Format:...")
+
+	DeleteRoleBindingCmd.Flags().StringVar(&DeleteRoleBindingInput.Principal, "principal", "", "Required. The principal of the binding to delete.")
+
+	DeleteRoleBindingCmd.Flags().StringVar(&DeleteRoleBindingFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var DeleteRoleBindingCmd = &cobra.Command{
+	Use:   "delete-role-binding",
+	Short: "DeleteRoleBinding removes the role that a...",
+	Long:  "DeleteRoleBinding removes the role that a principal has been granted.  (-- api-linter: core::0135::http-uri-name=disabled      aip.dev/not-precedent:...",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if DeleteRoleBindingFromFile == "" {
+
+			cmd.MarkFlagRequired("principal")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if DeleteRoleBindingFromFile != "" {
+			in, err = os.Open(DeleteRoleBindingFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &DeleteRoleBindingInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		if Verbose {
+			printVerboseInput("Admin", "DeleteRoleBinding", &DeleteRoleBindingInput)
+		}
+		err = AdminClient.DeleteRoleBinding(ctx, &DeleteRoleBindingInput)
+		if err != nil {
+			return err
+		}
+
+		return err
+	},
+}
@@ -0,0 +1,89 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"io"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var DownloadApiSpecContentsInput rpcpb.DownloadApiSpecContentsRequest
+
+var DownloadApiSpecContentsFromFile string
+
+func init() {
+	RegistryServiceCmd.AddCommand(DownloadApiSpecContentsCmd)
+
+	DownloadApiSpecContentsCmd.Flags().StringVar(&DownloadApiSpecContentsInput.Name, "name", "", "Required. The name of the spec whose contents...")
+
+	DownloadApiSpecContentsCmd.Flags().StringVar(&DownloadApiSpecContentsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var DownloadApiSpecContentsCmd = &cobra.Command{
+	Use:   "download-api-spec-contents",
+	Short: "DownloadApiSpecContents returns the contents of a...",
+	Long:  "DownloadApiSpecContents returns the contents of a specified spec in a  stream of chunks. Contents are returned in the format returned by ...",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if DownloadApiSpecContentsFromFile == "" {
+
+			cmd.MarkFlagRequired("name")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if DownloadApiSpecContentsFromFile != "" {
+			in, err = os.Open(DownloadApiSpecContentsFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &DownloadApiSpecContentsInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "DownloadApiSpecContents", &DownloadApiSpecContentsInput)
+		}
+		resp, err := RegistryClient.DownloadApiSpecContents(ctx, &DownloadApiSpecContentsInput)
+		if err != nil {
+			return err
+		}
+
+		var item *rpcpb.ContentsChunk
+		for {
+			item, err = resp.Recv()
+			if err != nil {
+				break
+			}
+
+			if Verbose {
+				fmt.Print("Output: ")
+			}
+			printMessage(item)
+		}
+
+		if err == io.EOF {
+			return nil
+		}
+
+		return err
+	},
+}
@@ -0,0 +1,89 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"io"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var DownloadArtifactContentsInput rpcpb.DownloadArtifactContentsRequest
+
+var DownloadArtifactContentsFromFile string
+
+func init() {
+	RegistryServiceCmd.AddCommand(DownloadArtifactContentsCmd)
+
+	DownloadArtifactContentsCmd.Flags().StringVar(&DownloadArtifactContentsInput.Name, "name", "", "Required. The name of the artifact whose contents...")
+
+	DownloadArtifactContentsCmd.Flags().StringVar(&DownloadArtifactContentsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var DownloadArtifactContentsCmd = &cobra.Command{
+	Use:   "download-artifact-contents",
+	Short: "DownloadArtifactContents returns the contents of...",
+	Long:  "DownloadArtifactContents returns the contents of a specified artifact in  a stream of chunks.",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if DownloadArtifactContentsFromFile == "" {
+
+			cmd.MarkFlagRequired("name")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if DownloadArtifactContentsFromFile != "" {
+			in, err = os.Open(DownloadArtifactContentsFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &DownloadArtifactContentsInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "DownloadArtifactContents", &DownloadArtifactContentsInput)
+		}
+		resp, err := RegistryClient.DownloadArtifactContents(ctx, &DownloadArtifactContentsInput)
+		if err != nil {
+			return err
+		}
+
+		var item *rpcpb.ContentsChunk
+		for {
+			item, err = resp.Recv()
+			if err != nil {
+				break
+			}
+
+			if Verbose {
+				fmt.Print("Output: ")
+			}
+			printMessage(item)
+		}
+
+		if err == io.EOF {
+			return nil
+		}
+
+		return err
+	},
+}
@@ -31,6 +31,10 @@ func init() {
 
 	ListApiDeploymentsCmd.Flags().StringVar(&ListApiDeploymentsInput.Filter, "filter", "", "An expression that can be used to filter the...")
 
+	ListApiDeploymentsCmd.Flags().StringVar(&ListApiDeploymentsInput.OrderBy, "order_by", "", "A comma-separated list of fields used to sort the...")
+
+	ListApiDeploymentsCmd.Flags().BoolVar(&ListApiDeploymentsInput.ShowDeleted, "show_deleted", false, "If set to true, deleted deployments that haven't...")
+
 	ListApiDeploymentsCmd.Flags().StringVar(&ListApiDeploymentsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
 
 }
@@ -31,6 +31,10 @@ func init() {
 
 	ListApiSpecsCmd.Flags().StringVar(&ListApiSpecsInput.Filter, "filter", "", "An expression that can be used to filter the...")
 
+	ListApiSpecsCmd.Flags().StringVar(&ListApiSpecsInput.OrderBy, "order_by", "", "A comma-separated list of fields used to sort the...")
+
+	ListApiSpecsCmd.Flags().BoolVar(&ListApiSpecsInput.ShowDeleted, "show_deleted", false, "If set to true, deleted specs that haven't been...")
+
 	ListApiSpecsCmd.Flags().StringVar(&ListApiSpecsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
 
 }
@@ -31,6 +31,10 @@ func init() {
 
 	ListApiVersionsCmd.Flags().StringVar(&ListApiVersionsInput.Filter, "filter", "", "An expression that can be used to filter the...")
 
+	ListApiVersionsCmd.Flags().StringVar(&ListApiVersionsInput.OrderBy, "order_by", "", "A comma-separated list of fields used to sort the...")
+
+	ListApiVersionsCmd.Flags().BoolVar(&ListApiVersionsInput.ShowDeleted, "show_deleted", false, "If set to true, deleted versions that haven't...")
+
 	ListApiVersionsCmd.Flags().StringVar(&ListApiVersionsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
 
 }
@@ -31,6 +31,10 @@ func init() {
 
 	ListApisCmd.Flags().StringVar(&ListApisInput.Filter, "filter", "", "An expression that can be used to filter the...")
 
+	ListApisCmd.Flags().StringVar(&ListApisInput.OrderBy, "order_by", "", "A comma-separated list of fields used to sort the...")
+
+	ListApisCmd.Flags().BoolVar(&ListApisInput.ShowDeleted, "show_deleted", false, "If set to true, deleted APIs that haven't been...")
+
 	ListApisCmd.Flags().StringVar(&ListApisFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
 
 }
@@ -0,0 +1,84 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"google.golang.org/api/iterator"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var ListArtifactRevisionsInput rpcpb.ListArtifactRevisionsRequest
+
+var ListArtifactRevisionsFromFile string
+
+func init() {
+	RegistryServiceCmd.AddCommand(ListArtifactRevisionsCmd)
+
+	ListArtifactRevisionsCmd.Flags().StringVar(&ListArtifactRevisionsInput.Name, "name", "", "Required. The name of the artifact to list...")
+
+	ListArtifactRevisionsCmd.Flags().Int32Var(&ListArtifactRevisionsInput.PageSize, "page_size", 10, "Default is 10. The maximum number of revisions to return per...")
+
+	ListArtifactRevisionsCmd.Flags().StringVar(&ListArtifactRevisionsInput.PageToken, "page_token", "", "The page token, received from a previous...")
+
+	ListArtifactRevisionsCmd.Flags().StringVar(&ListArtifactRevisionsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var ListArtifactRevisionsCmd = &cobra.Command{
+	Use:   "list-artifact-revisions",
+	Short: "ListArtifactRevisions lists all revisions of an...",
+	Long:  "ListArtifactRevisions lists all revisions of an artifact.  Revisions are returned in descending order of revision creation time.",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if ListArtifactRevisionsFromFile == "" {
+
+			cmd.MarkFlagRequired("name")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if ListArtifactRevisionsFromFile != "" {
+			in, err = os.Open(ListArtifactRevisionsFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &ListArtifactRevisionsInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "ListArtifactRevisions", &ListArtifactRevisionsInput)
+		}
+		iter := RegistryClient.ListArtifactRevisions(ctx, &ListArtifactRevisionsInput)
+
+		// populate iterator with a page
+		_, err = iter.Next()
+		if err != nil && err != iterator.Done {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(iter.Response)
+
+		return err
+	},
+}
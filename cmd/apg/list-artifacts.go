@@ -31,6 +31,10 @@ func init() {
 
 	ListArtifactsCmd.Flags().StringVar(&ListArtifactsInput.Filter, "filter", "", "An expression that can be used to filter the...")
 
+	ListArtifactsCmd.Flags().StringVar(&ListArtifactsInput.OrderBy, "order_by", "", "A comma-separated list of fields used to sort the...")
+
+	ListArtifactsCmd.Flags().BoolVar(&ListArtifactsInput.ShowDeleted, "show_deleted", false, "If set to true, deleted artifacts that haven't...")
+
 	ListArtifactsCmd.Flags().StringVar(&ListArtifactsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
 
 }
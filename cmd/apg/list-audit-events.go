@@ -0,0 +1,100 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"google.golang.org/api/iterator"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var ListAuditEventsInput rpcpb.ListAuditEventsRequest
+
+var ListAuditEventsFromFile string
+
+func init() {
+	AdminServiceCmd.AddCommand(ListAuditEventsCmd)
+
+	ListAuditEventsInput.StartTime = new(timestamppb.Timestamp)
+
+	ListAuditEventsInput.EndTime = new(timestamppb.Timestamp)
+
+	ListAuditEventsCmd.Flags().Int32Var(&ListAuditEventsInput.PageSize, "page_size", 10, "Default is 10. The maximum number of events to return.  The...")
+
+	ListAuditEventsCmd.Flags().StringVar(&ListAuditEventsInput.PageToken, "page_token", "", "A page token, received from a previous...")
+
+	ListAuditEventsCmd.Flags().StringVar(&ListAuditEventsInput.Pattern, "pattern", "", "If set, only events for resources matching this...")
+
+	ListAuditEventsCmd.Flags().StringVar(&ListAuditEventsInput.Principal, "principal", "", "If set, only events for calls made by this...")
+
+	ListAuditEventsCmd.Flags().Int64Var(&ListAuditEventsInput.StartTime.Seconds, "start_time.seconds", 0, "")
+
+	ListAuditEventsCmd.Flags().Int32Var(&ListAuditEventsInput.StartTime.Nanos, "start_time.nanos", 0, "")
+
+	ListAuditEventsCmd.Flags().Int64Var(&ListAuditEventsInput.EndTime.Seconds, "end_time.seconds", 0, "")
+
+	ListAuditEventsCmd.Flags().Int32Var(&ListAuditEventsInput.EndTime.Nanos, "end_time.nanos", 0, "")
+
+	ListAuditEventsCmd.Flags().StringVar(&ListAuditEventsInput.Filter, "filter", "", "An expression that can be used to filter the...")
+
+	ListAuditEventsCmd.Flags().StringVar(&ListAuditEventsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var ListAuditEventsCmd = &cobra.Command{
+	Use:   "list-audit-events",
+	Short: "ListAuditEvents returns records of the calls that...",
+	Long:  "ListAuditEvents returns records of the calls that changed, or attempted to  change, the registry.  (-- api-linter:...",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if ListAuditEventsFromFile == "" {
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if ListAuditEventsFromFile != "" {
+			in, err = os.Open(ListAuditEventsFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &ListAuditEventsInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		if Verbose {
+			printVerboseInput("Admin", "ListAuditEvents", &ListAuditEventsInput)
+		}
+		iter := AdminClient.ListAuditEvents(ctx, &ListAuditEventsInput)
+
+		// populate iterator with a page
+		_, err = iter.Next()
+		if err != nil && err != iterator.Done {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(iter.Response)
+
+		return err
+	},
+}
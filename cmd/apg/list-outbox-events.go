@@ -0,0 +1,84 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"google.golang.org/api/iterator"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var ListOutboxEventsInput rpcpb.ListOutboxEventsRequest
+
+var ListOutboxEventsFromFile string
+
+func init() {
+	AdminServiceCmd.AddCommand(ListOutboxEventsCmd)
+
+	ListOutboxEventsCmd.Flags().Int32Var(&ListOutboxEventsInput.PageSize, "page_size", 10, "Default is 10. The maximum number of events to return.  The...")
+
+	ListOutboxEventsCmd.Flags().StringVar(&ListOutboxEventsInput.PageToken, "page_token", "", "A page token, received from a previous...")
+
+	ListOutboxEventsCmd.Flags().StringVar(&ListOutboxEventsInput.Filter, "filter", "", "An expression that can be used to filter the...")
+
+	ListOutboxEventsCmd.Flags().BoolVar(&ListOutboxEventsInput.IncludeDelivered, "include_delivered", false, "If true, events that have been delivered are also...")
+
+	ListOutboxEventsCmd.Flags().StringVar(&ListOutboxEventsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var ListOutboxEventsCmd = &cobra.Command{
+	Use:   "list-outbox-events",
+	Short: "ListOutboxEvents returns notifications recorded...",
+	Long:  "ListOutboxEvents returns notifications recorded in the outbox.  By default, only notifications that haven't been delivered are listed.  (--...",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if ListOutboxEventsFromFile == "" {
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if ListOutboxEventsFromFile != "" {
+			in, err = os.Open(ListOutboxEventsFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &ListOutboxEventsInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		if Verbose {
+			printVerboseInput("Admin", "ListOutboxEvents", &ListOutboxEventsInput)
+		}
+		iter := AdminClient.ListOutboxEvents(ctx, &ListOutboxEventsInput)
+
+		// populate iterator with a page
+		_, err = iter.Next()
+		if err != nil && err != iterator.Done {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(iter.Response)
+
+		return err
+	},
+}
@@ -29,6 +29,10 @@ func init() {
 
 	ListProjectsCmd.Flags().StringVar(&ListProjectsInput.Filter, "filter", "", "An expression that can be used to filter the...")
 
+	ListProjectsCmd.Flags().StringVar(&ListProjectsInput.OrderBy, "order_by", "", "A comma-separated list of fields used to sort the...")
+
+	ListProjectsCmd.Flags().BoolVar(&ListProjectsInput.ShowDeleted, "show_deleted", false, "If set to true, deleted projects that haven't...")
+
 	ListProjectsCmd.Flags().StringVar(&ListProjectsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
 
 }
@@ -0,0 +1,84 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"google.golang.org/api/iterator"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var ListRoleBindingsInput rpcpb.ListRoleBindingsRequest
+
+var ListRoleBindingsFromFile string
+
+func init() {
+	AdminServiceCmd.AddCommand(ListRoleBindingsCmd)
+
+	ListRoleBindingsCmd.Flags().StringVar(&ListRoleBindingsInput.Project, "project", "", "If set, only bindings in this project are listed....")
+
+	ListRoleBindingsCmd.Flags().Int32Var(&ListRoleBindingsInput.PageSize, "page_size", 10, "Default is 10. The maximum number of bindings to return.  The...")
+
+	ListRoleBindingsCmd.Flags().StringVar(&ListRoleBindingsInput.PageToken, "page_token", "", "A page token, received from a previous...")
+
+	ListRoleBindingsCmd.Flags().StringVar(&ListRoleBindingsInput.Filter, "filter", "", "An expression that can be used to filter the...")
+
+	ListRoleBindingsCmd.Flags().StringVar(&ListRoleBindingsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var ListRoleBindingsCmd = &cobra.Command{
+	Use:   "list-role-bindings",
+	Short: "ListRoleBindings returns the roles that...",
+	Long:  "ListRoleBindings returns the roles that principals have been granted.  (-- api-linter: core::0132::method-signature=disabled     ...",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if ListRoleBindingsFromFile == "" {
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if ListRoleBindingsFromFile != "" {
+			in, err = os.Open(ListRoleBindingsFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &ListRoleBindingsInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		if Verbose {
+			printVerboseInput("Admin", "ListRoleBindings", &ListRoleBindingsInput)
+		}
+		iter := AdminClient.ListRoleBindings(ctx, &ListRoleBindingsInput)
+
+		// populate iterator with a page
+		_, err = iter.Next()
+		if err != nil && err != iterator.Done {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(iter.Response)
+
+		return err
+	},
+}
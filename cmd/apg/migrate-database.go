@@ -27,6 +27,8 @@ func init() {
 
 	MigrateDatabaseCmd.Flags().StringVar(&MigrateDatabaseInput.Kind, "kind", "", "A string describing the kind of migration to...")
 
+	MigrateDatabaseCmd.Flags().Int32Var(&MigrateDatabaseInput.Version, "version", 0, "The schema version to migrate the database to. ...")
+
 	MigrateDatabaseCmd.Flags().StringVar(&MigrateDatabaseFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
 
 	MigrateDatabaseCmd.Flags().BoolVar(&MigrateDatabaseFollow, "follow", false, "Block until the long running operation completes")
@@ -44,7 +46,7 @@ func init() {
 var MigrateDatabaseCmd = &cobra.Command{
 	Use:   "migrate-database",
 	Short: "MigrateDatabase attempts to migrate the database...",
-	Long:  "MigrateDatabase attempts to migrate the database to the current schema.",
+	Long:  "MigrateDatabase attempts to migrate the database to the current schema,  or to an earlier schema version. Migrations run in the background,  and...",
 	PreRun: func(cmd *cobra.Command, args []string) {
 
 		if MigrateDatabaseFromFile == "" {
@@ -22,17 +22,34 @@ var RegistrySubCommands []string = []string{
 	"create-api",
 	"update-api",
 	"delete-api",
+	"undelete-api",
+	"batch-get-apis",
+	"batch-create-apis",
+	"batch-update-apis",
+	"batch-delete-apis",
 	"list-api-versions",
 	"get-api-version",
 	"create-api-version",
 	"update-api-version",
 	"delete-api-version",
+	"undelete-api-version",
+	"batch-get-api-versions",
+	"batch-create-api-versions",
+	"batch-update-api-versions",
+	"batch-delete-api-versions",
 	"list-api-specs",
 	"get-api-spec",
 	"get-api-spec-contents",
+	"upload-api-spec-contents",
+	"download-api-spec-contents",
 	"create-api-spec",
 	"update-api-spec",
 	"delete-api-spec",
+	"undelete-api-spec",
+	"batch-get-api-specs",
+	"batch-create-api-specs",
+	"batch-update-api-specs",
+	"batch-delete-api-specs",
 	"tag-api-spec-revision",
 	"list-api-spec-revisions",
 	"rollback-api-spec",
@@ -42,6 +59,7 @@ var RegistrySubCommands []string = []string{
 	"create-api-deployment",
 	"update-api-deployment",
 	"delete-api-deployment",
+	"undelete-api-deployment",
 	"tag-api-deployment-revision",
 	"list-api-deployment-revisions",
 	"rollback-api-deployment",
@@ -49,9 +67,24 @@ var RegistrySubCommands []string = []string{
 	"list-artifacts",
 	"get-artifact",
 	"get-artifact-contents",
+	"upload-artifact-contents",
+	"download-artifact-contents",
 	"create-artifact",
 	"replace-artifact",
+	"update-artifact",
 	"delete-artifact",
+	"undelete-artifact",
+	"batch-get-artifacts",
+	"batch-create-artifacts",
+	"batch-replace-artifacts",
+	"batch-update-artifacts",
+	"batch-delete-artifacts",
+	"tag-artifact-revision",
+	"list-artifact-revisions",
+	"rollback-artifact",
+	"delete-artifact-revision",
+	"watch-resources",
+	"search-resources",
 }
 
 func init() {
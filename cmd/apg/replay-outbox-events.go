@@ -0,0 +1,75 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var ReplayOutboxEventsInput rpcpb.ReplayOutboxEventsRequest
+
+var ReplayOutboxEventsFromFile string
+
+func init() {
+	AdminServiceCmd.AddCommand(ReplayOutboxEventsCmd)
+
+	ReplayOutboxEventsCmd.Flags().StringVar(&ReplayOutboxEventsInput.Filter, "filter", "", "An expression that selects the events to replay,...")
+
+	ReplayOutboxEventsCmd.Flags().BoolVar(&ReplayOutboxEventsInput.IncludeDelivered, "include_delivered", false, "If true, events that have been delivered are also...")
+
+	ReplayOutboxEventsCmd.Flags().StringVar(&ReplayOutboxEventsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var ReplayOutboxEventsCmd = &cobra.Command{
+	Use:   "replay-outbox-events",
+	Short: "ReplayOutboxEvents schedules matching...",
+	Long:  "ReplayOutboxEvents schedules matching notifications for immediate delivery,  including notifications that were abandoned after repeated failures. ...",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if ReplayOutboxEventsFromFile == "" {
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if ReplayOutboxEventsFromFile != "" {
+			in, err = os.Open(ReplayOutboxEventsFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &ReplayOutboxEventsInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		if Verbose {
+			printVerboseInput("Admin", "ReplayOutboxEvents", &ReplayOutboxEventsInput)
+		}
+		resp, err := AdminClient.ReplayOutboxEvents(ctx, &ReplayOutboxEventsInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
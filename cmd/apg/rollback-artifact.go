@@ -0,0 +1,79 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var RollbackArtifactInput rpcpb.RollbackArtifactRequest
+
+var RollbackArtifactFromFile string
+
+func init() {
+	RegistryServiceCmd.AddCommand(RollbackArtifactCmd)
+
+	RollbackArtifactCmd.Flags().StringVar(&RollbackArtifactInput.Name, "name", "", "Required. The artifact being rolled back.")
+
+	RollbackArtifactCmd.Flags().StringVar(&RollbackArtifactInput.RevisionId, "revision_id", "", "Required. The revision ID to roll back to.  It...")
+
+	RollbackArtifactCmd.Flags().StringVar(&RollbackArtifactFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var RollbackArtifactCmd = &cobra.Command{
+	Use:   "rollback-artifact",
+	Short: "RollbackArtifact sets the current revision to a...",
+	Long:  "RollbackArtifact sets the current revision to a specified prior revision.  Note that this creates a new revision with a new revision ID.",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if RollbackArtifactFromFile == "" {
+
+			cmd.MarkFlagRequired("name")
+
+			cmd.MarkFlagRequired("revision_id")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if RollbackArtifactFromFile != "" {
+			in, err = os.Open(RollbackArtifactFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &RollbackArtifactInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "RollbackArtifact", &RollbackArtifactInput)
+		}
+		resp, err := RegistryClient.RollbackArtifact(ctx, &RollbackArtifactInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
@@ -0,0 +1,88 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"google.golang.org/api/iterator"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var SearchResourcesInput rpcpb.SearchResourcesRequest
+
+var SearchResourcesFromFile string
+
+func init() {
+	RegistryServiceCmd.AddCommand(SearchResourcesCmd)
+
+	SearchResourcesCmd.Flags().StringVar(&SearchResourcesInput.Parent, "parent", "", "Required. The location of the resources to...")
+
+	SearchResourcesCmd.Flags().StringVar(&SearchResourcesInput.Query, "query", "", "Required. The words to search for. Resources...")
+
+	SearchResourcesCmd.Flags().Int32Var(&SearchResourcesInput.PageSize, "page_size", 10, "Default is 10. The maximum number of results to return.  The...")
+
+	SearchResourcesCmd.Flags().StringVar(&SearchResourcesInput.PageToken, "page_token", "", "A page token, received from a previous...")
+
+	SearchResourcesCmd.Flags().StringVar(&SearchResourcesFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var SearchResourcesCmd = &cobra.Command{
+	Use:   "search-resources",
+	Short: "SearchResources returns the APIs, versions, specs...",
+	Long:  "SearchResources returns the APIs, versions, specs and deployments that  match a text query, ordered by relevance.",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if SearchResourcesFromFile == "" {
+
+			cmd.MarkFlagRequired("parent")
+
+			cmd.MarkFlagRequired("query")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if SearchResourcesFromFile != "" {
+			in, err = os.Open(SearchResourcesFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &SearchResourcesInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "SearchResources", &SearchResourcesInput)
+		}
+		iter := RegistryClient.SearchResources(ctx, &SearchResourcesInput)
+
+		// populate iterator with a page
+		_, err = iter.Next()
+		if err != nil && err != iterator.Done {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(iter.Response)
+
+		return err
+	},
+}
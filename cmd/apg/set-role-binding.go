@@ -0,0 +1,87 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+
+	"strings"
+)
+
+var SetRoleBindingInput rpcpb.SetRoleBindingRequest
+
+var SetRoleBindingFromFile string
+
+var SetRoleBindingInputRoleBindingRole string
+
+func init() {
+	AdminServiceCmd.AddCommand(SetRoleBindingCmd)
+
+	SetRoleBindingInput.RoleBinding = new(rpcpb.RoleBinding)
+
+	SetRoleBindingCmd.Flags().StringVar(&SetRoleBindingInput.RoleBinding.Project, "role_binding.project", "", "The project that the role is granted in.  Format:...")
+
+	SetRoleBindingCmd.Flags().StringVar(&SetRoleBindingInput.RoleBinding.Principal, "role_binding.principal", "", "The principal that is granted the role, as...")
+
+	SetRoleBindingCmd.Flags().StringVar(&SetRoleBindingInputRoleBindingRole, "role_binding.role", "", "The role that is granted.")
+
+	SetRoleBindingCmd.Flags().StringVar(&SetRoleBindingFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var SetRoleBindingCmd = &cobra.Command{
+	Use:   "set-role-binding",
+	Short: "SetRoleBinding grants a role to a principal,...",
+	Long:  "SetRoleBinding grants a role to a principal, replacing the role that the  principal had been granted in the same project.  (-- api-linter:...",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if SetRoleBindingFromFile == "" {
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if SetRoleBindingFromFile != "" {
+			in, err = os.Open(SetRoleBindingFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &SetRoleBindingInput)
+			if err != nil {
+				return err
+			}
+
+		} else {
+
+			SetRoleBindingInput.RoleBinding.Role = rpcpb.RoleBinding_Role(rpcpb.RoleBinding_Role_value[strings.ToUpper(SetRoleBindingInputRoleBindingRole)])
+
+		}
+
+		if Verbose {
+			printVerboseInput("Admin", "SetRoleBinding", &SetRoleBindingInput)
+		}
+		resp, err := AdminClient.SetRoleBinding(ctx, &SetRoleBindingInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
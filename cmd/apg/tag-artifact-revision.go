@@ -0,0 +1,79 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var TagArtifactRevisionInput rpcpb.TagArtifactRevisionRequest
+
+var TagArtifactRevisionFromFile string
+
+func init() {
+	RegistryServiceCmd.AddCommand(TagArtifactRevisionCmd)
+
+	TagArtifactRevisionCmd.Flags().StringVar(&TagArtifactRevisionInput.Name, "name", "", "Required. The name of the artifact to be tagged,...")
+
+	TagArtifactRevisionCmd.Flags().StringVar(&TagArtifactRevisionInput.Tag, "tag", "", "Required. The tag to apply.  The tag should be at...")
+
+	TagArtifactRevisionCmd.Flags().StringVar(&TagArtifactRevisionFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var TagArtifactRevisionCmd = &cobra.Command{
+	Use:   "tag-artifact-revision",
+	Short: "TagArtifactRevision adds a tag to a specified...",
+	Long:  "TagArtifactRevision adds a tag to a specified revision of an artifact.",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if TagArtifactRevisionFromFile == "" {
+
+			cmd.MarkFlagRequired("name")
+
+			cmd.MarkFlagRequired("tag")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if TagArtifactRevisionFromFile != "" {
+			in, err = os.Open(TagArtifactRevisionFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &TagArtifactRevisionInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "TagArtifactRevision", &TagArtifactRevisionInput)
+		}
+		resp, err := RegistryClient.TagArtifactRevision(ctx, &TagArtifactRevisionInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
@@ -0,0 +1,75 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var UndeleteApiDeploymentInput rpcpb.UndeleteApiDeploymentRequest
+
+var UndeleteApiDeploymentFromFile string
+
+func init() {
+	RegistryServiceCmd.AddCommand(UndeleteApiDeploymentCmd)
+
+	UndeleteApiDeploymentCmd.Flags().StringVar(&UndeleteApiDeploymentInput.Name, "name", "", "Required. The name of the deployment to restore. ...")
+
+	UndeleteApiDeploymentCmd.Flags().StringVar(&UndeleteApiDeploymentFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var UndeleteApiDeploymentCmd = &cobra.Command{
+	Use:   "undelete-api-deployment",
+	Short: "UndeleteApiDeployment restores a deleted...",
+	Long:  "UndeleteApiDeployment restores a deleted deployment and the resources that  were deleted with it.",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if UndeleteApiDeploymentFromFile == "" {
+
+			cmd.MarkFlagRequired("name")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if UndeleteApiDeploymentFromFile != "" {
+			in, err = os.Open(UndeleteApiDeploymentFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &UndeleteApiDeploymentInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "UndeleteApiDeployment", &UndeleteApiDeploymentInput)
+		}
+		resp, err := RegistryClient.UndeleteApiDeployment(ctx, &UndeleteApiDeploymentInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
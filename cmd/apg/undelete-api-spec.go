@@ -0,0 +1,75 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var UndeleteApiSpecInput rpcpb.UndeleteApiSpecRequest
+
+var UndeleteApiSpecFromFile string
+
+func init() {
+	RegistryServiceCmd.AddCommand(UndeleteApiSpecCmd)
+
+	UndeleteApiSpecCmd.Flags().StringVar(&UndeleteApiSpecInput.Name, "name", "", "Required. The name of the spec to restore. ...")
+
+	UndeleteApiSpecCmd.Flags().StringVar(&UndeleteApiSpecFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var UndeleteApiSpecCmd = &cobra.Command{
+	Use:   "undelete-api-spec",
+	Short: "UndeleteApiSpec restores a deleted spec and the...",
+	Long:  "UndeleteApiSpec restores a deleted spec and the resources that were deleted  with it.",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if UndeleteApiSpecFromFile == "" {
+
+			cmd.MarkFlagRequired("name")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if UndeleteApiSpecFromFile != "" {
+			in, err = os.Open(UndeleteApiSpecFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &UndeleteApiSpecInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "UndeleteApiSpec", &UndeleteApiSpecInput)
+		}
+		resp, err := RegistryClient.UndeleteApiSpec(ctx, &UndeleteApiSpecInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
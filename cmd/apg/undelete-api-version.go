@@ -0,0 +1,75 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var UndeleteApiVersionInput rpcpb.UndeleteApiVersionRequest
+
+var UndeleteApiVersionFromFile string
+
+func init() {
+	RegistryServiceCmd.AddCommand(UndeleteApiVersionCmd)
+
+	UndeleteApiVersionCmd.Flags().StringVar(&UndeleteApiVersionInput.Name, "name", "", "Required. The name of the version to restore. ...")
+
+	UndeleteApiVersionCmd.Flags().StringVar(&UndeleteApiVersionFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var UndeleteApiVersionCmd = &cobra.Command{
+	Use:   "undelete-api-version",
+	Short: "UndeleteApiVersion restores a deleted version and...",
+	Long:  "UndeleteApiVersion restores a deleted version and the resources that were  deleted with it.",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if UndeleteApiVersionFromFile == "" {
+
+			cmd.MarkFlagRequired("name")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if UndeleteApiVersionFromFile != "" {
+			in, err = os.Open(UndeleteApiVersionFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &UndeleteApiVersionInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "UndeleteApiVersion", &UndeleteApiVersionInput)
+		}
+		resp, err := RegistryClient.UndeleteApiVersion(ctx, &UndeleteApiVersionInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
@@ -0,0 +1,75 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var UndeleteApiInput rpcpb.UndeleteApiRequest
+
+var UndeleteApiFromFile string
+
+func init() {
+	RegistryServiceCmd.AddCommand(UndeleteApiCmd)
+
+	UndeleteApiCmd.Flags().StringVar(&UndeleteApiInput.Name, "name", "", "Required. The name of the API to restore. ...")
+
+	UndeleteApiCmd.Flags().StringVar(&UndeleteApiFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var UndeleteApiCmd = &cobra.Command{
+	Use:   "undelete-api",
+	Short: "UndeleteApi restores a deleted API and the...",
+	Long:  "UndeleteApi restores a deleted API and the resources that were deleted with  it.",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if UndeleteApiFromFile == "" {
+
+			cmd.MarkFlagRequired("name")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if UndeleteApiFromFile != "" {
+			in, err = os.Open(UndeleteApiFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &UndeleteApiInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "UndeleteApi", &UndeleteApiInput)
+		}
+		resp, err := RegistryClient.UndeleteApi(ctx, &UndeleteApiInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
@@ -0,0 +1,75 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var UndeleteArtifactInput rpcpb.UndeleteArtifactRequest
+
+var UndeleteArtifactFromFile string
+
+func init() {
+	RegistryServiceCmd.AddCommand(UndeleteArtifactCmd)
+
+	UndeleteArtifactCmd.Flags().StringVar(&UndeleteArtifactInput.Name, "name", "", "Required. The name of the artifact to restore. ...")
+
+	UndeleteArtifactCmd.Flags().StringVar(&UndeleteArtifactFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var UndeleteArtifactCmd = &cobra.Command{
+	Use:   "undelete-artifact",
+	Short: "UndeleteArtifact restores a deleted artifact.",
+	Long:  "UndeleteArtifact restores a deleted artifact.",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if UndeleteArtifactFromFile == "" {
+
+			cmd.MarkFlagRequired("name")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if UndeleteArtifactFromFile != "" {
+			in, err = os.Open(UndeleteArtifactFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &UndeleteArtifactInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "UndeleteArtifact", &UndeleteArtifactInput)
+		}
+		resp, err := RegistryClient.UndeleteArtifact(ctx, &UndeleteArtifactInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
@@ -0,0 +1,75 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var UndeleteProjectInput rpcpb.UndeleteProjectRequest
+
+var UndeleteProjectFromFile string
+
+func init() {
+	AdminServiceCmd.AddCommand(UndeleteProjectCmd)
+
+	UndeleteProjectCmd.Flags().StringVar(&UndeleteProjectInput.Name, "name", "", "Required. The name of the project to restore. ...")
+
+	UndeleteProjectCmd.Flags().StringVar(&UndeleteProjectFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var UndeleteProjectCmd = &cobra.Command{
+	Use:   "undelete-project",
+	Short: "UndeleteProject restores a deleted project and...",
+	Long:  "UndeleteProject restores a deleted project and the resources that were  deleted with it. Deleted projects can be restored until they are purged.",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if UndeleteProjectFromFile == "" {
+
+			cmd.MarkFlagRequired("name")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if UndeleteProjectFromFile != "" {
+			in, err = os.Open(UndeleteProjectFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &UndeleteProjectInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		if Verbose {
+			printVerboseInput("Admin", "UndeleteProject", &UndeleteProjectInput)
+		}
+		resp, err := AdminClient.UndeleteProject(ctx, &UndeleteProjectInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
@@ -49,6 +49,8 @@ func init() {
 
 	UpdateApiSpecCmd.Flags().StringArrayVar(&UpdateApiSpecInputApiSpecAnnotations, "api_spec.annotations", []string{}, "key=value pairs. Annotations attach non-identifying metadata to...")
 
+	UpdateApiSpecCmd.Flags().StringVar(&UpdateApiSpecInput.ApiSpec.Etag, "api_spec.etag", "", "A checksum computed by the server from the stored...")
+
 	UpdateApiSpecCmd.Flags().StringSliceVar(&UpdateApiSpecInput.UpdateMask.Paths, "update_mask.paths", []string{}, "The set of field mask paths.")
 
 	UpdateApiSpecCmd.Flags().BoolVar(&UpdateApiSpecInput.AllowMissing, "allow_missing", false, "If set to true, and the spec is not found, a new...")
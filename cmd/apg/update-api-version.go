@@ -45,6 +45,8 @@ func init() {
 
 	UpdateApiVersionCmd.Flags().StringArrayVar(&UpdateApiVersionInputApiVersionAnnotations, "api_version.annotations", []string{}, "key=value pairs. Annotations attach non-identifying metadata to...")
 
+	UpdateApiVersionCmd.Flags().StringVar(&UpdateApiVersionInput.ApiVersion.Etag, "api_version.etag", "", "A checksum computed by the server from the stored...")
+
 	UpdateApiVersionCmd.Flags().StringSliceVar(&UpdateApiVersionInput.UpdateMask.Paths, "update_mask.paths", []string{}, "The set of field mask paths.")
 
 	UpdateApiVersionCmd.Flags().BoolVar(&UpdateApiVersionInput.AllowMissing, "allow_missing", false, "If set to true, and the version is not found, a...")
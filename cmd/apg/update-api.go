@@ -49,6 +49,8 @@ func init() {
 
 	UpdateApiCmd.Flags().StringArrayVar(&UpdateApiInputApiAnnotations, "api.annotations", []string{}, "key=value pairs. Annotations attach non-identifying metadata to...")
 
+	UpdateApiCmd.Flags().StringVar(&UpdateApiInput.Api.Etag, "api.etag", "", "A checksum computed by the server from the stored...")
+
 	UpdateApiCmd.Flags().StringSliceVar(&UpdateApiInput.UpdateMask.Paths, "update_mask.paths", []string{}, "The set of field mask paths.")
 
 	UpdateApiCmd.Flags().BoolVar(&UpdateApiInput.AllowMissing, "allow_missing", false, "If set to true, and the api is not found, a new...")
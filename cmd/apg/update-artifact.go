@@ -0,0 +1,123 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+
+	"strings"
+)
+
+var UpdateArtifactInput rpcpb.UpdateArtifactRequest
+
+var UpdateArtifactFromFile string
+
+var UpdateArtifactInputArtifactLabels []string
+
+var UpdateArtifactInputArtifactAnnotations []string
+
+func init() {
+	RegistryServiceCmd.AddCommand(UpdateArtifactCmd)
+
+	UpdateArtifactInput.Artifact = new(rpcpb.Artifact)
+
+	UpdateArtifactInput.UpdateMask = new(fieldmaskpb.FieldMask)
+
+	UpdateArtifactCmd.Flags().StringVar(&UpdateArtifactInput.Artifact.Name, "artifact.name", "", "Resource name.")
+
+	UpdateArtifactCmd.Flags().StringVar(&UpdateArtifactInput.Artifact.MimeType, "artifact.mime_type", "", "A content type specifier for the artifact. ...")
+
+	UpdateArtifactCmd.Flags().BytesHexVar(&UpdateArtifactInput.Artifact.Contents, "artifact.contents", []byte{}, "Input only. The contents of the artifact. ...")
+
+	UpdateArtifactCmd.Flags().StringArrayVar(&UpdateArtifactInputArtifactLabels, "artifact.labels", []string{}, "key=value pairs. Labels attach identifying metadata to resources....")
+
+	UpdateArtifactCmd.Flags().StringArrayVar(&UpdateArtifactInputArtifactAnnotations, "artifact.annotations", []string{}, "key=value pairs. Annotations attach non-identifying metadata to...")
+
+	UpdateArtifactCmd.Flags().StringVar(&UpdateArtifactInput.Artifact.Etag, "artifact.etag", "", "A checksum computed by the server from the stored...")
+
+	UpdateArtifactCmd.Flags().StringSliceVar(&UpdateArtifactInput.UpdateMask.Paths, "update_mask.paths", []string{}, "The set of field mask paths.")
+
+	UpdateArtifactCmd.Flags().StringVar(&UpdateArtifactFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var UpdateArtifactCmd = &cobra.Command{
+	Use:   "update-artifact",
+	Short: "UpdateArtifact can be used to modify the labels...",
+	Long:  "UpdateArtifact can be used to modify the labels and annotations of a  specified artifact without sending its contents.",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if UpdateArtifactFromFile == "" {
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if UpdateArtifactFromFile != "" {
+			in, err = os.Open(UpdateArtifactFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &UpdateArtifactInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		if len(UpdateArtifactInputArtifactLabels) > 0 {
+			UpdateArtifactInput.Artifact.Labels = make(map[string]string)
+		}
+		for _, item := range UpdateArtifactInputArtifactLabels {
+			split := strings.Split(item, "=")
+			if len(split) < 2 {
+				err = fmt.Errorf("Invalid map item: %q", item)
+				return
+			}
+
+			UpdateArtifactInput.Artifact.Labels[split[0]] = split[1]
+		}
+
+		if len(UpdateArtifactInputArtifactAnnotations) > 0 {
+			UpdateArtifactInput.Artifact.Annotations = make(map[string]string)
+		}
+		for _, item := range UpdateArtifactInputArtifactAnnotations {
+			split := strings.Split(item, "=")
+			if len(split) < 2 {
+				err = fmt.Errorf("Invalid map item: %q", item)
+				return
+			}
+
+			UpdateArtifactInput.Artifact.Annotations[split[0]] = split[1]
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "UpdateArtifact", &UpdateArtifactInput)
+		}
+		resp, err := RegistryClient.UpdateArtifact(ctx, &UpdateArtifactInput)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
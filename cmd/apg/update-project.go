@@ -33,6 +33,10 @@ func init() {
 
 	UpdateProjectCmd.Flags().StringVar(&UpdateProjectInput.Project.Description, "project.description", "", "A detailed description.")
 
+	UpdateProjectCmd.Flags().Int32Var(&UpdateProjectInput.Project.ArtifactRevisionLimit, "project.artifact_revision_limit", 0, "The maximum number of revisions to retain for...")
+
+	UpdateProjectCmd.Flags().StringVar(&UpdateProjectInput.Project.Etag, "project.etag", "", "A checksum computed by the server from the stored...")
+
 	UpdateProjectCmd.Flags().StringSliceVar(&UpdateProjectInput.UpdateMask.Paths, "update_mask.paths", []string{}, "The set of field mask paths.")
 
 	UpdateProjectCmd.Flags().BoolVar(&UpdateProjectInput.AllowMissing, "allow_missing", false, "If set to true, and the project is not found, a...")
@@ -0,0 +1,89 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"bufio"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var UploadApiSpecContentsFromFile string
+
+func init() {
+	RegistryServiceCmd.AddCommand(UploadApiSpecContentsCmd)
+
+	UploadApiSpecContentsCmd.Flags().StringVar(&UploadApiSpecContentsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var UploadApiSpecContentsCmd = &cobra.Command{
+	Use:   "upload-api-spec-contents",
+	Short: "UploadApiSpecContents creates a spec or updates...",
+	Long:  "UploadApiSpecContents creates a spec or updates the contents of an  existing spec with contents that are sent in a stream of chunks.  It supports...",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if UploadApiSpecContentsFromFile != "" {
+			in, err = os.Open(UploadApiSpecContentsFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+		}
+
+		stream, err := RegistryClient.UploadApiSpecContents(ctx)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Println("Client stream open. Close with ctrl+D.")
+		}
+
+		var UploadApiSpecContentsInput rpcpb.UploadApiSpecContentsRequest
+		scanner := bufio.NewScanner(in)
+		for scanner.Scan() {
+			input := scanner.Text()
+			if input == "" {
+				continue
+			}
+			err = jsonpb.UnmarshalString(input, &UploadApiSpecContentsInput)
+			if err != nil {
+				return err
+			}
+
+			err = stream.Send(&UploadApiSpecContentsInput)
+			if err != nil {
+				return err
+			}
+		}
+		if err = scanner.Err(); err != nil {
+			return err
+		}
+
+		resp, err := stream.CloseAndRecv()
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
@@ -0,0 +1,89 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"bufio"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var UploadArtifactContentsFromFile string
+
+func init() {
+	RegistryServiceCmd.AddCommand(UploadArtifactContentsCmd)
+
+	UploadArtifactContentsCmd.Flags().StringVar(&UploadArtifactContentsFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var UploadArtifactContentsCmd = &cobra.Command{
+	Use:   "upload-artifact-contents",
+	Short: "UploadArtifactContents creates an artifact or...",
+	Long:  "UploadArtifactContents creates an artifact or replaces an existing  artifact with contents that are sent in a stream of chunks.  It supports...",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if UploadArtifactContentsFromFile != "" {
+			in, err = os.Open(UploadArtifactContentsFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+		}
+
+		stream, err := RegistryClient.UploadArtifactContents(ctx)
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Println("Client stream open. Close with ctrl+D.")
+		}
+
+		var UploadArtifactContentsInput rpcpb.UploadArtifactContentsRequest
+		scanner := bufio.NewScanner(in)
+		for scanner.Scan() {
+			input := scanner.Text()
+			if input == "" {
+				continue
+			}
+			err = jsonpb.UnmarshalString(input, &UploadArtifactContentsInput)
+			if err != nil {
+				return err
+			}
+
+			err = stream.Send(&UploadArtifactContentsInput)
+			if err != nil {
+				return err
+			}
+		}
+		if err = scanner.Err(); err != nil {
+			return err
+		}
+
+		resp, err := stream.CloseAndRecv()
+		if err != nil {
+			return err
+		}
+
+		if Verbose {
+			fmt.Print("Output: ")
+		}
+		printMessage(resp)
+
+		return err
+	},
+}
@@ -0,0 +1,93 @@
+// Code generated. DO NOT EDIT.
+
+package main
+
+import (
+	"github.com/spf13/cobra"
+
+	"fmt"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"io"
+
+	"os"
+
+	rpcpb "github.com/apigee/registry/rpc"
+)
+
+var WatchResourcesInput rpcpb.WatchResourcesRequest
+
+var WatchResourcesFromFile string
+
+func init() {
+	RegistryServiceCmd.AddCommand(WatchResourcesCmd)
+
+	WatchResourcesCmd.Flags().StringVar(&WatchResourcesInput.Pattern, "pattern", "", "Required. A pattern matching the names of the...")
+
+	WatchResourcesCmd.Flags().StringVar(&WatchResourcesInput.Filter, "filter", "", "An expression that can be used to filter...")
+
+	WatchResourcesCmd.Flags().StringVar(&WatchResourcesInput.ResumeToken, "resume_token", "", "A resume token, received in a notification from a...")
+
+	WatchResourcesCmd.Flags().StringVar(&WatchResourcesFromFile, "from_file", "", "Absolute path to JSON file containing request payload")
+
+}
+
+var WatchResourcesCmd = &cobra.Command{
+	Use:   "watch-resources",
+	Short: "WatchResources streams notifications of changes...",
+	Long:  "WatchResources streams notifications of changes to resources that  match a pattern.",
+	PreRun: func(cmd *cobra.Command, args []string) {
+
+		if WatchResourcesFromFile == "" {
+
+			cmd.MarkFlagRequired("pattern")
+
+		}
+
+	},
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+
+		in := os.Stdin
+		if WatchResourcesFromFile != "" {
+			in, err = os.Open(WatchResourcesFromFile)
+			if err != nil {
+				return err
+			}
+			defer in.Close()
+
+			err = jsonpb.Unmarshal(in, &WatchResourcesInput)
+			if err != nil {
+				return err
+			}
+
+		}
+
+		if Verbose {
+			printVerboseInput("Registry", "WatchResources", &WatchResourcesInput)
+		}
+		resp, err := RegistryClient.WatchResources(ctx, &WatchResourcesInput)
+		if err != nil {
+			return err
+		}
+
+		var item *rpcpb.Notification
+		for {
+			item, err = resp.Recv()
+			if err != nil {
+				break
+			}
+
+			if Verbose {
+				fmt.Print("Output: ")
+			}
+			printMessage(item)
+		}
+
+		if err == io.EOF {
+			return nil
+		}
+
+		return err
+	},
+}
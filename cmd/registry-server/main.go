@@ -15,18 +15,27 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/apigee/registry/log"
 	"github.com/apigee/registry/log/interceptor"
 	"github.com/apigee/registry/rpc"
 	"github.com/apigee/registry/server/registry"
+	"github.com/apigee/registry/server/registry/authenticator"
+	"github.com/apigee/registry/server/registry/metrics"
+	"github.com/apigee/registry/server/registry/notifier"
+	"github.com/apigee/registry/tracing"
 	"github.com/spf13/pflag"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/genproto/googleapis/longrunning"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 	"gopkg.in/yaml.v2"
@@ -35,10 +44,17 @@ import (
 // ServerConfig is the top-level configuration structure.
 type ServerConfig struct {
 	// Server port. If unset or zero, an open port will be assigned.
-	Port     int            `yaml:"port"`
-	Database DatabaseConfig `yaml:"database"`
-	Logging  LoggingConfig  `yaml:"logging"`
-	Pubsub   PubsubConfig   `yaml:"pubsub"`
+	Port           int                  `yaml:"port"`
+	Database       DatabaseConfig       `yaml:"database"`
+	Blobs          BlobsConfig          `yaml:"blobs"`
+	Logging        LoggingConfig        `yaml:"logging"`
+	Pubsub         PubsubConfig         `yaml:"pubsub"`
+	Notifications  NotificationsConfig  `yaml:"notifications"`
+	Trash          TrashConfig          `yaml:"trash"`
+	Authorization  AuthorizationConfig  `yaml:"authorization"`
+	Authentication AuthenticationConfig `yaml:"authentication"`
+	Metrics        MetricsConfig        `yaml:"metrics"`
+	Tracing        TracingConfig        `yaml:"tracing"`
 }
 
 // DatabaseConfig holds database configuration.
@@ -50,6 +66,28 @@ type DatabaseConfig struct {
 	// PostgreSQL Reference: See "Connection Strings" at https://www.postgresql.org/docs/current/libpq-connect.html#LIBPQ-CONNSTRING
 	// SQLite Reference: See "URI filename examples" at https://www.sqlite.org/c3ref/open.html
 	Config string `yaml:"config"`
+	// Maximum number of open connections to the database.
+	// If unset or zero, the number of open connections is unlimited.
+	MaxOpenConns int `yaml:"max_open_conns"`
+	// Maximum number of connections kept in the idle connection pool.
+	// If unset or zero, the database/sql default (2) is used.
+	MaxIdleConns int `yaml:"max_idle_conns"`
+	// Maximum amount of time a connection may be reused, e.g. "30m".
+	// If unset or zero, connections are reused forever.
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
+}
+
+// BlobsConfig holds configuration for the storage of spec and artifact contents.
+type BlobsConfig struct {
+	// Store that holds the contents of specs and artifacts. Metadata is always stored in the database.
+	// Contents stored in the database remain readable after the store is changed to filesystem.
+	// Values: [ sql, filesystem ] (default: sql)
+	Store string `yaml:"store"`
+	// Directory that holds contents when store is filesystem. It is created if it doesn't exist.
+	Directory string `yaml:"directory"`
+	// Maximum size in bytes of the contents received by upload streams, which are held in memory until they are saved.
+	// If unset or zero, uploads are limited to 1 GiB.
+	MaxUploadSize int64 `yaml:"max_upload_size"`
 }
 
 // LoggingConfig holds logging configuration.
@@ -72,6 +110,129 @@ type PubsubConfig struct {
 	Project string `yaml:"project"`
 }
 
+// NotificationsConfig holds notification configuration.
+type NotificationsConfig struct {
+	// Type of notifier used to publish changes to resources.
+	// If unset, Pub/Sub is used when pubsub.enable is true.
+	// Values: [ none, log, webhook, pubsub ]
+	Type string `yaml:"type"`
+	// Encoding of notifications published by webhook and pubsub notifiers.
+	// CloudEvents include the state of changed resources and the caller that made the change.
+	// Values: [ json, cloudevents ] (default: json)
+	Format string `yaml:"format"`
+	// Maximum number of notifications published together.
+	// If unset or zero, batches contain at most 100 notifications.
+	BatchSize int `yaml:"batch_size"`
+	// Time between checks for notifications that are due for delivery, e.g. "1s".
+	// Notifications are also delivered as soon as the changes they describe are saved.
+	// If unset or zero, pending notifications are checked every second.
+	BatchInterval time.Duration `yaml:"batch_interval"`
+	// Number of failed deliveries after which a notification is abandoned.
+	// Abandoned notifications can be delivered with the ReplayOutboxEvents admin method.
+	// If unset or zero, notifications are abandoned after 10 attempts.
+	MaxAttempts int `yaml:"max_attempts"`
+	// Amount of time delivered notifications are kept for replay, e.g. "24h".
+	// If unset or zero, delivered notifications are kept for 7 days.
+	Retention time.Duration `yaml:"retention"`
+	// Configuration used when type is webhook.
+	Webhook WebhookConfig `yaml:"webhook"`
+}
+
+// WebhookConfig holds configuration for webhook notifications.
+type WebhookConfig struct {
+	// URL that batches of notifications are posted to as JSON arrays.
+	URL string `yaml:"url"`
+	// Number of times a batch is posted before it is dropped.
+	// If unset or zero, batches are posted at most 5 times.
+	MaxAttempts int `yaml:"max_attempts"`
+	// Maximum duration of each post, e.g. "10s".
+	// If unset or zero, posts time out after 10 seconds.
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// TrashConfig holds configuration for deleted resources.
+type TrashConfig struct {
+	// Amount of time deleted resources can be restored before they are purged, e.g. "72h".
+	// If unset or zero, deleted resources are kept for 30 days.
+	Retention time.Duration `yaml:"retention"`
+	// Time between purges of deleted resources, e.g. "1h".
+	// If unset or zero, deleted resources are purged every hour.
+	PurgeInterval time.Duration `yaml:"purge_interval"`
+}
+
+// AuthorizationConfig holds configuration for the authorization of requests.
+type AuthorizationConfig struct {
+	// Enable checks that callers have been granted roles in the projects of their requests.
+	// Roles are granted with the SetRoleBinding admin method.
+	// Values: [ true, false ]
+	Enable bool `yaml:"enable"`
+	// Identify callers that aren't authenticated by the principal header.
+	// Any client can set the header, so this must only be enabled when a trusted proxy in front of the server
+	// sets the header and removes it from client requests. If false, callers must be authenticated.
+	// Values: [ true, false ]
+	TrustPrincipalHeader bool `yaml:"trust_principal_header"`
+	// Request header that identifies callers when trust_principal_header is true.
+	// If unset, callers are identified by the "x-registry-principal" header.
+	PrincipalHeader string `yaml:"principal_header"`
+	// Principals that are admins of the whole registry, which can grant roles to other principals.
+	Admins []string `yaml:"admins"`
+}
+
+// AuthenticationConfig holds configuration for the authentication of requests.
+type AuthenticationConfig struct {
+	// Enable rejection of requests without a valid bearer token or API key.
+	// The subjects of authenticated requests identify callers to authorization checks.
+	// Values: [ true, false ]
+	Enable bool `yaml:"enable"`
+	// Issuer that bearer tokens must be issued by. Required if jwks is set.
+	Issuer string `yaml:"issuer"`
+	// Audience that bearer tokens must be intended for. If unset, the audience isn't checked.
+	Audience string `yaml:"audience"`
+	// Path of a JSON Web Key Set file holding the keys that bearer tokens are signed with.
+	JWKS string `yaml:"jwks"`
+	// Static API keys, which can be sent as bearer tokens or in the "x-goog-api-key" header.
+	APIKeys []APIKeyConfig `yaml:"api_keys"`
+}
+
+// APIKeyConfig holds configuration for a static API key.
+type APIKeyConfig struct {
+	// Secret value of the key.
+	Key string `yaml:"key"`
+	// Subject that is authenticated by the key.
+	Subject string `yaml:"subject"`
+}
+
+// MetricsConfig holds configuration for the Prometheus metrics of the server.
+type MetricsConfig struct {
+	// Enable an HTTP listener that serves metrics at /metrics.
+	// Values: [ true, false ]
+	Enable bool `yaml:"enable"`
+	// Port of the metrics listener. If unset or zero, an open port will be assigned.
+	Port int `yaml:"port"`
+	// Time between refreshes of the row counts of database tables, e.g. "5m".
+	// If unset or zero, row counts are refreshed every minute.
+	RefreshInterval time.Duration `yaml:"refresh_interval"`
+}
+
+// TracingConfig holds configuration for the OpenTelemetry traces of the server.
+type TracingConfig struct {
+	// Exporter of the spans of requests and their database operations.
+	// Values: [ none, otlp, file ] (default: none)
+	Exporter string `yaml:"exporter"`
+	// Address of the OTLP collector when exporter is otlp, e.g. "localhost:4317".
+	// If unset, the OTEL_EXPORTER_OTLP_ENDPOINT environment variable or "localhost:4317" is used.
+	Endpoint string `yaml:"endpoint"`
+	// Disable TLS for connections to the OTLP collector.
+	// Values: [ true, false ]
+	Insecure bool `yaml:"insecure"`
+	// File that spans are appended to as JSON when exporter is file.
+	File string `yaml:"file"`
+	// Fraction of requests that are traced when callers don't send trace contexts, between 0 and 1.
+	// Requests are traced when their callers' traces are sampled.
+	// If unset or zero, all requests are traced.
+	SampleRatio float64 `yaml:"sample_ratio"`
+}
+
 // default configuration
 var config = ServerConfig{
 	Port: 8080,
@@ -120,6 +281,7 @@ func main() {
 		logger         = log.NewLogger(logOpts...)
 		logInterceptor = interceptor.CallLogger(logOpts...)
 	)
+	ctx := log.NewContext(context.Background(), logger)
 
 	logger.Infof("Configured port %d", config.Port)
 	listener, err := net.ListenTCP("tcp", &net.TCPAddr{
@@ -130,22 +292,94 @@ func main() {
 	}
 	defer listener.Close()
 
+	// Start tracing before the registry server opens its database, so database operations are traced.
+	stopTracing, err := tracing.Start(ctx, tracingConfig(config.Tracing))
+	if err != nil {
+		logger.WithError(err).Fatalf("Failed to start tracing")
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		if err := stopTracing(ctx); err != nil {
+			logger.WithError(err).Error("Failed to flush traces")
+		}
+	}()
+
+	n, err := newNotifier(ctx, config)
+	if err != nil {
+		logger.WithError(err).Fatalf("Failed to create notifier")
+	}
+
 	registryServer, err := registry.New(registry.Config{
-		Database:  config.Database.Driver,
-		DBConfig:  config.Database.Config,
-		LogLevel:  config.Logging.Level,
-		LogFormat: config.Logging.Format,
-		Notify:    config.Pubsub.Enable,
-		ProjectID: config.Pubsub.Project,
+		Database:             config.Database.Driver,
+		DBConfig:             config.Database.Config,
+		DBMaxOpenConns:       config.Database.MaxOpenConns,
+		DBMaxIdleConns:       config.Database.MaxIdleConns,
+		DBConnMaxLifetime:    config.Database.ConnMaxLifetime,
+		BlobStore:            config.Blobs.Store,
+		BlobDirectory:        config.Blobs.Directory,
+		MaxUploadSize:        config.Blobs.MaxUploadSize,
+		LogLevel:             config.Logging.Level,
+		LogFormat:            config.Logging.Format,
+		Notifier:             n,
+		OutboxBatchSize:      config.Notifications.BatchSize,
+		OutboxInterval:       config.Notifications.BatchInterval,
+		OutboxMaxAttempts:    config.Notifications.MaxAttempts,
+		OutboxRetention:      config.Notifications.Retention,
+		DeleteRetention:      config.Trash.Retention,
+		PurgeInterval:        config.Trash.PurgeInterval,
+		Authorization:        config.Authorization.Enable,
+		TrustPrincipalHeader: config.Authorization.TrustPrincipalHeader,
+		PrincipalHeader:      config.Authorization.PrincipalHeader,
+		Admins:               config.Authorization.Admins,
 	})
 	if err != nil {
 		logger.WithError(err).Fatalf("Failed to create registry server")
 	}
+	defer registryServer.Close()
 
-	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(logInterceptor))
+	var (
+		unaryInterceptors  []grpc.UnaryServerInterceptor
+		streamInterceptors []grpc.StreamServerInterceptor
+	)
+	if tracingConfig(config.Tracing).Enabled() {
+		// Start spans first so the time spent in other interceptors is included in them
+		// and requests are logged with their trace IDs.
+		unaryInterceptors = append(unaryInterceptors, otelgrpc.UnaryServerInterceptor())
+		streamInterceptors = append(streamInterceptors, otelgrpc.StreamServerInterceptor())
+	}
+	if config.Metrics.Enable {
+		m := metrics.New()
+		stop, err := serveMetrics(ctx, m, registryServer, config.Metrics)
+		if err != nil {
+			logger.WithError(err).Fatalf("Failed to serve metrics")
+		}
+		defer stop()
+		// Observe requests first so calls that are rejected by other interceptors are counted.
+		unaryInterceptors = append(unaryInterceptors, m.UnaryInterceptor())
+		streamInterceptors = append(streamInterceptors, m.StreamInterceptor())
+	}
+	if config.Authentication.Enable {
+		a, err := newAuthenticator(config.Authentication)
+		if err != nil {
+			logger.WithError(err).Fatalf("Failed to create authenticator")
+		}
+		// Authenticate requests first so their subjects are logged and authorized.
+		unaryInterceptors = append(unaryInterceptors, a.UnaryInterceptor())
+		streamInterceptors = append(streamInterceptors, a.StreamInterceptor())
+	}
+	// Audit calls after they are logged and before they are authorized, so denied calls are audited with their request IDs.
+	unaryInterceptors = append(unaryInterceptors, logInterceptor, registryServer.UnaryAuditor(), registryServer.UnaryAuthorizer())
+	streamInterceptors = append(streamInterceptors, registryServer.StreamAuditor(), registryServer.StreamAuthorizer())
+
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
 	reflection.Register(grpcServer)
 	rpc.RegisterRegistryServer(grpcServer, registryServer)
 	rpc.RegisterAdminServer(grpcServer, registryServer)
+	longrunning.RegisterOperationsServer(grpcServer, registryServer)
 
 	go func() {
 		_ = grpcServer.Serve(listener)
@@ -156,6 +390,10 @@ func main() {
 	done := make(chan os.Signal, 1)
 	signal.Notify(done, os.Interrupt, syscall.SIGTERM)
 	<-done
+
+	// Finish in-flight requests before the deferred calls release the database.
+	registryServer.StopWatches()
+	grpcServer.GracefulStop()
 }
 
 func validateConfig() error {
@@ -169,6 +407,32 @@ func validateConfig() error {
 		return fmt.Errorf("invalid database.driver %q: must be one of [sqlite3, postgres, cloudsqlpostgres]", driver)
 	}
 
+	if n := config.Database.MaxOpenConns; n < 0 {
+		return fmt.Errorf("invalid database.max_open_conns %d: must be non-negative", n)
+	}
+
+	if n := config.Database.MaxIdleConns; n < 0 {
+		return fmt.Errorf("invalid database.max_idle_conns %d: must be non-negative", n)
+	}
+
+	if d := config.Database.ConnMaxLifetime; d < 0 {
+		return fmt.Errorf("invalid database.conn_max_lifetime %s: must be non-negative", d)
+	}
+
+	switch store := config.Blobs.Store; store {
+	case "", "sql":
+	case "filesystem":
+		if dir := config.Blobs.Directory; dir == "" {
+			return fmt.Errorf("invalid blobs.directory %q: must be set for filesystem blob store", dir)
+		}
+	default:
+		return fmt.Errorf("invalid blobs.store %q: must be one of [sql, filesystem]", store)
+	}
+
+	if n := config.Blobs.MaxUploadSize; n < 0 {
+		return fmt.Errorf("invalid blobs.max_upload_size %d: must be non-negative", n)
+	}
+
 	switch level := config.Logging.Level; level {
 	case "fatal", "error", "warn", "info", "debug":
 	default:
@@ -185,9 +449,159 @@ func validateConfig() error {
 		return fmt.Errorf("invalid pubsub.project %q: pubsub cannot be enabled without GCP project ID", project)
 	}
 
+	switch t := config.Notifications.Type; t {
+	case "", "none", "log", "pubsub":
+	case "webhook":
+		if url := config.Notifications.Webhook.URL; url == "" {
+			return fmt.Errorf("invalid notifications.webhook.url %q: must be set for webhook notifications", url)
+		}
+	default:
+		return fmt.Errorf("invalid notifications.type %q: must be one of [none, log, webhook, pubsub]", t)
+	}
+
+	switch f := config.Notifications.Format; f {
+	case "", "json", "cloudevents":
+	default:
+		return fmt.Errorf("invalid notifications.format %q: must be one of [json, cloudevents]", f)
+	}
+
+	if project := config.Pubsub.Project; config.Notifications.Type == "pubsub" && project == "" {
+		return fmt.Errorf("invalid pubsub.project %q: must be set for pubsub notifications", project)
+	}
+
+	if n := config.Notifications.BatchSize; n < 0 {
+		return fmt.Errorf("invalid notifications.batch_size %d: must be non-negative", n)
+	}
+
+	if d := config.Notifications.BatchInterval; d < 0 {
+		return fmt.Errorf("invalid notifications.batch_interval %s: must be non-negative", d)
+	}
+
+	if n := config.Notifications.MaxAttempts; n < 0 {
+		return fmt.Errorf("invalid notifications.max_attempts %d: must be non-negative", n)
+	}
+
+	if d := config.Notifications.Retention; d < 0 {
+		return fmt.Errorf("invalid notifications.retention %s: must be non-negative", d)
+	}
+
+	if config.Authorization.Enable && len(config.Authorization.Admins) == 0 {
+		return fmt.Errorf("invalid authorization.admins %q: at least one admin must be set when authorization is enabled", config.Authorization.Admins)
+	}
+
+	if config.Authorization.Enable && !config.Authentication.Enable && !config.Authorization.TrustPrincipalHeader {
+		return fmt.Errorf("invalid authorization: authentication or authorization.trust_principal_header must be enabled to identify callers")
+	}
+
+	if p := config.Metrics.Port; p < 0 {
+		return fmt.Errorf("invalid metrics.port %d: must be non-negative", p)
+	} else if config.Metrics.Enable && p != 0 && p == config.Port {
+		return fmt.Errorf("invalid metrics.port %d: must differ from port", p)
+	}
+
+	if d := config.Metrics.RefreshInterval; d < 0 {
+		return fmt.Errorf("invalid metrics.refresh_interval %s: must be non-negative", d)
+	}
+
+	if err := tracingConfig(config.Tracing).Validate(); err != nil {
+		return fmt.Errorf("invalid tracing: %s", err)
+	}
+
+	if auth := config.Authentication; auth.Enable {
+		if auth.JWKS == "" && len(auth.APIKeys) == 0 {
+			return fmt.Errorf("invalid authentication: jwks or api_keys must be set when authentication is enabled")
+		}
+		if auth.JWKS != "" && auth.Issuer == "" {
+			return fmt.Errorf("invalid authentication.issuer %q: must be set to verify bearer tokens", auth.Issuer)
+		}
+		for i, k := range auth.APIKeys {
+			if k.Key == "" || k.Subject == "" {
+				return fmt.Errorf("invalid authentication.api_keys[%d]: key and subject must be set", i)
+			}
+		}
+	}
+
 	return nil
 }
 
+// serveMetrics starts serving the metrics of a server and returns a function that stops serving them.
+func serveMetrics(ctx context.Context, m *metrics.Metrics, s *registry.RegistryServer, conf MetricsConfig) (func(), error) {
+	listener, err := net.ListenTCP("tcp", &net.TCPAddr{
+		Port: conf.Port,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	m.RegisterStorage(ctx, s, conf.RefreshInterval)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", m.Handler())
+	server := &http.Server{Handler: mux}
+	go func() {
+		if err := server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			log.FromContext(ctx).WithError(err).Error("Failed to serve metrics")
+		}
+	}()
+	log.FromContext(ctx).Infof("Serving metrics on %s", listener.Addr())
+
+	return func() {
+		_ = server.Close()
+		cancel()
+	}, nil
+}
+
+// tracingConfig returns the configuration of the server's traces.
+func tracingConfig(conf TracingConfig) tracing.Config {
+	return tracing.Config{
+		ServiceName: "registry-server",
+		Exporter:    conf.Exporter,
+		Endpoint:    conf.Endpoint,
+		Insecure:    conf.Insecure,
+		File:        conf.File,
+		SampleRatio: conf.SampleRatio,
+	}
+}
+
+// newAuthenticator returns an authenticator that accepts the credentials selected by the server config.
+func newAuthenticator(conf AuthenticationConfig) (*authenticator.Authenticator, error) {
+	keys := make(map[string]string, len(conf.APIKeys))
+	for _, k := range conf.APIKeys {
+		keys[k.Key] = k.Subject
+	}
+	return authenticator.New(authenticator.Options{
+		Issuer:   conf.Issuer,
+		Audience: conf.Audience,
+		JWKSFile: conf.JWKS,
+		APIKeys:  keys,
+	})
+}
+
+// newNotifier returns the notifier selected by the server config, or nil if notifications are disabled.
+func newNotifier(ctx context.Context, conf ServerConfig) (notifier.Notifier, error) {
+	t := conf.Notifications.Type
+	if t == "" && conf.Pubsub.Enable {
+		t = "pubsub"
+	}
+
+	switch t {
+	case "log":
+		return notifier.NewLog(), nil
+	case "webhook":
+		return notifier.NewWebhook(notifier.WebhookOptions{
+			URL:         conf.Notifications.Webhook.URL,
+			MaxAttempts: conf.Notifications.Webhook.MaxAttempts,
+			Timeout:     conf.Notifications.Webhook.Timeout,
+			Format:      notifier.Format(conf.Notifications.Format),
+		})
+	case "pubsub":
+		return notifier.NewPubsub(ctx, conf.Pubsub.Project, notifier.Format(conf.Notifications.Format))
+	default:
+		return nil, nil
+	}
+}
+
 func loggerOptions(conf LoggingConfig) []log.Option {
 	opts := make([]log.Option, 0, 2)
 	switch conf.Level {
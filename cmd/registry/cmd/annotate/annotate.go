@@ -27,6 +27,8 @@ import (
 	"github.com/apigee/registry/server/registry/names"
 	"github.com/spf13/cobra"
 	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func Command(ctx context.Context) *cobra.Command {
@@ -93,6 +95,8 @@ func matchAndHandleAnnotateCmd(
 		return annotateVersions(ctx, client, version, filter, labeling, taskQueue)
 	} else if spec, err := names.ParseSpecCollection(name); err == nil {
 		return annotateSpecs(ctx, client, spec, filter, labeling, taskQueue)
+	} else if artifact, err := names.ParseArtifactCollection(name); err == nil {
+		return annotateArtifacts(ctx, client, artifact, filter, labeling, taskQueue)
 	}
 
 	// Then try to match resource names.
@@ -102,6 +106,8 @@ func matchAndHandleAnnotateCmd(
 		return annotateVersions(ctx, client, version, filter, labeling, taskQueue)
 	} else if spec, err := names.ParseSpec(name); err == nil {
 		return annotateSpecs(ctx, client, spec, filter, labeling, taskQueue)
+	} else if artifact, err := names.ParseArtifact(name); err == nil {
+		return annotateArtifacts(ctx, client, artifact, filter, labeling, taskQueue)
 	} else {
 		return fmt.Errorf("unsupported resource name %s", name)
 	}
@@ -113,12 +119,20 @@ func annotateAPIs(ctx context.Context,
 	filterFlag string,
 	labeling *core.Labeling,
 	taskQueue chan<- core.Task) error {
-	return core.ListAPIs(ctx, client, api, filterFlag, func(api *rpc.Api) {
-		taskQueue <- &annotateApiTask{
+	batches := core.NewBatcher(taskQueue, func(parent string, items []interface{}) core.Task {
+		task := &annotateAPIsTask{
 			client:   client,
-			api:      api,
+			parent:   parent,
 			labeling: labeling,
 		}
+		for _, item := range items {
+			task.apis = append(task.apis, item.(*rpc.Api))
+		}
+		return task
+	})
+	defer batches.Flush()
+	return core.ListAPIs(ctx, client, api, filterFlag, func(api *rpc.Api) {
+		batches.Add(core.BatchParent(api.GetName()), api)
 	})
 }
 
@@ -129,12 +143,20 @@ func annotateVersions(
 	filterFlag string,
 	labeling *core.Labeling,
 	taskQueue chan<- core.Task) error {
-	return core.ListVersions(ctx, client, version, filterFlag, func(version *rpc.ApiVersion) {
-		taskQueue <- &annotateVersionTask{
+	batches := core.NewBatcher(taskQueue, func(parent string, items []interface{}) core.Task {
+		task := &annotateVersionsTask{
 			client:   client,
-			version:  version,
+			parent:   parent,
 			labeling: labeling,
 		}
+		for _, item := range items {
+			task.versions = append(task.versions, item.(*rpc.ApiVersion))
+		}
+		return task
+	})
+	defer batches.Flush()
+	return core.ListVersions(ctx, client, version, filterFlag, func(version *rpc.ApiVersion) {
+		batches.Add(core.BatchParent(version.GetName()), version)
 	})
 }
 
@@ -145,12 +167,44 @@ func annotateSpecs(
 	filterFlag string,
 	labeling *core.Labeling,
 	taskQueue chan<- core.Task) error {
+	batches := core.NewBatcher(taskQueue, func(parent string, items []interface{}) core.Task {
+		task := &annotateSpecsTask{
+			client:   client,
+			parent:   parent,
+			labeling: labeling,
+		}
+		for _, item := range items {
+			task.specs = append(task.specs, item.(*rpc.ApiSpec))
+		}
+		return task
+	})
+	defer batches.Flush()
 	return core.ListSpecs(ctx, client, spec, filterFlag, func(spec *rpc.ApiSpec) {
-		taskQueue <- &annotateSpecTask{
+		batches.Add(core.BatchParent(spec.GetName()), spec)
+	})
+}
+
+func annotateArtifacts(
+	ctx context.Context,
+	client *gapic.RegistryClient,
+	artifact names.Artifact,
+	filterFlag string,
+	labeling *core.Labeling,
+	taskQueue chan<- core.Task) error {
+	batches := core.NewBatcher(taskQueue, func(parent string, items []interface{}) core.Task {
+		task := &annotateArtifactsTask{
 			client:   client,
-			spec:     spec,
+			parent:   parent,
 			labeling: labeling,
 		}
+		for _, item := range items {
+			task.artifacts = append(task.artifacts, item.(*rpc.Artifact))
+		}
+		return task
+	})
+	defer batches.Flush()
+	return core.ListArtifacts(ctx, client, artifact, filterFlag, false, func(artifact *rpc.Artifact) {
+		batches.Add(core.BatchParent(artifact.GetName()), artifact)
 	})
 }
 
@@ -165,20 +219,26 @@ func (task *annotateApiTask) String() string {
 }
 
 func (task *annotateApiTask) Run(ctx context.Context) error {
-	var err error
-	task.api.Annotations, err = task.labeling.Apply(task.api.Annotations)
-	if err != nil {
-		log.FromContext(ctx).WithError(err).Errorf("Invalid annotation")
-		return nil
-	}
-	_, err = task.client.UpdateApi(ctx,
-		&rpc.UpdateApiRequest{
-			Api: task.api,
-			UpdateMask: &field_mask.FieldMask{
-				Paths: []string{"annotations"},
-			},
-		})
-	return err
+	// The API is read again if it changed after it was listed.
+	return core.RetryAborted(ctx, func() (err error) {
+		task.api, err = task.client.GetApi(ctx, &rpc.GetApiRequest{Name: task.api.GetName()})
+		return err
+	}, func() error {
+		var err error
+		task.api.Annotations, err = task.labeling.Apply(task.api.Annotations)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Errorf("Invalid annotation")
+			return nil
+		}
+		_, err = task.client.UpdateApi(ctx,
+			&rpc.UpdateApiRequest{
+				Api: task.api,
+				UpdateMask: &field_mask.FieldMask{
+					Paths: []string{"annotations"},
+				},
+			})
+		return err
+	})
 }
 
 type annotateVersionTask struct {
@@ -192,20 +252,26 @@ func (task *annotateVersionTask) String() string {
 }
 
 func (task *annotateVersionTask) Run(ctx context.Context) error {
-	var err error
-	task.version.Annotations, err = task.labeling.Apply(task.version.Annotations)
-	if err != nil {
-		log.FromContext(ctx).WithError(err).Errorf("Invalid annotation")
-		return nil
-	}
-	_, err = task.client.UpdateApiVersion(ctx,
-		&rpc.UpdateApiVersionRequest{
-			ApiVersion: task.version,
-			UpdateMask: &field_mask.FieldMask{
-				Paths: []string{"annotations"},
-			},
-		})
-	return err
+	// The version is read again if it changed after it was listed.
+	return core.RetryAborted(ctx, func() (err error) {
+		task.version, err = task.client.GetApiVersion(ctx, &rpc.GetApiVersionRequest{Name: task.version.GetName()})
+		return err
+	}, func() error {
+		var err error
+		task.version.Annotations, err = task.labeling.Apply(task.version.Annotations)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Errorf("Invalid annotation")
+			return nil
+		}
+		_, err = task.client.UpdateApiVersion(ctx,
+			&rpc.UpdateApiVersionRequest{
+				ApiVersion: task.version,
+				UpdateMask: &field_mask.FieldMask{
+					Paths: []string{"annotations"},
+				},
+			})
+		return err
+	})
 }
 
 type annotateSpecTask struct {
@@ -219,18 +285,285 @@ func (task *annotateSpecTask) String() string {
 }
 
 func (task *annotateSpecTask) Run(ctx context.Context) error {
-	var err error
-	task.spec.Annotations, err = task.labeling.Apply(task.spec.Annotations)
+	// The spec is read again if it changed after it was listed.
+	return core.RetryAborted(ctx, func() (err error) {
+		task.spec, err = task.client.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: task.spec.GetName()})
+		return err
+	}, func() error {
+		var err error
+		task.spec.Annotations, err = task.labeling.Apply(task.spec.Annotations)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Errorf("Invalid annotation")
+			return nil
+		}
+		_, err = task.client.UpdateApiSpec(ctx,
+			&rpc.UpdateApiSpecRequest{
+				ApiSpec: task.spec,
+				UpdateMask: &field_mask.FieldMask{
+					Paths: []string{"annotations"},
+				},
+			})
+		return err
+	})
+}
+
+type annotateArtifactTask struct {
+	client   connection.Client
+	artifact *rpc.Artifact
+	labeling *core.Labeling
+}
+
+func (task *annotateArtifactTask) String() string {
+	return "annotate " + task.artifact.Name
+}
+
+func (task *annotateArtifactTask) Run(ctx context.Context) error {
+	// The artifact is read again if it changed after it was listed.
+	return core.RetryAborted(ctx, func() (err error) {
+		name, err := names.ParseArtifact(task.artifact.GetName())
+		if err != nil {
+			return err
+		}
+		task.artifact, err = core.GetArtifact(ctx, task.client, name, false, nil)
+		return err
+	}, func() error {
+		var err error
+		task.artifact.Annotations, err = task.labeling.Apply(task.artifact.Annotations)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Errorf("Invalid annotation")
+			return nil
+		}
+		_, err = task.client.UpdateArtifact(ctx,
+			&rpc.UpdateArtifactRequest{
+				Artifact: task.artifact,
+				UpdateMask: &field_mask.FieldMask{
+					Paths: []string{"annotations"},
+				},
+			})
+		return err
+	})
+}
+
+type annotateAPIsTask struct {
+	client   connection.Client
+	parent   string
+	apis     []*rpc.Api
+	labeling *core.Labeling
+}
+
+func (task *annotateAPIsTask) String() string {
+	return fmt.Sprintf("annotate %d apis in %s", len(task.apis), task.parent)
+}
+
+func (task *annotateAPIsTask) Run(ctx context.Context) error {
+	req := &rpc.BatchUpdateApisRequest{Parent: task.parent}
+	for _, api := range task.apis {
+		var err error
+		api.Annotations, err = task.labeling.Apply(api.Annotations)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Errorf("Invalid annotation of %s", api.GetName())
+			continue
+		}
+		req.Requests = append(req.Requests, &rpc.UpdateApiRequest{
+			Api: api,
+			UpdateMask: &field_mask.FieldMask{
+				Paths: []string{"annotations"},
+			},
+		})
+	}
+	if len(req.Requests) == 0 {
+		return nil
+	}
+
+	response, err := task.client.BatchUpdateApis(ctx, req)
 	if err != nil {
-		log.FromContext(ctx).WithError(err).Errorf("Invalid annotation")
+		return err
+	}
+	for i, result := range response.GetResults() {
+		name := req.Requests[i].GetApi().GetName()
+		switch err := core.BatchError(result.GetStatus()); status.Code(err) {
+		case codes.OK:
+		case codes.Aborted:
+			// APIs that changed after they were listed are read again and annotated individually.
+			api, err := task.client.GetApi(ctx, &rpc.GetApiRequest{Name: name})
+			if err != nil {
+				return err
+			}
+			if err := (&annotateApiTask{client: task.client, api: api, labeling: task.labeling}).Run(ctx); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("failed to annotate %s: %s", name, err)
+		}
+	}
+	return nil
+}
+
+type annotateVersionsTask struct {
+	client   connection.Client
+	parent   string
+	versions []*rpc.ApiVersion
+	labeling *core.Labeling
+}
+
+func (task *annotateVersionsTask) String() string {
+	return fmt.Sprintf("annotate %d versions in %s", len(task.versions), task.parent)
+}
+
+func (task *annotateVersionsTask) Run(ctx context.Context) error {
+	req := &rpc.BatchUpdateApiVersionsRequest{Parent: task.parent}
+	for _, version := range task.versions {
+		var err error
+		version.Annotations, err = task.labeling.Apply(version.Annotations)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Errorf("Invalid annotation of %s", version.GetName())
+			continue
+		}
+		req.Requests = append(req.Requests, &rpc.UpdateApiVersionRequest{
+			ApiVersion: version,
+			UpdateMask: &field_mask.FieldMask{
+				Paths: []string{"annotations"},
+			},
+		})
+	}
+	if len(req.Requests) == 0 {
 		return nil
 	}
-	_, err = task.client.UpdateApiSpec(ctx,
-		&rpc.UpdateApiSpecRequest{
-			ApiSpec: task.spec,
+
+	response, err := task.client.BatchUpdateApiVersions(ctx, req)
+	if err != nil {
+		return err
+	}
+	for i, result := range response.GetResults() {
+		name := req.Requests[i].GetApiVersion().GetName()
+		switch err := core.BatchError(result.GetStatus()); status.Code(err) {
+		case codes.OK:
+		case codes.Aborted:
+			// Versions that changed after they were listed are read again and annotated individually.
+			version, err := task.client.GetApiVersion(ctx, &rpc.GetApiVersionRequest{Name: name})
+			if err != nil {
+				return err
+			}
+			if err := (&annotateVersionTask{client: task.client, version: version, labeling: task.labeling}).Run(ctx); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("failed to annotate %s: %s", name, err)
+		}
+	}
+	return nil
+}
+
+type annotateSpecsTask struct {
+	client   connection.Client
+	parent   string
+	specs    []*rpc.ApiSpec
+	labeling *core.Labeling
+}
+
+func (task *annotateSpecsTask) String() string {
+	return fmt.Sprintf("annotate %d specs in %s", len(task.specs), task.parent)
+}
+
+func (task *annotateSpecsTask) Run(ctx context.Context) error {
+	req := &rpc.BatchUpdateApiSpecsRequest{Parent: task.parent}
+	for _, spec := range task.specs {
+		var err error
+		spec.Annotations, err = task.labeling.Apply(spec.Annotations)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Errorf("Invalid annotation of %s", spec.GetName())
+			continue
+		}
+		req.Requests = append(req.Requests, &rpc.UpdateApiSpecRequest{
+			ApiSpec: spec,
 			UpdateMask: &field_mask.FieldMask{
 				Paths: []string{"annotations"},
 			},
 		})
-	return err
+	}
+	if len(req.Requests) == 0 {
+		return nil
+	}
+
+	response, err := task.client.BatchUpdateApiSpecs(ctx, req)
+	if err != nil {
+		return err
+	}
+	for i, result := range response.GetResults() {
+		name := req.Requests[i].GetApiSpec().GetName()
+		switch err := core.BatchError(result.GetStatus()); status.Code(err) {
+		case codes.OK:
+		case codes.Aborted:
+			// Specs that changed after they were listed are read again and annotated individually.
+			spec, err := task.client.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: name})
+			if err != nil {
+				return err
+			}
+			if err := (&annotateSpecTask{client: task.client, spec: spec, labeling: task.labeling}).Run(ctx); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("failed to annotate %s: %s", name, err)
+		}
+	}
+	return nil
+}
+
+type annotateArtifactsTask struct {
+	client    connection.Client
+	parent    string
+	artifacts []*rpc.Artifact
+	labeling  *core.Labeling
+}
+
+func (task *annotateArtifactsTask) String() string {
+	return fmt.Sprintf("annotate %d artifacts in %s", len(task.artifacts), task.parent)
+}
+
+func (task *annotateArtifactsTask) Run(ctx context.Context) error {
+	req := &rpc.BatchUpdateArtifactsRequest{Parent: task.parent}
+	for _, artifact := range task.artifacts {
+		var err error
+		artifact.Annotations, err = task.labeling.Apply(artifact.Annotations)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Errorf("Invalid annotation of %s", artifact.GetName())
+			continue
+		}
+		req.Requests = append(req.Requests, &rpc.UpdateArtifactRequest{
+			Artifact: artifact,
+			UpdateMask: &field_mask.FieldMask{
+				Paths: []string{"annotations"},
+			},
+		})
+	}
+	if len(req.Requests) == 0 {
+		return nil
+	}
+
+	response, err := task.client.BatchUpdateArtifacts(ctx, req)
+	if err != nil {
+		return err
+	}
+	for i, result := range response.GetResults() {
+		name := req.Requests[i].GetArtifact().GetName()
+		switch err := core.BatchError(result.GetStatus()); status.Code(err) {
+		case codes.OK:
+		case codes.Aborted:
+			// Artifacts that changed after they were listed are read again and annotated individually.
+			artifactName, err := names.ParseArtifact(name)
+			if err != nil {
+				return err
+			}
+			artifact, err := core.GetArtifact(ctx, task.client, artifactName, false, nil)
+			if err != nil {
+				return err
+			}
+			if err := (&annotateArtifactTask{client: task.client, artifact: artifact, labeling: task.labeling}).Run(ctx); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("failed to annotate %s: %s", name, err)
+		}
+	}
+	return nil
 }
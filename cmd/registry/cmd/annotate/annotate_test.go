@@ -27,14 +27,16 @@ import (
 
 func TestAnnotate(t *testing.T) {
 	const (
-		projectID   = "annotate-test"
-		projectName = "projects/" + projectID
-		apiID       = "sample"
-		apiName     = projectName + "/locations/global/apis/" + apiID
-		versionID   = "1.0.0"
-		versionName = apiName + "/versions/" + versionID
-		specID      = "openapi.json"
-		specName    = versionName + "/specs/" + specID
+		projectID    = "annotate-test"
+		projectName  = "projects/" + projectID
+		apiID        = "sample"
+		apiName      = projectName + "/locations/global/apis/" + apiID
+		versionID    = "1.0.0"
+		versionName  = apiName + "/versions/" + versionID
+		specID       = "openapi.json"
+		specName     = versionName + "/specs/" + specID
+		artifactID   = "complexity"
+		artifactName = specName + "/artifacts/" + artifactID
 	)
 
 	// Create a registry client.
@@ -98,6 +100,18 @@ func TestAnnotate(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Error creating spec %s", err)
 	}
+	// Create a sample artifact.
+	_, err = registryClient.CreateArtifact(ctx, &rpc.CreateArtifactRequest{
+		Parent:     specName,
+		ArtifactId: artifactID,
+		Artifact: &rpc.Artifact{
+			MimeType: "text/plain",
+			Contents: []byte("sample"),
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error creating artifact %s", err)
+	}
 
 	testCases := []struct {
 		comment  string
@@ -169,6 +183,34 @@ func TestAnnotate(t *testing.T) {
 		}
 	}
 
+	// test annotations for artifacts.
+	for _, tc := range testCases {
+		cmd := Command(ctx)
+		cmd.SetArgs(append([]string{artifactName}, tc.args...))
+		if err := cmd.Execute(); err != nil {
+			t.Fatalf("Execute() with args %+v returned error: %s", tc.args, err)
+		}
+		artifact, err := registryClient.GetArtifact(ctx, &rpc.GetArtifactRequest{
+			Name: artifactName,
+		})
+		if err != nil {
+			t.Errorf("Error getting artifact %s", err)
+		} else {
+			if diff := cmp.Diff(artifact.Annotations, tc.expected); diff != "" {
+				t.Errorf("annotations were incorrectly set %+v", artifact.Annotations)
+			}
+		}
+	}
+	// The contents of artifacts are preserved.
+	contents, err := registryClient.GetArtifactContents(ctx, &rpc.GetArtifactContentsRequest{
+		Name: artifactName,
+	})
+	if err != nil {
+		t.Errorf("Error getting artifact contents %s", err)
+	} else if string(contents.GetData()) != "sample" {
+		t.Errorf("artifact contents were changed to %q", contents.GetData())
+	}
+
 	// Delete the test project.
 	{
 		req := &rpc.DeleteProjectRequest{
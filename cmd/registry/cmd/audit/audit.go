@@ -0,0 +1,121 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/apigee/registry/connection"
+	"github.com/apigee/registry/log"
+	"github.com/apigee/registry/rpc"
+	"github.com/spf13/cobra"
+	"google.golang.org/api/iterator"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func Command(ctx context.Context) *cobra.Command {
+	var (
+		principal string
+		since     string
+		until     string
+		filter    string
+		limit     int
+		jsonOut   bool
+	)
+	cmd := &cobra.Command{
+		Use:   "audit [PATTERN]",
+		Short: "List the audit log of changes to the API Registry",
+		Long: "List the calls that changed, or attempted to change, the API Registry in the order they were made. " +
+			"Resource IDs in the pattern can be replaced with \"-\" to match any resource in a collection.",
+		Example: "registry audit projects/demo/locations/global/apis/petstore --since 24h",
+		Args:    cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			req := &rpc.ListAuditEventsRequest{
+				Principal: principal,
+				Filter:    filter,
+			}
+			if len(args) > 0 {
+				req.Pattern = args[0]
+			}
+
+			now := time.Now()
+			if since != "" {
+				t, err := parseTime(since, now)
+				if err != nil {
+					log.FromContext(ctx).WithError(err).Fatal("Invalid --since")
+				}
+				req.StartTime = timestamppb.New(t)
+			}
+			if until != "" {
+				t, err := parseTime(until, now)
+				if err != nil {
+					log.FromContext(ctx).WithError(err).Fatal("Invalid --until")
+				}
+				req.EndTime = timestamppb.New(t)
+			}
+
+			client, err := connection.NewAdminClient(ctx)
+			if err != nil {
+				log.FromContext(ctx).WithError(err).Fatal("Failed to get client")
+			}
+
+			it := client.ListAuditEvents(ctx, req)
+			for count := 0; limit <= 0 || count < limit; count++ {
+				event, err := it.Next()
+				if err == iterator.Done {
+					break
+				} else if err != nil {
+					log.FromContext(ctx).WithError(err).Fatal("Failed to list audit events")
+				}
+
+				if jsonOut {
+					fmt.Fprintln(cmd.OutOrStdout(), protojson.Format(event))
+					continue
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), strings.Join([]string{
+					event.GetTime().AsTime().Format(time.RFC3339),
+					event.GetPrincipal(),
+					event.GetMethod(),
+					event.GetResource(),
+					event.GetStatusCode(),
+				}, "\t"))
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&principal, "principal", "", "List only the calls made by this principal")
+	cmd.Flags().StringVar(&since, "since", "", "List only calls made since a time, either RFC 3339 or a duration before now like \"24h\"")
+	cmd.Flags().StringVar(&until, "until", "", "List only calls made before a time, either RFC 3339 or a duration before now like \"1h\"")
+	cmd.Flags().StringVar(&filter, "filter", "", "Filter expression, e.g. 'method == \"DeleteApi\"'")
+	cmd.Flags().IntVar(&limit, "limit", 50, "Maximum number of calls to list, or 0 to list all calls")
+	cmd.Flags().BoolVar(&jsonOut, "json", false, "Print each call as JSON")
+	return cmd
+}
+
+// parseTime returns the time described by an RFC 3339 timestamp or by a duration before now.
+func parseTime(s string, now time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(s); err == nil {
+		return now.Add(-d), nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q must be an RFC 3339 timestamp or a duration", s)
+	}
+	return t, nil
+}
@@ -0,0 +1,172 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/connection"
+	"github.com/apigee/registry/rpc"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// auditCalls runs the audit command and returns the tab-separated fields of each call that it lists.
+func auditCalls(ctx context.Context, t *testing.T, args ...string) [][]string {
+	t.Helper()
+	out := new(bytes.Buffer)
+	cmd := Command(ctx)
+	cmd.SetOut(out)
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() with args %+v returned error: %s", args, err)
+	}
+	calls := [][]string{}
+	for _, line := range strings.Split(out.String(), "\n") {
+		if line != "" {
+			calls = append(calls, strings.Split(line, "\t"))
+		}
+	}
+	return calls
+}
+
+func TestAudit(t *testing.T) {
+	const (
+		projectID   = "audit-test"
+		projectName = "projects/" + projectID
+		parent      = projectName + "/locations/global"
+		apiName     = parent + "/apis/sample"
+		otherName   = parent + "/apis/other"
+		versionName = apiName + "/versions/1.0.0"
+	)
+
+	// Create a registry client.
+	ctx := context.Background()
+	registryClient, err := connection.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("Error creating client: %+v", err)
+	}
+	defer registryClient.Close()
+	adminClient, err := connection.NewAdminClient(ctx)
+	if err != nil {
+		t.Fatalf("Error creating client: %+v", err)
+	}
+	defer adminClient.Close()
+	// Clear the test project.
+	err = adminClient.DeleteProject(ctx, &rpc.DeleteProjectRequest{
+		Name:  projectName,
+		Force: true,
+	})
+	if err != nil && status.Code(err) != codes.NotFound {
+		t.Fatalf("Error deleting test project: %+v", err)
+	}
+	// Only list the calls made by this test.
+	since := time.Now().Format(time.RFC3339Nano)
+	// Create the test project.
+	_, err = adminClient.CreateProject(ctx, &rpc.CreateProjectRequest{
+		ProjectId: projectID,
+		Project:   &rpc.Project{},
+	})
+	if err != nil {
+		t.Fatalf("Error creating project %s", err)
+	}
+	// Create some sample apis.
+	for _, id := range []string{"sample", "other"} {
+		_, err = registryClient.CreateApi(ctx, &rpc.CreateApiRequest{
+			Parent: parent,
+			ApiId:  id,
+			Api:    &rpc.Api{},
+		})
+		if err != nil {
+			t.Fatalf("Error creating api %s", err)
+		}
+	}
+	// Create a sample version.
+	_, err = registryClient.CreateApiVersion(ctx, &rpc.CreateApiVersionRequest{
+		Parent:       apiName,
+		ApiVersionId: "1.0.0",
+		ApiVersion:   &rpc.ApiVersion{},
+	})
+	if err != nil {
+		t.Fatalf("Error creating version %s", err)
+	}
+
+	// The principal of the calls depends on how the server identifies callers.
+	calls := auditCalls(ctx, t, apiName, "--since", since)
+	if len(calls) != 1 || len(calls[0]) != 5 {
+		t.Fatalf("Execute() listed %v, expected one call to %s", calls, apiName)
+	}
+	principal := calls[0][1]
+
+	testCases := []struct {
+		comment  string
+		args     []string
+		expected []string
+	}{
+		{comment: "list the calls to one api",
+			args:     []string{apiName},
+			expected: []string{"CreateApi " + apiName}},
+		{comment: "list the calls to all apis",
+			args:     []string{parent + "/apis/-"},
+			expected: []string{"CreateApi " + apiName, "CreateApi " + otherName}},
+		{comment: "list the calls to the versions of all apis",
+			args:     []string{parent + "/apis/-/versions/-"},
+			expected: []string{"CreateApiVersion " + versionName}},
+		{comment: "list the calls to a project",
+			args:     []string{projectName},
+			expected: []string{"CreateProject " + projectName}},
+		{comment: "list the calls to a resource that wasn't changed",
+			args:     []string{parent + "/apis/missing"},
+			expected: []string{}},
+		{comment: "list the calls of the principal that made them",
+			args:     []string{parent + "/apis/-", "--principal", principal},
+			expected: []string{"CreateApi " + apiName, "CreateApi " + otherName}},
+		{comment: "list the calls of a principal that made none",
+			args:     []string{parent + "/apis/-", "--principal", principal + "-nobody"},
+			expected: []string{}},
+		{comment: "list the calls of a filtered method",
+			args:     []string{parent + "/apis/-", "--filter", `method == "CreateApi"`},
+			expected: []string{"CreateApi " + apiName, "CreateApi " + otherName}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.comment, func(t *testing.T) {
+			got := []string{}
+			for _, call := range auditCalls(ctx, t, append(tc.args, "--since", since)...) {
+				got = append(got, call[2]+" "+call[3])
+			}
+			opts := cmp.Options{
+				cmpopts.EquateEmpty(),
+				cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+			}
+			if diff := cmp.Diff(tc.expected, got, opts); diff != "" {
+				t.Errorf("Execute() with args %+v returned unexpected calls (-want +got):\n%s", tc.args, diff)
+			}
+		})
+	}
+
+	// Delete the test project.
+	if err := adminClient.DeleteProject(ctx, &rpc.DeleteProjectRequest{
+		Name:  projectName,
+		Force: true,
+	}); err != nil {
+		t.Fatalf("Failed to delete test project: %s", err)
+	}
+}
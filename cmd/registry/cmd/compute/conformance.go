@@ -30,6 +30,10 @@ import (
 	"github.com/apigee/registry/rpc"
 	"github.com/apigee/registry/server/registry/names"
 	"github.com/spf13/cobra"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -258,7 +262,14 @@ func (task *computeConformanceTask) computeConformanceReport(
 		cmd.Stdin = bytes.NewReader(requestBytes)
 		cmd.Stderr = os.Stderr
 		pluginStartTime := time.Now()
+		_, span := otel.Tracer("github.com/apigee/registry/cmd/registry/cmd/compute").Start(ctx, executableName,
+			trace.WithAttributes(attribute.String("registry.spec", task.spec.GetName())))
 		output, err := cmd.Output()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
 
 		// If a linter returned an error, we shouldn't stop linting completely across all linters and
 		// discard the conformance report for this spec. We should log but still continue, because there
@@ -25,6 +25,7 @@ import (
 	"github.com/apigee/registry/rpc"
 	"github.com/apigee/registry/server/registry/names"
 	"github.com/spf13/cobra"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
 )
 
 func Command(ctx context.Context) *cobra.Command {
@@ -56,28 +57,82 @@ func Command(ctx context.Context) *cobra.Command {
 
 type deleteTask struct {
 	client       connection.Client
-	resourceName string
+	parent       string
 	resourceKind string
+	names        []string
 }
 
 func (task *deleteTask) String() string {
-	return "delete " + task.resourceName
+	return fmt.Sprintf("delete %d %ss in %s", len(task.names), task.resourceKind, task.parent)
 }
 
 func (task *deleteTask) Run(ctx context.Context) error {
-	log.Debugf(ctx, "Deleting %s %s", task.resourceKind, task.resourceName)
+	log.Debugf(ctx, "Deleting %d %ss in %s", len(task.names), task.resourceKind, task.parent)
+	var statuses []*spb.Status
 	switch task.resourceKind {
 	case "api":
-		return task.client.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: task.resourceName})
+		req := &rpc.BatchDeleteApisRequest{Parent: task.parent}
+		for _, name := range task.names {
+			req.Requests = append(req.Requests, &rpc.DeleteApiRequest{Name: name})
+		}
+		resp, err := task.client.BatchDeleteApis(ctx, req)
+		if err != nil {
+			return err
+		}
+		statuses = resp.GetStatuses()
 	case "version":
-		return task.client.DeleteApiVersion(ctx, &rpc.DeleteApiVersionRequest{Name: task.resourceName})
+		req := &rpc.BatchDeleteApiVersionsRequest{Parent: task.parent}
+		for _, name := range task.names {
+			req.Requests = append(req.Requests, &rpc.DeleteApiVersionRequest{Name: name})
+		}
+		resp, err := task.client.BatchDeleteApiVersions(ctx, req)
+		if err != nil {
+			return err
+		}
+		statuses = resp.GetStatuses()
 	case "spec":
-		return task.client.DeleteApiSpec(ctx, &rpc.DeleteApiSpecRequest{Name: task.resourceName})
+		req := &rpc.BatchDeleteApiSpecsRequest{Parent: task.parent}
+		for _, name := range task.names {
+			req.Requests = append(req.Requests, &rpc.DeleteApiSpecRequest{Name: name})
+		}
+		resp, err := task.client.BatchDeleteApiSpecs(ctx, req)
+		if err != nil {
+			return err
+		}
+		statuses = resp.GetStatuses()
 	case "artifact":
-		return task.client.DeleteArtifact(ctx, &rpc.DeleteArtifactRequest{Name: task.resourceName})
-	default:
-		return nil
+		req := &rpc.BatchDeleteArtifactsRequest{Parent: task.parent}
+		for _, name := range task.names {
+			req.Requests = append(req.Requests, &rpc.DeleteArtifactRequest{Name: name})
+		}
+		resp, err := task.client.BatchDeleteArtifacts(ctx, req)
+		if err != nil {
+			return err
+		}
+		statuses = resp.GetStatuses()
+	}
+
+	for i, s := range statuses {
+		if err := core.BatchError(s); err != nil {
+			return fmt.Errorf("failed to delete %s: %s", task.names[i], err)
+		}
 	}
+	return nil
+}
+
+// deleteBatcher returns a batcher that queues a task to delete each batch of resources of a kind.
+func deleteBatcher(client connection.Client, kind string, taskQueue chan<- core.Task) *core.Batcher {
+	return core.NewBatcher(taskQueue, func(parent string, items []interface{}) core.Task {
+		task := &deleteTask{
+			client:       client,
+			parent:       parent,
+			resourceKind: kind,
+		}
+		for _, item := range items {
+			task.names = append(task.names, item.(string))
+		}
+		return task
+	})
 }
 
 func matchAndHandleDeleteCmd(
@@ -106,12 +161,10 @@ func deleteAPIs(
 	api names.Api,
 	filterFlag string,
 	taskQueue chan<- core.Task) error {
+	batches := deleteBatcher(client, "api", taskQueue)
+	defer batches.Flush()
 	return core.ListAPIs(ctx, client, api, filterFlag, func(api *rpc.Api) {
-		taskQueue <- &deleteTask{
-			client:       client,
-			resourceName: api.Name,
-			resourceKind: "api",
-		}
+		batches.Add(core.BatchParent(api.GetName()), api.GetName())
 	})
 }
 
@@ -121,12 +174,10 @@ func deleteVersions(
 	version names.Version,
 	filterFlag string,
 	taskQueue chan<- core.Task) error {
+	batches := deleteBatcher(client, "version", taskQueue)
+	defer batches.Flush()
 	return core.ListVersions(ctx, client, version, filterFlag, func(version *rpc.ApiVersion) {
-		taskQueue <- &deleteTask{
-			client:       client,
-			resourceName: version.Name,
-			resourceKind: "version",
-		}
+		batches.Add(core.BatchParent(version.GetName()), version.GetName())
 	})
 }
 
@@ -136,12 +187,10 @@ func deleteSpecs(
 	spec names.Spec,
 	filterFlag string,
 	taskQueue chan<- core.Task) error {
+	batches := deleteBatcher(client, "spec", taskQueue)
+	defer batches.Flush()
 	return core.ListSpecs(ctx, client, spec, filterFlag, func(spec *rpc.ApiSpec) {
-		taskQueue <- &deleteTask{
-			client:       client,
-			resourceName: spec.Name,
-			resourceKind: "spec",
-		}
+		batches.Add(core.BatchParent(spec.GetName()), spec.GetName())
 	})
 }
 
@@ -151,11 +200,9 @@ func deleteArtifacts(
 	artifact names.Artifact,
 	filterFlag string,
 	taskQueue chan<- core.Task) error {
+	batches := deleteBatcher(client, "artifact", taskQueue)
+	defer batches.Flush()
 	return core.ListArtifacts(ctx, client, artifact, filterFlag, false, func(artifact *rpc.Artifact) {
-		taskQueue <- &deleteTask{
-			client:       client,
-			resourceName: artifact.Name,
-			resourceKind: "artifact",
-		}
+		batches.Add(core.BatchParent(artifact.GetName()), artifact.GetName())
 	})
 }
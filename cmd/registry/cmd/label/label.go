@@ -27,6 +27,8 @@ import (
 	"github.com/apigee/registry/server/registry/names"
 	"github.com/spf13/cobra"
 	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func Command(ctx context.Context) *cobra.Command {
@@ -93,6 +95,8 @@ func matchAndHandleLabelCmd(
 		return labelVersions(ctx, client, version, filter, labeling, taskQueue)
 	} else if spec, err := names.ParseSpecCollection(name); err == nil {
 		return labelSpecs(ctx, client, spec, filter, labeling, taskQueue)
+	} else if artifact, err := names.ParseArtifactCollection(name); err == nil {
+		return labelArtifacts(ctx, client, artifact, filter, labeling, taskQueue)
 	}
 
 	// Then try to match resource names.
@@ -102,6 +106,8 @@ func matchAndHandleLabelCmd(
 		return labelVersions(ctx, client, version, filter, labeling, taskQueue)
 	} else if spec, err := names.ParseSpec(name); err == nil {
 		return labelSpecs(ctx, client, spec, filter, labeling, taskQueue)
+	} else if artifact, err := names.ParseArtifact(name); err == nil {
+		return labelArtifacts(ctx, client, artifact, filter, labeling, taskQueue)
 	} else {
 		return fmt.Errorf("unsupported resource name %s", name)
 	}
@@ -113,12 +119,20 @@ func labelAPIs(ctx context.Context,
 	filterFlag string,
 	labeling *core.Labeling,
 	taskQueue chan<- core.Task) error {
-	return core.ListAPIs(ctx, client, api, filterFlag, func(api *rpc.Api) {
-		taskQueue <- &labelApiTask{
+	batches := core.NewBatcher(taskQueue, func(parent string, items []interface{}) core.Task {
+		task := &labelAPIsTask{
 			client:   client,
-			api:      api,
+			parent:   parent,
 			labeling: labeling,
 		}
+		for _, item := range items {
+			task.apis = append(task.apis, item.(*rpc.Api))
+		}
+		return task
+	})
+	defer batches.Flush()
+	return core.ListAPIs(ctx, client, api, filterFlag, func(api *rpc.Api) {
+		batches.Add(core.BatchParent(api.GetName()), api)
 	})
 }
 
@@ -129,12 +143,20 @@ func labelVersions(
 	filterFlag string,
 	labeling *core.Labeling,
 	taskQueue chan<- core.Task) error {
-	return core.ListVersions(ctx, client, version, filterFlag, func(version *rpc.ApiVersion) {
-		taskQueue <- &labelVersionTask{
+	batches := core.NewBatcher(taskQueue, func(parent string, items []interface{}) core.Task {
+		task := &labelVersionsTask{
 			client:   client,
-			version:  version,
+			parent:   parent,
 			labeling: labeling,
 		}
+		for _, item := range items {
+			task.versions = append(task.versions, item.(*rpc.ApiVersion))
+		}
+		return task
+	})
+	defer batches.Flush()
+	return core.ListVersions(ctx, client, version, filterFlag, func(version *rpc.ApiVersion) {
+		batches.Add(core.BatchParent(version.GetName()), version)
 	})
 }
 
@@ -145,12 +167,44 @@ func labelSpecs(
 	filterFlag string,
 	labeling *core.Labeling,
 	taskQueue chan<- core.Task) error {
+	batches := core.NewBatcher(taskQueue, func(parent string, items []interface{}) core.Task {
+		task := &labelSpecsTask{
+			client:   client,
+			parent:   parent,
+			labeling: labeling,
+		}
+		for _, item := range items {
+			task.specs = append(task.specs, item.(*rpc.ApiSpec))
+		}
+		return task
+	})
+	defer batches.Flush()
 	return core.ListSpecs(ctx, client, spec, filterFlag, func(spec *rpc.ApiSpec) {
-		taskQueue <- &labelSpecTask{
+		batches.Add(core.BatchParent(spec.GetName()), spec)
+	})
+}
+
+func labelArtifacts(
+	ctx context.Context,
+	client *gapic.RegistryClient,
+	artifact names.Artifact,
+	filterFlag string,
+	labeling *core.Labeling,
+	taskQueue chan<- core.Task) error {
+	batches := core.NewBatcher(taskQueue, func(parent string, items []interface{}) core.Task {
+		task := &labelArtifactsTask{
 			client:   client,
-			spec:     spec,
+			parent:   parent,
 			labeling: labeling,
 		}
+		for _, item := range items {
+			task.artifacts = append(task.artifacts, item.(*rpc.Artifact))
+		}
+		return task
+	})
+	defer batches.Flush()
+	return core.ListArtifacts(ctx, client, artifact, filterFlag, false, func(artifact *rpc.Artifact) {
+		batches.Add(core.BatchParent(artifact.GetName()), artifact)
 	})
 }
 
@@ -165,20 +219,26 @@ func (task *labelApiTask) String() string {
 }
 
 func (task *labelApiTask) Run(ctx context.Context) error {
-	var err error
-	task.api.Labels, err = task.labeling.Apply(task.api.Labels)
-	if err != nil {
-		log.FromContext(ctx).WithError(err).Errorf("Invalid labelling")
-		return nil
-	}
-	_, err = task.client.UpdateApi(ctx,
-		&rpc.UpdateApiRequest{
-			Api: task.api,
-			UpdateMask: &field_mask.FieldMask{
-				Paths: []string{"labels"},
-			},
-		})
-	return err
+	// The API is read again if it changed after it was listed.
+	return core.RetryAborted(ctx, func() (err error) {
+		task.api, err = task.client.GetApi(ctx, &rpc.GetApiRequest{Name: task.api.GetName()})
+		return err
+	}, func() error {
+		var err error
+		task.api.Labels, err = task.labeling.Apply(task.api.Labels)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Errorf("Invalid labelling")
+			return nil
+		}
+		_, err = task.client.UpdateApi(ctx,
+			&rpc.UpdateApiRequest{
+				Api: task.api,
+				UpdateMask: &field_mask.FieldMask{
+					Paths: []string{"labels"},
+				},
+			})
+		return err
+	})
 }
 
 type labelVersionTask struct {
@@ -192,20 +252,26 @@ func (task *labelVersionTask) String() string {
 }
 
 func (task *labelVersionTask) Run(ctx context.Context) error {
-	var err error
-	task.version.Labels, err = task.labeling.Apply(task.version.Labels)
-	if err != nil {
-		log.FromContext(ctx).WithError(err).Errorf("Invalid labelling")
-		return nil
-	}
-	_, err = task.client.UpdateApiVersion(ctx,
-		&rpc.UpdateApiVersionRequest{
-			ApiVersion: task.version,
-			UpdateMask: &field_mask.FieldMask{
-				Paths: []string{"labels"},
-			},
-		})
-	return err
+	// The version is read again if it changed after it was listed.
+	return core.RetryAborted(ctx, func() (err error) {
+		task.version, err = task.client.GetApiVersion(ctx, &rpc.GetApiVersionRequest{Name: task.version.GetName()})
+		return err
+	}, func() error {
+		var err error
+		task.version.Labels, err = task.labeling.Apply(task.version.Labels)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Errorf("Invalid labelling")
+			return nil
+		}
+		_, err = task.client.UpdateApiVersion(ctx,
+			&rpc.UpdateApiVersionRequest{
+				ApiVersion: task.version,
+				UpdateMask: &field_mask.FieldMask{
+					Paths: []string{"labels"},
+				},
+			})
+		return err
+	})
 }
 
 type labelSpecTask struct {
@@ -219,18 +285,285 @@ func (task *labelSpecTask) String() string {
 }
 
 func (task *labelSpecTask) Run(ctx context.Context) error {
-	var err error
-	task.spec.Labels, err = task.labeling.Apply(task.spec.Labels)
+	// The spec is read again if it changed after it was listed.
+	return core.RetryAborted(ctx, func() (err error) {
+		task.spec, err = task.client.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: task.spec.GetName()})
+		return err
+	}, func() error {
+		var err error
+		task.spec.Labels, err = task.labeling.Apply(task.spec.Labels)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Errorf("Invalid labelling")
+			return nil
+		}
+		_, err = task.client.UpdateApiSpec(ctx,
+			&rpc.UpdateApiSpecRequest{
+				ApiSpec: task.spec,
+				UpdateMask: &field_mask.FieldMask{
+					Paths: []string{"labels"},
+				},
+			})
+		return err
+	})
+}
+
+type labelArtifactTask struct {
+	client   connection.Client
+	artifact *rpc.Artifact
+	labeling *core.Labeling
+}
+
+func (task *labelArtifactTask) String() string {
+	return "label " + task.artifact.Name
+}
+
+func (task *labelArtifactTask) Run(ctx context.Context) error {
+	// The artifact is read again if it changed after it was listed.
+	return core.RetryAborted(ctx, func() (err error) {
+		name, err := names.ParseArtifact(task.artifact.GetName())
+		if err != nil {
+			return err
+		}
+		task.artifact, err = core.GetArtifact(ctx, task.client, name, false, nil)
+		return err
+	}, func() error {
+		var err error
+		task.artifact.Labels, err = task.labeling.Apply(task.artifact.Labels)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Errorf("Invalid labelling")
+			return nil
+		}
+		_, err = task.client.UpdateArtifact(ctx,
+			&rpc.UpdateArtifactRequest{
+				Artifact: task.artifact,
+				UpdateMask: &field_mask.FieldMask{
+					Paths: []string{"labels"},
+				},
+			})
+		return err
+	})
+}
+
+type labelAPIsTask struct {
+	client   connection.Client
+	parent   string
+	apis     []*rpc.Api
+	labeling *core.Labeling
+}
+
+func (task *labelAPIsTask) String() string {
+	return fmt.Sprintf("label %d apis in %s", len(task.apis), task.parent)
+}
+
+func (task *labelAPIsTask) Run(ctx context.Context) error {
+	req := &rpc.BatchUpdateApisRequest{Parent: task.parent}
+	for _, api := range task.apis {
+		var err error
+		api.Labels, err = task.labeling.Apply(api.Labels)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Errorf("Invalid labelling of %s", api.GetName())
+			continue
+		}
+		req.Requests = append(req.Requests, &rpc.UpdateApiRequest{
+			Api: api,
+			UpdateMask: &field_mask.FieldMask{
+				Paths: []string{"labels"},
+			},
+		})
+	}
+	if len(req.Requests) == 0 {
+		return nil
+	}
+
+	response, err := task.client.BatchUpdateApis(ctx, req)
 	if err != nil {
-		log.FromContext(ctx).WithError(err).Errorf("Invalid labelling")
+		return err
+	}
+	for i, result := range response.GetResults() {
+		name := req.Requests[i].GetApi().GetName()
+		switch err := core.BatchError(result.GetStatus()); status.Code(err) {
+		case codes.OK:
+		case codes.Aborted:
+			// APIs that changed after they were listed are read again and labeled individually.
+			api, err := task.client.GetApi(ctx, &rpc.GetApiRequest{Name: name})
+			if err != nil {
+				return err
+			}
+			if err := (&labelApiTask{client: task.client, api: api, labeling: task.labeling}).Run(ctx); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("failed to label %s: %s", name, err)
+		}
+	}
+	return nil
+}
+
+type labelVersionsTask struct {
+	client   connection.Client
+	parent   string
+	versions []*rpc.ApiVersion
+	labeling *core.Labeling
+}
+
+func (task *labelVersionsTask) String() string {
+	return fmt.Sprintf("label %d versions in %s", len(task.versions), task.parent)
+}
+
+func (task *labelVersionsTask) Run(ctx context.Context) error {
+	req := &rpc.BatchUpdateApiVersionsRequest{Parent: task.parent}
+	for _, version := range task.versions {
+		var err error
+		version.Labels, err = task.labeling.Apply(version.Labels)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Errorf("Invalid labelling of %s", version.GetName())
+			continue
+		}
+		req.Requests = append(req.Requests, &rpc.UpdateApiVersionRequest{
+			ApiVersion: version,
+			UpdateMask: &field_mask.FieldMask{
+				Paths: []string{"labels"},
+			},
+		})
+	}
+	if len(req.Requests) == 0 {
 		return nil
 	}
-	_, err = task.client.UpdateApiSpec(ctx,
-		&rpc.UpdateApiSpecRequest{
-			ApiSpec: task.spec,
+
+	response, err := task.client.BatchUpdateApiVersions(ctx, req)
+	if err != nil {
+		return err
+	}
+	for i, result := range response.GetResults() {
+		name := req.Requests[i].GetApiVersion().GetName()
+		switch err := core.BatchError(result.GetStatus()); status.Code(err) {
+		case codes.OK:
+		case codes.Aborted:
+			// Versions that changed after they were listed are read again and labeled individually.
+			version, err := task.client.GetApiVersion(ctx, &rpc.GetApiVersionRequest{Name: name})
+			if err != nil {
+				return err
+			}
+			if err := (&labelVersionTask{client: task.client, version: version, labeling: task.labeling}).Run(ctx); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("failed to label %s: %s", name, err)
+		}
+	}
+	return nil
+}
+
+type labelSpecsTask struct {
+	client   connection.Client
+	parent   string
+	specs    []*rpc.ApiSpec
+	labeling *core.Labeling
+}
+
+func (task *labelSpecsTask) String() string {
+	return fmt.Sprintf("label %d specs in %s", len(task.specs), task.parent)
+}
+
+func (task *labelSpecsTask) Run(ctx context.Context) error {
+	req := &rpc.BatchUpdateApiSpecsRequest{Parent: task.parent}
+	for _, spec := range task.specs {
+		var err error
+		spec.Labels, err = task.labeling.Apply(spec.Labels)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Errorf("Invalid labelling of %s", spec.GetName())
+			continue
+		}
+		req.Requests = append(req.Requests, &rpc.UpdateApiSpecRequest{
+			ApiSpec: spec,
 			UpdateMask: &field_mask.FieldMask{
 				Paths: []string{"labels"},
 			},
 		})
-	return err
+	}
+	if len(req.Requests) == 0 {
+		return nil
+	}
+
+	response, err := task.client.BatchUpdateApiSpecs(ctx, req)
+	if err != nil {
+		return err
+	}
+	for i, result := range response.GetResults() {
+		name := req.Requests[i].GetApiSpec().GetName()
+		switch err := core.BatchError(result.GetStatus()); status.Code(err) {
+		case codes.OK:
+		case codes.Aborted:
+			// Specs that changed after they were listed are read again and labeled individually.
+			spec, err := task.client.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: name})
+			if err != nil {
+				return err
+			}
+			if err := (&labelSpecTask{client: task.client, spec: spec, labeling: task.labeling}).Run(ctx); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("failed to label %s: %s", name, err)
+		}
+	}
+	return nil
+}
+
+type labelArtifactsTask struct {
+	client    connection.Client
+	parent    string
+	artifacts []*rpc.Artifact
+	labeling  *core.Labeling
+}
+
+func (task *labelArtifactsTask) String() string {
+	return fmt.Sprintf("label %d artifacts in %s", len(task.artifacts), task.parent)
+}
+
+func (task *labelArtifactsTask) Run(ctx context.Context) error {
+	req := &rpc.BatchUpdateArtifactsRequest{Parent: task.parent}
+	for _, artifact := range task.artifacts {
+		var err error
+		artifact.Labels, err = task.labeling.Apply(artifact.Labels)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Errorf("Invalid labelling of %s", artifact.GetName())
+			continue
+		}
+		req.Requests = append(req.Requests, &rpc.UpdateArtifactRequest{
+			Artifact: artifact,
+			UpdateMask: &field_mask.FieldMask{
+				Paths: []string{"labels"},
+			},
+		})
+	}
+	if len(req.Requests) == 0 {
+		return nil
+	}
+
+	response, err := task.client.BatchUpdateArtifacts(ctx, req)
+	if err != nil {
+		return err
+	}
+	for i, result := range response.GetResults() {
+		name := req.Requests[i].GetArtifact().GetName()
+		switch err := core.BatchError(result.GetStatus()); status.Code(err) {
+		case codes.OK:
+		case codes.Aborted:
+			// Artifacts that changed after they were listed are read again and labeled individually.
+			artifactName, err := names.ParseArtifact(name)
+			if err != nil {
+				return err
+			}
+			artifact, err := core.GetArtifact(ctx, task.client, artifactName, false, nil)
+			if err != nil {
+				return err
+			}
+			if err := (&labelArtifactTask{client: task.client, artifact: artifact, labeling: task.labeling}).Run(ctx); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("failed to label %s: %s", name, err)
+		}
+	}
+	return nil
 }
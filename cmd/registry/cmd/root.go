@@ -19,6 +19,7 @@ import (
 	"fmt"
 
 	"github.com/apigee/registry/cmd/registry/cmd/annotate"
+	"github.com/apigee/registry/cmd/registry/cmd/audit"
 	"github.com/apigee/registry/cmd/registry/cmd/compute"
 	"github.com/apigee/registry/cmd/registry/cmd/delete"
 	"github.com/apigee/registry/cmd/registry/cmd/export"
@@ -27,6 +28,7 @@ import (
 	"github.com/apigee/registry/cmd/registry/cmd/label"
 	"github.com/apigee/registry/cmd/registry/cmd/list"
 	"github.com/apigee/registry/cmd/registry/cmd/resolve"
+	"github.com/apigee/registry/cmd/registry/cmd/search"
 	"github.com/apigee/registry/cmd/registry/cmd/upload"
 	"github.com/apigee/registry/cmd/registry/cmd/vocabulary"
 	"github.com/apigee/registry/log"
@@ -47,6 +49,7 @@ func Command(ctx context.Context) *cobra.Command {
 	})
 
 	cmd.AddCommand(annotate.Command(ctx))
+	cmd.AddCommand(audit.Command(ctx))
 	cmd.AddCommand(compute.Command(ctx))
 	cmd.AddCommand(resolve.Command(ctx))
 	cmd.AddCommand(delete.Command(ctx))
@@ -55,6 +58,7 @@ func Command(ctx context.Context) *cobra.Command {
 	cmd.AddCommand(index.Command(ctx))
 	cmd.AddCommand(label.Command(ctx))
 	cmd.AddCommand(list.Command(ctx))
+	cmd.AddCommand(search.Command(ctx))
 	cmd.AddCommand(upload.Command(ctx))
 	cmd.AddCommand(vocabulary.Command(ctx))
 
@@ -0,0 +1,77 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/apigee/registry/connection"
+	"github.com/apigee/registry/log"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/names"
+	"github.com/spf13/cobra"
+	"google.golang.org/api/iterator"
+)
+
+func Command(ctx context.Context) *cobra.Command {
+	var limit int
+	var scores bool
+	cmd := &cobra.Command{
+		Use:   "search PROJECT QUERY...",
+		Short: "Search for APIs, versions, specs and deployments in the API Registry",
+		Long: "Search for the APIs, versions, specs and deployments of a project that contain all words of a query " +
+			"in their display names, descriptions, labels, annotations or spec contents. Results are listed with the most relevant first.",
+		Example: "registry search projects/demo/locations/global invoices",
+		Args:    cobra.MinimumNArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			client, err := connection.NewClient(ctx)
+			if err != nil {
+				log.FromContext(ctx).WithError(err).Fatal("Failed to get client")
+			}
+
+			project, err := names.ParseProjectWithLocation(args[0])
+			if err != nil {
+				if project, err = names.ParseProject(args[0]); err != nil {
+					log.FromContext(ctx).WithError(err).Fatal("Invalid project")
+				}
+			}
+
+			it := client.SearchResources(ctx, &rpc.SearchResourcesRequest{
+				Parent: project.String() + "/locations/global",
+				Query:  strings.Join(args[1:], " "),
+			})
+			for count := 0; limit <= 0 || count < limit; count++ {
+				result, err := it.Next()
+				if err == iterator.Done {
+					break
+				} else if err != nil {
+					log.FromContext(ctx).WithError(err).Fatal("Failed to search")
+				}
+
+				if scores {
+					fmt.Fprintf(cmd.OutOrStdout(), "%s\t%.4f\n", result.GetName(), result.GetScore())
+				} else {
+					fmt.Fprintln(cmd.OutOrStdout(), result.GetName())
+				}
+			}
+		},
+	}
+
+	cmd.Flags().IntVar(&limit, "limit", 50, "Maximum number of results to list, or 0 to list all results")
+	cmd.Flags().BoolVar(&scores, "scores", false, "List the relevance score of each result")
+	return cmd
+}
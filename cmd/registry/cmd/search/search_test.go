@@ -0,0 +1,160 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/apigee/registry/connection"
+	"github.com/apigee/registry/rpc"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSearch(t *testing.T) {
+	const (
+		projectID   = "search-test"
+		projectName = "projects/" + projectID
+		parent      = projectName + "/locations/global"
+		billingName = parent + "/apis/billing"
+		paymentName = parent + "/apis/payments"
+		parcelName  = parent + "/apis/parcels"
+	)
+
+	// Create a registry client.
+	ctx := context.Background()
+	registryClient, err := connection.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("Error creating client: %+v", err)
+	}
+	defer registryClient.Close()
+	adminClient, err := connection.NewAdminClient(ctx)
+	if err != nil {
+		t.Fatalf("Error creating client: %+v", err)
+	}
+	defer adminClient.Close()
+	// Clear the test project.
+	err = adminClient.DeleteProject(ctx, &rpc.DeleteProjectRequest{
+		Name:  projectName,
+		Force: true,
+	})
+	if err != nil && status.Code(err) != codes.NotFound {
+		t.Fatalf("Error deleting test project: %+v", err)
+	}
+	// Create the test project.
+	_, err = adminClient.CreateProject(ctx, &rpc.CreateProjectRequest{
+		ProjectId: projectID,
+		Project: &rpc.Project{
+			DisplayName: "Test",
+			Description: "A test catalog",
+		},
+	})
+	if err != nil {
+		t.Fatalf("Error creating project %s", err)
+	}
+	// Create some sample apis.
+	for id, api := range map[string]*rpc.Api{
+		"billing":  {DisplayName: "Billing", Description: "Creates and lists invoices"},
+		"payments": {DisplayName: "Payments", Description: "Pays invoices by card"},
+		"parcels":  {DisplayName: "Parcels", Description: "Tracks parcels"},
+	} {
+		_, err = registryClient.CreateApi(ctx, &rpc.CreateApiRequest{
+			Parent: parent,
+			ApiId:  id,
+			Api:    api,
+		})
+		if err != nil {
+			t.Fatalf("Error creating api %s", err)
+		}
+	}
+
+	testCases := []struct {
+		comment  string
+		args     []string
+		expected []string
+	}{
+		{comment: "search for a word of one api",
+			args:     []string{parent, "parcels"},
+			expected: []string{parcelName}},
+		{comment: "search for a word of several apis",
+			args:     []string{parent, "invoices"},
+			expected: []string{billingName, paymentName}},
+		{comment: "search for all words of a query",
+			args:     []string{parent, "invoices", "card"},
+			expected: []string{paymentName}},
+		{comment: "search with a project name without a location",
+			args:     []string{projectName, "parcels"},
+			expected: []string{parcelName}},
+		{comment: "search for a word of no api",
+			args:     []string{parent, "shipping"},
+			expected: []string{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.comment, func(t *testing.T) {
+			out := new(bytes.Buffer)
+			cmd := Command(ctx)
+			cmd.SetOut(out)
+			cmd.SetArgs(tc.args)
+			if err := cmd.Execute(); err != nil {
+				t.Fatalf("Execute() with args %+v returned error: %s", tc.args, err)
+			}
+			got := strings.Fields(out.String())
+			opts := cmp.Options{
+				cmpopts.EquateEmpty(),
+				cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+			}
+			if diff := cmp.Diff(tc.expected, got, opts); diff != "" {
+				t.Errorf("Execute() with args %+v returned unexpected results (-want +got):\n%s", tc.args, diff)
+			}
+		})
+	}
+
+	// Results beyond the limit aren't listed.
+	out := new(bytes.Buffer)
+	cmd := Command(ctx)
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{parent, "invoices", "--limit", "1"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() with --limit returned error: %s", err)
+	}
+	if got := strings.Fields(out.String()); len(got) != 1 {
+		t.Errorf("Execute() with --limit 1 returned %d results, expected 1: %v", len(got), got)
+	}
+
+	// The relevance score of each result follows its name.
+	out.Reset()
+	cmd = Command(ctx)
+	cmd.SetOut(out)
+	cmd.SetArgs([]string{parent, "parcels", "--scores"})
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() with --scores returned error: %s", err)
+	}
+	if fields := strings.Split(strings.TrimSpace(out.String()), "\t"); len(fields) != 2 || fields[0] != parcelName {
+		t.Errorf("Execute() with --scores returned %q, expected the name and score of %s", out.String(), parcelName)
+	}
+
+	// Delete the test project.
+	if err := adminClient.DeleteProject(ctx, &rpc.DeleteProjectRequest{
+		Name:  projectName,
+		Force: true,
+	}); err != nil {
+		t.Fatalf("Failed to delete test project: %s", err)
+	}
+}
@@ -0,0 +1,219 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bulk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apigee/registry/cmd/registry/core"
+	"github.com/apigee/registry/connection"
+	"github.com/apigee/registry/log"
+	"github.com/apigee/registry/rpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// specUpload is a spec to upload along with the API and version that contain it.
+type specUpload struct {
+	api     *rpc.Api
+	version *rpc.ApiVersion
+	spec    *rpc.ApiSpec
+	size    int    // size of the spec contents, used to skip unchanged specs
+	hash    string // hash of the spec contents, used to skip unchanged specs
+}
+
+// specSource reads a spec to upload.
+type specSource interface {
+	String() string
+	// prepare returns nil if the spec should be skipped.
+	prepare(ctx context.Context) (*specUpload, error)
+}
+
+// uploadBatcher returns a batcher that queues a task to upload each batch of specs in a project.
+func uploadBatcher(client connection.Client, taskQueue chan<- core.Task) *core.Batcher {
+	return core.NewBatcher(taskQueue, func(parent string, items []interface{}) core.Task {
+		task := &uploadBatchTask{
+			client: client,
+			parent: parent,
+		}
+		for _, item := range items {
+			task.sources = append(task.sources, item.(specSource))
+		}
+		return task
+	})
+}
+
+// uploadParent returns the parent of the APIs uploaded to a project.
+func uploadParent(projectID string) string {
+	return fmt.Sprintf("projects/%s/locations/global", projectID)
+}
+
+type uploadBatchTask struct {
+	client  connection.Client
+	parent  string
+	sources []specSource
+}
+
+func (task *uploadBatchTask) String() string {
+	return fmt.Sprintf("upload %d specs to %s", len(task.sources), task.parent)
+}
+
+func (task *uploadBatchTask) Run(ctx context.Context) error {
+	uploads := make([]*specUpload, 0, len(task.sources))
+	for _, source := range task.sources {
+		upload, err := source.prepare(ctx)
+		if err != nil {
+			return err
+		}
+		if upload != nil {
+			uploads = append(uploads, upload)
+		}
+	}
+	if len(uploads) == 0 {
+		return nil
+	}
+
+	// If the APIs do not exist, create them.
+	if err := task.createAPIs(ctx, uploads); err != nil {
+		return err
+	}
+	// If the API versions do not exist, create them.
+	if err := task.createVersions(ctx, uploads); err != nil {
+		return err
+	}
+	// Create or update the specs as needed.
+	return task.createOrUpdateSpecs(ctx, uploads)
+}
+
+func (task *uploadBatchTask) createAPIs(ctx context.Context, uploads []*specUpload) error {
+	// Create APIs if needed (or update existing ones)
+	request := &rpc.BatchUpdateApisRequest{Parent: task.parent}
+	seen := make(map[string]bool)
+	for _, u := range uploads {
+		if !seen[u.api.GetName()] {
+			seen[u.api.GetName()] = true
+			request.Requests = append(request.Requests, &rpc.UpdateApiRequest{Api: u.api, AllowMissing: true})
+		}
+	}
+
+	response, err := task.client.BatchUpdateApis(ctx, request)
+	if err != nil {
+		return fmt.Errorf("Failed to create APIs in %s, %s", task.parent, err)
+	}
+	for i, result := range response.GetResults() {
+		name := request.Requests[i].GetApi().GetName()
+		if err := core.BatchError(result.GetStatus()); err != nil {
+			log.FromContext(ctx).WithError(err).Debugf("Failed to create API %s", name)
+			// Returning this error ends all tasks, which seems appropriate to
+			// handle situations where all might fail due to a common problem
+			// (a missing project or incorrect project-id).
+			return fmt.Errorf("Failed to create %s, %s", name, err)
+		}
+		log.Debugf(ctx, "Updated %s", name)
+	}
+
+	return nil
+}
+
+func (task *uploadBatchTask) createVersions(ctx context.Context, uploads []*specUpload) error {
+	// Create API versions if needed (or update existing ones)
+	request := &rpc.BatchUpdateApiVersionsRequest{Parent: task.parent + "/apis/-"}
+	seen := make(map[string]bool)
+	for _, u := range uploads {
+		if !seen[u.version.GetName()] {
+			seen[u.version.GetName()] = true
+			request.Requests = append(request.Requests, &rpc.UpdateApiVersionRequest{ApiVersion: u.version, AllowMissing: true})
+		}
+	}
+
+	response, err := task.client.BatchUpdateApiVersions(ctx, request)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Debugf("Failed to create versions in %s", task.parent)
+		return nil
+	}
+	for i, result := range response.GetResults() {
+		name := request.Requests[i].GetApiVersion().GetName()
+		if err := core.BatchError(result.GetStatus()); err != nil {
+			log.FromContext(ctx).WithError(err).Debugf("Failed to create version %s", name)
+		} else {
+			log.Debugf(ctx, "Updated %s", name)
+		}
+	}
+
+	return nil
+}
+
+func (task *uploadBatchTask) createOrUpdateSpecs(ctx context.Context, uploads []*specUpload) error {
+	// Use the spec sizes and hashes to avoid unnecessary uploads.
+	parent := task.parent + "/apis/-/versions/-"
+	current := &rpc.BatchGetApiSpecsRequest{Parent: parent}
+	for _, u := range uploads {
+		current.Names = append(current.Names, u.spec.GetName())
+	}
+	specs, err := task.client.BatchGetApiSpecs(ctx, current)
+	if err != nil {
+		return err
+	}
+
+	requests := make([]*rpc.UpdateApiSpecRequest, 0, len(uploads))
+	for i, result := range specs.GetResults() {
+		u, spec := uploads[i], result.GetApiSpec()
+		if core.BatchError(result.GetStatus()) == nil && int(spec.GetSizeBytes()) == u.size && spec.GetHash() == u.hash {
+			log.Debugf(ctx, "Matched already uploaded spec %s", u.spec.GetName())
+			continue
+		}
+		if len(u.spec.GetContents()) > core.StreamingThreshold {
+			// Specs that are too large for a single message are uploaded in streams of chunks.
+			if _, err := core.UploadSpecContents(ctx, task.client, u.spec, u.spec.GetContents()); err != nil {
+				return fmt.Errorf("Failed to upload %s, %s", u.spec.GetName(), err)
+			}
+			log.Debugf(ctx, "Updated %s", u.spec.GetName())
+			continue
+		}
+		requests = append(requests, &rpc.UpdateApiSpecRequest{ApiSpec: u.spec, AllowMissing: true})
+	}
+
+	// Split the requests into batches that are small enough for servers to receive.
+	for len(requests) > 0 {
+		n, size := 1, proto.Size(requests[0])
+		for n < len(requests) && size+proto.Size(requests[n]) <= core.MaxBatchBytes {
+			size += proto.Size(requests[n])
+			n++
+		}
+		if err := task.updateSpecs(ctx, &rpc.BatchUpdateApiSpecsRequest{Parent: parent, Requests: requests[:n]}); err != nil {
+			return err
+		}
+		requests = requests[n:]
+	}
+
+	return nil
+}
+
+func (task *uploadBatchTask) updateSpecs(ctx context.Context, request *rpc.BatchUpdateApiSpecsRequest) error {
+	response, err := task.client.BatchUpdateApiSpecs(ctx, request)
+	if err != nil {
+		return fmt.Errorf("Failed to update specs in %s, %s", task.parent, err)
+	}
+	for i, result := range response.GetResults() {
+		spec := request.Requests[i].GetApiSpec()
+		if err := core.BatchError(result.GetStatus()); err != nil {
+			log.FromContext(ctx).WithError(err).Debugf("Error %s [contents-length: %d]", spec.GetName(), len(spec.GetContents()))
+		} else {
+			log.Debugf(ctx, "Updated %s", spec.GetName())
+		}
+	}
+
+	return nil
+}
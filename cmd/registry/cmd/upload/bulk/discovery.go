@@ -24,8 +24,6 @@ import (
 	"github.com/apigee/registry/log"
 	"github.com/apigee/registry/rpc"
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"gopkg.in/yaml.v2"
 
 	discovery "github.com/google/gnostic/discovery"
@@ -60,15 +58,16 @@ func discoveryCommand(ctx context.Context) *cobra.Command {
 			}
 
 			// Create an upload job for each API.
+			batches := uploadBatcher(client, taskQueue)
+			defer batches.Flush()
 			for _, api := range discoveryResponse.APIs {
-				taskQueue <- &uploadDiscoveryTask{
-					client:    client,
+				batches.Add(uploadParent(projectID), &uploadDiscoveryTask{
 					path:      api.DiscoveryRestURL,
 					projectID: projectID,
 					apiID:     sanitize(api.Name),
 					versionID: sanitize(api.Version),
 					specID:    "discovery.json",
-				}
+				})
 			}
 		},
 	}
@@ -77,7 +76,6 @@ func discoveryCommand(ctx context.Context) *cobra.Command {
 }
 
 type uploadDiscoveryTask struct {
-	client    connection.Client
 	path      string
 	projectID string
 	apiID     string
@@ -91,106 +89,39 @@ func (task *uploadDiscoveryTask) String() string {
 	return "upload discovery " + task.path
 }
 
-func (task *uploadDiscoveryTask) Run(ctx context.Context) error {
+func (task *uploadDiscoveryTask) prepare(ctx context.Context) (*specUpload, error) {
 	log.Infof(ctx, "Uploading apis/%s/versions/%s/specs/%s", task.apiID, task.versionID, task.specID)
 	// Fetch the contents of the discovery doc.
 	// Do this first in case the doc URL is invalid; we skip APIs with these errors.
 	if err := task.fetchDiscoveryDoc(); err != nil {
 		log.FromContext(ctx).WithError(err).Error("Failed to download discovery doc")
-		return nil
+		return nil, nil
 	}
-	// If the API does not exist, create it.
-	if err := task.createAPI(ctx); err != nil {
-		return err
-	}
-	// If the API version does not exist, create it.
-	if err := task.createVersion(ctx); err != nil {
-		return err
-	}
-	// Create or update the spec as needed.
-	if err := task.createOrUpdateSpec(ctx); err != nil {
-		return err
+
+	gzippedContents, err := core.GZippedBytes(task.contents)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-func (task *uploadDiscoveryTask) createAPI(ctx context.Context) error {
-	// Create an API if needed (or update an existing one)
-	response, err := task.client.UpdateApi(ctx, &rpc.UpdateApiRequest{
-		Api: &rpc.Api{
+	return &specUpload{
+		api: &rpc.Api{
 			Name:        task.apiName(),
 			DisplayName: task.info.Title,
 			Description: task.info.Description,
 		},
-		AllowMissing: true,
-	})
-	if err == nil {
-		log.Debugf(ctx, "Updated %s", response.Name)
-	} else if status.Code(err) == codes.AlreadyExists {
-		log.Debugf(ctx, "Found %s", task.apiName())
-	} else {
-		log.FromContext(ctx).WithError(err).Debugf("Failed to create API %s", task.apiName())
-		// Returning this error ends all tasks, which seems appropriate to
-		// handle situations where all might fail due to a common problem
-		// (a missing project or incorrect project-id).
-		return fmt.Errorf("Failed to create %s, %s", task.apiName(), err)
-	}
-
-	return nil
-}
-
-func (task *uploadDiscoveryTask) createVersion(ctx context.Context) error {
-	// Create an API version if needed (or update an existing one)
-	response, err := task.client.UpdateApiVersion(ctx, &rpc.UpdateApiVersionRequest{
-		ApiVersion: &rpc.ApiVersion{
+		version: &rpc.ApiVersion{
 			Name: task.versionName(),
 		},
-		AllowMissing: true,
-	})
-	if err != nil {
-		log.FromContext(ctx).WithError(err).Debugf("Failed to create version %s", task.versionName())
-	} else {
-		log.Debugf(ctx, "Updated %s", response.Name)
-	}
-
-	return nil
-}
-
-func (task *uploadDiscoveryTask) createOrUpdateSpec(ctx context.Context) error {
-	// Use the spec size and hash to avoid unnecessary uploads.
-	spec, err := task.client.GetApiSpec(ctx, &rpc.GetApiSpecRequest{
-		Name: task.specName(),
-	})
-
-	if err == nil && int(spec.GetSizeBytes()) == len(task.contents) && spec.GetHash() == hashForBytes(task.contents) {
-		log.Debugf(ctx, "Matched already uploaded spec %s", task.specName())
-		return nil
-	}
-
-	gzippedContents, err := core.GZippedBytes(task.contents)
-	if err != nil {
-		return err
-	}
-
-	request := &rpc.UpdateApiSpecRequest{
-		ApiSpec: &rpc.ApiSpec{
+		spec: &rpc.ApiSpec{
 			Name:      task.specName(),
 			MimeType:  core.DiscoveryMimeType("+gzip"),
 			Filename:  "discovery.json",
 			Contents:  gzippedContents,
 			SourceUri: task.path,
 		},
-		AllowMissing: true,
-	}
-
-	response, err := task.client.UpdateApiSpec(ctx, request)
-	if err != nil {
-		log.FromContext(ctx).WithError(err).Debugf("Error %s [contents-length: %d]", task.specName(), len(task.contents))
-	} else {
-		log.Debugf(ctx, "Updated %s", response.Name)
-	}
-
-	return nil
+		size: len(task.contents),
+		hash: hashForBytes(task.contents),
+	}, nil
 }
 
 func (task *uploadDiscoveryTask) projectName() string {
@@ -29,8 +29,6 @@ import (
 	"github.com/apigee/registry/log"
 	"github.com/apigee/registry/rpc"
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"gopkg.in/yaml.v3"
 )
 
@@ -74,6 +72,9 @@ func openAPICommand(ctx context.Context) *cobra.Command {
 }
 
 func scanDirectoryForOpenAPI(ctx context.Context, client connection.Client, projectID, baseURI, directory string, taskQueue chan<- core.Task) {
+	batches := uploadBatcher(client, taskQueue)
+	defer batches.Flush()
+
 	// walk a directory hierarchy, uploading every API spec that matches a set of expected file names.
 	if err := filepath.Walk(directory, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
@@ -81,7 +82,6 @@ func scanDirectoryForOpenAPI(ctx context.Context, client connection.Client, proj
 		}
 
 		task := &uploadOpenAPITask{
-			client:    client,
 			projectID: projectID,
 			baseURI:   baseURI,
 			path:      path,
@@ -91,10 +91,10 @@ func scanDirectoryForOpenAPI(ctx context.Context, client connection.Client, proj
 		switch {
 		case strings.HasSuffix(path, "swagger.yaml"), strings.HasSuffix(path, "swagger.json"):
 			task.version = "2"
-			taskQueue <- task
+			batches.Add(uploadParent(projectID), task)
 		case strings.HasSuffix(path, "openapi.yaml"), strings.HasSuffix(path, "openapi.json"):
 			task.version = "3"
-			taskQueue <- task
+			batches.Add(uploadParent(projectID), task)
 		}
 
 		return nil
@@ -119,7 +119,6 @@ func sanitize(name string) string {
 }
 
 type uploadOpenAPITask struct {
-	client    connection.Client
 	baseURI   string
 	path      string
 	directory string
@@ -136,27 +135,41 @@ func (task *uploadOpenAPITask) String() string {
 	return "upload openapi " + task.path
 }
 
-func (task *uploadOpenAPITask) Run(ctx context.Context) error {
+func (task *uploadOpenAPITask) prepare(ctx context.Context) (*specUpload, error) {
 	// Populate API path fields using the file's path.
 	if err := task.populateFields(); err != nil {
 		log.FromContext(ctx).WithError(err).Debugf("Failed to import API %s", task.apiName())
-		return nil
+		return nil, nil
 	}
 	log.Infof(ctx, "Uploading apis/%s/versions/%s/specs/%s", task.apiID, task.versionID, task.specID)
 
-	// If the API does not exist, create it.
-	if err := task.createAPI(ctx); err != nil {
-		return err
+	gzippedContents, err := core.GZippedBytes(task.contents)
+	if err != nil {
+		return nil, err
 	}
-	// If the API version does not exist, create it.
-	if err := task.createVersion(ctx); err != nil {
-		return err
+
+	upload := &specUpload{
+		api: &rpc.Api{
+			Name:        task.apiName(),
+			DisplayName: task.apiID,
+			Description: task.document.Info.Title,
+		},
+		version: &rpc.ApiVersion{
+			Name: task.versionName(),
+		},
+		spec: &rpc.ApiSpec{
+			Name:     task.specName(),
+			MimeType: core.OpenAPIMimeType("+gzip", task.version),
+			Filename: task.fileName(),
+			Contents: gzippedContents,
+		},
+		size: len(task.contents),
+		hash: hashForBytes(task.contents),
 	}
-	// Create or update the spec as needed.
-	if err := task.createOrUpdateSpec(ctx); err != nil {
-		return err
+	if task.baseURI != "" {
+		upload.spec.SourceUri = fmt.Sprintf("%s/%s", task.baseURI, task.apiPath())
 	}
-	return nil
+	return upload, nil
 }
 
 func (task *uploadOpenAPITask) populateFields() error {
@@ -183,87 +196,6 @@ func (task *uploadOpenAPITask) populateFields() error {
 	return yaml.Unmarshal(task.contents, &(task.document))
 }
 
-func (task *uploadOpenAPITask) createAPI(ctx context.Context) error {
-	// Create an API if needed (or update an existing one)
-	response, err := task.client.UpdateApi(ctx, &rpc.UpdateApiRequest{
-		Api: &rpc.Api{
-			Name:        task.apiName(),
-			DisplayName: task.apiID,
-			Description: task.document.Info.Title,
-		},
-		AllowMissing: true,
-	})
-	if err == nil {
-		log.Debugf(ctx, "Updated %s", response.Name)
-	} else if status.Code(err) == codes.AlreadyExists {
-		log.Debugf(ctx, "Found %s", task.apiName())
-	} else {
-		log.FromContext(ctx).WithError(err).Debugf("Failed to create API %s", task.apiName())
-		// Returning this error ends all tasks, which seems appropriate to
-		// handle situations where all might fail due to a common problem
-		// (a missing project or incorrect project-id).
-		return fmt.Errorf("Failed to create %s, %s", task.apiName(), err)
-	}
-
-	return nil
-}
-
-func (task *uploadOpenAPITask) createVersion(ctx context.Context) error {
-	// Create an API version if needed (or update an existing one)
-	response, err := task.client.UpdateApiVersion(ctx, &rpc.UpdateApiVersionRequest{
-		ApiVersion: &rpc.ApiVersion{
-			Name: task.versionName(),
-		},
-		AllowMissing: true,
-	})
-	if err == nil {
-		log.Debugf(ctx, "Updated %s", response.Name)
-	} else {
-		log.FromContext(ctx).WithError(err).Debugf("Failed to create version %s", task.versionName())
-	}
-
-	return nil
-}
-
-func (task *uploadOpenAPITask) createOrUpdateSpec(ctx context.Context) error {
-	// Use the spec size and hash to avoid unnecessary uploads.
-	spec, err := task.client.GetApiSpec(ctx, &rpc.GetApiSpecRequest{
-		Name: task.specName(),
-	})
-
-	if err == nil && int(spec.GetSizeBytes()) == len(task.contents) && spec.GetHash() == hashForBytes(task.contents) {
-		log.Debugf(ctx, "Matched already uploaded spec %s", task.specName())
-		return nil
-	}
-
-	gzippedContents, err := core.GZippedBytes(task.contents)
-	if err != nil {
-		return err
-	}
-
-	request := &rpc.UpdateApiSpecRequest{
-		ApiSpec: &rpc.ApiSpec{
-			Name:     task.specName(),
-			MimeType: core.OpenAPIMimeType("+gzip", task.version),
-			Filename: task.fileName(),
-			Contents: gzippedContents,
-		},
-		AllowMissing: true,
-	}
-	if task.baseURI != "" {
-		request.ApiSpec.SourceUri = fmt.Sprintf("%s/%s", task.baseURI, task.apiPath())
-	}
-
-	response, err := task.client.UpdateApiSpec(ctx, request)
-	if err != nil {
-		log.FromContext(ctx).WithError(err).Debugf("Error %s [contents-length: %d]", task.specName(), len(task.contents))
-	} else {
-		log.Debugf(ctx, "Updated %s", response.Name)
-	}
-
-	return nil
-}
-
 func (task *uploadOpenAPITask) projectName() string {
 	return fmt.Sprintf("projects/%s", task.projectID)
 }
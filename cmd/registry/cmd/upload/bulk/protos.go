@@ -29,8 +29,6 @@ import (
 	"github.com/apigee/registry/log"
 	"github.com/apigee/registry/rpc"
 	"github.com/spf13/cobra"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"gopkg.in/yaml.v2"
 )
 
@@ -74,6 +72,9 @@ func protosCommand(ctx context.Context) *cobra.Command {
 }
 
 func scanDirectoryForProtos(ctx context.Context, client connection.Client, projectID, baseURI, directory string, taskQueue chan<- core.Task) {
+	batches := uploadBatcher(client, taskQueue)
+	defer batches.Flush()
+
 	dirPattern := regexp.MustCompile("v.*[1-9]+.*")
 	if err := filepath.Walk(directory, func(fullname string, info os.FileInfo, err error) error {
 		if err != nil {
@@ -97,8 +98,7 @@ func scanDirectoryForProtos(ctx context.Context, client connection.Client, proje
 			return nil
 		}
 
-		taskQueue <- &uploadProtoTask{
-			client:         client,
+		batches.Add(uploadParent(projectID), &uploadProtoTask{
 			baseURI:        baseURI,
 			projectID:      projectID,
 			apiID:          strings.TrimSuffix(serviceConfig.Name, ".googleapis.com"),
@@ -106,7 +106,7 @@ func scanDirectoryForProtos(ctx context.Context, client connection.Client, proje
 			apiDescription: strings.ReplaceAll(serviceConfig.Documentation.Summary, "\n", " "),
 			path:           fullname,
 			directory:      directory,
-		}
+		})
 
 		return nil
 	}); err != nil {
@@ -115,7 +115,6 @@ func scanDirectoryForProtos(ctx context.Context, client connection.Client, proje
 }
 
 type uploadProtoTask struct {
-	client         connection.Client
 	baseURI        string
 	projectID      string
 	path           string
@@ -131,115 +130,48 @@ func (task *uploadProtoTask) String() string {
 	return "upload proto " + task.path
 }
 
-func (task *uploadProtoTask) Run(ctx context.Context) error {
+func (task *uploadProtoTask) prepare(ctx context.Context) (*specUpload, error) {
 	// Populate API path fields using the file's path.
 	task.populateFields()
 	log.Infof(ctx, "Uploading apis/%s/versions/%s/specs/%s", task.apiID, task.versionID, task.specID)
 
-	// If the API does not exist, create it.
-	if err := task.createAPI(ctx); err != nil {
-		return err
-	}
-	// If the API version does not exist, create it.
-	if err := task.createVersion(ctx); err != nil {
-		return err
-	}
-	// Create or update the spec as needed.
-	if err := task.createOrUpdateSpec(ctx); err != nil {
-		return err
+	contents, err := task.zipContents()
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
-
-func (task *uploadProtoTask) populateFields() {
-	parts := strings.Split(task.apiPath(), "/")
-
-	versionPart := parts[len(parts)-1]
-	task.versionID = sanitize(versionPart)
-
-	specPart := task.fileName()
-	task.specID = sanitize(specPart)
-}
 
-func (task *uploadProtoTask) createAPI(ctx context.Context) error {
-	// Create an API if needed (or update an existing one)
-	response, err := task.client.UpdateApi(ctx, &rpc.UpdateApiRequest{
-		Api: &rpc.Api{
+	upload := &specUpload{
+		api: &rpc.Api{
 			Name:        task.apiName(),
 			DisplayName: task.apiTitle,
 			Description: task.apiDescription,
 		},
-		AllowMissing: true,
-	})
-	if err == nil {
-		log.Debugf(ctx, "Updated %s", response.Name)
-	} else if status.Code(err) == codes.AlreadyExists {
-		log.Debugf(ctx, "Found %s", task.apiName())
-	} else {
-		log.FromContext(ctx).WithError(err).Debugf("Failed to create API %s", task.apiName())
-		// Returning this error ends all tasks, which seems appropriate to
-		// handle situations where all might fail due to a common problem
-		// (a missing project or incorrect project-id).
-		return fmt.Errorf("Failed to create %s, %s", task.apiName(), err)
-	}
-
-	return nil
-}
-
-func (task *uploadProtoTask) createVersion(ctx context.Context) error {
-	// Create an API version if needed (or update an existing one)
-	response, err := task.client.UpdateApiVersion(ctx, &rpc.UpdateApiVersionRequest{
-		ApiVersion: &rpc.ApiVersion{
+		version: &rpc.ApiVersion{
 			Name: task.versionName(),
 		},
-		AllowMissing: true,
-	})
-	if err == nil {
-		log.Debugf(ctx, "Updated %s", response.Name)
-	} else {
-		log.FromContext(ctx).WithError(err).Debugf("Failed to create version %s", task.versionName())
-	}
-
-	return nil
-}
-
-func (task *uploadProtoTask) createOrUpdateSpec(ctx context.Context) error {
-	contents, err := task.zipContents()
-	if err != nil {
-		return err
-	}
-
-	// Use the spec size and hash to avoid unnecessary uploads.
-	spec, err := task.client.GetApiSpec(ctx, &rpc.GetApiSpecRequest{
-		Name: task.specName(),
-	})
-
-	if err == nil && int(spec.GetSizeBytes()) == len(contents) && spec.GetHash() == hashForBytes(contents) {
-		log.Debugf(ctx, "Matched already uploaded spec %s", task.specName())
-		return nil
-	}
-
-	request := &rpc.UpdateApiSpecRequest{
-		ApiSpec: &rpc.ApiSpec{
+		spec: &rpc.ApiSpec{
 			Name:     task.specName(),
 			MimeType: core.ProtobufMimeType("+zip"),
 			Filename: task.fileName(),
 			Contents: contents,
 		},
-		AllowMissing: true,
+		size: len(contents),
+		hash: hashForBytes(contents),
 	}
 	if task.baseURI != "" {
-		request.ApiSpec.SourceUri = fmt.Sprintf("%s/%s", task.baseURI, task.apiPath())
+		upload.spec.SourceUri = fmt.Sprintf("%s/%s", task.baseURI, task.apiPath())
 	}
+	return upload, nil
+}
 
-	response, err := task.client.UpdateApiSpec(ctx, request)
-	if err != nil {
-		log.FromContext(ctx).WithError(err).Errorf("Error %s [contents-length: %d]", task.specName(), len(contents))
-	} else {
-		log.Debugf(ctx, "Updated %s", response.Name)
-	}
+func (task *uploadProtoTask) populateFields() {
+	parts := strings.Split(task.apiPath(), "/")
 
-	return nil
+	versionPart := parts[len(parts)-1]
+	task.versionID = sanitize(versionPart)
+
+	specPart := task.fileName()
+	task.specID = sanitize(specPart)
 }
 
 func (task *uploadProtoTask) projectName() string {
@@ -28,6 +28,8 @@ import (
 	"github.com/apigee/registry/log"
 	"github.com/apigee/registry/rpc"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func specCommand(ctx context.Context) *cobra.Command {
@@ -101,7 +103,12 @@ func uploadSpecDirectory(ctx context.Context, dirname string, client *gapic.Regi
 			Contents: buf.Bytes(),
 		},
 	}
-	response, err := client.CreateApiSpec(ctx, request)
+	var response *rpc.ApiSpec
+	if len(request.ApiSpec.Contents) > core.StreamingThreshold {
+		response, err = uploadLargeSpec(ctx, client, request)
+	} else {
+		response, err = client.CreateApiSpec(ctx, request)
+	}
 	if err == nil {
 		log.Debugf(ctx, "Created %s", response.Name)
 	} else if core.AlreadyExists(err) {
@@ -149,7 +156,12 @@ func uploadSpecFile(ctx context.Context, filename string, client *gapic.Registry
 				log.FromContext(ctx).WithError(err).Debug("Failed to compress spec contents")
 			}
 
-			response, err := client.CreateApiSpec(ctx, request)
+			var response *rpc.ApiSpec
+			if len(request.ApiSpec.Contents) > core.StreamingThreshold {
+				response, err = uploadLargeSpec(ctx, client, request)
+			} else {
+				response, err = client.CreateApiSpec(ctx, request)
+			}
 			if err != nil {
 				log.FromContext(ctx).WithError(err).Debug("Failed to create spec")
 			}
@@ -159,3 +171,21 @@ func uploadSpecFile(ctx context.Context, filename string, client *gapic.Registry
 	}
 	return nil
 }
+
+// uploadLargeSpec creates a spec with contents that are too large to send in a single message.
+// Like CreateApiSpec, it returns an AlreadyExists error instead of changing a spec that exists.
+func uploadLargeSpec(ctx context.Context, client *gapic.RegistryClient, request *rpc.CreateApiSpecRequest) (*rpc.ApiSpec, error) {
+	name := request.Parent + "/specs/" + request.ApiSpecId
+	if _, err := client.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: name}); err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "spec %q already exists", name)
+	} else if !core.NotFound(err) {
+		return nil, err
+	}
+
+	spec := &rpc.ApiSpec{
+		Name:     name,
+		Filename: request.ApiSpec.Filename,
+		MimeType: request.ApiSpec.MimeType,
+	}
+	return core.UploadSpecContents(ctx, client, spec, request.ApiSpec.Contents)
+}
@@ -0,0 +1,183 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upload
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/apigee/registry/cmd/registry/core"
+	"github.com/apigee/registry/connection"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/names"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestLargeSpecUpload(t *testing.T) {
+	const project = "upload-large-spec-demo"
+	ctx := context.Background()
+	client, err := connection.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("Setup: Failed to create client: %s", err)
+	}
+	adminClient, err := connection.NewAdminClient(ctx)
+	if err != nil {
+		t.Fatalf("Setup: Failed to create client: %s", err)
+	}
+
+	err = adminClient.DeleteProject(ctx, &rpc.DeleteProjectRequest{
+		Name:  "projects/" + project,
+		Force: true,
+	})
+	if err != nil && status.Code(err) != codes.NotFound {
+		t.Fatalf("Setup: Failed to delete test project: %s", err)
+	}
+	if _, err := adminClient.CreateProject(ctx, &rpc.CreateProjectRequest{
+		ProjectId: project,
+		Project:   &rpc.Project{},
+	}); err != nil {
+		t.Fatalf("Setup: Failed to create project %s: %s", project, err)
+	}
+	api := "projects/" + project + "/locations/global/apis/a"
+	if _, err := client.CreateApi(ctx, &rpc.CreateApiRequest{
+		Parent: "projects/" + project + "/locations/global",
+		ApiId:  "a",
+		Api:    &rpc.Api{},
+	}); err != nil {
+		t.Fatalf("Setup: Failed to create API %s: %s", api, err)
+	}
+	version := api + "/versions/v"
+	if _, err := client.UpdateApiVersion(ctx, &rpc.UpdateApiVersionRequest{
+		ApiVersion:   &rpc.ApiVersion{Name: version},
+		AllowMissing: true,
+	}); err != nil {
+		t.Fatalf("Setup: Failed to create version %s: %s", version, err)
+	}
+
+	// Random contents are still larger than the streaming threshold after compression.
+	random := make([]byte, 3*core.StreamingThreshold)
+	rand.New(rand.NewSource(1)).Read(random)
+	contents := []byte("openapi: 3.0.0\nx-data: " + hex.EncodeToString(random) + "\n")
+	filename := filepath.Join(t.TempDir(), "openapi.yaml")
+	if err := os.WriteFile(filename, contents, 0o644); err != nil {
+		t.Fatalf("Setup: Failed to write spec file: %s", err)
+	}
+
+	cmd := Command(ctx)
+	args := []string{"spec", filename, "--version", version, "--style", "openapi"}
+	cmd.SetArgs(args)
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("Execute() with args %v returned error: %s", args, err)
+	}
+
+	name, err := names.ParseSpec(version + "/specs/openapi.yaml")
+	if err != nil {
+		t.Fatalf("ParseSpec() returned error: %s", err)
+	}
+	spec, err := core.GetSpec(ctx, client, name, true, nil)
+	if err != nil {
+		t.Fatalf("GetSpec() returned error: %s", err)
+	}
+	if spec.GetSizeBytes() <= core.StreamingThreshold {
+		t.Errorf("GetSpec() returned size_bytes %d, want more than %d", spec.GetSizeBytes(), core.StreamingThreshold)
+	}
+	if !bytes.Equal(spec.GetContents(), contents) {
+		t.Errorf("GetSpec() returned contents that don't match the uploaded file")
+	}
+}
+
+func TestLargeSpecDirectoryUploadDoesNotReplace(t *testing.T) {
+	const project = "upload-large-protos-demo"
+	ctx := context.Background()
+	client, err := connection.NewClient(ctx)
+	if err != nil {
+		t.Fatalf("Setup: Failed to create client: %s", err)
+	}
+	adminClient, err := connection.NewAdminClient(ctx)
+	if err != nil {
+		t.Fatalf("Setup: Failed to create client: %s", err)
+	}
+
+	err = adminClient.DeleteProject(ctx, &rpc.DeleteProjectRequest{
+		Name:  "projects/" + project,
+		Force: true,
+	})
+	if err != nil && status.Code(err) != codes.NotFound {
+		t.Fatalf("Setup: Failed to delete test project: %s", err)
+	}
+	if _, err := adminClient.CreateProject(ctx, &rpc.CreateProjectRequest{
+		ProjectId: project,
+		Project:   &rpc.Project{},
+	}); err != nil {
+		t.Fatalf("Setup: Failed to create project %s: %s", project, err)
+	}
+	version := "projects/" + project + "/locations/global/apis/a/versions/v"
+	if _, err := client.CreateApi(ctx, &rpc.CreateApiRequest{
+		Parent: "projects/" + project + "/locations/global",
+		ApiId:  "a",
+		Api:    &rpc.Api{},
+	}); err != nil {
+		t.Fatalf("Setup: Failed to create API: %s", err)
+	}
+	if _, err := client.UpdateApiVersion(ctx, &rpc.UpdateApiVersionRequest{
+		ApiVersion:   &rpc.ApiVersion{Name: version},
+		AllowMissing: true,
+	}); err != nil {
+		t.Fatalf("Setup: Failed to create version %s: %s", version, err)
+	}
+
+	// Random contents are still larger than the streaming threshold after compression.
+	dir := t.TempDir()
+	random := make([]byte, 3*core.StreamingThreshold)
+	upload := func(seed int64) {
+		t.Helper()
+		rand.New(rand.NewSource(seed)).Read(random)
+		contents := []byte("syntax = \"proto3\";\n// " + hex.EncodeToString(random) + "\n")
+		if err := os.WriteFile(filepath.Join(dir, "a.proto"), contents, 0o644); err != nil {
+			t.Fatalf("Setup: Failed to write proto file: %s", err)
+		}
+		cmd := Command(ctx)
+		args := []string{"spec", dir, "--version", version, "--style", "proto"}
+		cmd.SetArgs(args)
+		if err := cmd.Execute(); err != nil {
+			t.Fatalf("Execute() with args %v returned error: %s", args, err)
+		}
+	}
+
+	// Uploading a directory again doesn't change its spec, whatever its size.
+	upload(1)
+	upload(2)
+
+	it := client.ListApiSpecRevisions(ctx, &rpc.ListApiSpecRevisionsRequest{Name: version + "/specs/protos.zip"})
+	revisions := 0
+	for {
+		if _, err := it.Next(); err == iterator.Done {
+			break
+		} else if err != nil {
+			t.Fatalf("ListApiSpecRevisions() returned error: %s", err)
+		}
+		revisions++
+	}
+	if revisions != 1 {
+		t.Errorf("ListApiSpecRevisions() returned %d revisions, want 1", revisions)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"strings"
+
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/status"
+)
+
+// MaxBatchSize is the maximum number of requests sent in a batch RPC.
+const MaxBatchSize = 100
+
+// MaxBatchBytes is the maximum size of the requests sent in a batch RPC.
+// It leaves room below the 4 MiB messages that servers receive by default.
+const MaxBatchBytes = 3 << 20
+
+// BatchParent returns the parent of a batch that can include the named resource.
+// Resource IDs between the location and the resource are replaced with "-",
+// so resources with different parents in the same project share a batch.
+func BatchParent(name string) string {
+	parts := strings.Split(name, "/")
+	parts = parts[:len(parts)-2]
+	for i := 5; i < len(parts); i += 2 {
+		parts[i] = "-"
+	}
+	return strings.Join(parts, "/")
+}
+
+// Batcher groups items by the parent of their batch and queues a task for each batch.
+// Batchers aren't safe for concurrent use.
+type Batcher struct {
+	taskQueue chan<- Task
+	newTask   func(parent string, items []interface{}) Task
+	batches   map[string][]interface{}
+}
+
+// NewBatcher returns a Batcher that queues the tasks returned by newTask.
+func NewBatcher(taskQueue chan<- Task, newTask func(parent string, items []interface{}) Task) *Batcher {
+	return &Batcher{
+		taskQueue: taskQueue,
+		newTask:   newTask,
+		batches:   make(map[string][]interface{}),
+	}
+}
+
+// Add adds an item to the batch for a parent, queueing a task for the batch when it is full.
+func (b *Batcher) Add(parent string, item interface{}) {
+	b.batches[parent] = append(b.batches[parent], item)
+	if len(b.batches[parent]) == MaxBatchSize {
+		b.taskQueue <- b.newTask(parent, b.batches[parent])
+		delete(b.batches, parent)
+	}
+}
+
+// Flush queues tasks for all batches that aren't full.
+func (b *Batcher) Flush() {
+	for parent, items := range b.batches {
+		b.taskQueue <- b.newTask(parent, items)
+	}
+	b.batches = make(map[string][]interface{})
+}
+
+// BatchError returns the error of a request in a batch, or nil if it succeeded.
+func BatchError(s *spb.Status) error {
+	return status.FromProto(s).Err()
+}
@@ -0,0 +1,173 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/apigee/registry/gapic"
+	"github.com/apigee/registry/rpc"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// StreamingThreshold is the size of the largest contents that are sent in a single message.
+// Larger contents are uploaded and downloaded in streams of chunks.
+const StreamingThreshold = 1 << 20
+
+// streamingChunkSize is the size of the chunks of uploaded contents.
+const streamingChunkSize = 1 << 20
+
+func contentsChecksum(contents []byte) string {
+	sum := sha256.Sum256(contents)
+	return hex.EncodeToString(sum[:])
+}
+
+// sendChunks calls send for each chunk of contents.
+// The checksum of the contents is included with the last chunk.
+func sendChunks(contents []byte, send func(chunk []byte, checksum string) error) error {
+	checksum := contentsChecksum(contents)
+	for {
+		n := len(contents)
+		if n > streamingChunkSize {
+			n = streamingChunkSize
+		}
+		chunk := contents[:n]
+		contents = contents[n:]
+		if len(contents) == 0 {
+			return send(chunk, checksum)
+		}
+		if err := send(chunk, ""); err != nil {
+			return err
+		}
+	}
+}
+
+// receiveChunks calls recv until the stream ends and returns the received contents after verifying their checksum.
+func receiveChunks(recv func() (*rpc.ContentsChunk, error)) (*httpbody.HttpBody, error) {
+	var (
+		first    *rpc.ContentsChunk
+		contents bytes.Buffer
+	)
+	for {
+		chunk, err := recv()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if first == nil {
+			first = chunk
+			contents.Grow(int(chunk.GetSizeBytes()))
+		}
+		contents.Write(chunk.GetData())
+	}
+
+	if first == nil {
+		return nil, errors.New("download stream ended without contents")
+	}
+	if int64(contents.Len()) != first.GetSizeBytes() {
+		return nil, fmt.Errorf("received %d bytes, expected %d", contents.Len(), first.GetSizeBytes())
+	}
+	if checksum := contentsChecksum(contents.Bytes()); checksum != first.GetChecksum() {
+		return nil, fmt.Errorf("received contents with checksum %q, expected %q", checksum, first.GetChecksum())
+	}
+
+	return &httpbody.HttpBody{
+		ContentType: first.GetContentType(),
+		Data:        contents.Bytes(),
+	}, nil
+}
+
+// UploadSpecContents creates or updates a spec with contents that are sent in a stream of chunks.
+func UploadSpecContents(ctx context.Context, client *gapic.RegistryClient, spec *rpc.ApiSpec, contents []byte) (*rpc.ApiSpec, error) {
+	stream, err := client.UploadApiSpecContents(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	spec = proto.Clone(spec).(*rpc.ApiSpec)
+	spec.Contents = nil
+	if err := sendChunks(contents, func(chunk []byte, checksum string) error {
+		err := stream.Send(&rpc.UploadApiSpecContentsRequest{
+			ApiSpec:  spec,
+			Chunk:    chunk,
+			Checksum: checksum,
+		})
+		spec = nil
+		return err
+	}); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	// Errors that ended the stream early are returned here.
+	return stream.CloseAndRecv()
+}
+
+// DownloadSpecContents returns the contents of a spec after receiving them in a stream of chunks.
+func DownloadSpecContents(ctx context.Context, client *gapic.RegistryClient, name string) (*httpbody.HttpBody, error) {
+	stream, err := client.DownloadApiSpecContents(ctx, &rpc.DownloadApiSpecContentsRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	return receiveChunks(stream.Recv)
+}
+
+// UploadArtifactContents creates or replaces an artifact with contents that are sent in a stream of chunks.
+func UploadArtifactContents(ctx context.Context, client *gapic.RegistryClient, artifact *rpc.Artifact, contents []byte) (*rpc.Artifact, error) {
+	stream, err := client.UploadArtifactContents(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	artifact = proto.Clone(artifact).(*rpc.Artifact)
+	artifact.Contents = nil
+	if err := sendChunks(contents, func(chunk []byte, checksum string) error {
+		err := stream.Send(&rpc.UploadArtifactContentsRequest{
+			Artifact: artifact,
+			Chunk:    chunk,
+			Checksum: checksum,
+		})
+		artifact = nil
+		return err
+	}); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	// Errors that ended the stream early are returned here.
+	return stream.CloseAndRecv()
+}
+
+// DownloadArtifactContents returns the contents of an artifact after receiving them in a stream of chunks.
+func DownloadArtifactContents(ctx context.Context, client *gapic.RegistryClient, name string) (*httpbody.HttpBody, error) {
+	stream, err := client.DownloadArtifactContents(ctx, &rpc.DownloadArtifactContentsRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	return receiveChunks(stream.Recv)
+}
+
+// tooLarge returns true if an error was caused by a message that exceeded a size limit.
+func tooLarge(err error) bool {
+	return status.Code(err) == codes.ResourceExhausted
+}
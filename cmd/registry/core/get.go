@@ -20,6 +20,7 @@ import (
 	"github.com/apigee/registry/gapic"
 	"github.com/apigee/registry/rpc"
 	"github.com/apigee/registry/server/registry/names"
+	"google.golang.org/genproto/googleapis/api/httpbody"
 )
 
 func GetProject(ctx context.Context,
@@ -103,10 +104,20 @@ func GetSpec(ctx context.Context,
 		return nil, err
 	}
 	if getContents {
-		request := &rpc.GetApiSpecContentsRequest{
-			Name: spec.GetName(),
+		// Large contents are downloaded in a stream, which is also used when
+		// the contents are too large for a single message after decompression.
+		var contents *httpbody.HttpBody
+		if spec.GetSizeBytes() > StreamingThreshold {
+			contents, err = DownloadSpecContents(ctx, client, spec.GetName())
+		} else {
+			request := &rpc.GetApiSpecContentsRequest{
+				Name: spec.GetName(),
+			}
+			contents, err = client.GetApiSpecContents(ctx, request)
+			if tooLarge(err) {
+				contents, err = DownloadSpecContents(ctx, client, spec.GetName())
+			}
 		}
-		contents, err := client.GetApiSpecContents(ctx, request)
 		if err != nil {
 			return nil, err
 		}
@@ -132,10 +143,15 @@ func GetArtifact(ctx context.Context,
 		return nil, err
 	}
 	if getContents {
-		request := &rpc.GetArtifactContentsRequest{
-			Name: artifact.GetName(),
+		var contents *httpbody.HttpBody
+		if artifact.GetSizeBytes() > StreamingThreshold {
+			contents, err = DownloadArtifactContents(ctx, client, artifact.GetName())
+		} else {
+			request := &rpc.GetArtifactContentsRequest{
+				Name: artifact.GetName(),
+			}
+			contents, err = client.GetArtifactContents(ctx, request)
 		}
-		contents, err := client.GetArtifactContents(ctx, request)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,43 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+
+	"github.com/apigee/registry/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxUpdateAttempts is the number of times RetryAborted attempts an update.
+const maxUpdateAttempts = 5
+
+// RetryAborted calls update until it succeeds or fails with an error other than ABORTED.
+// Updates that include an etag fail with ABORTED when the resource changed after it was read,
+// so refresh is called to read the resource again before each retry.
+func RetryAborted(ctx context.Context, refresh, update func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := update()
+		if status.Code(err) != codes.Aborted || attempt == maxUpdateAttempts {
+			return err
+		}
+
+		log.FromContext(ctx).WithError(err).Debug("Retrying update of a changed resource.")
+		if err := refresh(); err != nil {
+			return err
+		}
+	}
+}
@@ -18,14 +18,36 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/apigee/registry/cmd/registry/cmd"
+	"github.com/apigee/registry/connection"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func main() {
 	ctx := context.Background()
+	stopTracing, err := connection.StartTracing(ctx, "registry")
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	// Calls made by the command are recorded in a single trace.
+	ctx, span := otel.Tracer("github.com/apigee/registry/cmd/registry").Start(ctx, "registry",
+		trace.WithAttributes(attribute.String("registry.args", strings.Join(os.Args[1:], " "))))
 	cmd := cmd.Command(ctx)
-	if err := cmd.Execute(); err != nil {
+	err = cmd.Execute()
+	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+	_ = stopTracing(context.Background())
+
+	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
 	}
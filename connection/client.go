@@ -21,6 +21,8 @@ import (
 	"strconv"
 
 	"github.com/apigee/registry/gapic"
+	"github.com/apigee/registry/tracing"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"golang.org/x/oauth2"
 	"google.golang.org/api/option"
 	"google.golang.org/grpc"
@@ -50,12 +52,28 @@ func clientOptions(settings *Settings) ([]option.ClientOption, error) {
 		return nil, fmt.Errorf("rpc error: address must be set")
 	}
 	opts = append(opts, option.WithEndpoint(settings.Address))
+	// Calls are recorded as client spans that propagate their trace contexts to the server.
+	// Spans are only exported when tracing has been started, e.g. with StartTracing.
+	tracingOpts := []grpc.DialOption{
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor()),
+	}
 	if settings.Insecure {
-		conn, err := grpc.Dial(settings.Address, grpc.WithInsecure())
+		dialOpts := append([]grpc.DialOption{grpc.WithInsecure()}, tracingOpts...)
+		// Clients don't use their token sources with connections that are dialed here,
+		// so the connection sends the token itself.
+		if settings.Token != "" {
+			dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(insecureToken(settings.Token)))
+		}
+		conn, err := grpc.Dial(settings.Address, dialOpts...)
 		if err != nil {
 			return nil, err
 		}
 		opts = append(opts, option.WithGRPCConn(conn))
+	} else {
+		for _, o := range tracingOpts {
+			opts = append(opts, option.WithGRPCDialOption(o))
+		}
 	}
 	if settings.Token != "" {
 		opts = append(opts, option.WithTokenSource(oauth2.StaticTokenSource(
@@ -67,6 +85,34 @@ func clientOptions(settings *Settings) ([]option.ClientOption, error) {
 	return opts, nil
 }
 
+// StartTracing exports the spans of clients as configured by environment variables.
+// APG_REGISTRY_TRACE_EXPORTER selects an exporter, one of [ none, otlp, file ].
+// APG_REGISTRY_TRACE_ENDPOINT is the address of the OTLP collector and
+// APG_REGISTRY_TRACE_INSECURE disables TLS for connections to it.
+// APG_REGISTRY_TRACE_FILE is the file that the file exporter appends spans to.
+// The returned function flushes pending spans and should be called before the program exits.
+func StartTracing(ctx context.Context, serviceName string) (func(context.Context) error, error) {
+	insecure, _ := strconv.ParseBool(os.Getenv("APG_REGISTRY_TRACE_INSECURE"))
+	return tracing.Start(ctx, tracing.Config{
+		ServiceName: serviceName,
+		Exporter:    os.Getenv("APG_REGISTRY_TRACE_EXPORTER"),
+		Endpoint:    os.Getenv("APG_REGISTRY_TRACE_ENDPOINT"),
+		Insecure:    insecure,
+		File:        os.Getenv("APG_REGISTRY_TRACE_FILE"),
+	})
+}
+
+// insecureToken sends a bearer token with requests made over insecure connections.
+type insecureToken string
+
+func (t insecureToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+func (t insecureToken) RequireTransportSecurity() bool {
+	return false
+}
+
 // Client is a client of the Registry API
 // TODO: Rename this to RegistryClient
 type Client = *gapic.RegistryClient
@@ -50,6 +50,13 @@ type AdminCallOptions struct {
 	CreateProject []gax.CallOption
 	UpdateProject []gax.CallOption
 	DeleteProject []gax.CallOption
+	UndeleteProject []gax.CallOption
+	ListOutboxEvents []gax.CallOption
+	ReplayOutboxEvents []gax.CallOption
+	ListRoleBindings []gax.CallOption
+	SetRoleBinding []gax.CallOption
+	DeleteRoleBinding []gax.CallOption
+	ListAuditEvents []gax.CallOption
 }
 
 func defaultAdminGRPCClientOptions() []option.ClientOption {
@@ -82,6 +89,20 @@ func defaultAdminCallOptions() *AdminCallOptions {
 		},
 		DeleteProject: []gax.CallOption{
 		},
+		UndeleteProject: []gax.CallOption{
+		},
+		ListOutboxEvents: []gax.CallOption{
+		},
+		ReplayOutboxEvents: []gax.CallOption{
+		},
+		ListRoleBindings: []gax.CallOption{
+		},
+		SetRoleBinding: []gax.CallOption{
+		},
+		DeleteRoleBinding: []gax.CallOption{
+		},
+		ListAuditEvents: []gax.CallOption{
+		},
 	}
 }
 
@@ -99,6 +120,13 @@ type internalAdminClient interface {
 	CreateProject(context.Context, *rpcpb.CreateProjectRequest, ...gax.CallOption) (*rpcpb.Project, error)
 	UpdateProject(context.Context, *rpcpb.UpdateProjectRequest, ...gax.CallOption) (*rpcpb.Project, error)
 	DeleteProject(context.Context, *rpcpb.DeleteProjectRequest, ...gax.CallOption) error
+	UndeleteProject(context.Context, *rpcpb.UndeleteProjectRequest, ...gax.CallOption) (*rpcpb.Project, error)
+	ListOutboxEvents(context.Context, *rpcpb.ListOutboxEventsRequest, ...gax.CallOption) *OutboxEventIterator
+	ReplayOutboxEvents(context.Context, *rpcpb.ReplayOutboxEventsRequest, ...gax.CallOption) (*rpcpb.ReplayOutboxEventsResponse, error)
+	ListRoleBindings(context.Context, *rpcpb.ListRoleBindingsRequest, ...gax.CallOption) *RoleBindingIterator
+	SetRoleBinding(context.Context, *rpcpb.SetRoleBindingRequest, ...gax.CallOption) (*rpcpb.RoleBinding, error)
+	DeleteRoleBinding(context.Context, *rpcpb.DeleteRoleBindingRequest, ...gax.CallOption) error
+	ListAuditEvents(context.Context, *rpcpb.ListAuditEventsRequest, ...gax.CallOption) *AuditEventIterator
 }
 
 // AdminClient is a client for interacting with .
@@ -164,7 +192,9 @@ func (c *AdminClient) GetStorage(ctx context.Context, req *emptypb.Empty, opts .
 	return c.internalClient.GetStorage(ctx, req, opts...)
 }
 
-// MigrateDatabase migrateDatabase attempts to migrate the database to the current schema.
+// MigrateDatabase migrateDatabase attempts to migrate the database to the current schema,
+// or to an earlier schema version. Migrations run in the background,
+// and their operations can be checked with the Operations service.
 func (c *AdminClient) MigrateDatabase(ctx context.Context, req *rpcpb.MigrateDatabaseRequest, opts ...gax.CallOption) (*MigrateDatabaseOperation, error) {
 	return c.internalClient.MigrateDatabase(ctx, req, opts...)
 }
@@ -209,6 +239,64 @@ func (c *AdminClient) DeleteProject(ctx context.Context, req *rpcpb.DeleteProjec
 	return c.internalClient.DeleteProject(ctx, req, opts...)
 }
 
+// UndeleteProject undeleteProject restores a deleted project and the resources that were
+// deleted with it. Deleted projects can be restored until they are purged.
+func (c *AdminClient) UndeleteProject(ctx context.Context, req *rpcpb.UndeleteProjectRequest, opts ...gax.CallOption) (*rpcpb.Project, error) {
+	return c.internalClient.UndeleteProject(ctx, req, opts...)
+}
+
+// ListOutboxEvents listOutboxEvents returns notifications recorded in the outbox.
+// By default, only notifications that haven’t been delivered are listed.
+// (– api-linter: core::0132::method-signature=disabled
+// aip.dev/not-precedent (at http://aip.dev/not-precedent): Outbox events have no parent. –)
+// (– api-linter: core::0132::request-parent-required=disabled
+// aip.dev/not-precedent (at http://aip.dev/not-precedent): Outbox events have no parent. –)
+func (c *AdminClient) ListOutboxEvents(ctx context.Context, req *rpcpb.ListOutboxEventsRequest, opts ...gax.CallOption) *OutboxEventIterator {
+	return c.internalClient.ListOutboxEvents(ctx, req, opts...)
+}
+
+// ReplayOutboxEvents replayOutboxEvents schedules matching notifications for immediate delivery,
+// including notifications that were abandoned after repeated failures.
+// (– api-linter: core::0136::http-uri-suffix=disabled
+// aip.dev/not-precedent (at http://aip.dev/not-precedent): Outbox events have no parent. –)
+func (c *AdminClient) ReplayOutboxEvents(ctx context.Context, req *rpcpb.ReplayOutboxEventsRequest, opts ...gax.CallOption) (*rpcpb.ReplayOutboxEventsResponse, error) {
+	return c.internalClient.ReplayOutboxEvents(ctx, req, opts...)
+}
+
+// ListRoleBindings listRoleBindings returns the roles that principals have been granted.
+// (– api-linter: core::0132::method-signature=disabled
+// aip.dev/not-precedent (at http://aip.dev/not-precedent): Role bindings have no parent. –)
+// (– api-linter: core::0132::request-parent-required=disabled
+// aip.dev/not-precedent (at http://aip.dev/not-precedent): Role bindings have no parent. –)
+func (c *AdminClient) ListRoleBindings(ctx context.Context, req *rpcpb.ListRoleBindingsRequest, opts ...gax.CallOption) *RoleBindingIterator {
+	return c.internalClient.ListRoleBindings(ctx, req, opts...)
+}
+
+// SetRoleBinding setRoleBinding grants a role to a principal, replacing the role that the
+// principal had been granted in the same project.
+// (– api-linter: core::0136::http-uri-suffix=disabled
+// aip.dev/not-precedent (at http://aip.dev/not-precedent): Role bindings have no parent. –)
+func (c *AdminClient) SetRoleBinding(ctx context.Context, req *rpcpb.SetRoleBindingRequest, opts ...gax.CallOption) (*rpcpb.RoleBinding, error) {
+	return c.internalClient.SetRoleBinding(ctx, req, opts...)
+}
+
+// DeleteRoleBinding deleteRoleBinding removes the role that a principal has been granted.
+// (– api-linter: core::0135::http-uri-name=disabled
+// aip.dev/not-precedent (at http://aip.dev/not-precedent): Role bindings have no names. –)
+func (c *AdminClient) DeleteRoleBinding(ctx context.Context, req *rpcpb.DeleteRoleBindingRequest, opts ...gax.CallOption) error {
+	return c.internalClient.DeleteRoleBinding(ctx, req, opts...)
+}
+
+// ListAuditEvents listAuditEvents returns records of the calls that changed, or attempted to
+// change, the registry.
+// (– api-linter: core::0132::method-signature=disabled
+// aip.dev/not-precedent (at http://aip.dev/not-precedent): Audit events have no parent. –)
+// (– api-linter: core::0132::request-parent-required=disabled
+// aip.dev/not-precedent (at http://aip.dev/not-precedent): Audit events have no parent. –)
+func (c *AdminClient) ListAuditEvents(ctx context.Context, req *rpcpb.ListAuditEventsRequest, opts ...gax.CallOption) *AuditEventIterator {
+	return c.internalClient.ListAuditEvents(ctx, req, opts...)
+}
+
 // adminGRPCClient is a client for interacting with  over gRPC transport.
 //
 // Methods, except Close, may be called concurrently. However, fields must not be modified concurrently with method calls.
@@ -456,6 +544,192 @@ func (c *adminGRPCClient) DeleteProject(ctx context.Context, req *rpcpb.DeletePr
 	return err
 }
 
+func (c *adminGRPCClient) UndeleteProject(ctx context.Context, req *rpcpb.UndeleteProjectRequest, opts ...gax.CallOption) (*rpcpb.Project, error) {
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).UndeleteProject[0:len((*c.CallOptions).UndeleteProject):len((*c.CallOptions).UndeleteProject)], opts...)
+	var resp *rpcpb.Project
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.adminClient.UndeleteProject(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *adminGRPCClient) ListOutboxEvents(ctx context.Context, req *rpcpb.ListOutboxEventsRequest, opts ...gax.CallOption) *OutboxEventIterator {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).ListOutboxEvents[0:len((*c.CallOptions).ListOutboxEvents):len((*c.CallOptions).ListOutboxEvents)], opts...)
+	it := &OutboxEventIterator{}
+	req = proto.Clone(req).(*rpcpb.ListOutboxEventsRequest)
+	it.InternalFetch = func(pageSize int, pageToken string) ([]*rpcpb.OutboxEvent, string, error) {
+		resp := &rpcpb.ListOutboxEventsResponse{}
+		if pageToken != "" {
+			req.PageToken = pageToken
+		}
+		if pageSize > math.MaxInt32 {
+			req.PageSize = math.MaxInt32
+		} else if pageSize != 0 {
+			req.PageSize = int32(pageSize)
+		}
+		err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+			var err error
+			resp, err = c.adminClient.ListOutboxEvents(ctx, req, settings.GRPC...)
+			return err
+		}, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+
+		it.Response = resp
+		return resp.GetEvents(), resp.GetNextPageToken(), nil
+	}
+	fetch := func(pageSize int, pageToken string) (string, error) {
+		items, nextPageToken, err := it.InternalFetch(pageSize, pageToken)
+		if err != nil {
+			return "", err
+		}
+		it.items = append(it.items, items...)
+		return nextPageToken, nil
+	}
+
+	it.pageInfo, it.nextFunc = iterator.NewPageInfo(fetch, it.bufLen, it.takeBuf)
+	it.pageInfo.MaxSize = int(req.GetPageSize())
+	it.pageInfo.Token = req.GetPageToken()
+
+	return it
+}
+
+func (c *adminGRPCClient) ReplayOutboxEvents(ctx context.Context, req *rpcpb.ReplayOutboxEventsRequest, opts ...gax.CallOption) (*rpcpb.ReplayOutboxEventsResponse, error) {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).ReplayOutboxEvents[0:len((*c.CallOptions).ReplayOutboxEvents):len((*c.CallOptions).ReplayOutboxEvents)], opts...)
+	var resp *rpcpb.ReplayOutboxEventsResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.adminClient.ReplayOutboxEvents(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *adminGRPCClient) ListRoleBindings(ctx context.Context, req *rpcpb.ListRoleBindingsRequest, opts ...gax.CallOption) *RoleBindingIterator {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).ListRoleBindings[0:len((*c.CallOptions).ListRoleBindings):len((*c.CallOptions).ListRoleBindings)], opts...)
+	it := &RoleBindingIterator{}
+	req = proto.Clone(req).(*rpcpb.ListRoleBindingsRequest)
+	it.InternalFetch = func(pageSize int, pageToken string) ([]*rpcpb.RoleBinding, string, error) {
+		resp := &rpcpb.ListRoleBindingsResponse{}
+		if pageToken != "" {
+			req.PageToken = pageToken
+		}
+		if pageSize > math.MaxInt32 {
+			req.PageSize = math.MaxInt32
+		} else if pageSize != 0 {
+			req.PageSize = int32(pageSize)
+		}
+		err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+			var err error
+			resp, err = c.adminClient.ListRoleBindings(ctx, req, settings.GRPC...)
+			return err
+		}, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+
+		it.Response = resp
+		return resp.GetRoleBindings(), resp.GetNextPageToken(), nil
+	}
+	fetch := func(pageSize int, pageToken string) (string, error) {
+		items, nextPageToken, err := it.InternalFetch(pageSize, pageToken)
+		if err != nil {
+			return "", err
+		}
+		it.items = append(it.items, items...)
+		return nextPageToken, nil
+	}
+
+	it.pageInfo, it.nextFunc = iterator.NewPageInfo(fetch, it.bufLen, it.takeBuf)
+	it.pageInfo.MaxSize = int(req.GetPageSize())
+	it.pageInfo.Token = req.GetPageToken()
+
+	return it
+}
+
+func (c *adminGRPCClient) SetRoleBinding(ctx context.Context, req *rpcpb.SetRoleBindingRequest, opts ...gax.CallOption) (*rpcpb.RoleBinding, error) {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).SetRoleBinding[0:len((*c.CallOptions).SetRoleBinding):len((*c.CallOptions).SetRoleBinding)], opts...)
+	var resp *rpcpb.RoleBinding
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.adminClient.SetRoleBinding(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *adminGRPCClient) DeleteRoleBinding(ctx context.Context, req *rpcpb.DeleteRoleBindingRequest, opts ...gax.CallOption) error {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).DeleteRoleBinding[0:len((*c.CallOptions).DeleteRoleBinding):len((*c.CallOptions).DeleteRoleBinding)], opts...)
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		_, err = c.adminClient.DeleteRoleBinding(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	return err
+}
+
+func (c *adminGRPCClient) ListAuditEvents(ctx context.Context, req *rpcpb.ListAuditEventsRequest, opts ...gax.CallOption) *AuditEventIterator {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	opts = append((*c.CallOptions).ListAuditEvents[0:len((*c.CallOptions).ListAuditEvents):len((*c.CallOptions).ListAuditEvents)], opts...)
+	it := &AuditEventIterator{}
+	req = proto.Clone(req).(*rpcpb.ListAuditEventsRequest)
+	it.InternalFetch = func(pageSize int, pageToken string) ([]*rpcpb.AuditEvent, string, error) {
+		resp := &rpcpb.ListAuditEventsResponse{}
+		if pageToken != "" {
+			req.PageToken = pageToken
+		}
+		if pageSize > math.MaxInt32 {
+			req.PageSize = math.MaxInt32
+		} else if pageSize != 0 {
+			req.PageSize = int32(pageSize)
+		}
+		err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+			var err error
+			resp, err = c.adminClient.ListAuditEvents(ctx, req, settings.GRPC...)
+			return err
+		}, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+
+		it.Response = resp
+		return resp.GetAuditEvents(), resp.GetNextPageToken(), nil
+	}
+	fetch := func(pageSize int, pageToken string) (string, error) {
+		items, nextPageToken, err := it.InternalFetch(pageSize, pageToken)
+		if err != nil {
+			return "", err
+		}
+		it.items = append(it.items, items...)
+		return nextPageToken, nil
+	}
+
+	it.pageInfo, it.nextFunc = iterator.NewPageInfo(fetch, it.bufLen, it.takeBuf)
+	it.pageInfo.MaxSize = int(req.GetPageSize())
+	it.pageInfo.Token = req.GetPageToken()
+
+	return it
+}
+
 // MigrateDatabaseOperation manages a long-running operation from MigrateDatabase.
 type MigrateDatabaseOperation struct {
 	lro *longrunning.Operation
@@ -525,6 +799,100 @@ func (op *MigrateDatabaseOperation) Name() string {
 	return op.lro.Name()
 }
 
+// AuditEventIterator manages a stream of *rpcpb.AuditEvent.
+type AuditEventIterator struct {
+	items    []*rpcpb.AuditEvent
+	pageInfo *iterator.PageInfo
+	nextFunc func() error
+
+	// Response is the raw response for the current page.
+	// It must be cast to the RPC response type.
+	// Calling Next() or InternalFetch() updates this value.
+	Response interface{}
+
+	// InternalFetch is for use by the Google Cloud Libraries only.
+	// It is not part of the stable interface of this package.
+	//
+	// InternalFetch returns results from a single call to the underlying RPC.
+	// The number of results is no greater than pageSize.
+	// If there are no more results, nextPageToken is empty and err is nil.
+	InternalFetch func(pageSize int, pageToken string) (results []*rpcpb.AuditEvent, nextPageToken string, err error)
+}
+
+// PageInfo supports pagination. See the google.golang.org/api/iterator package for details.
+func (it *AuditEventIterator) PageInfo() *iterator.PageInfo {
+	return it.pageInfo
+}
+
+// Next returns the next result. Its second return value is iterator.Done if there are no more
+// results. Once Next returns Done, all subsequent calls will return Done.
+func (it *AuditEventIterator) Next() (*rpcpb.AuditEvent, error) {
+	var item *rpcpb.AuditEvent
+	if err := it.nextFunc(); err != nil {
+		return item, err
+	}
+	item = it.items[0]
+	it.items = it.items[1:]
+	return item, nil
+}
+
+func (it *AuditEventIterator) bufLen() int {
+	return len(it.items)
+}
+
+func (it *AuditEventIterator) takeBuf() interface{} {
+	b := it.items
+	it.items = nil
+	return b
+}
+
+// OutboxEventIterator manages a stream of *rpcpb.OutboxEvent.
+type OutboxEventIterator struct {
+	items    []*rpcpb.OutboxEvent
+	pageInfo *iterator.PageInfo
+	nextFunc func() error
+
+	// Response is the raw response for the current page.
+	// It must be cast to the RPC response type.
+	// Calling Next() or InternalFetch() updates this value.
+	Response interface{}
+
+	// InternalFetch is for use by the Google Cloud Libraries only.
+	// It is not part of the stable interface of this package.
+	//
+	// InternalFetch returns results from a single call to the underlying RPC.
+	// The number of results is no greater than pageSize.
+	// If there are no more results, nextPageToken is empty and err is nil.
+	InternalFetch func(pageSize int, pageToken string) (results []*rpcpb.OutboxEvent, nextPageToken string, err error)
+}
+
+// PageInfo supports pagination. See the google.golang.org/api/iterator package for details.
+func (it *OutboxEventIterator) PageInfo() *iterator.PageInfo {
+	return it.pageInfo
+}
+
+// Next returns the next result. Its second return value is iterator.Done if there are no more
+// results. Once Next returns Done, all subsequent calls will return Done.
+func (it *OutboxEventIterator) Next() (*rpcpb.OutboxEvent, error) {
+	var item *rpcpb.OutboxEvent
+	if err := it.nextFunc(); err != nil {
+		return item, err
+	}
+	item = it.items[0]
+	it.items = it.items[1:]
+	return item, nil
+}
+
+func (it *OutboxEventIterator) bufLen() int {
+	return len(it.items)
+}
+
+func (it *OutboxEventIterator) takeBuf() interface{} {
+	b := it.items
+	it.items = nil
+	return b
+}
+
 // ProjectIterator manages a stream of *rpcpb.Project.
 type ProjectIterator struct {
 	items    []*rpcpb.Project
@@ -572,6 +940,53 @@ func (it *ProjectIterator) takeBuf() interface{} {
 	return b
 }
 
+// RoleBindingIterator manages a stream of *rpcpb.RoleBinding.
+type RoleBindingIterator struct {
+	items    []*rpcpb.RoleBinding
+	pageInfo *iterator.PageInfo
+	nextFunc func() error
+
+	// Response is the raw response for the current page.
+	// It must be cast to the RPC response type.
+	// Calling Next() or InternalFetch() updates this value.
+	Response interface{}
+
+	// InternalFetch is for use by the Google Cloud Libraries only.
+	// It is not part of the stable interface of this package.
+	//
+	// InternalFetch returns results from a single call to the underlying RPC.
+	// The number of results is no greater than pageSize.
+	// If there are no more results, nextPageToken is empty and err is nil.
+	InternalFetch func(pageSize int, pageToken string) (results []*rpcpb.RoleBinding, nextPageToken string, err error)
+}
+
+// PageInfo supports pagination. See the google.golang.org/api/iterator package for details.
+func (it *RoleBindingIterator) PageInfo() *iterator.PageInfo {
+	return it.pageInfo
+}
+
+// Next returns the next result. Its second return value is iterator.Done if there are no more
+// results. Once Next returns Done, all subsequent calls will return Done.
+func (it *RoleBindingIterator) Next() (*rpcpb.RoleBinding, error) {
+	var item *rpcpb.RoleBinding
+	if err := it.nextFunc(); err != nil {
+		return item, err
+	}
+	item = it.items[0]
+	it.items = it.items[1:]
+	return item, nil
+}
+
+func (it *RoleBindingIterator) bufLen() int {
+	return len(it.items)
+}
+
+func (it *RoleBindingIterator) takeBuf() interface{} {
+	b := it.items
+	it.items = nil
+	return b
+}
+
 func (c *AdminClient) GrpcClient() rpcpb.AdminClient {
 	return c.internalClient.(*adminGRPCClient).adminClient
 }
@@ -45,17 +45,34 @@ type RegistryCallOptions struct {
 	CreateApi []gax.CallOption
 	UpdateApi []gax.CallOption
 	DeleteApi []gax.CallOption
+	UndeleteApi []gax.CallOption
+	BatchGetApis []gax.CallOption
+	BatchCreateApis []gax.CallOption
+	BatchUpdateApis []gax.CallOption
+	BatchDeleteApis []gax.CallOption
 	ListApiVersions []gax.CallOption
 	GetApiVersion []gax.CallOption
 	CreateApiVersion []gax.CallOption
 	UpdateApiVersion []gax.CallOption
 	DeleteApiVersion []gax.CallOption
+	UndeleteApiVersion []gax.CallOption
+	BatchGetApiVersions []gax.CallOption
+	BatchCreateApiVersions []gax.CallOption
+	BatchUpdateApiVersions []gax.CallOption
+	BatchDeleteApiVersions []gax.CallOption
 	ListApiSpecs []gax.CallOption
 	GetApiSpec []gax.CallOption
 	GetApiSpecContents []gax.CallOption
+	UploadApiSpecContents []gax.CallOption
+	DownloadApiSpecContents []gax.CallOption
 	CreateApiSpec []gax.CallOption
 	UpdateApiSpec []gax.CallOption
 	DeleteApiSpec []gax.CallOption
+	UndeleteApiSpec []gax.CallOption
+	BatchGetApiSpecs []gax.CallOption
+	BatchCreateApiSpecs []gax.CallOption
+	BatchUpdateApiSpecs []gax.CallOption
+	BatchDeleteApiSpecs []gax.CallOption
 	TagApiSpecRevision []gax.CallOption
 	ListApiSpecRevisions []gax.CallOption
 	RollbackApiSpec []gax.CallOption
@@ -65,6 +82,7 @@ type RegistryCallOptions struct {
 	CreateApiDeployment []gax.CallOption
 	UpdateApiDeployment []gax.CallOption
 	DeleteApiDeployment []gax.CallOption
+	UndeleteApiDeployment []gax.CallOption
 	TagApiDeploymentRevision []gax.CallOption
 	ListApiDeploymentRevisions []gax.CallOption
 	RollbackApiDeployment []gax.CallOption
@@ -72,9 +90,24 @@ type RegistryCallOptions struct {
 	ListArtifacts []gax.CallOption
 	GetArtifact []gax.CallOption
 	GetArtifactContents []gax.CallOption
+	UploadArtifactContents []gax.CallOption
+	DownloadArtifactContents []gax.CallOption
 	CreateArtifact []gax.CallOption
 	ReplaceArtifact []gax.CallOption
+	UpdateArtifact []gax.CallOption
 	DeleteArtifact []gax.CallOption
+	UndeleteArtifact []gax.CallOption
+	BatchGetArtifacts []gax.CallOption
+	BatchCreateArtifacts []gax.CallOption
+	BatchReplaceArtifacts []gax.CallOption
+	BatchUpdateArtifacts []gax.CallOption
+	BatchDeleteArtifacts []gax.CallOption
+	TagArtifactRevision []gax.CallOption
+	ListArtifactRevisions []gax.CallOption
+	RollbackArtifact []gax.CallOption
+	DeleteArtifactRevision []gax.CallOption
+	WatchResources []gax.CallOption
+	SearchResources []gax.CallOption
 }
 
 func defaultRegistryGRPCClientOptions() []option.ClientOption {
@@ -156,6 +189,71 @@ func defaultRegistryCallOptions() *RegistryCallOptions {
 				})
 			}),
 		},
+		UndeleteApi: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		BatchGetApis: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		BatchCreateApis: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		BatchUpdateApis: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		BatchDeleteApis: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
 		ListApiVersions: []gax.CallOption{
 			gax.WithRetry(func() gax.Retryer {
 				return gax.OnCodes([]codes.Code{
@@ -221,6 +319,71 @@ func defaultRegistryCallOptions() *RegistryCallOptions {
 				})
 			}),
 		},
+		UndeleteApiVersion: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		BatchGetApiVersions: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		BatchCreateApiVersions: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		BatchUpdateApiVersions: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		BatchDeleteApiVersions: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
 		ListApiSpecs: []gax.CallOption{
 			gax.WithRetry(func() gax.Retryer {
 				return gax.OnCodes([]codes.Code{
@@ -260,6 +423,32 @@ func defaultRegistryCallOptions() *RegistryCallOptions {
 				})
 			}),
 		},
+		UploadApiSpecContents: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		DownloadApiSpecContents: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
 		CreateApiSpec: []gax.CallOption{
 			gax.WithRetry(func() gax.Retryer {
 				return gax.OnCodes([]codes.Code{
@@ -299,6 +488,71 @@ func defaultRegistryCallOptions() *RegistryCallOptions {
 				})
 			}),
 		},
+		UndeleteApiSpec: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		BatchGetApiSpecs: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		BatchCreateApiSpecs: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		BatchUpdateApiSpecs: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		BatchDeleteApiSpecs: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
 		TagApiSpecRevision: []gax.CallOption{
 			gax.WithRetry(func() gax.Retryer {
 				return gax.OnCodes([]codes.Code{
@@ -416,6 +670,19 @@ func defaultRegistryCallOptions() *RegistryCallOptions {
 				})
 			}),
 		},
+		UndeleteApiDeployment: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
 		TagApiDeploymentRevision: []gax.CallOption{
 			gax.WithRetry(func() gax.Retryer {
 				return gax.OnCodes([]codes.Code{
@@ -507,6 +774,32 @@ func defaultRegistryCallOptions() *RegistryCallOptions {
 				})
 			}),
 		},
+		UploadArtifactContents: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		DownloadArtifactContents: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
 		CreateArtifact: []gax.CallOption{
 			gax.WithRetry(func() gax.Retryer {
 				return gax.OnCodes([]codes.Code{
@@ -533,6 +826,19 @@ func defaultRegistryCallOptions() *RegistryCallOptions {
 				})
 			}),
 		},
+		UpdateArtifact: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
 		DeleteArtifact: []gax.CallOption{
 			gax.WithRetry(func() gax.Retryer {
 				return gax.OnCodes([]codes.Code{
@@ -546,10 +852,166 @@ func defaultRegistryCallOptions() *RegistryCallOptions {
 				})
 			}),
 		},
-	}
-}
-
-// internalRegistryClient is an interface that defines the methods availaible from .
+		UndeleteArtifact: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		BatchGetArtifacts: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		BatchCreateArtifacts: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		BatchReplaceArtifacts: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		BatchUpdateArtifacts: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		BatchDeleteArtifacts: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		TagArtifactRevision: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		ListArtifactRevisions: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		RollbackArtifact: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		DeleteArtifactRevision: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		WatchResources: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+		SearchResources: []gax.CallOption{
+			gax.WithRetry(func() gax.Retryer {
+				return gax.OnCodes([]codes.Code{
+					codes.Aborted,
+					codes.Canceled,
+					codes.Unavailable,
+				}, gax.Backoff{
+					Initial:    200 * time.Millisecond,
+					Max:        10000 * time.Millisecond,
+					Multiplier: 1.30,
+				})
+			}),
+		},
+	}
+}
+
+// internalRegistryClient is an interface that defines the methods availaible from .
 type internalRegistryClient interface {
 	Close() error
 	setGoogleClientInfo(...string)
@@ -559,17 +1021,34 @@ type internalRegistryClient interface {
 	CreateApi(context.Context, *rpcpb.CreateApiRequest, ...gax.CallOption) (*rpcpb.Api, error)
 	UpdateApi(context.Context, *rpcpb.UpdateApiRequest, ...gax.CallOption) (*rpcpb.Api, error)
 	DeleteApi(context.Context, *rpcpb.DeleteApiRequest, ...gax.CallOption) error
+	UndeleteApi(context.Context, *rpcpb.UndeleteApiRequest, ...gax.CallOption) (*rpcpb.Api, error)
+	BatchGetApis(context.Context, *rpcpb.BatchGetApisRequest, ...gax.CallOption) (*rpcpb.BatchGetApisResponse, error)
+	BatchCreateApis(context.Context, *rpcpb.BatchCreateApisRequest, ...gax.CallOption) (*rpcpb.BatchCreateApisResponse, error)
+	BatchUpdateApis(context.Context, *rpcpb.BatchUpdateApisRequest, ...gax.CallOption) (*rpcpb.BatchUpdateApisResponse, error)
+	BatchDeleteApis(context.Context, *rpcpb.BatchDeleteApisRequest, ...gax.CallOption) (*rpcpb.BatchDeleteApisResponse, error)
 	ListApiVersions(context.Context, *rpcpb.ListApiVersionsRequest, ...gax.CallOption) *ApiVersionIterator
 	GetApiVersion(context.Context, *rpcpb.GetApiVersionRequest, ...gax.CallOption) (*rpcpb.ApiVersion, error)
 	CreateApiVersion(context.Context, *rpcpb.CreateApiVersionRequest, ...gax.CallOption) (*rpcpb.ApiVersion, error)
 	UpdateApiVersion(context.Context, *rpcpb.UpdateApiVersionRequest, ...gax.CallOption) (*rpcpb.ApiVersion, error)
 	DeleteApiVersion(context.Context, *rpcpb.DeleteApiVersionRequest, ...gax.CallOption) error
+	UndeleteApiVersion(context.Context, *rpcpb.UndeleteApiVersionRequest, ...gax.CallOption) (*rpcpb.ApiVersion, error)
+	BatchGetApiVersions(context.Context, *rpcpb.BatchGetApiVersionsRequest, ...gax.CallOption) (*rpcpb.BatchGetApiVersionsResponse, error)
+	BatchCreateApiVersions(context.Context, *rpcpb.BatchCreateApiVersionsRequest, ...gax.CallOption) (*rpcpb.BatchCreateApiVersionsResponse, error)
+	BatchUpdateApiVersions(context.Context, *rpcpb.BatchUpdateApiVersionsRequest, ...gax.CallOption) (*rpcpb.BatchUpdateApiVersionsResponse, error)
+	BatchDeleteApiVersions(context.Context, *rpcpb.BatchDeleteApiVersionsRequest, ...gax.CallOption) (*rpcpb.BatchDeleteApiVersionsResponse, error)
 	ListApiSpecs(context.Context, *rpcpb.ListApiSpecsRequest, ...gax.CallOption) *ApiSpecIterator
 	GetApiSpec(context.Context, *rpcpb.GetApiSpecRequest, ...gax.CallOption) (*rpcpb.ApiSpec, error)
 	GetApiSpecContents(context.Context, *rpcpb.GetApiSpecContentsRequest, ...gax.CallOption) (*httpbodypb.HttpBody, error)
+	UploadApiSpecContents(context.Context, ...gax.CallOption) (rpcpb.Registry_UploadApiSpecContentsClient, error)
+	DownloadApiSpecContents(context.Context, *rpcpb.DownloadApiSpecContentsRequest, ...gax.CallOption) (rpcpb.Registry_DownloadApiSpecContentsClient, error)
 	CreateApiSpec(context.Context, *rpcpb.CreateApiSpecRequest, ...gax.CallOption) (*rpcpb.ApiSpec, error)
 	UpdateApiSpec(context.Context, *rpcpb.UpdateApiSpecRequest, ...gax.CallOption) (*rpcpb.ApiSpec, error)
 	DeleteApiSpec(context.Context, *rpcpb.DeleteApiSpecRequest, ...gax.CallOption) error
+	UndeleteApiSpec(context.Context, *rpcpb.UndeleteApiSpecRequest, ...gax.CallOption) (*rpcpb.ApiSpec, error)
+	BatchGetApiSpecs(context.Context, *rpcpb.BatchGetApiSpecsRequest, ...gax.CallOption) (*rpcpb.BatchGetApiSpecsResponse, error)
+	BatchCreateApiSpecs(context.Context, *rpcpb.BatchCreateApiSpecsRequest, ...gax.CallOption) (*rpcpb.BatchCreateApiSpecsResponse, error)
+	BatchUpdateApiSpecs(context.Context, *rpcpb.BatchUpdateApiSpecsRequest, ...gax.CallOption) (*rpcpb.BatchUpdateApiSpecsResponse, error)
+	BatchDeleteApiSpecs(context.Context, *rpcpb.BatchDeleteApiSpecsRequest, ...gax.CallOption) (*rpcpb.BatchDeleteApiSpecsResponse, error)
 	TagApiSpecRevision(context.Context, *rpcpb.TagApiSpecRevisionRequest, ...gax.CallOption) (*rpcpb.ApiSpec, error)
 	ListApiSpecRevisions(context.Context, *rpcpb.ListApiSpecRevisionsRequest, ...gax.CallOption) *ApiSpecIterator
 	RollbackApiSpec(context.Context, *rpcpb.RollbackApiSpecRequest, ...gax.CallOption) (*rpcpb.ApiSpec, error)
@@ -579,6 +1058,7 @@ type internalRegistryClient interface {
 	CreateApiDeployment(context.Context, *rpcpb.CreateApiDeploymentRequest, ...gax.CallOption) (*rpcpb.ApiDeployment, error)
 	UpdateApiDeployment(context.Context, *rpcpb.UpdateApiDeploymentRequest, ...gax.CallOption) (*rpcpb.ApiDeployment, error)
 	DeleteApiDeployment(context.Context, *rpcpb.DeleteApiDeploymentRequest, ...gax.CallOption) error
+	UndeleteApiDeployment(context.Context, *rpcpb.UndeleteApiDeploymentRequest, ...gax.CallOption) (*rpcpb.ApiDeployment, error)
 	TagApiDeploymentRevision(context.Context, *rpcpb.TagApiDeploymentRevisionRequest, ...gax.CallOption) (*rpcpb.ApiDeployment, error)
 	ListApiDeploymentRevisions(context.Context, *rpcpb.ListApiDeploymentRevisionsRequest, ...gax.CallOption) *ApiDeploymentIterator
 	RollbackApiDeployment(context.Context, *rpcpb.RollbackApiDeploymentRequest, ...gax.CallOption) (*rpcpb.ApiDeployment, error)
@@ -586,9 +1066,24 @@ type internalRegistryClient interface {
 	ListArtifacts(context.Context, *rpcpb.ListArtifactsRequest, ...gax.CallOption) *ArtifactIterator
 	GetArtifact(context.Context, *rpcpb.GetArtifactRequest, ...gax.CallOption) (*rpcpb.Artifact, error)
 	GetArtifactContents(context.Context, *rpcpb.GetArtifactContentsRequest, ...gax.CallOption) (*httpbodypb.HttpBody, error)
+	UploadArtifactContents(context.Context, ...gax.CallOption) (rpcpb.Registry_UploadArtifactContentsClient, error)
+	DownloadArtifactContents(context.Context, *rpcpb.DownloadArtifactContentsRequest, ...gax.CallOption) (rpcpb.Registry_DownloadArtifactContentsClient, error)
 	CreateArtifact(context.Context, *rpcpb.CreateArtifactRequest, ...gax.CallOption) (*rpcpb.Artifact, error)
 	ReplaceArtifact(context.Context, *rpcpb.ReplaceArtifactRequest, ...gax.CallOption) (*rpcpb.Artifact, error)
+	UpdateArtifact(context.Context, *rpcpb.UpdateArtifactRequest, ...gax.CallOption) (*rpcpb.Artifact, error)
 	DeleteArtifact(context.Context, *rpcpb.DeleteArtifactRequest, ...gax.CallOption) error
+	UndeleteArtifact(context.Context, *rpcpb.UndeleteArtifactRequest, ...gax.CallOption) (*rpcpb.Artifact, error)
+	BatchGetArtifacts(context.Context, *rpcpb.BatchGetArtifactsRequest, ...gax.CallOption) (*rpcpb.BatchGetArtifactsResponse, error)
+	BatchCreateArtifacts(context.Context, *rpcpb.BatchCreateArtifactsRequest, ...gax.CallOption) (*rpcpb.BatchCreateArtifactsResponse, error)
+	BatchReplaceArtifacts(context.Context, *rpcpb.BatchReplaceArtifactsRequest, ...gax.CallOption) (*rpcpb.BatchReplaceArtifactsResponse, error)
+	BatchUpdateArtifacts(context.Context, *rpcpb.BatchUpdateArtifactsRequest, ...gax.CallOption) (*rpcpb.BatchUpdateArtifactsResponse, error)
+	BatchDeleteArtifacts(context.Context, *rpcpb.BatchDeleteArtifactsRequest, ...gax.CallOption) (*rpcpb.BatchDeleteArtifactsResponse, error)
+	TagArtifactRevision(context.Context, *rpcpb.TagArtifactRevisionRequest, ...gax.CallOption) (*rpcpb.Artifact, error)
+	ListArtifactRevisions(context.Context, *rpcpb.ListArtifactRevisionsRequest, ...gax.CallOption) *ArtifactIterator
+	RollbackArtifact(context.Context, *rpcpb.RollbackArtifactRequest, ...gax.CallOption) (*rpcpb.Artifact, error)
+	DeleteArtifactRevision(context.Context, *rpcpb.DeleteArtifactRevisionRequest, ...gax.CallOption) (*rpcpb.Artifact, error)
+	WatchResources(context.Context, *rpcpb.WatchResourcesRequest, ...gax.CallOption) (rpcpb.Registry_WatchResourcesClient, error)
+	SearchResources(context.Context, *rpcpb.SearchResourcesRequest, ...gax.CallOption) *SearchResultIterator
 }
 
 // RegistryClient is a client for interacting with .
@@ -652,6 +1147,38 @@ func (c *RegistryClient) DeleteApi(ctx context.Context, req *rpcpb.DeleteApiRequ
 	return c.internalClient.DeleteApi(ctx, req, opts...)
 }
 
+// UndeleteApi undeleteApi restores a deleted API and the resources that were deleted with
+// it.
+func (c *RegistryClient) UndeleteApi(ctx context.Context, req *rpcpb.UndeleteApiRequest, opts ...gax.CallOption) (*rpcpb.Api, error) {
+	return c.internalClient.UndeleteApi(ctx, req, opts...)
+}
+
+// BatchGetApis batchGetApis returns multiple specified APIs.
+func (c *RegistryClient) BatchGetApis(ctx context.Context, req *rpcpb.BatchGetApisRequest, opts ...gax.CallOption) (*rpcpb.BatchGetApisResponse, error) {
+	return c.internalClient.BatchGetApis(ctx, req, opts...)
+}
+
+// BatchCreateApis batchCreateApis creates multiple APIs in a single transaction.
+// Requests that fail are reported in the results without preventing the
+// others from being applied.
+func (c *RegistryClient) BatchCreateApis(ctx context.Context, req *rpcpb.BatchCreateApisRequest, opts ...gax.CallOption) (*rpcpb.BatchCreateApisResponse, error) {
+	return c.internalClient.BatchCreateApis(ctx, req, opts...)
+}
+
+// BatchUpdateApis batchUpdateApis modifies multiple APIs in a single transaction.
+// Requests that fail are reported in the results without preventing the
+// others from being applied.
+func (c *RegistryClient) BatchUpdateApis(ctx context.Context, req *rpcpb.BatchUpdateApisRequest, opts ...gax.CallOption) (*rpcpb.BatchUpdateApisResponse, error) {
+	return c.internalClient.BatchUpdateApis(ctx, req, opts...)
+}
+
+// BatchDeleteApis batchDeleteApis removes multiple APIs in a single transaction.
+// Requests that fail are reported in the results without preventing the
+// others from being applied.
+func (c *RegistryClient) BatchDeleteApis(ctx context.Context, req *rpcpb.BatchDeleteApisRequest, opts ...gax.CallOption) (*rpcpb.BatchDeleteApisResponse, error) {
+	return c.internalClient.BatchDeleteApis(ctx, req, opts...)
+}
+
 // ListApiVersions listApiVersions returns matching versions.
 func (c *RegistryClient) ListApiVersions(ctx context.Context, req *rpcpb.ListApiVersionsRequest, opts ...gax.CallOption) *ApiVersionIterator {
 	return c.internalClient.ListApiVersions(ctx, req, opts...)
@@ -678,6 +1205,38 @@ func (c *RegistryClient) DeleteApiVersion(ctx context.Context, req *rpcpb.Delete
 	return c.internalClient.DeleteApiVersion(ctx, req, opts...)
 }
 
+// UndeleteApiVersion undeleteApiVersion restores a deleted version and the resources that were
+// deleted with it.
+func (c *RegistryClient) UndeleteApiVersion(ctx context.Context, req *rpcpb.UndeleteApiVersionRequest, opts ...gax.CallOption) (*rpcpb.ApiVersion, error) {
+	return c.internalClient.UndeleteApiVersion(ctx, req, opts...)
+}
+
+// BatchGetApiVersions batchGetApiVersions returns multiple specified versions.
+func (c *RegistryClient) BatchGetApiVersions(ctx context.Context, req *rpcpb.BatchGetApiVersionsRequest, opts ...gax.CallOption) (*rpcpb.BatchGetApiVersionsResponse, error) {
+	return c.internalClient.BatchGetApiVersions(ctx, req, opts...)
+}
+
+// BatchCreateApiVersions batchCreateApiVersions creates multiple versions in a single transaction.
+// Requests that fail are reported in the results without preventing the
+// others from being applied.
+func (c *RegistryClient) BatchCreateApiVersions(ctx context.Context, req *rpcpb.BatchCreateApiVersionsRequest, opts ...gax.CallOption) (*rpcpb.BatchCreateApiVersionsResponse, error) {
+	return c.internalClient.BatchCreateApiVersions(ctx, req, opts...)
+}
+
+// BatchUpdateApiVersions batchUpdateApiVersions modifies multiple versions in a single transaction.
+// Requests that fail are reported in the results without preventing the
+// others from being applied.
+func (c *RegistryClient) BatchUpdateApiVersions(ctx context.Context, req *rpcpb.BatchUpdateApiVersionsRequest, opts ...gax.CallOption) (*rpcpb.BatchUpdateApiVersionsResponse, error) {
+	return c.internalClient.BatchUpdateApiVersions(ctx, req, opts...)
+}
+
+// BatchDeleteApiVersions batchDeleteApiVersions removes multiple versions in a single transaction.
+// Requests that fail are reported in the results without preventing the
+// others from being applied.
+func (c *RegistryClient) BatchDeleteApiVersions(ctx context.Context, req *rpcpb.BatchDeleteApiVersionsRequest, opts ...gax.CallOption) (*rpcpb.BatchDeleteApiVersionsResponse, error) {
+	return c.internalClient.BatchDeleteApiVersions(ctx, req, opts...)
+}
+
 // ListApiSpecs listApiSpecs returns matching specs.
 func (c *RegistryClient) ListApiSpecs(ctx context.Context, req *rpcpb.ListApiSpecsRequest, opts ...gax.CallOption) *ApiSpecIterator {
 	return c.internalClient.ListApiSpecs(ctx, req, opts...)
@@ -696,6 +1255,20 @@ func (c *RegistryClient) GetApiSpecContents(ctx context.Context, req *rpcpb.GetA
 	return c.internalClient.GetApiSpecContents(ctx, req, opts...)
 }
 
+// UploadApiSpecContents uploadApiSpecContents creates a spec or updates the contents of an
+// existing spec with contents that are sent in a stream of chunks.
+// It supports specs that are too large to send in a single message.
+func (c *RegistryClient) UploadApiSpecContents(ctx context.Context, opts ...gax.CallOption) (rpcpb.Registry_UploadApiSpecContentsClient, error) {
+	return c.internalClient.UploadApiSpecContents(ctx, opts...)
+}
+
+// DownloadApiSpecContents downloadApiSpecContents returns the contents of a specified spec in a
+// stream of chunks. Contents are returned in the format returned by
+// GetApiSpecContents.
+func (c *RegistryClient) DownloadApiSpecContents(ctx context.Context, req *rpcpb.DownloadApiSpecContentsRequest, opts ...gax.CallOption) (rpcpb.Registry_DownloadApiSpecContentsClient, error) {
+	return c.internalClient.DownloadApiSpecContents(ctx, req, opts...)
+}
+
 // CreateApiSpec createApiSpec creates a specified spec.
 func (c *RegistryClient) CreateApiSpec(ctx context.Context, req *rpcpb.CreateApiSpecRequest, opts ...gax.CallOption) (*rpcpb.ApiSpec, error) {
 	return c.internalClient.CreateApiSpec(ctx, req, opts...)
@@ -712,6 +1285,38 @@ func (c *RegistryClient) DeleteApiSpec(ctx context.Context, req *rpcpb.DeleteApi
 	return c.internalClient.DeleteApiSpec(ctx, req, opts...)
 }
 
+// UndeleteApiSpec undeleteApiSpec restores a deleted spec and the resources that were deleted
+// with it.
+func (c *RegistryClient) UndeleteApiSpec(ctx context.Context, req *rpcpb.UndeleteApiSpecRequest, opts ...gax.CallOption) (*rpcpb.ApiSpec, error) {
+	return c.internalClient.UndeleteApiSpec(ctx, req, opts...)
+}
+
+// BatchGetApiSpecs batchGetApiSpecs returns multiple specified specs.
+func (c *RegistryClient) BatchGetApiSpecs(ctx context.Context, req *rpcpb.BatchGetApiSpecsRequest, opts ...gax.CallOption) (*rpcpb.BatchGetApiSpecsResponse, error) {
+	return c.internalClient.BatchGetApiSpecs(ctx, req, opts...)
+}
+
+// BatchCreateApiSpecs batchCreateApiSpecs creates multiple specs in a single transaction.
+// Requests that fail are reported in the results without preventing the
+// others from being applied.
+func (c *RegistryClient) BatchCreateApiSpecs(ctx context.Context, req *rpcpb.BatchCreateApiSpecsRequest, opts ...gax.CallOption) (*rpcpb.BatchCreateApiSpecsResponse, error) {
+	return c.internalClient.BatchCreateApiSpecs(ctx, req, opts...)
+}
+
+// BatchUpdateApiSpecs batchUpdateApiSpecs modifies multiple specs in a single transaction.
+// Requests that fail are reported in the results without preventing the
+// others from being applied.
+func (c *RegistryClient) BatchUpdateApiSpecs(ctx context.Context, req *rpcpb.BatchUpdateApiSpecsRequest, opts ...gax.CallOption) (*rpcpb.BatchUpdateApiSpecsResponse, error) {
+	return c.internalClient.BatchUpdateApiSpecs(ctx, req, opts...)
+}
+
+// BatchDeleteApiSpecs batchDeleteApiSpecs removes multiple specs in a single transaction.
+// Requests that fail are reported in the results without preventing the
+// others from being applied.
+func (c *RegistryClient) BatchDeleteApiSpecs(ctx context.Context, req *rpcpb.BatchDeleteApiSpecsRequest, opts ...gax.CallOption) (*rpcpb.BatchDeleteApiSpecsResponse, error) {
+	return c.internalClient.BatchDeleteApiSpecs(ctx, req, opts...)
+}
+
 // TagApiSpecRevision tagApiSpecRevision adds a tag to a specified revision of a spec.
 func (c *RegistryClient) TagApiSpecRevision(ctx context.Context, req *rpcpb.TagApiSpecRevisionRequest, opts ...gax.CallOption) (*rpcpb.ApiSpec, error) {
 	return c.internalClient.TagApiSpecRevision(ctx, req, opts...)
@@ -760,6 +1365,12 @@ func (c *RegistryClient) DeleteApiDeployment(ctx context.Context, req *rpcpb.Del
 	return c.internalClient.DeleteApiDeployment(ctx, req, opts...)
 }
 
+// UndeleteApiDeployment undeleteApiDeployment restores a deleted deployment and the resources that
+// were deleted with it.
+func (c *RegistryClient) UndeleteApiDeployment(ctx context.Context, req *rpcpb.UndeleteApiDeploymentRequest, opts ...gax.CallOption) (*rpcpb.ApiDeployment, error) {
+	return c.internalClient.UndeleteApiDeployment(ctx, req, opts...)
+}
+
 // TagApiDeploymentRevision tagApiDeploymentRevision adds a tag to a specified revision of a
 // deployment.
 func (c *RegistryClient) TagApiDeploymentRevision(ctx context.Context, req *rpcpb.TagApiDeploymentRevisionRequest, opts ...gax.CallOption) (*rpcpb.ApiDeployment, error) {
@@ -801,6 +1412,19 @@ func (c *RegistryClient) GetArtifactContents(ctx context.Context, req *rpcpb.Get
 	return c.internalClient.GetArtifactContents(ctx, req, opts...)
 }
 
+// UploadArtifactContents uploadArtifactContents creates an artifact or replaces an existing
+// artifact with contents that are sent in a stream of chunks.
+// It supports artifacts that are too large to send in a single message.
+func (c *RegistryClient) UploadArtifactContents(ctx context.Context, opts ...gax.CallOption) (rpcpb.Registry_UploadArtifactContentsClient, error) {
+	return c.internalClient.UploadArtifactContents(ctx, opts...)
+}
+
+// DownloadArtifactContents downloadArtifactContents returns the contents of a specified artifact in
+// a stream of chunks.
+func (c *RegistryClient) DownloadArtifactContents(ctx context.Context, req *rpcpb.DownloadArtifactContentsRequest, opts ...gax.CallOption) (rpcpb.Registry_DownloadArtifactContentsClient, error) {
+	return c.internalClient.DownloadArtifactContents(ctx, req, opts...)
+}
+
 // CreateArtifact createArtifact creates a specified artifact.
 func (c *RegistryClient) CreateArtifact(ctx context.Context, req *rpcpb.CreateArtifactRequest, opts ...gax.CallOption) (*rpcpb.Artifact, error) {
 	return c.internalClient.CreateArtifact(ctx, req, opts...)
@@ -811,11 +1435,89 @@ func (c *RegistryClient) ReplaceArtifact(ctx context.Context, req *rpcpb.Replace
 	return c.internalClient.ReplaceArtifact(ctx, req, opts...)
 }
 
+// UpdateArtifact updateArtifact can be used to modify the labels and annotations of a
+// specified artifact without sending its contents.
+func (c *RegistryClient) UpdateArtifact(ctx context.Context, req *rpcpb.UpdateArtifactRequest, opts ...gax.CallOption) (*rpcpb.Artifact, error) {
+	return c.internalClient.UpdateArtifact(ctx, req, opts...)
+}
+
 // DeleteArtifact deleteArtifact removes a specified artifact.
 func (c *RegistryClient) DeleteArtifact(ctx context.Context, req *rpcpb.DeleteArtifactRequest, opts ...gax.CallOption) error {
 	return c.internalClient.DeleteArtifact(ctx, req, opts...)
 }
 
+// UndeleteArtifact undeleteArtifact restores a deleted artifact.
+func (c *RegistryClient) UndeleteArtifact(ctx context.Context, req *rpcpb.UndeleteArtifactRequest, opts ...gax.CallOption) (*rpcpb.Artifact, error) {
+	return c.internalClient.UndeleteArtifact(ctx, req, opts...)
+}
+
+// BatchGetArtifacts batchGetArtifacts returns multiple specified artifacts.
+func (c *RegistryClient) BatchGetArtifacts(ctx context.Context, req *rpcpb.BatchGetArtifactsRequest, opts ...gax.CallOption) (*rpcpb.BatchGetArtifactsResponse, error) {
+	return c.internalClient.BatchGetArtifacts(ctx, req, opts...)
+}
+
+// BatchCreateArtifacts batchCreateArtifacts creates multiple artifacts in a single transaction.
+// Requests that fail are reported in the results without preventing the
+// others from being applied.
+func (c *RegistryClient) BatchCreateArtifacts(ctx context.Context, req *rpcpb.BatchCreateArtifactsRequest, opts ...gax.CallOption) (*rpcpb.BatchCreateArtifactsResponse, error) {
+	return c.internalClient.BatchCreateArtifacts(ctx, req, opts...)
+}
+
+// BatchReplaceArtifacts batchReplaceArtifacts replaces multiple artifacts in a single transaction.
+// Requests that fail are reported in the results without preventing the
+// others from being applied.
+func (c *RegistryClient) BatchReplaceArtifacts(ctx context.Context, req *rpcpb.BatchReplaceArtifactsRequest, opts ...gax.CallOption) (*rpcpb.BatchReplaceArtifactsResponse, error) {
+	return c.internalClient.BatchReplaceArtifacts(ctx, req, opts...)
+}
+
+// BatchUpdateArtifacts batchUpdateArtifacts modifies the labels and annotations of multiple
+// artifacts in a single transaction. Requests that fail are reported in the
+// results without preventing the others from being applied.
+func (c *RegistryClient) BatchUpdateArtifacts(ctx context.Context, req *rpcpb.BatchUpdateArtifactsRequest, opts ...gax.CallOption) (*rpcpb.BatchUpdateArtifactsResponse, error) {
+	return c.internalClient.BatchUpdateArtifacts(ctx, req, opts...)
+}
+
+// BatchDeleteArtifacts batchDeleteArtifacts removes multiple artifacts in a single transaction.
+// Requests that fail are reported in the results without preventing the
+// others from being applied.
+func (c *RegistryClient) BatchDeleteArtifacts(ctx context.Context, req *rpcpb.BatchDeleteArtifactsRequest, opts ...gax.CallOption) (*rpcpb.BatchDeleteArtifactsResponse, error) {
+	return c.internalClient.BatchDeleteArtifacts(ctx, req, opts...)
+}
+
+// TagArtifactRevision tagArtifactRevision adds a tag to a specified revision of an artifact.
+func (c *RegistryClient) TagArtifactRevision(ctx context.Context, req *rpcpb.TagArtifactRevisionRequest, opts ...gax.CallOption) (*rpcpb.Artifact, error) {
+	return c.internalClient.TagArtifactRevision(ctx, req, opts...)
+}
+
+// ListArtifactRevisions listArtifactRevisions lists all revisions of an artifact.
+// Revisions are returned in descending order of revision creation time.
+func (c *RegistryClient) ListArtifactRevisions(ctx context.Context, req *rpcpb.ListArtifactRevisionsRequest, opts ...gax.CallOption) *ArtifactIterator {
+	return c.internalClient.ListArtifactRevisions(ctx, req, opts...)
+}
+
+// RollbackArtifact rollbackArtifact sets the current revision to a specified prior revision.
+// Note that this creates a new revision with a new revision ID.
+func (c *RegistryClient) RollbackArtifact(ctx context.Context, req *rpcpb.RollbackArtifactRequest, opts ...gax.CallOption) (*rpcpb.Artifact, error) {
+	return c.internalClient.RollbackArtifact(ctx, req, opts...)
+}
+
+// DeleteArtifactRevision deleteArtifactRevision deletes a revision of an artifact.
+func (c *RegistryClient) DeleteArtifactRevision(ctx context.Context, req *rpcpb.DeleteArtifactRevisionRequest, opts ...gax.CallOption) (*rpcpb.Artifact, error) {
+	return c.internalClient.DeleteArtifactRevision(ctx, req, opts...)
+}
+
+// WatchResources watchResources streams notifications of changes to resources that
+// match a pattern.
+func (c *RegistryClient) WatchResources(ctx context.Context, req *rpcpb.WatchResourcesRequest, opts ...gax.CallOption) (rpcpb.Registry_WatchResourcesClient, error) {
+	return c.internalClient.WatchResources(ctx, req, opts...)
+}
+
+// SearchResources searchResources returns the APIs, versions, specs and deployments that
+// match a text query, ordered by relevance.
+func (c *RegistryClient) SearchResources(ctx context.Context, req *rpcpb.SearchResourcesRequest, opts ...gax.CallOption) *SearchResultIterator {
+	return c.internalClient.SearchResources(ctx, req, opts...)
+}
+
 // registryGRPCClient is a client for interacting with  over gRPC transport.
 //
 // Methods, except Close, may be called concurrently. However, fields must not be modified concurrently with method calls.
@@ -1021,63 +1723,40 @@ func (c *registryGRPCClient) DeleteApi(ctx context.Context, req *rpcpb.DeleteApi
 	return err
 }
 
-func (c *registryGRPCClient) ListApiVersions(ctx context.Context, req *rpcpb.ListApiVersionsRequest, opts ...gax.CallOption) *ApiVersionIterator {
-	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
+func (c *registryGRPCClient) UndeleteApi(ctx context.Context, req *rpcpb.UndeleteApiRequest, opts ...gax.CallOption) (*rpcpb.Api, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).ListApiVersions[0:len((*c.CallOptions).ListApiVersions):len((*c.CallOptions).ListApiVersions)], opts...)
-	it := &ApiVersionIterator{}
-	req = proto.Clone(req).(*rpcpb.ListApiVersionsRequest)
-	it.InternalFetch = func(pageSize int, pageToken string) ([]*rpcpb.ApiVersion, string, error) {
-		resp := &rpcpb.ListApiVersionsResponse{}
-		if pageToken != "" {
-			req.PageToken = pageToken
-		}
-		if pageSize > math.MaxInt32 {
-			req.PageSize = math.MaxInt32
-		} else if pageSize != 0 {
-			req.PageSize = int32(pageSize)
-		}
-		err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
-			var err error
-			resp, err = c.registryClient.ListApiVersions(ctx, req, settings.GRPC...)
-			return err
-		}, opts...)
-		if err != nil {
-			return nil, "", err
-		}
-
-		it.Response = resp
-		return resp.GetApiVersions(), resp.GetNextPageToken(), nil
+	opts = append((*c.CallOptions).UndeleteApi[0:len((*c.CallOptions).UndeleteApi):len((*c.CallOptions).UndeleteApi)], opts...)
+	var resp *rpcpb.Api
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.UndeleteApi(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
 	}
-	fetch := func(pageSize int, pageToken string) (string, error) {
-		items, nextPageToken, err := it.InternalFetch(pageSize, pageToken)
-		if err != nil {
-			return "", err
-		}
-		it.items = append(it.items, items...)
-		return nextPageToken, nil
-	}
-
-	it.pageInfo, it.nextFunc = iterator.NewPageInfo(fetch, it.bufLen, it.takeBuf)
-	it.pageInfo.MaxSize = int(req.GetPageSize())
-	it.pageInfo.Token = req.GetPageToken()
-
-	return it
+	return resp, nil
 }
 
-func (c *registryGRPCClient) GetApiVersion(ctx context.Context, req *rpcpb.GetApiVersionRequest, opts ...gax.CallOption) (*rpcpb.ApiVersion, error) {
+func (c *registryGRPCClient) BatchGetApis(ctx context.Context, req *rpcpb.BatchGetApisRequest, opts ...gax.CallOption) (*rpcpb.BatchGetApisResponse, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
 		defer cancel()
 		ctx = cctx
 	}
-	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).GetApiVersion[0:len((*c.CallOptions).GetApiVersion):len((*c.CallOptions).GetApiVersion)], opts...)
-	var resp *rpcpb.ApiVersion
+	opts = append((*c.CallOptions).BatchGetApis[0:len((*c.CallOptions).BatchGetApis):len((*c.CallOptions).BatchGetApis)], opts...)
+	var resp *rpcpb.BatchGetApisResponse
 	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
 		var err error
-		resp, err = c.registryClient.GetApiVersion(ctx, req, settings.GRPC...)
+		resp, err = c.registryClient.BatchGetApis(ctx, req, settings.GRPC...)
 		return err
 	}, opts...)
 	if err != nil {
@@ -1086,7 +1765,7 @@ func (c *registryGRPCClient) GetApiVersion(ctx context.Context, req *rpcpb.GetAp
 	return resp, nil
 }
 
-func (c *registryGRPCClient) CreateApiVersion(ctx context.Context, req *rpcpb.CreateApiVersionRequest, opts ...gax.CallOption) (*rpcpb.ApiVersion, error) {
+func (c *registryGRPCClient) BatchCreateApis(ctx context.Context, req *rpcpb.BatchCreateApisRequest, opts ...gax.CallOption) (*rpcpb.BatchCreateApisResponse, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
 		defer cancel()
@@ -1094,11 +1773,11 @@ func (c *registryGRPCClient) CreateApiVersion(ctx context.Context, req *rpcpb.Cr
 	}
 	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).CreateApiVersion[0:len((*c.CallOptions).CreateApiVersion):len((*c.CallOptions).CreateApiVersion)], opts...)
-	var resp *rpcpb.ApiVersion
+	opts = append((*c.CallOptions).BatchCreateApis[0:len((*c.CallOptions).BatchCreateApis):len((*c.CallOptions).BatchCreateApis)], opts...)
+	var resp *rpcpb.BatchCreateApisResponse
 	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
 		var err error
-		resp, err = c.registryClient.CreateApiVersion(ctx, req, settings.GRPC...)
+		resp, err = c.registryClient.BatchCreateApis(ctx, req, settings.GRPC...)
 		return err
 	}, opts...)
 	if err != nil {
@@ -1107,19 +1786,19 @@ func (c *registryGRPCClient) CreateApiVersion(ctx context.Context, req *rpcpb.Cr
 	return resp, nil
 }
 
-func (c *registryGRPCClient) UpdateApiVersion(ctx context.Context, req *rpcpb.UpdateApiVersionRequest, opts ...gax.CallOption) (*rpcpb.ApiVersion, error) {
+func (c *registryGRPCClient) BatchUpdateApis(ctx context.Context, req *rpcpb.BatchUpdateApisRequest, opts ...gax.CallOption) (*rpcpb.BatchUpdateApisResponse, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
 		defer cancel()
 		ctx = cctx
 	}
-	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "api_version.name", url.QueryEscape(req.GetApiVersion().GetName())))
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).UpdateApiVersion[0:len((*c.CallOptions).UpdateApiVersion):len((*c.CallOptions).UpdateApiVersion)], opts...)
-	var resp *rpcpb.ApiVersion
+	opts = append((*c.CallOptions).BatchUpdateApis[0:len((*c.CallOptions).BatchUpdateApis):len((*c.CallOptions).BatchUpdateApis)], opts...)
+	var resp *rpcpb.BatchUpdateApisResponse
 	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
 		var err error
-		resp, err = c.registryClient.UpdateApiVersion(ctx, req, settings.GRPC...)
+		resp, err = c.registryClient.BatchUpdateApis(ctx, req, settings.GRPC...)
 		return err
 	}, opts...)
 	if err != nil {
@@ -1128,31 +1807,35 @@ func (c *registryGRPCClient) UpdateApiVersion(ctx context.Context, req *rpcpb.Up
 	return resp, nil
 }
 
-func (c *registryGRPCClient) DeleteApiVersion(ctx context.Context, req *rpcpb.DeleteApiVersionRequest, opts ...gax.CallOption) error {
+func (c *registryGRPCClient) BatchDeleteApis(ctx context.Context, req *rpcpb.BatchDeleteApisRequest, opts ...gax.CallOption) (*rpcpb.BatchDeleteApisResponse, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
 		defer cancel()
 		ctx = cctx
 	}
-	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).DeleteApiVersion[0:len((*c.CallOptions).DeleteApiVersion):len((*c.CallOptions).DeleteApiVersion)], opts...)
+	opts = append((*c.CallOptions).BatchDeleteApis[0:len((*c.CallOptions).BatchDeleteApis):len((*c.CallOptions).BatchDeleteApis)], opts...)
+	var resp *rpcpb.BatchDeleteApisResponse
 	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
 		var err error
-		_, err = c.registryClient.DeleteApiVersion(ctx, req, settings.GRPC...)
+		resp, err = c.registryClient.BatchDeleteApis(ctx, req, settings.GRPC...)
 		return err
 	}, opts...)
-	return err
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
 }
 
-func (c *registryGRPCClient) ListApiSpecs(ctx context.Context, req *rpcpb.ListApiSpecsRequest, opts ...gax.CallOption) *ApiSpecIterator {
+func (c *registryGRPCClient) ListApiVersions(ctx context.Context, req *rpcpb.ListApiVersionsRequest, opts ...gax.CallOption) *ApiVersionIterator {
 	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).ListApiSpecs[0:len((*c.CallOptions).ListApiSpecs):len((*c.CallOptions).ListApiSpecs)], opts...)
-	it := &ApiSpecIterator{}
-	req = proto.Clone(req).(*rpcpb.ListApiSpecsRequest)
-	it.InternalFetch = func(pageSize int, pageToken string) ([]*rpcpb.ApiSpec, string, error) {
-		resp := &rpcpb.ListApiSpecsResponse{}
+	opts = append((*c.CallOptions).ListApiVersions[0:len((*c.CallOptions).ListApiVersions):len((*c.CallOptions).ListApiVersions)], opts...)
+	it := &ApiVersionIterator{}
+	req = proto.Clone(req).(*rpcpb.ListApiVersionsRequest)
+	it.InternalFetch = func(pageSize int, pageToken string) ([]*rpcpb.ApiVersion, string, error) {
+		resp := &rpcpb.ListApiVersionsResponse{}
 		if pageToken != "" {
 			req.PageToken = pageToken
 		}
@@ -1163,7 +1846,7 @@ func (c *registryGRPCClient) ListApiSpecs(ctx context.Context, req *rpcpb.ListAp
 		}
 		err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
 			var err error
-			resp, err = c.registryClient.ListApiSpecs(ctx, req, settings.GRPC...)
+			resp, err = c.registryClient.ListApiVersions(ctx, req, settings.GRPC...)
 			return err
 		}, opts...)
 		if err != nil {
@@ -1171,7 +1854,7 @@ func (c *registryGRPCClient) ListApiSpecs(ctx context.Context, req *rpcpb.ListAp
 		}
 
 		it.Response = resp
-		return resp.GetApiSpecs(), resp.GetNextPageToken(), nil
+		return resp.GetApiVersions(), resp.GetNextPageToken(), nil
 	}
 	fetch := func(pageSize int, pageToken string) (string, error) {
 		items, nextPageToken, err := it.InternalFetch(pageSize, pageToken)
@@ -1189,7 +1872,7 @@ func (c *registryGRPCClient) ListApiSpecs(ctx context.Context, req *rpcpb.ListAp
 	return it
 }
 
-func (c *registryGRPCClient) GetApiSpec(ctx context.Context, req *rpcpb.GetApiSpecRequest, opts ...gax.CallOption) (*rpcpb.ApiSpec, error) {
+func (c *registryGRPCClient) GetApiVersion(ctx context.Context, req *rpcpb.GetApiVersionRequest, opts ...gax.CallOption) (*rpcpb.ApiVersion, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
 		defer cancel()
@@ -1197,11 +1880,11 @@ func (c *registryGRPCClient) GetApiSpec(ctx context.Context, req *rpcpb.GetApiSp
 	}
 	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).GetApiSpec[0:len((*c.CallOptions).GetApiSpec):len((*c.CallOptions).GetApiSpec)], opts...)
-	var resp *rpcpb.ApiSpec
+	opts = append((*c.CallOptions).GetApiVersion[0:len((*c.CallOptions).GetApiVersion):len((*c.CallOptions).GetApiVersion)], opts...)
+	var resp *rpcpb.ApiVersion
 	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
 		var err error
-		resp, err = c.registryClient.GetApiSpec(ctx, req, settings.GRPC...)
+		resp, err = c.registryClient.GetApiVersion(ctx, req, settings.GRPC...)
 		return err
 	}, opts...)
 	if err != nil {
@@ -1210,7 +1893,49 @@ func (c *registryGRPCClient) GetApiSpec(ctx context.Context, req *rpcpb.GetApiSp
 	return resp, nil
 }
 
-func (c *registryGRPCClient) GetApiSpecContents(ctx context.Context, req *rpcpb.GetApiSpecContentsRequest, opts ...gax.CallOption) (*httpbodypb.HttpBody, error) {
+func (c *registryGRPCClient) CreateApiVersion(ctx context.Context, req *rpcpb.CreateApiVersionRequest, opts ...gax.CallOption) (*rpcpb.ApiVersion, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).CreateApiVersion[0:len((*c.CallOptions).CreateApiVersion):len((*c.CallOptions).CreateApiVersion)], opts...)
+	var resp *rpcpb.ApiVersion
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.CreateApiVersion(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) UpdateApiVersion(ctx context.Context, req *rpcpb.UpdateApiVersionRequest, opts ...gax.CallOption) (*rpcpb.ApiVersion, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "api_version.name", url.QueryEscape(req.GetApiVersion().GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).UpdateApiVersion[0:len((*c.CallOptions).UpdateApiVersion):len((*c.CallOptions).UpdateApiVersion)], opts...)
+	var resp *rpcpb.ApiVersion
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.UpdateApiVersion(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) DeleteApiVersion(ctx context.Context, req *rpcpb.DeleteApiVersionRequest, opts ...gax.CallOption) error {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
 		defer cancel()
@@ -1218,11 +1943,28 @@ func (c *registryGRPCClient) GetApiSpecContents(ctx context.Context, req *rpcpb.
 	}
 	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).GetApiSpecContents[0:len((*c.CallOptions).GetApiSpecContents):len((*c.CallOptions).GetApiSpecContents)], opts...)
-	var resp *httpbodypb.HttpBody
+	opts = append((*c.CallOptions).DeleteApiVersion[0:len((*c.CallOptions).DeleteApiVersion):len((*c.CallOptions).DeleteApiVersion)], opts...)
 	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
 		var err error
-		resp, err = c.registryClient.GetApiSpecContents(ctx, req, settings.GRPC...)
+		_, err = c.registryClient.DeleteApiVersion(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	return err
+}
+
+func (c *registryGRPCClient) UndeleteApiVersion(ctx context.Context, req *rpcpb.UndeleteApiVersionRequest, opts ...gax.CallOption) (*rpcpb.ApiVersion, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).UndeleteApiVersion[0:len((*c.CallOptions).UndeleteApiVersion):len((*c.CallOptions).UndeleteApiVersion)], opts...)
+	var resp *rpcpb.ApiVersion
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.UndeleteApiVersion(ctx, req, settings.GRPC...)
 		return err
 	}, opts...)
 	if err != nil {
@@ -1231,7 +1973,7 @@ func (c *registryGRPCClient) GetApiSpecContents(ctx context.Context, req *rpcpb.
 	return resp, nil
 }
 
-func (c *registryGRPCClient) CreateApiSpec(ctx context.Context, req *rpcpb.CreateApiSpecRequest, opts ...gax.CallOption) (*rpcpb.ApiSpec, error) {
+func (c *registryGRPCClient) BatchGetApiVersions(ctx context.Context, req *rpcpb.BatchGetApiVersionsRequest, opts ...gax.CallOption) (*rpcpb.BatchGetApiVersionsResponse, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
 		defer cancel()
@@ -1239,11 +1981,11 @@ func (c *registryGRPCClient) CreateApiSpec(ctx context.Context, req *rpcpb.Creat
 	}
 	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).CreateApiSpec[0:len((*c.CallOptions).CreateApiSpec):len((*c.CallOptions).CreateApiSpec)], opts...)
-	var resp *rpcpb.ApiSpec
+	opts = append((*c.CallOptions).BatchGetApiVersions[0:len((*c.CallOptions).BatchGetApiVersions):len((*c.CallOptions).BatchGetApiVersions)], opts...)
+	var resp *rpcpb.BatchGetApiVersionsResponse
 	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
 		var err error
-		resp, err = c.registryClient.CreateApiSpec(ctx, req, settings.GRPC...)
+		resp, err = c.registryClient.BatchGetApiVersions(ctx, req, settings.GRPC...)
 		return err
 	}, opts...)
 	if err != nil {
@@ -1252,19 +1994,19 @@ func (c *registryGRPCClient) CreateApiSpec(ctx context.Context, req *rpcpb.Creat
 	return resp, nil
 }
 
-func (c *registryGRPCClient) UpdateApiSpec(ctx context.Context, req *rpcpb.UpdateApiSpecRequest, opts ...gax.CallOption) (*rpcpb.ApiSpec, error) {
+func (c *registryGRPCClient) BatchCreateApiVersions(ctx context.Context, req *rpcpb.BatchCreateApiVersionsRequest, opts ...gax.CallOption) (*rpcpb.BatchCreateApiVersionsResponse, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
 		defer cancel()
 		ctx = cctx
 	}
-	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "api_spec.name", url.QueryEscape(req.GetApiSpec().GetName())))
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).UpdateApiSpec[0:len((*c.CallOptions).UpdateApiSpec):len((*c.CallOptions).UpdateApiSpec)], opts...)
-	var resp *rpcpb.ApiSpec
+	opts = append((*c.CallOptions).BatchCreateApiVersions[0:len((*c.CallOptions).BatchCreateApiVersions):len((*c.CallOptions).BatchCreateApiVersions)], opts...)
+	var resp *rpcpb.BatchCreateApiVersionsResponse
 	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
 		var err error
-		resp, err = c.registryClient.UpdateApiSpec(ctx, req, settings.GRPC...)
+		resp, err = c.registryClient.BatchCreateApiVersions(ctx, req, settings.GRPC...)
 		return err
 	}, opts...)
 	if err != nil {
@@ -1273,36 +2015,40 @@ func (c *registryGRPCClient) UpdateApiSpec(ctx context.Context, req *rpcpb.Updat
 	return resp, nil
 }
 
-func (c *registryGRPCClient) DeleteApiSpec(ctx context.Context, req *rpcpb.DeleteApiSpecRequest, opts ...gax.CallOption) error {
+func (c *registryGRPCClient) BatchUpdateApiVersions(ctx context.Context, req *rpcpb.BatchUpdateApiVersionsRequest, opts ...gax.CallOption) (*rpcpb.BatchUpdateApiVersionsResponse, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
 		defer cancel()
 		ctx = cctx
 	}
-	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).DeleteApiSpec[0:len((*c.CallOptions).DeleteApiSpec):len((*c.CallOptions).DeleteApiSpec)], opts...)
+	opts = append((*c.CallOptions).BatchUpdateApiVersions[0:len((*c.CallOptions).BatchUpdateApiVersions):len((*c.CallOptions).BatchUpdateApiVersions)], opts...)
+	var resp *rpcpb.BatchUpdateApiVersionsResponse
 	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
 		var err error
-		_, err = c.registryClient.DeleteApiSpec(ctx, req, settings.GRPC...)
+		resp, err = c.registryClient.BatchUpdateApiVersions(ctx, req, settings.GRPC...)
 		return err
 	}, opts...)
-	return err
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
 }
 
-func (c *registryGRPCClient) TagApiSpecRevision(ctx context.Context, req *rpcpb.TagApiSpecRevisionRequest, opts ...gax.CallOption) (*rpcpb.ApiSpec, error) {
+func (c *registryGRPCClient) BatchDeleteApiVersions(ctx context.Context, req *rpcpb.BatchDeleteApiVersionsRequest, opts ...gax.CallOption) (*rpcpb.BatchDeleteApiVersionsResponse, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
 		defer cancel()
 		ctx = cctx
 	}
-	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).TagApiSpecRevision[0:len((*c.CallOptions).TagApiSpecRevision):len((*c.CallOptions).TagApiSpecRevision)], opts...)
-	var resp *rpcpb.ApiSpec
+	opts = append((*c.CallOptions).BatchDeleteApiVersions[0:len((*c.CallOptions).BatchDeleteApiVersions):len((*c.CallOptions).BatchDeleteApiVersions)], opts...)
+	var resp *rpcpb.BatchDeleteApiVersionsResponse
 	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
 		var err error
-		resp, err = c.registryClient.TagApiSpecRevision(ctx, req, settings.GRPC...)
+		resp, err = c.registryClient.BatchDeleteApiVersions(ctx, req, settings.GRPC...)
 		return err
 	}, opts...)
 	if err != nil {
@@ -1311,14 +2057,14 @@ func (c *registryGRPCClient) TagApiSpecRevision(ctx context.Context, req *rpcpb.
 	return resp, nil
 }
 
-func (c *registryGRPCClient) ListApiSpecRevisions(ctx context.Context, req *rpcpb.ListApiSpecRevisionsRequest, opts ...gax.CallOption) *ApiSpecIterator {
-	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+func (c *registryGRPCClient) ListApiSpecs(ctx context.Context, req *rpcpb.ListApiSpecsRequest, opts ...gax.CallOption) *ApiSpecIterator {
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).ListApiSpecRevisions[0:len((*c.CallOptions).ListApiSpecRevisions):len((*c.CallOptions).ListApiSpecRevisions)], opts...)
+	opts = append((*c.CallOptions).ListApiSpecs[0:len((*c.CallOptions).ListApiSpecs):len((*c.CallOptions).ListApiSpecs)], opts...)
 	it := &ApiSpecIterator{}
-	req = proto.Clone(req).(*rpcpb.ListApiSpecRevisionsRequest)
+	req = proto.Clone(req).(*rpcpb.ListApiSpecsRequest)
 	it.InternalFetch = func(pageSize int, pageToken string) ([]*rpcpb.ApiSpec, string, error) {
-		resp := &rpcpb.ListApiSpecRevisionsResponse{}
+		resp := &rpcpb.ListApiSpecsResponse{}
 		if pageToken != "" {
 			req.PageToken = pageToken
 		}
@@ -1329,7 +2075,7 @@ func (c *registryGRPCClient) ListApiSpecRevisions(ctx context.Context, req *rpcp
 		}
 		err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
 			var err error
-			resp, err = c.registryClient.ListApiSpecRevisions(ctx, req, settings.GRPC...)
+			resp, err = c.registryClient.ListApiSpecs(ctx, req, settings.GRPC...)
 			return err
 		}, opts...)
 		if err != nil {
@@ -1355,7 +2101,7 @@ func (c *registryGRPCClient) ListApiSpecRevisions(ctx context.Context, req *rpcp
 	return it
 }
 
-func (c *registryGRPCClient) RollbackApiSpec(ctx context.Context, req *rpcpb.RollbackApiSpecRequest, opts ...gax.CallOption) (*rpcpb.ApiSpec, error) {
+func (c *registryGRPCClient) GetApiSpec(ctx context.Context, req *rpcpb.GetApiSpecRequest, opts ...gax.CallOption) (*rpcpb.ApiSpec, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
 		defer cancel()
@@ -1363,11 +2109,11 @@ func (c *registryGRPCClient) RollbackApiSpec(ctx context.Context, req *rpcpb.Rol
 	}
 	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).RollbackApiSpec[0:len((*c.CallOptions).RollbackApiSpec):len((*c.CallOptions).RollbackApiSpec)], opts...)
+	opts = append((*c.CallOptions).GetApiSpec[0:len((*c.CallOptions).GetApiSpec):len((*c.CallOptions).GetApiSpec)], opts...)
 	var resp *rpcpb.ApiSpec
 	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
 		var err error
-		resp, err = c.registryClient.RollbackApiSpec(ctx, req, settings.GRPC...)
+		resp, err = c.registryClient.GetApiSpec(ctx, req, settings.GRPC...)
 		return err
 	}, opts...)
 	if err != nil {
@@ -1376,7 +2122,7 @@ func (c *registryGRPCClient) RollbackApiSpec(ctx context.Context, req *rpcpb.Rol
 	return resp, nil
 }
 
-func (c *registryGRPCClient) DeleteApiSpecRevision(ctx context.Context, req *rpcpb.DeleteApiSpecRevisionRequest, opts ...gax.CallOption) (*rpcpb.ApiSpec, error) {
+func (c *registryGRPCClient) GetApiSpecContents(ctx context.Context, req *rpcpb.GetApiSpecContentsRequest, opts ...gax.CallOption) (*httpbodypb.HttpBody, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
 		defer cancel()
@@ -1384,11 +2130,11 @@ func (c *registryGRPCClient) DeleteApiSpecRevision(ctx context.Context, req *rpc
 	}
 	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).DeleteApiSpecRevision[0:len((*c.CallOptions).DeleteApiSpecRevision):len((*c.CallOptions).DeleteApiSpecRevision)], opts...)
-	var resp *rpcpb.ApiSpec
+	opts = append((*c.CallOptions).GetApiSpecContents[0:len((*c.CallOptions).GetApiSpecContents):len((*c.CallOptions).GetApiSpecContents)], opts...)
+	var resp *httpbodypb.HttpBody
 	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
 		var err error
-		resp, err = c.registryClient.DeleteApiSpecRevision(ctx, req, settings.GRPC...)
+		resp, err = c.registryClient.GetApiSpecContents(ctx, req, settings.GRPC...)
 		return err
 	}, opts...)
 	if err != nil {
@@ -1397,63 +2143,728 @@ func (c *registryGRPCClient) DeleteApiSpecRevision(ctx context.Context, req *rpc
 	return resp, nil
 }
 
-func (c *registryGRPCClient) ListApiDeployments(ctx context.Context, req *rpcpb.ListApiDeploymentsRequest, opts ...gax.CallOption) *ApiDeploymentIterator {
+func (c *registryGRPCClient) UploadApiSpecContents(ctx context.Context, opts ...gax.CallOption) (rpcpb.Registry_UploadApiSpecContentsClient, error) {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	var resp rpcpb.Registry_UploadApiSpecContentsClient
+	opts = append((*c.CallOptions).UploadApiSpecContents[0:len((*c.CallOptions).UploadApiSpecContents):len((*c.CallOptions).UploadApiSpecContents)], opts...)
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.UploadApiSpecContents(ctx, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) DownloadApiSpecContents(ctx context.Context, req *rpcpb.DownloadApiSpecContentsRequest, opts ...gax.CallOption) (rpcpb.Registry_DownloadApiSpecContentsClient, error) {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	var resp rpcpb.Registry_DownloadApiSpecContentsClient
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.DownloadApiSpecContents(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) CreateApiSpec(ctx context.Context, req *rpcpb.CreateApiSpecRequest, opts ...gax.CallOption) (*rpcpb.ApiSpec, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
 	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).ListApiDeployments[0:len((*c.CallOptions).ListApiDeployments):len((*c.CallOptions).ListApiDeployments)], opts...)
-	it := &ApiDeploymentIterator{}
-	req = proto.Clone(req).(*rpcpb.ListApiDeploymentsRequest)
-	it.InternalFetch = func(pageSize int, pageToken string) ([]*rpcpb.ApiDeployment, string, error) {
-		resp := &rpcpb.ListApiDeploymentsResponse{}
-		if pageToken != "" {
-			req.PageToken = pageToken
-		}
-		if pageSize > math.MaxInt32 {
-			req.PageSize = math.MaxInt32
-		} else if pageSize != 0 {
-			req.PageSize = int32(pageSize)
-		}
-		err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
-			var err error
-			resp, err = c.registryClient.ListApiDeployments(ctx, req, settings.GRPC...)
-			return err
-		}, opts...)
-		if err != nil {
-			return nil, "", err
-		}
+	opts = append((*c.CallOptions).CreateApiSpec[0:len((*c.CallOptions).CreateApiSpec):len((*c.CallOptions).CreateApiSpec)], opts...)
+	var resp *rpcpb.ApiSpec
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.CreateApiSpec(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
 
-		it.Response = resp
-		return resp.GetApiDeployments(), resp.GetNextPageToken(), nil
+func (c *registryGRPCClient) UpdateApiSpec(ctx context.Context, req *rpcpb.UpdateApiSpecRequest, opts ...gax.CallOption) (*rpcpb.ApiSpec, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
 	}
-	fetch := func(pageSize int, pageToken string) (string, error) {
-		items, nextPageToken, err := it.InternalFetch(pageSize, pageToken)
-		if err != nil {
-			return "", err
-		}
-		it.items = append(it.items, items...)
-		return nextPageToken, nil
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "api_spec.name", url.QueryEscape(req.GetApiSpec().GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).UpdateApiSpec[0:len((*c.CallOptions).UpdateApiSpec):len((*c.CallOptions).UpdateApiSpec)], opts...)
+	var resp *rpcpb.ApiSpec
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.UpdateApiSpec(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) DeleteApiSpec(ctx context.Context, req *rpcpb.DeleteApiSpecRequest, opts ...gax.CallOption) error {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).DeleteApiSpec[0:len((*c.CallOptions).DeleteApiSpec):len((*c.CallOptions).DeleteApiSpec)], opts...)
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		_, err = c.registryClient.DeleteApiSpec(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	return err
+}
+
+func (c *registryGRPCClient) UndeleteApiSpec(ctx context.Context, req *rpcpb.UndeleteApiSpecRequest, opts ...gax.CallOption) (*rpcpb.ApiSpec, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).UndeleteApiSpec[0:len((*c.CallOptions).UndeleteApiSpec):len((*c.CallOptions).UndeleteApiSpec)], opts...)
+	var resp *rpcpb.ApiSpec
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.UndeleteApiSpec(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) BatchGetApiSpecs(ctx context.Context, req *rpcpb.BatchGetApiSpecsRequest, opts ...gax.CallOption) (*rpcpb.BatchGetApiSpecsResponse, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).BatchGetApiSpecs[0:len((*c.CallOptions).BatchGetApiSpecs):len((*c.CallOptions).BatchGetApiSpecs)], opts...)
+	var resp *rpcpb.BatchGetApiSpecsResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.BatchGetApiSpecs(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) BatchCreateApiSpecs(ctx context.Context, req *rpcpb.BatchCreateApiSpecsRequest, opts ...gax.CallOption) (*rpcpb.BatchCreateApiSpecsResponse, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).BatchCreateApiSpecs[0:len((*c.CallOptions).BatchCreateApiSpecs):len((*c.CallOptions).BatchCreateApiSpecs)], opts...)
+	var resp *rpcpb.BatchCreateApiSpecsResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.BatchCreateApiSpecs(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) BatchUpdateApiSpecs(ctx context.Context, req *rpcpb.BatchUpdateApiSpecsRequest, opts ...gax.CallOption) (*rpcpb.BatchUpdateApiSpecsResponse, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).BatchUpdateApiSpecs[0:len((*c.CallOptions).BatchUpdateApiSpecs):len((*c.CallOptions).BatchUpdateApiSpecs)], opts...)
+	var resp *rpcpb.BatchUpdateApiSpecsResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.BatchUpdateApiSpecs(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) BatchDeleteApiSpecs(ctx context.Context, req *rpcpb.BatchDeleteApiSpecsRequest, opts ...gax.CallOption) (*rpcpb.BatchDeleteApiSpecsResponse, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).BatchDeleteApiSpecs[0:len((*c.CallOptions).BatchDeleteApiSpecs):len((*c.CallOptions).BatchDeleteApiSpecs)], opts...)
+	var resp *rpcpb.BatchDeleteApiSpecsResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.BatchDeleteApiSpecs(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) TagApiSpecRevision(ctx context.Context, req *rpcpb.TagApiSpecRevisionRequest, opts ...gax.CallOption) (*rpcpb.ApiSpec, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).TagApiSpecRevision[0:len((*c.CallOptions).TagApiSpecRevision):len((*c.CallOptions).TagApiSpecRevision)], opts...)
+	var resp *rpcpb.ApiSpec
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.TagApiSpecRevision(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) ListApiSpecRevisions(ctx context.Context, req *rpcpb.ListApiSpecRevisionsRequest, opts ...gax.CallOption) *ApiSpecIterator {
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).ListApiSpecRevisions[0:len((*c.CallOptions).ListApiSpecRevisions):len((*c.CallOptions).ListApiSpecRevisions)], opts...)
+	it := &ApiSpecIterator{}
+	req = proto.Clone(req).(*rpcpb.ListApiSpecRevisionsRequest)
+	it.InternalFetch = func(pageSize int, pageToken string) ([]*rpcpb.ApiSpec, string, error) {
+		resp := &rpcpb.ListApiSpecRevisionsResponse{}
+		if pageToken != "" {
+			req.PageToken = pageToken
+		}
+		if pageSize > math.MaxInt32 {
+			req.PageSize = math.MaxInt32
+		} else if pageSize != 0 {
+			req.PageSize = int32(pageSize)
+		}
+		err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+			var err error
+			resp, err = c.registryClient.ListApiSpecRevisions(ctx, req, settings.GRPC...)
+			return err
+		}, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+
+		it.Response = resp
+		return resp.GetApiSpecs(), resp.GetNextPageToken(), nil
+	}
+	fetch := func(pageSize int, pageToken string) (string, error) {
+		items, nextPageToken, err := it.InternalFetch(pageSize, pageToken)
+		if err != nil {
+			return "", err
+		}
+		it.items = append(it.items, items...)
+		return nextPageToken, nil
+	}
+
+	it.pageInfo, it.nextFunc = iterator.NewPageInfo(fetch, it.bufLen, it.takeBuf)
+	it.pageInfo.MaxSize = int(req.GetPageSize())
+	it.pageInfo.Token = req.GetPageToken()
+
+	return it
+}
+
+func (c *registryGRPCClient) RollbackApiSpec(ctx context.Context, req *rpcpb.RollbackApiSpecRequest, opts ...gax.CallOption) (*rpcpb.ApiSpec, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).RollbackApiSpec[0:len((*c.CallOptions).RollbackApiSpec):len((*c.CallOptions).RollbackApiSpec)], opts...)
+	var resp *rpcpb.ApiSpec
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.RollbackApiSpec(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) DeleteApiSpecRevision(ctx context.Context, req *rpcpb.DeleteApiSpecRevisionRequest, opts ...gax.CallOption) (*rpcpb.ApiSpec, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).DeleteApiSpecRevision[0:len((*c.CallOptions).DeleteApiSpecRevision):len((*c.CallOptions).DeleteApiSpecRevision)], opts...)
+	var resp *rpcpb.ApiSpec
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.DeleteApiSpecRevision(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) ListApiDeployments(ctx context.Context, req *rpcpb.ListApiDeploymentsRequest, opts ...gax.CallOption) *ApiDeploymentIterator {
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).ListApiDeployments[0:len((*c.CallOptions).ListApiDeployments):len((*c.CallOptions).ListApiDeployments)], opts...)
+	it := &ApiDeploymentIterator{}
+	req = proto.Clone(req).(*rpcpb.ListApiDeploymentsRequest)
+	it.InternalFetch = func(pageSize int, pageToken string) ([]*rpcpb.ApiDeployment, string, error) {
+		resp := &rpcpb.ListApiDeploymentsResponse{}
+		if pageToken != "" {
+			req.PageToken = pageToken
+		}
+		if pageSize > math.MaxInt32 {
+			req.PageSize = math.MaxInt32
+		} else if pageSize != 0 {
+			req.PageSize = int32(pageSize)
+		}
+		err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+			var err error
+			resp, err = c.registryClient.ListApiDeployments(ctx, req, settings.GRPC...)
+			return err
+		}, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+
+		it.Response = resp
+		return resp.GetApiDeployments(), resp.GetNextPageToken(), nil
+	}
+	fetch := func(pageSize int, pageToken string) (string, error) {
+		items, nextPageToken, err := it.InternalFetch(pageSize, pageToken)
+		if err != nil {
+			return "", err
+		}
+		it.items = append(it.items, items...)
+		return nextPageToken, nil
+	}
+
+	it.pageInfo, it.nextFunc = iterator.NewPageInfo(fetch, it.bufLen, it.takeBuf)
+	it.pageInfo.MaxSize = int(req.GetPageSize())
+	it.pageInfo.Token = req.GetPageToken()
+
+	return it
+}
+
+func (c *registryGRPCClient) GetApiDeployment(ctx context.Context, req *rpcpb.GetApiDeploymentRequest, opts ...gax.CallOption) (*rpcpb.ApiDeployment, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).GetApiDeployment[0:len((*c.CallOptions).GetApiDeployment):len((*c.CallOptions).GetApiDeployment)], opts...)
+	var resp *rpcpb.ApiDeployment
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.GetApiDeployment(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) CreateApiDeployment(ctx context.Context, req *rpcpb.CreateApiDeploymentRequest, opts ...gax.CallOption) (*rpcpb.ApiDeployment, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).CreateApiDeployment[0:len((*c.CallOptions).CreateApiDeployment):len((*c.CallOptions).CreateApiDeployment)], opts...)
+	var resp *rpcpb.ApiDeployment
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.CreateApiDeployment(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) UpdateApiDeployment(ctx context.Context, req *rpcpb.UpdateApiDeploymentRequest, opts ...gax.CallOption) (*rpcpb.ApiDeployment, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "api_deployment.name", url.QueryEscape(req.GetApiDeployment().GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).UpdateApiDeployment[0:len((*c.CallOptions).UpdateApiDeployment):len((*c.CallOptions).UpdateApiDeployment)], opts...)
+	var resp *rpcpb.ApiDeployment
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.UpdateApiDeployment(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) DeleteApiDeployment(ctx context.Context, req *rpcpb.DeleteApiDeploymentRequest, opts ...gax.CallOption) error {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).DeleteApiDeployment[0:len((*c.CallOptions).DeleteApiDeployment):len((*c.CallOptions).DeleteApiDeployment)], opts...)
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		_, err = c.registryClient.DeleteApiDeployment(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	return err
+}
+
+func (c *registryGRPCClient) UndeleteApiDeployment(ctx context.Context, req *rpcpb.UndeleteApiDeploymentRequest, opts ...gax.CallOption) (*rpcpb.ApiDeployment, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).UndeleteApiDeployment[0:len((*c.CallOptions).UndeleteApiDeployment):len((*c.CallOptions).UndeleteApiDeployment)], opts...)
+	var resp *rpcpb.ApiDeployment
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.UndeleteApiDeployment(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) TagApiDeploymentRevision(ctx context.Context, req *rpcpb.TagApiDeploymentRevisionRequest, opts ...gax.CallOption) (*rpcpb.ApiDeployment, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).TagApiDeploymentRevision[0:len((*c.CallOptions).TagApiDeploymentRevision):len((*c.CallOptions).TagApiDeploymentRevision)], opts...)
+	var resp *rpcpb.ApiDeployment
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.TagApiDeploymentRevision(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) ListApiDeploymentRevisions(ctx context.Context, req *rpcpb.ListApiDeploymentRevisionsRequest, opts ...gax.CallOption) *ApiDeploymentIterator {
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).ListApiDeploymentRevisions[0:len((*c.CallOptions).ListApiDeploymentRevisions):len((*c.CallOptions).ListApiDeploymentRevisions)], opts...)
+	it := &ApiDeploymentIterator{}
+	req = proto.Clone(req).(*rpcpb.ListApiDeploymentRevisionsRequest)
+	it.InternalFetch = func(pageSize int, pageToken string) ([]*rpcpb.ApiDeployment, string, error) {
+		resp := &rpcpb.ListApiDeploymentRevisionsResponse{}
+		if pageToken != "" {
+			req.PageToken = pageToken
+		}
+		if pageSize > math.MaxInt32 {
+			req.PageSize = math.MaxInt32
+		} else if pageSize != 0 {
+			req.PageSize = int32(pageSize)
+		}
+		err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+			var err error
+			resp, err = c.registryClient.ListApiDeploymentRevisions(ctx, req, settings.GRPC...)
+			return err
+		}, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+
+		it.Response = resp
+		return resp.GetApiDeployments(), resp.GetNextPageToken(), nil
+	}
+	fetch := func(pageSize int, pageToken string) (string, error) {
+		items, nextPageToken, err := it.InternalFetch(pageSize, pageToken)
+		if err != nil {
+			return "", err
+		}
+		it.items = append(it.items, items...)
+		return nextPageToken, nil
+	}
+
+	it.pageInfo, it.nextFunc = iterator.NewPageInfo(fetch, it.bufLen, it.takeBuf)
+	it.pageInfo.MaxSize = int(req.GetPageSize())
+	it.pageInfo.Token = req.GetPageToken()
+
+	return it
+}
+
+func (c *registryGRPCClient) RollbackApiDeployment(ctx context.Context, req *rpcpb.RollbackApiDeploymentRequest, opts ...gax.CallOption) (*rpcpb.ApiDeployment, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).RollbackApiDeployment[0:len((*c.CallOptions).RollbackApiDeployment):len((*c.CallOptions).RollbackApiDeployment)], opts...)
+	var resp *rpcpb.ApiDeployment
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.RollbackApiDeployment(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) DeleteApiDeploymentRevision(ctx context.Context, req *rpcpb.DeleteApiDeploymentRevisionRequest, opts ...gax.CallOption) (*rpcpb.ApiDeployment, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).DeleteApiDeploymentRevision[0:len((*c.CallOptions).DeleteApiDeploymentRevision):len((*c.CallOptions).DeleteApiDeploymentRevision)], opts...)
+	var resp *rpcpb.ApiDeployment
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.DeleteApiDeploymentRevision(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) ListArtifacts(ctx context.Context, req *rpcpb.ListArtifactsRequest, opts ...gax.CallOption) *ArtifactIterator {
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).ListArtifacts[0:len((*c.CallOptions).ListArtifacts):len((*c.CallOptions).ListArtifacts)], opts...)
+	it := &ArtifactIterator{}
+	req = proto.Clone(req).(*rpcpb.ListArtifactsRequest)
+	it.InternalFetch = func(pageSize int, pageToken string) ([]*rpcpb.Artifact, string, error) {
+		resp := &rpcpb.ListArtifactsResponse{}
+		if pageToken != "" {
+			req.PageToken = pageToken
+		}
+		if pageSize > math.MaxInt32 {
+			req.PageSize = math.MaxInt32
+		} else if pageSize != 0 {
+			req.PageSize = int32(pageSize)
+		}
+		err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+			var err error
+			resp, err = c.registryClient.ListArtifacts(ctx, req, settings.GRPC...)
+			return err
+		}, opts...)
+		if err != nil {
+			return nil, "", err
+		}
+
+		it.Response = resp
+		return resp.GetArtifacts(), resp.GetNextPageToken(), nil
+	}
+	fetch := func(pageSize int, pageToken string) (string, error) {
+		items, nextPageToken, err := it.InternalFetch(pageSize, pageToken)
+		if err != nil {
+			return "", err
+		}
+		it.items = append(it.items, items...)
+		return nextPageToken, nil
+	}
+
+	it.pageInfo, it.nextFunc = iterator.NewPageInfo(fetch, it.bufLen, it.takeBuf)
+	it.pageInfo.MaxSize = int(req.GetPageSize())
+	it.pageInfo.Token = req.GetPageToken()
+
+	return it
+}
+
+func (c *registryGRPCClient) GetArtifact(ctx context.Context, req *rpcpb.GetArtifactRequest, opts ...gax.CallOption) (*rpcpb.Artifact, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).GetArtifact[0:len((*c.CallOptions).GetArtifact):len((*c.CallOptions).GetArtifact)], opts...)
+	var resp *rpcpb.Artifact
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.GetArtifact(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) GetArtifactContents(ctx context.Context, req *rpcpb.GetArtifactContentsRequest, opts ...gax.CallOption) (*httpbodypb.HttpBody, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).GetArtifactContents[0:len((*c.CallOptions).GetArtifactContents):len((*c.CallOptions).GetArtifactContents)], opts...)
+	var resp *httpbodypb.HttpBody
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.GetArtifactContents(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) UploadArtifactContents(ctx context.Context, opts ...gax.CallOption) (rpcpb.Registry_UploadArtifactContentsClient, error) {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	var resp rpcpb.Registry_UploadArtifactContentsClient
+	opts = append((*c.CallOptions).UploadArtifactContents[0:len((*c.CallOptions).UploadArtifactContents):len((*c.CallOptions).UploadArtifactContents)], opts...)
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.UploadArtifactContents(ctx, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) DownloadArtifactContents(ctx context.Context, req *rpcpb.DownloadArtifactContentsRequest, opts ...gax.CallOption) (rpcpb.Registry_DownloadArtifactContentsClient, error) {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	var resp rpcpb.Registry_DownloadArtifactContentsClient
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.DownloadArtifactContents(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) CreateArtifact(ctx context.Context, req *rpcpb.CreateArtifactRequest, opts ...gax.CallOption) (*rpcpb.Artifact, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).CreateArtifact[0:len((*c.CallOptions).CreateArtifact):len((*c.CallOptions).CreateArtifact)], opts...)
+	var resp *rpcpb.Artifact
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.CreateArtifact(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *registryGRPCClient) ReplaceArtifact(ctx context.Context, req *rpcpb.ReplaceArtifactRequest, opts ...gax.CallOption) (*rpcpb.Artifact, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "artifact.name", url.QueryEscape(req.GetArtifact().GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).ReplaceArtifact[0:len((*c.CallOptions).ReplaceArtifact):len((*c.CallOptions).ReplaceArtifact)], opts...)
+	var resp *rpcpb.Artifact
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.ReplaceArtifact(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
 	}
-
-	it.pageInfo, it.nextFunc = iterator.NewPageInfo(fetch, it.bufLen, it.takeBuf)
-	it.pageInfo.MaxSize = int(req.GetPageSize())
-	it.pageInfo.Token = req.GetPageToken()
-
-	return it
+	return resp, nil
 }
 
-func (c *registryGRPCClient) GetApiDeployment(ctx context.Context, req *rpcpb.GetApiDeploymentRequest, opts ...gax.CallOption) (*rpcpb.ApiDeployment, error) {
+func (c *registryGRPCClient) UpdateArtifact(ctx context.Context, req *rpcpb.UpdateArtifactRequest, opts ...gax.CallOption) (*rpcpb.Artifact, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
 		defer cancel()
 		ctx = cctx
 	}
-	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "artifact.name", url.QueryEscape(req.GetArtifact().GetName())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).GetApiDeployment[0:len((*c.CallOptions).GetApiDeployment):len((*c.CallOptions).GetApiDeployment)], opts...)
-	var resp *rpcpb.ApiDeployment
+	opts = append((*c.CallOptions).UpdateArtifact[0:len((*c.CallOptions).UpdateArtifact):len((*c.CallOptions).UpdateArtifact)], opts...)
+	var resp *rpcpb.Artifact
 	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
 		var err error
-		resp, err = c.registryClient.GetApiDeployment(ctx, req, settings.GRPC...)
+		resp, err = c.registryClient.UpdateArtifact(ctx, req, settings.GRPC...)
 		return err
 	}, opts...)
 	if err != nil {
@@ -1462,19 +2873,36 @@ func (c *registryGRPCClient) GetApiDeployment(ctx context.Context, req *rpcpb.Ge
 	return resp, nil
 }
 
-func (c *registryGRPCClient) CreateApiDeployment(ctx context.Context, req *rpcpb.CreateApiDeploymentRequest, opts ...gax.CallOption) (*rpcpb.ApiDeployment, error) {
+func (c *registryGRPCClient) DeleteArtifact(ctx context.Context, req *rpcpb.DeleteArtifactRequest, opts ...gax.CallOption) error {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
 		defer cancel()
 		ctx = cctx
 	}
-	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).CreateApiDeployment[0:len((*c.CallOptions).CreateApiDeployment):len((*c.CallOptions).CreateApiDeployment)], opts...)
-	var resp *rpcpb.ApiDeployment
+	opts = append((*c.CallOptions).DeleteArtifact[0:len((*c.CallOptions).DeleteArtifact):len((*c.CallOptions).DeleteArtifact)], opts...)
 	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
 		var err error
-		resp, err = c.registryClient.CreateApiDeployment(ctx, req, settings.GRPC...)
+		_, err = c.registryClient.DeleteArtifact(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	return err
+}
+
+func (c *registryGRPCClient) UndeleteArtifact(ctx context.Context, req *rpcpb.UndeleteArtifactRequest, opts ...gax.CallOption) (*rpcpb.Artifact, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
+	}
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).UndeleteArtifact[0:len((*c.CallOptions).UndeleteArtifact):len((*c.CallOptions).UndeleteArtifact)], opts...)
+	var resp *rpcpb.Artifact
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.UndeleteArtifact(ctx, req, settings.GRPC...)
 		return err
 	}, opts...)
 	if err != nil {
@@ -1483,19 +2911,19 @@ func (c *registryGRPCClient) CreateApiDeployment(ctx context.Context, req *rpcpb
 	return resp, nil
 }
 
-func (c *registryGRPCClient) UpdateApiDeployment(ctx context.Context, req *rpcpb.UpdateApiDeploymentRequest, opts ...gax.CallOption) (*rpcpb.ApiDeployment, error) {
+func (c *registryGRPCClient) BatchGetArtifacts(ctx context.Context, req *rpcpb.BatchGetArtifactsRequest, opts ...gax.CallOption) (*rpcpb.BatchGetArtifactsResponse, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
 		defer cancel()
 		ctx = cctx
 	}
-	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "api_deployment.name", url.QueryEscape(req.GetApiDeployment().GetName())))
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).UpdateApiDeployment[0:len((*c.CallOptions).UpdateApiDeployment):len((*c.CallOptions).UpdateApiDeployment)], opts...)
-	var resp *rpcpb.ApiDeployment
+	opts = append((*c.CallOptions).BatchGetArtifacts[0:len((*c.CallOptions).BatchGetArtifacts):len((*c.CallOptions).BatchGetArtifacts)], opts...)
+	var resp *rpcpb.BatchGetArtifactsResponse
 	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
 		var err error
-		resp, err = c.registryClient.UpdateApiDeployment(ctx, req, settings.GRPC...)
+		resp, err = c.registryClient.BatchGetArtifacts(ctx, req, settings.GRPC...)
 		return err
 	}, opts...)
 	if err != nil {
@@ -1504,36 +2932,40 @@ func (c *registryGRPCClient) UpdateApiDeployment(ctx context.Context, req *rpcpb
 	return resp, nil
 }
 
-func (c *registryGRPCClient) DeleteApiDeployment(ctx context.Context, req *rpcpb.DeleteApiDeploymentRequest, opts ...gax.CallOption) error {
+func (c *registryGRPCClient) BatchCreateArtifacts(ctx context.Context, req *rpcpb.BatchCreateArtifactsRequest, opts ...gax.CallOption) (*rpcpb.BatchCreateArtifactsResponse, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
 		defer cancel()
 		ctx = cctx
 	}
-	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).DeleteApiDeployment[0:len((*c.CallOptions).DeleteApiDeployment):len((*c.CallOptions).DeleteApiDeployment)], opts...)
+	opts = append((*c.CallOptions).BatchCreateArtifacts[0:len((*c.CallOptions).BatchCreateArtifacts):len((*c.CallOptions).BatchCreateArtifacts)], opts...)
+	var resp *rpcpb.BatchCreateArtifactsResponse
 	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
 		var err error
-		_, err = c.registryClient.DeleteApiDeployment(ctx, req, settings.GRPC...)
+		resp, err = c.registryClient.BatchCreateArtifacts(ctx, req, settings.GRPC...)
 		return err
 	}, opts...)
-	return err
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
 }
 
-func (c *registryGRPCClient) TagApiDeploymentRevision(ctx context.Context, req *rpcpb.TagApiDeploymentRevisionRequest, opts ...gax.CallOption) (*rpcpb.ApiDeployment, error) {
+func (c *registryGRPCClient) BatchReplaceArtifacts(ctx context.Context, req *rpcpb.BatchReplaceArtifactsRequest, opts ...gax.CallOption) (*rpcpb.BatchReplaceArtifactsResponse, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
 		defer cancel()
 		ctx = cctx
 	}
-	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).TagApiDeploymentRevision[0:len((*c.CallOptions).TagApiDeploymentRevision):len((*c.CallOptions).TagApiDeploymentRevision)], opts...)
-	var resp *rpcpb.ApiDeployment
+	opts = append((*c.CallOptions).BatchReplaceArtifacts[0:len((*c.CallOptions).BatchReplaceArtifacts):len((*c.CallOptions).BatchReplaceArtifacts)], opts...)
+	var resp *rpcpb.BatchReplaceArtifactsResponse
 	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
 		var err error
-		resp, err = c.registryClient.TagApiDeploymentRevision(ctx, req, settings.GRPC...)
+		resp, err = c.registryClient.BatchReplaceArtifacts(ctx, req, settings.GRPC...)
 		return err
 	}, opts...)
 	if err != nil {
@@ -1542,63 +2974,40 @@ func (c *registryGRPCClient) TagApiDeploymentRevision(ctx context.Context, req *
 	return resp, nil
 }
 
-func (c *registryGRPCClient) ListApiDeploymentRevisions(ctx context.Context, req *rpcpb.ListApiDeploymentRevisionsRequest, opts ...gax.CallOption) *ApiDeploymentIterator {
-	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
-	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).ListApiDeploymentRevisions[0:len((*c.CallOptions).ListApiDeploymentRevisions):len((*c.CallOptions).ListApiDeploymentRevisions)], opts...)
-	it := &ApiDeploymentIterator{}
-	req = proto.Clone(req).(*rpcpb.ListApiDeploymentRevisionsRequest)
-	it.InternalFetch = func(pageSize int, pageToken string) ([]*rpcpb.ApiDeployment, string, error) {
-		resp := &rpcpb.ListApiDeploymentRevisionsResponse{}
-		if pageToken != "" {
-			req.PageToken = pageToken
-		}
-		if pageSize > math.MaxInt32 {
-			req.PageSize = math.MaxInt32
-		} else if pageSize != 0 {
-			req.PageSize = int32(pageSize)
-		}
-		err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
-			var err error
-			resp, err = c.registryClient.ListApiDeploymentRevisions(ctx, req, settings.GRPC...)
-			return err
-		}, opts...)
-		if err != nil {
-			return nil, "", err
-		}
-
-		it.Response = resp
-		return resp.GetApiDeployments(), resp.GetNextPageToken(), nil
+func (c *registryGRPCClient) BatchUpdateArtifacts(ctx context.Context, req *rpcpb.BatchUpdateArtifactsRequest, opts ...gax.CallOption) (*rpcpb.BatchUpdateArtifactsResponse, error) {
+	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
+		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
+		defer cancel()
+		ctx = cctx
 	}
-	fetch := func(pageSize int, pageToken string) (string, error) {
-		items, nextPageToken, err := it.InternalFetch(pageSize, pageToken)
-		if err != nil {
-			return "", err
-		}
-		it.items = append(it.items, items...)
-		return nextPageToken, nil
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
+	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
+	opts = append((*c.CallOptions).BatchUpdateArtifacts[0:len((*c.CallOptions).BatchUpdateArtifacts):len((*c.CallOptions).BatchUpdateArtifacts)], opts...)
+	var resp *rpcpb.BatchUpdateArtifactsResponse
+	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+		var err error
+		resp, err = c.registryClient.BatchUpdateArtifacts(ctx, req, settings.GRPC...)
+		return err
+	}, opts...)
+	if err != nil {
+		return nil, err
 	}
-
-	it.pageInfo, it.nextFunc = iterator.NewPageInfo(fetch, it.bufLen, it.takeBuf)
-	it.pageInfo.MaxSize = int(req.GetPageSize())
-	it.pageInfo.Token = req.GetPageToken()
-
-	return it
+	return resp, nil
 }
 
-func (c *registryGRPCClient) RollbackApiDeployment(ctx context.Context, req *rpcpb.RollbackApiDeploymentRequest, opts ...gax.CallOption) (*rpcpb.ApiDeployment, error) {
+func (c *registryGRPCClient) BatchDeleteArtifacts(ctx context.Context, req *rpcpb.BatchDeleteArtifactsRequest, opts ...gax.CallOption) (*rpcpb.BatchDeleteArtifactsResponse, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
 		defer cancel()
 		ctx = cctx
 	}
-	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).RollbackApiDeployment[0:len((*c.CallOptions).RollbackApiDeployment):len((*c.CallOptions).RollbackApiDeployment)], opts...)
-	var resp *rpcpb.ApiDeployment
+	opts = append((*c.CallOptions).BatchDeleteArtifacts[0:len((*c.CallOptions).BatchDeleteArtifacts):len((*c.CallOptions).BatchDeleteArtifacts)], opts...)
+	var resp *rpcpb.BatchDeleteArtifactsResponse
 	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
 		var err error
-		resp, err = c.registryClient.RollbackApiDeployment(ctx, req, settings.GRPC...)
+		resp, err = c.registryClient.BatchDeleteArtifacts(ctx, req, settings.GRPC...)
 		return err
 	}, opts...)
 	if err != nil {
@@ -1607,7 +3016,7 @@ func (c *registryGRPCClient) RollbackApiDeployment(ctx context.Context, req *rpc
 	return resp, nil
 }
 
-func (c *registryGRPCClient) DeleteApiDeploymentRevision(ctx context.Context, req *rpcpb.DeleteApiDeploymentRevisionRequest, opts ...gax.CallOption) (*rpcpb.ApiDeployment, error) {
+func (c *registryGRPCClient) TagArtifactRevision(ctx context.Context, req *rpcpb.TagArtifactRevisionRequest, opts ...gax.CallOption) (*rpcpb.Artifact, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
 		defer cancel()
@@ -1615,11 +3024,11 @@ func (c *registryGRPCClient) DeleteApiDeploymentRevision(ctx context.Context, re
 	}
 	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).DeleteApiDeploymentRevision[0:len((*c.CallOptions).DeleteApiDeploymentRevision):len((*c.CallOptions).DeleteApiDeploymentRevision)], opts...)
-	var resp *rpcpb.ApiDeployment
+	opts = append((*c.CallOptions).TagArtifactRevision[0:len((*c.CallOptions).TagArtifactRevision):len((*c.CallOptions).TagArtifactRevision)], opts...)
+	var resp *rpcpb.Artifact
 	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
 		var err error
-		resp, err = c.registryClient.DeleteApiDeploymentRevision(ctx, req, settings.GRPC...)
+		resp, err = c.registryClient.TagArtifactRevision(ctx, req, settings.GRPC...)
 		return err
 	}, opts...)
 	if err != nil {
@@ -1628,14 +3037,14 @@ func (c *registryGRPCClient) DeleteApiDeploymentRevision(ctx context.Context, re
 	return resp, nil
 }
 
-func (c *registryGRPCClient) ListArtifacts(ctx context.Context, req *rpcpb.ListArtifactsRequest, opts ...gax.CallOption) *ArtifactIterator {
-	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
+func (c *registryGRPCClient) ListArtifactRevisions(ctx context.Context, req *rpcpb.ListArtifactRevisionsRequest, opts ...gax.CallOption) *ArtifactIterator {
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).ListArtifacts[0:len((*c.CallOptions).ListArtifacts):len((*c.CallOptions).ListArtifacts)], opts...)
+	opts = append((*c.CallOptions).ListArtifactRevisions[0:len((*c.CallOptions).ListArtifactRevisions):len((*c.CallOptions).ListArtifactRevisions)], opts...)
 	it := &ArtifactIterator{}
-	req = proto.Clone(req).(*rpcpb.ListArtifactsRequest)
+	req = proto.Clone(req).(*rpcpb.ListArtifactRevisionsRequest)
 	it.InternalFetch = func(pageSize int, pageToken string) ([]*rpcpb.Artifact, string, error) {
-		resp := &rpcpb.ListArtifactsResponse{}
+		resp := &rpcpb.ListArtifactRevisionsResponse{}
 		if pageToken != "" {
 			req.PageToken = pageToken
 		}
@@ -1646,7 +3055,7 @@ func (c *registryGRPCClient) ListArtifacts(ctx context.Context, req *rpcpb.ListA
 		}
 		err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
 			var err error
-			resp, err = c.registryClient.ListArtifacts(ctx, req, settings.GRPC...)
+			resp, err = c.registryClient.ListArtifactRevisions(ctx, req, settings.GRPC...)
 			return err
 		}, opts...)
 		if err != nil {
@@ -1672,7 +3081,7 @@ func (c *registryGRPCClient) ListArtifacts(ctx context.Context, req *rpcpb.ListA
 	return it
 }
 
-func (c *registryGRPCClient) GetArtifact(ctx context.Context, req *rpcpb.GetArtifactRequest, opts ...gax.CallOption) (*rpcpb.Artifact, error) {
+func (c *registryGRPCClient) RollbackArtifact(ctx context.Context, req *rpcpb.RollbackArtifactRequest, opts ...gax.CallOption) (*rpcpb.Artifact, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
 		defer cancel()
@@ -1680,11 +3089,11 @@ func (c *registryGRPCClient) GetArtifact(ctx context.Context, req *rpcpb.GetArti
 	}
 	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).GetArtifact[0:len((*c.CallOptions).GetArtifact):len((*c.CallOptions).GetArtifact)], opts...)
+	opts = append((*c.CallOptions).RollbackArtifact[0:len((*c.CallOptions).RollbackArtifact):len((*c.CallOptions).RollbackArtifact)], opts...)
 	var resp *rpcpb.Artifact
 	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
 		var err error
-		resp, err = c.registryClient.GetArtifact(ctx, req, settings.GRPC...)
+		resp, err = c.registryClient.RollbackArtifact(ctx, req, settings.GRPC...)
 		return err
 	}, opts...)
 	if err != nil {
@@ -1693,7 +3102,7 @@ func (c *registryGRPCClient) GetArtifact(ctx context.Context, req *rpcpb.GetArti
 	return resp, nil
 }
 
-func (c *registryGRPCClient) GetArtifactContents(ctx context.Context, req *rpcpb.GetArtifactContentsRequest, opts ...gax.CallOption) (*httpbodypb.HttpBody, error) {
+func (c *registryGRPCClient) DeleteArtifactRevision(ctx context.Context, req *rpcpb.DeleteArtifactRevisionRequest, opts ...gax.CallOption) (*rpcpb.Artifact, error) {
 	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
 		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
 		defer cancel()
@@ -1701,11 +3110,11 @@ func (c *registryGRPCClient) GetArtifactContents(ctx context.Context, req *rpcpb
 	}
 	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).GetArtifactContents[0:len((*c.CallOptions).GetArtifactContents):len((*c.CallOptions).GetArtifactContents)], opts...)
-	var resp *httpbodypb.HttpBody
+	opts = append((*c.CallOptions).DeleteArtifactRevision[0:len((*c.CallOptions).DeleteArtifactRevision):len((*c.CallOptions).DeleteArtifactRevision)], opts...)
+	var resp *rpcpb.Artifact
 	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
 		var err error
-		resp, err = c.registryClient.GetArtifactContents(ctx, req, settings.GRPC...)
+		resp, err = c.registryClient.DeleteArtifactRevision(ctx, req, settings.GRPC...)
 		return err
 	}, opts...)
 	if err != nil {
@@ -1714,19 +3123,12 @@ func (c *registryGRPCClient) GetArtifactContents(ctx context.Context, req *rpcpb
 	return resp, nil
 }
 
-func (c *registryGRPCClient) CreateArtifact(ctx context.Context, req *rpcpb.CreateArtifactRequest, opts ...gax.CallOption) (*rpcpb.Artifact, error) {
-	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
-		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
-		defer cancel()
-		ctx = cctx
-	}
-	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
-	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).CreateArtifact[0:len((*c.CallOptions).CreateArtifact):len((*c.CallOptions).CreateArtifact)], opts...)
-	var resp *rpcpb.Artifact
+func (c *registryGRPCClient) WatchResources(ctx context.Context, req *rpcpb.WatchResourcesRequest, opts ...gax.CallOption) (rpcpb.Registry_WatchResourcesClient, error) {
+	ctx = insertMetadata(ctx, c.xGoogMetadata)
+	var resp rpcpb.Registry_WatchResourcesClient
 	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
 		var err error
-		resp, err = c.registryClient.CreateArtifact(ctx, req, settings.GRPC...)
+		resp, err = c.registryClient.WatchResources(ctx, req, settings.GRPC...)
 		return err
 	}, opts...)
 	if err != nil {
@@ -1735,42 +3137,48 @@ func (c *registryGRPCClient) CreateArtifact(ctx context.Context, req *rpcpb.Crea
 	return resp, nil
 }
 
-func (c *registryGRPCClient) ReplaceArtifact(ctx context.Context, req *rpcpb.ReplaceArtifactRequest, opts ...gax.CallOption) (*rpcpb.Artifact, error) {
-	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
-		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
-		defer cancel()
-		ctx = cctx
-	}
-	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "artifact.name", url.QueryEscape(req.GetArtifact().GetName())))
+func (c *registryGRPCClient) SearchResources(ctx context.Context, req *rpcpb.SearchResourcesRequest, opts ...gax.CallOption) *SearchResultIterator {
+	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "parent", url.QueryEscape(req.GetParent())))
 	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).ReplaceArtifact[0:len((*c.CallOptions).ReplaceArtifact):len((*c.CallOptions).ReplaceArtifact)], opts...)
-	var resp *rpcpb.Artifact
-	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
-		var err error
-		resp, err = c.registryClient.ReplaceArtifact(ctx, req, settings.GRPC...)
-		return err
-	}, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return resp, nil
-}
+	opts = append((*c.CallOptions).SearchResources[0:len((*c.CallOptions).SearchResources):len((*c.CallOptions).SearchResources)], opts...)
+	it := &SearchResultIterator{}
+	req = proto.Clone(req).(*rpcpb.SearchResourcesRequest)
+	it.InternalFetch = func(pageSize int, pageToken string) ([]*rpcpb.SearchResult, string, error) {
+		resp := &rpcpb.SearchResourcesResponse{}
+		if pageToken != "" {
+			req.PageToken = pageToken
+		}
+		if pageSize > math.MaxInt32 {
+			req.PageSize = math.MaxInt32
+		} else if pageSize != 0 {
+			req.PageSize = int32(pageSize)
+		}
+		err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
+			var err error
+			resp, err = c.registryClient.SearchResources(ctx, req, settings.GRPC...)
+			return err
+		}, opts...)
+		if err != nil {
+			return nil, "", err
+		}
 
-func (c *registryGRPCClient) DeleteArtifact(ctx context.Context, req *rpcpb.DeleteArtifactRequest, opts ...gax.CallOption) error {
-	if _, ok := ctx.Deadline(); !ok && !c.disableDeadlines {
-		cctx, cancel := context.WithTimeout(ctx, 20000 * time.Millisecond)
-		defer cancel()
-		ctx = cctx
+		it.Response = resp
+		return resp.GetResults(), resp.GetNextPageToken(), nil
 	}
-	md := metadata.Pairs("x-goog-request-params", fmt.Sprintf("%s=%v", "name", url.QueryEscape(req.GetName())))
-	ctx = insertMetadata(ctx, c.xGoogMetadata, md)
-	opts = append((*c.CallOptions).DeleteArtifact[0:len((*c.CallOptions).DeleteArtifact):len((*c.CallOptions).DeleteArtifact)], opts...)
-	err := gax.Invoke(ctx, func(ctx context.Context, settings gax.CallSettings) error {
-		var err error
-		_, err = c.registryClient.DeleteArtifact(ctx, req, settings.GRPC...)
-		return err
-	}, opts...)
-	return err
+	fetch := func(pageSize int, pageToken string) (string, error) {
+		items, nextPageToken, err := it.InternalFetch(pageSize, pageToken)
+		if err != nil {
+			return "", err
+		}
+		it.items = append(it.items, items...)
+		return nextPageToken, nil
+	}
+
+	it.pageInfo, it.nextFunc = iterator.NewPageInfo(fetch, it.bufLen, it.takeBuf)
+	it.pageInfo.MaxSize = int(req.GetPageSize())
+	it.pageInfo.Token = req.GetPageToken()
+
+	return it
 }
 
 // ApiDeploymentIterator manages a stream of *rpcpb.ApiDeployment.
@@ -2008,6 +3416,53 @@ func (it *ArtifactIterator) takeBuf() interface{} {
 	return b
 }
 
+// SearchResultIterator manages a stream of *rpcpb.SearchResult.
+type SearchResultIterator struct {
+	items    []*rpcpb.SearchResult
+	pageInfo *iterator.PageInfo
+	nextFunc func() error
+
+	// Response is the raw response for the current page.
+	// It must be cast to the RPC response type.
+	// Calling Next() or InternalFetch() updates this value.
+	Response interface{}
+
+	// InternalFetch is for use by the Google Cloud Libraries only.
+	// It is not part of the stable interface of this package.
+	//
+	// InternalFetch returns results from a single call to the underlying RPC.
+	// The number of results is no greater than pageSize.
+	// If there are no more results, nextPageToken is empty and err is nil.
+	InternalFetch func(pageSize int, pageToken string) (results []*rpcpb.SearchResult, nextPageToken string, err error)
+}
+
+// PageInfo supports pagination. See the google.golang.org/api/iterator package for details.
+func (it *SearchResultIterator) PageInfo() *iterator.PageInfo {
+	return it.pageInfo
+}
+
+// Next returns the next result. Its second return value is iterator.Done if there are no more
+// results. Once Next returns Done, all subsequent calls will return Done.
+func (it *SearchResultIterator) Next() (*rpcpb.SearchResult, error) {
+	var item *rpcpb.SearchResult
+	if err := it.nextFunc(); err != nil {
+		return item, err
+	}
+	item = it.items[0]
+	it.items = it.items[1:]
+	return item, nil
+}
+
+func (it *SearchResultIterator) bufLen() int {
+	return len(it.items)
+}
+
+func (it *SearchResultIterator) takeBuf() interface{} {
+	b := it.items
+	it.items = nil
+	return b
+}
+
 func (c *RegistryClient) GrpcClient() rpcpb.RegistryClient {
 	return c.internalClient.(*registryGRPCClient).registryClient
 }
@@ -140,6 +140,106 @@ func ExampleRegistryClient_DeleteApi() {
 	}
 }
 
+func ExampleRegistryClient_UndeleteApi() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.UndeleteApiRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#UndeleteApiRequest.
+	}
+	resp, err := c.UndeleteApi(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
+func ExampleRegistryClient_BatchGetApis() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.BatchGetApisRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#BatchGetApisRequest.
+	}
+	resp, err := c.BatchGetApis(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
+func ExampleRegistryClient_BatchCreateApis() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.BatchCreateApisRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#BatchCreateApisRequest.
+	}
+	resp, err := c.BatchCreateApis(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
+func ExampleRegistryClient_BatchUpdateApis() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.BatchUpdateApisRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#BatchUpdateApisRequest.
+	}
+	resp, err := c.BatchUpdateApis(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
+func ExampleRegistryClient_BatchDeleteApis() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.BatchDeleteApisRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#BatchDeleteApisRequest.
+	}
+	resp, err := c.BatchDeleteApis(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
 func ExampleRegistryClient_ListApiVersions() {
 	ctx := context.Background()
 	c, err := gapic.NewRegistryClient(ctx)
@@ -244,6 +344,106 @@ func ExampleRegistryClient_DeleteApiVersion() {
 	}
 }
 
+func ExampleRegistryClient_UndeleteApiVersion() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.UndeleteApiVersionRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#UndeleteApiVersionRequest.
+	}
+	resp, err := c.UndeleteApiVersion(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
+func ExampleRegistryClient_BatchGetApiVersions() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.BatchGetApiVersionsRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#BatchGetApiVersionsRequest.
+	}
+	resp, err := c.BatchGetApiVersions(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
+func ExampleRegistryClient_BatchCreateApiVersions() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.BatchCreateApiVersionsRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#BatchCreateApiVersionsRequest.
+	}
+	resp, err := c.BatchCreateApiVersions(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
+func ExampleRegistryClient_BatchUpdateApiVersions() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.BatchUpdateApiVersionsRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#BatchUpdateApiVersionsRequest.
+	}
+	resp, err := c.BatchUpdateApiVersions(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
+func ExampleRegistryClient_BatchDeleteApiVersions() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.BatchDeleteApiVersionsRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#BatchDeleteApiVersionsRequest.
+	}
+	resp, err := c.BatchDeleteApiVersions(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
 func ExampleRegistryClient_ListApiSpecs() {
 	ctx := context.Background()
 	c, err := gapic.NewRegistryClient(ctx)
@@ -368,6 +568,106 @@ func ExampleRegistryClient_DeleteApiSpec() {
 	}
 }
 
+func ExampleRegistryClient_UndeleteApiSpec() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.UndeleteApiSpecRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#UndeleteApiSpecRequest.
+	}
+	resp, err := c.UndeleteApiSpec(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
+func ExampleRegistryClient_BatchGetApiSpecs() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.BatchGetApiSpecsRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#BatchGetApiSpecsRequest.
+	}
+	resp, err := c.BatchGetApiSpecs(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
+func ExampleRegistryClient_BatchCreateApiSpecs() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.BatchCreateApiSpecsRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#BatchCreateApiSpecsRequest.
+	}
+	resp, err := c.BatchCreateApiSpecs(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
+func ExampleRegistryClient_BatchUpdateApiSpecs() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.BatchUpdateApiSpecsRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#BatchUpdateApiSpecsRequest.
+	}
+	resp, err := c.BatchUpdateApiSpecs(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
+func ExampleRegistryClient_BatchDeleteApiSpecs() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.BatchDeleteApiSpecsRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#BatchDeleteApiSpecsRequest.
+	}
+	resp, err := c.BatchDeleteApiSpecs(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
 func ExampleRegistryClient_TagApiSpecRevision() {
 	ctx := context.Background()
 	c, err := gapic.NewRegistryClient(ctx)
@@ -558,6 +858,26 @@ func ExampleRegistryClient_DeleteApiDeployment() {
 	}
 }
 
+func ExampleRegistryClient_UndeleteApiDeployment() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.UndeleteApiDeploymentRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#UndeleteApiDeploymentRequest.
+	}
+	resp, err := c.UndeleteApiDeployment(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
 func ExampleRegistryClient_TagApiDeploymentRevision() {
 	ctx := context.Background()
 	c, err := gapic.NewRegistryClient(ctx)
@@ -750,6 +1070,26 @@ func ExampleRegistryClient_ReplaceArtifact() {
 	_ = resp
 }
 
+func ExampleRegistryClient_UpdateArtifact() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.UpdateArtifactRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#UpdateArtifactRequest.
+	}
+	resp, err := c.UpdateArtifact(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
 func ExampleRegistryClient_DeleteArtifact() {
 	ctx := context.Background()
 	c, err := gapic.NewRegistryClient(ctx)
@@ -767,3 +1107,235 @@ func ExampleRegistryClient_DeleteArtifact() {
 		// TODO: Handle error.
 	}
 }
+
+func ExampleRegistryClient_UndeleteArtifact() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.UndeleteArtifactRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#UndeleteArtifactRequest.
+	}
+	resp, err := c.UndeleteArtifact(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
+func ExampleRegistryClient_BatchGetArtifacts() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.BatchGetArtifactsRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#BatchGetArtifactsRequest.
+	}
+	resp, err := c.BatchGetArtifacts(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
+func ExampleRegistryClient_BatchCreateArtifacts() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.BatchCreateArtifactsRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#BatchCreateArtifactsRequest.
+	}
+	resp, err := c.BatchCreateArtifacts(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
+func ExampleRegistryClient_BatchReplaceArtifacts() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.BatchReplaceArtifactsRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#BatchReplaceArtifactsRequest.
+	}
+	resp, err := c.BatchReplaceArtifacts(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
+func ExampleRegistryClient_BatchUpdateArtifacts() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.BatchUpdateArtifactsRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#BatchUpdateArtifactsRequest.
+	}
+	resp, err := c.BatchUpdateArtifacts(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
+func ExampleRegistryClient_BatchDeleteArtifacts() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.BatchDeleteArtifactsRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#BatchDeleteArtifactsRequest.
+	}
+	resp, err := c.BatchDeleteArtifacts(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
+func ExampleRegistryClient_TagArtifactRevision() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.TagArtifactRevisionRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#TagArtifactRevisionRequest.
+	}
+	resp, err := c.TagArtifactRevision(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
+func ExampleRegistryClient_ListArtifactRevisions() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.ListArtifactRevisionsRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#ListArtifactRevisionsRequest.
+	}
+	it := c.ListArtifactRevisions(ctx, req)
+	for {
+		resp, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			// TODO: Handle error.
+		}
+		// TODO: Use resp.
+		_ = resp
+	}
+}
+
+func ExampleRegistryClient_RollbackArtifact() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.RollbackArtifactRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#RollbackArtifactRequest.
+	}
+	resp, err := c.RollbackArtifact(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
+func ExampleRegistryClient_DeleteArtifactRevision() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.DeleteArtifactRevisionRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#DeleteArtifactRevisionRequest.
+	}
+	resp, err := c.DeleteArtifactRevision(ctx, req)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	// TODO: Use resp.
+	_ = resp
+}
+
+func ExampleRegistryClient_SearchResources() {
+	ctx := context.Background()
+	c, err := gapic.NewRegistryClient(ctx)
+	if err != nil {
+		// TODO: Handle error.
+	}
+	defer c.Close()
+
+	req := &rpcpb.SearchResourcesRequest{
+		// TODO: Fill request struct fields.
+		// See https://pkg.go.dev/github.com/apigee/registry/rpc#SearchResourcesRequest.
+	}
+	it := c.SearchResources(ctx, req)
+	for {
+		resp, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			// TODO: Handle error.
+		}
+		// TODO: Use resp.
+		_ = resp
+	}
+}
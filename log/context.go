@@ -42,6 +42,35 @@ func NewOutboundContext(ctx context.Context, md Metadata) context.Context {
 	return metadata.AppendToOutgoingContext(ctx, uidKey, md.UID)
 }
 
+// subjectKey is an unexported type used to attach authenticated subjects as context values.
+type subjectKey struct{}
+
+// NewSubjectContext returns a new context identifying the authenticated subject of an incoming request.
+// Loggers created with WithInboundFields include the subject.
+func NewSubjectContext(ctx context.Context, subject string) context.Context {
+	return context.WithValue(ctx, subjectKey{}, subject)
+}
+
+// Subject returns the authenticated subject of an incoming request, or an empty string if it wasn't authenticated.
+func Subject(ctx context.Context) string {
+	subject, _ := ctx.Value(subjectKey{}).(string)
+	return subject
+}
+
+// requestIDKey is an unexported type used to attach request IDs as context values.
+type requestIDKey struct{}
+
+// NewRequestIDContext returns a new context holding the ID that identifies a request in call logs.
+func NewRequestIDContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the ID that identifies a request in call logs, or an empty string if it wasn't logged.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
 // loggerKey is an unexported type used to attach loggers as context values.
 type loggerKey struct{}
 
@@ -67,8 +96,13 @@ func FromContext(ctx context.Context, opts ...Option) Logger {
 	return logger
 }
 
-// WithInboundFields returns a new logger including fields from the incoming context's metadata.
+// WithInboundFields returns a new logger including fields from the incoming context's metadata
+// and the authenticated subject of the request.
 func WithInboundFields(ctx context.Context, logger Logger) Logger {
+	if subject := Subject(ctx); subject != "" {
+		logger = logger.WithFields(map[string]interface{}{"subject": subject})
+	}
+
 	if md, ok := metadata.FromIncomingContext(ctx); ok {
 		return withMetadataFields(logger, md)
 	}
@@ -52,6 +52,29 @@ func ExampleNewOutboundContext() {
 	})
 }
 
+func ExampleNewSubjectContext() {
+	var (
+		// Buffer to hold logs.
+		buf bytes.Buffer
+		// Logger that writes JSON entries to a buffer.
+		logger = log.NewLogger(log.JSONFormat(&buf))
+		// Struct to hold the parsed log entry.
+		entry struct {
+			Fields map[string]interface{} `json:"fields"`
+		}
+	)
+
+	// A server identifies the caller of a request before it is handled.
+	ctx := log.NewSubjectContext(context.Background(), "test_subject")
+	log.WithInboundFields(ctx, logger).Info("Print a server log with the subject")
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		panic(err)
+	}
+
+	// Output: Subject = test_subject
+	fmt.Printf("Subject = %v", entry.Fields["subject"])
+}
+
 // Converts outgoing gRPC metadata (if present) to incoming metadata before calling the handler.
 func grpcFakeCall(ctx context.Context, handler func(context.Context)) {
 	if md, ok := metadata.FromOutgoingContext(ctx); ok {
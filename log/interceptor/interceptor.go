@@ -23,6 +23,7 @@ import (
 
 	"github.com/apigee/registry/log"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -39,11 +40,17 @@ func CallLogger(opts ...log.Option) grpc.UnaryServerInterceptor {
 	// Each request will share this logger as a base template.
 	sharedLogger := log.NewLogger(opts...)
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		requestID := fmt.Sprintf("%.8s", uuid.New())
 		reqInfo := map[string]interface{}{
-			"request_id": fmt.Sprintf("%.8s", uuid.New()),
+			"request_id": requestID,
 			"method":     filepath.Base(info.FullMethod),
 		}
 
+		// Requests that are traced are logged with their trace IDs, so logs can be found from traces.
+		if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+			reqInfo["trace_id"] = sc.TraceID().String()
+		}
+
 		if r, ok := req.(resourceOperation); ok {
 			reqInfo["resource"] = r.GetName()
 		}
@@ -55,6 +62,7 @@ func CallLogger(opts ...log.Option) grpc.UnaryServerInterceptor {
 		// Bind request-scoped and inbound attributes to the context logger before handling the request.
 		logger := log.WithInboundFields(ctx, sharedLogger).WithFields(reqInfo)
 		ctx = log.NewContext(ctx, logger)
+		ctx = log.NewRequestIDContext(ctx, requestID)
 
 		logger.Info("Handling request.")
 		start := time.Now()
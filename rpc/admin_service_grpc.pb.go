@@ -40,7 +40,9 @@ type AdminClient interface {
 	// (-- api-linter: core::0131::http-uri-name=disabled
 	//     aip.dev/not-precedent: Not in the official API. --)
 	GetStorage(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Storage, error)
-	// MigrateDatabase attempts to migrate the database to the current schema.
+	// MigrateDatabase attempts to migrate the database to the current schema,
+	// or to an earlier schema version. Migrations run in the background,
+	// and their operations can be checked with the Operations service.
 	MigrateDatabase(ctx context.Context, in *MigrateDatabaseRequest, opts ...grpc.CallOption) (*longrunning.Operation, error)
 	// ListProjects returns matching projects.
 	// (-- api-linter: standard-methods=disabled --)
@@ -61,6 +63,43 @@ type AdminClient interface {
 	// DeleteProject removes a specified project and all of the resources that it
 	// owns.
 	DeleteProject(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UndeleteProject restores a deleted project and the resources that were
+	// deleted with it. Deleted projects can be restored until they are purged.
+	UndeleteProject(ctx context.Context, in *UndeleteProjectRequest, opts ...grpc.CallOption) (*Project, error)
+	// ListOutboxEvents returns notifications recorded in the outbox.
+	// By default, only notifications that haven't been delivered are listed.
+	// (-- api-linter: core::0132::method-signature=disabled
+	//     aip.dev/not-precedent: Outbox events have no parent. --)
+	// (-- api-linter: core::0132::request-parent-required=disabled
+	//     aip.dev/not-precedent: Outbox events have no parent. --)
+	ListOutboxEvents(ctx context.Context, in *ListOutboxEventsRequest, opts ...grpc.CallOption) (*ListOutboxEventsResponse, error)
+	// ReplayOutboxEvents schedules matching notifications for immediate delivery,
+	// including notifications that were abandoned after repeated failures.
+	// (-- api-linter: core::0136::http-uri-suffix=disabled
+	//     aip.dev/not-precedent: Outbox events have no parent. --)
+	ReplayOutboxEvents(ctx context.Context, in *ReplayOutboxEventsRequest, opts ...grpc.CallOption) (*ReplayOutboxEventsResponse, error)
+	// ListRoleBindings returns the roles that principals have been granted.
+	// (-- api-linter: core::0132::method-signature=disabled
+	//     aip.dev/not-precedent: Role bindings have no parent. --)
+	// (-- api-linter: core::0132::request-parent-required=disabled
+	//     aip.dev/not-precedent: Role bindings have no parent. --)
+	ListRoleBindings(ctx context.Context, in *ListRoleBindingsRequest, opts ...grpc.CallOption) (*ListRoleBindingsResponse, error)
+	// SetRoleBinding grants a role to a principal, replacing the role that the
+	// principal had been granted in the same project.
+	// (-- api-linter: core::0136::http-uri-suffix=disabled
+	//     aip.dev/not-precedent: Role bindings have no parent. --)
+	SetRoleBinding(ctx context.Context, in *SetRoleBindingRequest, opts ...grpc.CallOption) (*RoleBinding, error)
+	// DeleteRoleBinding removes the role that a principal has been granted.
+	// (-- api-linter: core::0135::http-uri-name=disabled
+	//     aip.dev/not-precedent: Role bindings have no names. --)
+	DeleteRoleBinding(ctx context.Context, in *DeleteRoleBindingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ListAuditEvents returns records of the calls that changed, or attempted to
+	// change, the registry.
+	// (-- api-linter: core::0132::method-signature=disabled
+	//     aip.dev/not-precedent: Audit events have no parent. --)
+	// (-- api-linter: core::0132::request-parent-required=disabled
+	//     aip.dev/not-precedent: Audit events have no parent. --)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
 }
 
 type adminClient struct {
@@ -143,6 +182,69 @@ func (c *adminClient) DeleteProject(ctx context.Context, in *DeleteProjectReques
 	return out, nil
 }
 
+func (c *adminClient) UndeleteProject(ctx context.Context, in *UndeleteProjectRequest, opts ...grpc.CallOption) (*Project, error) {
+	out := new(Project)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Admin/UndeleteProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListOutboxEvents(ctx context.Context, in *ListOutboxEventsRequest, opts ...grpc.CallOption) (*ListOutboxEventsResponse, error) {
+	out := new(ListOutboxEventsResponse)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Admin/ListOutboxEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ReplayOutboxEvents(ctx context.Context, in *ReplayOutboxEventsRequest, opts ...grpc.CallOption) (*ReplayOutboxEventsResponse, error) {
+	out := new(ReplayOutboxEventsResponse)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Admin/ReplayOutboxEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListRoleBindings(ctx context.Context, in *ListRoleBindingsRequest, opts ...grpc.CallOption) (*ListRoleBindingsResponse, error) {
+	out := new(ListRoleBindingsResponse)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Admin/ListRoleBindings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SetRoleBinding(ctx context.Context, in *SetRoleBindingRequest, opts ...grpc.CallOption) (*RoleBinding, error) {
+	out := new(RoleBinding)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Admin/SetRoleBinding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DeleteRoleBinding(ctx context.Context, in *DeleteRoleBindingRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Admin/DeleteRoleBinding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Admin/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServer is the server API for Admin service.
 // All implementations must embed UnimplementedAdminServer
 // for forward compatibility
@@ -163,7 +265,9 @@ type AdminServer interface {
 	// (-- api-linter: core::0131::http-uri-name=disabled
 	//     aip.dev/not-precedent: Not in the official API. --)
 	GetStorage(context.Context, *emptypb.Empty) (*Storage, error)
-	// MigrateDatabase attempts to migrate the database to the current schema.
+	// MigrateDatabase attempts to migrate the database to the current schema,
+	// or to an earlier schema version. Migrations run in the background,
+	// and their operations can be checked with the Operations service.
 	MigrateDatabase(context.Context, *MigrateDatabaseRequest) (*longrunning.Operation, error)
 	// ListProjects returns matching projects.
 	// (-- api-linter: standard-methods=disabled --)
@@ -184,6 +288,43 @@ type AdminServer interface {
 	// DeleteProject removes a specified project and all of the resources that it
 	// owns.
 	DeleteProject(context.Context, *DeleteProjectRequest) (*emptypb.Empty, error)
+	// UndeleteProject restores a deleted project and the resources that were
+	// deleted with it. Deleted projects can be restored until they are purged.
+	UndeleteProject(context.Context, *UndeleteProjectRequest) (*Project, error)
+	// ListOutboxEvents returns notifications recorded in the outbox.
+	// By default, only notifications that haven't been delivered are listed.
+	// (-- api-linter: core::0132::method-signature=disabled
+	//     aip.dev/not-precedent: Outbox events have no parent. --)
+	// (-- api-linter: core::0132::request-parent-required=disabled
+	//     aip.dev/not-precedent: Outbox events have no parent. --)
+	ListOutboxEvents(context.Context, *ListOutboxEventsRequest) (*ListOutboxEventsResponse, error)
+	// ReplayOutboxEvents schedules matching notifications for immediate delivery,
+	// including notifications that were abandoned after repeated failures.
+	// (-- api-linter: core::0136::http-uri-suffix=disabled
+	//     aip.dev/not-precedent: Outbox events have no parent. --)
+	ReplayOutboxEvents(context.Context, *ReplayOutboxEventsRequest) (*ReplayOutboxEventsResponse, error)
+	// ListRoleBindings returns the roles that principals have been granted.
+	// (-- api-linter: core::0132::method-signature=disabled
+	//     aip.dev/not-precedent: Role bindings have no parent. --)
+	// (-- api-linter: core::0132::request-parent-required=disabled
+	//     aip.dev/not-precedent: Role bindings have no parent. --)
+	ListRoleBindings(context.Context, *ListRoleBindingsRequest) (*ListRoleBindingsResponse, error)
+	// SetRoleBinding grants a role to a principal, replacing the role that the
+	// principal had been granted in the same project.
+	// (-- api-linter: core::0136::http-uri-suffix=disabled
+	//     aip.dev/not-precedent: Role bindings have no parent. --)
+	SetRoleBinding(context.Context, *SetRoleBindingRequest) (*RoleBinding, error)
+	// DeleteRoleBinding removes the role that a principal has been granted.
+	// (-- api-linter: core::0135::http-uri-name=disabled
+	//     aip.dev/not-precedent: Role bindings have no names. --)
+	DeleteRoleBinding(context.Context, *DeleteRoleBindingRequest) (*emptypb.Empty, error)
+	// ListAuditEvents returns records of the calls that changed, or attempted to
+	// change, the registry.
+	// (-- api-linter: core::0132::method-signature=disabled
+	//     aip.dev/not-precedent: Audit events have no parent. --)
+	// (-- api-linter: core::0132::request-parent-required=disabled
+	//     aip.dev/not-precedent: Audit events have no parent. --)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
 	mustEmbedUnimplementedAdminServer()
 }
 
@@ -215,6 +356,27 @@ func (UnimplementedAdminServer) UpdateProject(context.Context, *UpdateProjectReq
 func (UnimplementedAdminServer) DeleteProject(context.Context, *DeleteProjectRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteProject not implemented")
 }
+func (UnimplementedAdminServer) UndeleteProject(context.Context, *UndeleteProjectRequest) (*Project, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteProject not implemented")
+}
+func (UnimplementedAdminServer) ListOutboxEvents(context.Context, *ListOutboxEventsRequest) (*ListOutboxEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutboxEvents not implemented")
+}
+func (UnimplementedAdminServer) ReplayOutboxEvents(context.Context, *ReplayOutboxEventsRequest) (*ReplayOutboxEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayOutboxEvents not implemented")
+}
+func (UnimplementedAdminServer) ListRoleBindings(context.Context, *ListRoleBindingsRequest) (*ListRoleBindingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleBindings not implemented")
+}
+func (UnimplementedAdminServer) SetRoleBinding(context.Context, *SetRoleBindingRequest) (*RoleBinding, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRoleBinding not implemented")
+}
+func (UnimplementedAdminServer) DeleteRoleBinding(context.Context, *DeleteRoleBindingRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoleBinding not implemented")
+}
+func (UnimplementedAdminServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
 func (UnimplementedAdminServer) mustEmbedUnimplementedAdminServer() {}
 
 // UnsafeAdminServer may be embedded to opt out of forward compatibility for this service.
@@ -372,6 +534,132 @@ func _Admin_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Admin_UndeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).UndeleteProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Admin/UndeleteProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).UndeleteProject(ctx, req.(*UndeleteProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListOutboxEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutboxEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListOutboxEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Admin/ListOutboxEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListOutboxEvents(ctx, req.(*ListOutboxEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ReplayOutboxEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayOutboxEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ReplayOutboxEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Admin/ReplayOutboxEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ReplayOutboxEvents(ctx, req.(*ReplayOutboxEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListRoleBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoleBindingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListRoleBindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Admin/ListRoleBindings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListRoleBindings(ctx, req.(*ListRoleBindingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SetRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRoleBindingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SetRoleBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Admin/SetRoleBinding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SetRoleBinding(ctx, req.(*SetRoleBindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DeleteRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleBindingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DeleteRoleBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Admin/DeleteRoleBinding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DeleteRoleBinding(ctx, req.(*DeleteRoleBindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Admin/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Admin_ServiceDesc is the grpc.ServiceDesc for Admin service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -411,6 +699,34 @@ var Admin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProject",
 			Handler:    _Admin_DeleteProject_Handler,
 		},
+		{
+			MethodName: "UndeleteProject",
+			Handler:    _Admin_UndeleteProject_Handler,
+		},
+		{
+			MethodName: "ListOutboxEvents",
+			Handler:    _Admin_ListOutboxEvents_Handler,
+		},
+		{
+			MethodName: "ReplayOutboxEvents",
+			Handler:    _Admin_ReplayOutboxEvents_Handler,
+		},
+		{
+			MethodName: "ListRoleBindings",
+			Handler:    _Admin_ListRoleBindings_Handler,
+		},
+		{
+			MethodName: "SetRoleBinding",
+			Handler:    _Admin_SetRoleBinding_Handler,
+		},
+		{
+			MethodName: "DeleteRoleBinding",
+			Handler:    _Admin_DeleteRoleBinding_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Admin_ListAuditEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "google/cloud/apigeeregistry/v1/admin_service.proto",
@@ -35,6 +35,23 @@ type RegistryClient interface {
 	// DeleteApi removes a specified API and all of the resources that it
 	// owns.
 	DeleteApi(ctx context.Context, in *DeleteApiRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UndeleteApi restores a deleted API and the resources that were deleted with
+	// it.
+	UndeleteApi(ctx context.Context, in *UndeleteApiRequest, opts ...grpc.CallOption) (*Api, error)
+	// BatchGetApis returns multiple specified APIs.
+	BatchGetApis(ctx context.Context, in *BatchGetApisRequest, opts ...grpc.CallOption) (*BatchGetApisResponse, error)
+	// BatchCreateApis creates multiple APIs in a single transaction.
+	// Requests that fail are reported in the results without preventing the
+	// others from being applied.
+	BatchCreateApis(ctx context.Context, in *BatchCreateApisRequest, opts ...grpc.CallOption) (*BatchCreateApisResponse, error)
+	// BatchUpdateApis modifies multiple APIs in a single transaction.
+	// Requests that fail are reported in the results without preventing the
+	// others from being applied.
+	BatchUpdateApis(ctx context.Context, in *BatchUpdateApisRequest, opts ...grpc.CallOption) (*BatchUpdateApisResponse, error)
+	// BatchDeleteApis removes multiple APIs in a single transaction.
+	// Requests that fail are reported in the results without preventing the
+	// others from being applied.
+	BatchDeleteApis(ctx context.Context, in *BatchDeleteApisRequest, opts ...grpc.CallOption) (*BatchDeleteApisResponse, error)
 	// ListApiVersions returns matching versions.
 	ListApiVersions(ctx context.Context, in *ListApiVersionsRequest, opts ...grpc.CallOption) (*ListApiVersionsResponse, error)
 	// GetApiVersion returns a specified version.
@@ -46,6 +63,23 @@ type RegistryClient interface {
 	// DeleteApiVersion removes a specified version and all of the resources that
 	// it owns.
 	DeleteApiVersion(ctx context.Context, in *DeleteApiVersionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UndeleteApiVersion restores a deleted version and the resources that were
+	// deleted with it.
+	UndeleteApiVersion(ctx context.Context, in *UndeleteApiVersionRequest, opts ...grpc.CallOption) (*ApiVersion, error)
+	// BatchGetApiVersions returns multiple specified versions.
+	BatchGetApiVersions(ctx context.Context, in *BatchGetApiVersionsRequest, opts ...grpc.CallOption) (*BatchGetApiVersionsResponse, error)
+	// BatchCreateApiVersions creates multiple versions in a single transaction.
+	// Requests that fail are reported in the results without preventing the
+	// others from being applied.
+	BatchCreateApiVersions(ctx context.Context, in *BatchCreateApiVersionsRequest, opts ...grpc.CallOption) (*BatchCreateApiVersionsResponse, error)
+	// BatchUpdateApiVersions modifies multiple versions in a single transaction.
+	// Requests that fail are reported in the results without preventing the
+	// others from being applied.
+	BatchUpdateApiVersions(ctx context.Context, in *BatchUpdateApiVersionsRequest, opts ...grpc.CallOption) (*BatchUpdateApiVersionsResponse, error)
+	// BatchDeleteApiVersions removes multiple versions in a single transaction.
+	// Requests that fail are reported in the results without preventing the
+	// others from being applied.
+	BatchDeleteApiVersions(ctx context.Context, in *BatchDeleteApiVersionsRequest, opts ...grpc.CallOption) (*BatchDeleteApiVersionsResponse, error)
 	// ListApiSpecs returns matching specs.
 	ListApiSpecs(ctx context.Context, in *ListApiSpecsRequest, opts ...grpc.CallOption) (*ListApiSpecsResponse, error)
 	// GetApiSpec returns a specified spec.
@@ -55,6 +89,14 @@ type RegistryClient interface {
 	// is to return the spec uncompressed (the mime_type response field
 	// indicates the exact format returned).
 	GetApiSpecContents(ctx context.Context, in *GetApiSpecContentsRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// UploadApiSpecContents creates a spec or updates the contents of an
+	// existing spec with contents that are sent in a stream of chunks.
+	// It supports specs that are too large to send in a single message.
+	UploadApiSpecContents(ctx context.Context, opts ...grpc.CallOption) (Registry_UploadApiSpecContentsClient, error)
+	// DownloadApiSpecContents returns the contents of a specified spec in a
+	// stream of chunks. Contents are returned in the format returned by
+	// GetApiSpecContents.
+	DownloadApiSpecContents(ctx context.Context, in *DownloadApiSpecContentsRequest, opts ...grpc.CallOption) (Registry_DownloadApiSpecContentsClient, error)
 	// CreateApiSpec creates a specified spec.
 	CreateApiSpec(ctx context.Context, in *CreateApiSpecRequest, opts ...grpc.CallOption) (*ApiSpec, error)
 	// UpdateApiSpec can be used to modify a specified spec.
@@ -62,6 +104,23 @@ type RegistryClient interface {
 	// DeleteApiSpec removes a specified spec, all revisions, and all child
 	// resources (e.g. artifacts).
 	DeleteApiSpec(ctx context.Context, in *DeleteApiSpecRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UndeleteApiSpec restores a deleted spec and the resources that were deleted
+	// with it.
+	UndeleteApiSpec(ctx context.Context, in *UndeleteApiSpecRequest, opts ...grpc.CallOption) (*ApiSpec, error)
+	// BatchGetApiSpecs returns multiple specified specs.
+	BatchGetApiSpecs(ctx context.Context, in *BatchGetApiSpecsRequest, opts ...grpc.CallOption) (*BatchGetApiSpecsResponse, error)
+	// BatchCreateApiSpecs creates multiple specs in a single transaction.
+	// Requests that fail are reported in the results without preventing the
+	// others from being applied.
+	BatchCreateApiSpecs(ctx context.Context, in *BatchCreateApiSpecsRequest, opts ...grpc.CallOption) (*BatchCreateApiSpecsResponse, error)
+	// BatchUpdateApiSpecs modifies multiple specs in a single transaction.
+	// Requests that fail are reported in the results without preventing the
+	// others from being applied.
+	BatchUpdateApiSpecs(ctx context.Context, in *BatchUpdateApiSpecsRequest, opts ...grpc.CallOption) (*BatchUpdateApiSpecsResponse, error)
+	// BatchDeleteApiSpecs removes multiple specs in a single transaction.
+	// Requests that fail are reported in the results without preventing the
+	// others from being applied.
+	BatchDeleteApiSpecs(ctx context.Context, in *BatchDeleteApiSpecsRequest, opts ...grpc.CallOption) (*BatchDeleteApiSpecsResponse, error)
 	// TagApiSpecRevision adds a tag to a specified revision of a spec.
 	TagApiSpecRevision(ctx context.Context, in *TagApiSpecRevisionRequest, opts ...grpc.CallOption) (*ApiSpec, error)
 	// ListApiSpecRevisions lists all revisions of a spec.
@@ -83,6 +142,9 @@ type RegistryClient interface {
 	// DeleteApiDeployment removes a specified deployment, all revisions, and all
 	// child resources (e.g. artifacts).
 	DeleteApiDeployment(ctx context.Context, in *DeleteApiDeploymentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UndeleteApiDeployment restores a deleted deployment and the resources that
+	// were deleted with it.
+	UndeleteApiDeployment(ctx context.Context, in *UndeleteApiDeploymentRequest, opts ...grpc.CallOption) (*ApiDeployment, error)
 	// TagApiDeploymentRevision adds a tag to a specified revision of a
 	// deployment.
 	TagApiDeploymentRevision(ctx context.Context, in *TagApiDeploymentRevisionRequest, opts ...grpc.CallOption) (*ApiDeployment, error)
@@ -103,12 +165,58 @@ type RegistryClient interface {
 	// is to return the artifact uncompressed (the mime_type response field
 	// indicates the exact format returned).
 	GetArtifactContents(ctx context.Context, in *GetArtifactContentsRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// UploadArtifactContents creates an artifact or replaces an existing
+	// artifact with contents that are sent in a stream of chunks.
+	// It supports artifacts that are too large to send in a single message.
+	UploadArtifactContents(ctx context.Context, opts ...grpc.CallOption) (Registry_UploadArtifactContentsClient, error)
+	// DownloadArtifactContents returns the contents of a specified artifact in
+	// a stream of chunks.
+	DownloadArtifactContents(ctx context.Context, in *DownloadArtifactContentsRequest, opts ...grpc.CallOption) (Registry_DownloadArtifactContentsClient, error)
 	// CreateArtifact creates a specified artifact.
 	CreateArtifact(ctx context.Context, in *CreateArtifactRequest, opts ...grpc.CallOption) (*Artifact, error)
 	// ReplaceArtifact can be used to replace a specified artifact.
 	ReplaceArtifact(ctx context.Context, in *ReplaceArtifactRequest, opts ...grpc.CallOption) (*Artifact, error)
+	// UpdateArtifact can be used to modify the labels and annotations of a
+	// specified artifact without sending its contents.
+	UpdateArtifact(ctx context.Context, in *UpdateArtifactRequest, opts ...grpc.CallOption) (*Artifact, error)
 	// DeleteArtifact removes a specified artifact.
 	DeleteArtifact(ctx context.Context, in *DeleteArtifactRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UndeleteArtifact restores a deleted artifact.
+	UndeleteArtifact(ctx context.Context, in *UndeleteArtifactRequest, opts ...grpc.CallOption) (*Artifact, error)
+	// BatchGetArtifacts returns multiple specified artifacts.
+	BatchGetArtifacts(ctx context.Context, in *BatchGetArtifactsRequest, opts ...grpc.CallOption) (*BatchGetArtifactsResponse, error)
+	// BatchCreateArtifacts creates multiple artifacts in a single transaction.
+	// Requests that fail are reported in the results without preventing the
+	// others from being applied.
+	BatchCreateArtifacts(ctx context.Context, in *BatchCreateArtifactsRequest, opts ...grpc.CallOption) (*BatchCreateArtifactsResponse, error)
+	// BatchReplaceArtifacts replaces multiple artifacts in a single transaction.
+	// Requests that fail are reported in the results without preventing the
+	// others from being applied.
+	BatchReplaceArtifacts(ctx context.Context, in *BatchReplaceArtifactsRequest, opts ...grpc.CallOption) (*BatchReplaceArtifactsResponse, error)
+	// BatchUpdateArtifacts modifies the labels and annotations of multiple
+	// artifacts in a single transaction. Requests that fail are reported in the
+	// results without preventing the others from being applied.
+	BatchUpdateArtifacts(ctx context.Context, in *BatchUpdateArtifactsRequest, opts ...grpc.CallOption) (*BatchUpdateArtifactsResponse, error)
+	// BatchDeleteArtifacts removes multiple artifacts in a single transaction.
+	// Requests that fail are reported in the results without preventing the
+	// others from being applied.
+	BatchDeleteArtifacts(ctx context.Context, in *BatchDeleteArtifactsRequest, opts ...grpc.CallOption) (*BatchDeleteArtifactsResponse, error)
+	// TagArtifactRevision adds a tag to a specified revision of an artifact.
+	TagArtifactRevision(ctx context.Context, in *TagArtifactRevisionRequest, opts ...grpc.CallOption) (*Artifact, error)
+	// ListArtifactRevisions lists all revisions of an artifact.
+	// Revisions are returned in descending order of revision creation time.
+	ListArtifactRevisions(ctx context.Context, in *ListArtifactRevisionsRequest, opts ...grpc.CallOption) (*ListArtifactRevisionsResponse, error)
+	// RollbackArtifact sets the current revision to a specified prior revision.
+	// Note that this creates a new revision with a new revision ID.
+	RollbackArtifact(ctx context.Context, in *RollbackArtifactRequest, opts ...grpc.CallOption) (*Artifact, error)
+	// DeleteArtifactRevision deletes a revision of an artifact.
+	DeleteArtifactRevision(ctx context.Context, in *DeleteArtifactRevisionRequest, opts ...grpc.CallOption) (*Artifact, error)
+	// WatchResources streams notifications of changes to resources that
+	// match a pattern.
+	WatchResources(ctx context.Context, in *WatchResourcesRequest, opts ...grpc.CallOption) (Registry_WatchResourcesClient, error)
+	// SearchResources returns the APIs, versions, specs and deployments that
+	// match a text query, ordered by relevance.
+	SearchResources(ctx context.Context, in *SearchResourcesRequest, opts ...grpc.CallOption) (*SearchResourcesResponse, error)
 }
 
 type registryClient struct {
@@ -164,6 +272,51 @@ func (c *registryClient) DeleteApi(ctx context.Context, in *DeleteApiRequest, op
 	return out, nil
 }
 
+func (c *registryClient) UndeleteApi(ctx context.Context, in *UndeleteApiRequest, opts ...grpc.CallOption) (*Api, error) {
+	out := new(Api)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/UndeleteApi", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) BatchGetApis(ctx context.Context, in *BatchGetApisRequest, opts ...grpc.CallOption) (*BatchGetApisResponse, error) {
+	out := new(BatchGetApisResponse)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/BatchGetApis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) BatchCreateApis(ctx context.Context, in *BatchCreateApisRequest, opts ...grpc.CallOption) (*BatchCreateApisResponse, error) {
+	out := new(BatchCreateApisResponse)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/BatchCreateApis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) BatchUpdateApis(ctx context.Context, in *BatchUpdateApisRequest, opts ...grpc.CallOption) (*BatchUpdateApisResponse, error) {
+	out := new(BatchUpdateApisResponse)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/BatchUpdateApis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) BatchDeleteApis(ctx context.Context, in *BatchDeleteApisRequest, opts ...grpc.CallOption) (*BatchDeleteApisResponse, error) {
+	out := new(BatchDeleteApisResponse)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/BatchDeleteApis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *registryClient) ListApiVersions(ctx context.Context, in *ListApiVersionsRequest, opts ...grpc.CallOption) (*ListApiVersionsResponse, error) {
 	out := new(ListApiVersionsResponse)
 	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/ListApiVersions", in, out, opts...)
@@ -209,6 +362,51 @@ func (c *registryClient) DeleteApiVersion(ctx context.Context, in *DeleteApiVers
 	return out, nil
 }
 
+func (c *registryClient) UndeleteApiVersion(ctx context.Context, in *UndeleteApiVersionRequest, opts ...grpc.CallOption) (*ApiVersion, error) {
+	out := new(ApiVersion)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/UndeleteApiVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) BatchGetApiVersions(ctx context.Context, in *BatchGetApiVersionsRequest, opts ...grpc.CallOption) (*BatchGetApiVersionsResponse, error) {
+	out := new(BatchGetApiVersionsResponse)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/BatchGetApiVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) BatchCreateApiVersions(ctx context.Context, in *BatchCreateApiVersionsRequest, opts ...grpc.CallOption) (*BatchCreateApiVersionsResponse, error) {
+	out := new(BatchCreateApiVersionsResponse)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/BatchCreateApiVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) BatchUpdateApiVersions(ctx context.Context, in *BatchUpdateApiVersionsRequest, opts ...grpc.CallOption) (*BatchUpdateApiVersionsResponse, error) {
+	out := new(BatchUpdateApiVersionsResponse)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/BatchUpdateApiVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) BatchDeleteApiVersions(ctx context.Context, in *BatchDeleteApiVersionsRequest, opts ...grpc.CallOption) (*BatchDeleteApiVersionsResponse, error) {
+	out := new(BatchDeleteApiVersionsResponse)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/BatchDeleteApiVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *registryClient) ListApiSpecs(ctx context.Context, in *ListApiSpecsRequest, opts ...grpc.CallOption) (*ListApiSpecsResponse, error) {
 	out := new(ListApiSpecsResponse)
 	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/ListApiSpecs", in, out, opts...)
@@ -236,6 +434,72 @@ func (c *registryClient) GetApiSpecContents(ctx context.Context, in *GetApiSpecC
 	return out, nil
 }
 
+func (c *registryClient) UploadApiSpecContents(ctx context.Context, opts ...grpc.CallOption) (Registry_UploadApiSpecContentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Registry_ServiceDesc.Streams[0], "/google.cloud.apigeeregistry.v1.Registry/UploadApiSpecContents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &registryUploadApiSpecContentsClient{stream}
+	return x, nil
+}
+
+type Registry_UploadApiSpecContentsClient interface {
+	Send(*UploadApiSpecContentsRequest) error
+	CloseAndRecv() (*ApiSpec, error)
+	grpc.ClientStream
+}
+
+type registryUploadApiSpecContentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *registryUploadApiSpecContentsClient) Send(m *UploadApiSpecContentsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *registryUploadApiSpecContentsClient) CloseAndRecv() (*ApiSpec, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ApiSpec)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *registryClient) DownloadApiSpecContents(ctx context.Context, in *DownloadApiSpecContentsRequest, opts ...grpc.CallOption) (Registry_DownloadApiSpecContentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Registry_ServiceDesc.Streams[1], "/google.cloud.apigeeregistry.v1.Registry/DownloadApiSpecContents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &registryDownloadApiSpecContentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Registry_DownloadApiSpecContentsClient interface {
+	Recv() (*ContentsChunk, error)
+	grpc.ClientStream
+}
+
+type registryDownloadApiSpecContentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *registryDownloadApiSpecContentsClient) Recv() (*ContentsChunk, error) {
+	m := new(ContentsChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *registryClient) CreateApiSpec(ctx context.Context, in *CreateApiSpecRequest, opts ...grpc.CallOption) (*ApiSpec, error) {
 	out := new(ApiSpec)
 	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/CreateApiSpec", in, out, opts...)
@@ -263,6 +527,51 @@ func (c *registryClient) DeleteApiSpec(ctx context.Context, in *DeleteApiSpecReq
 	return out, nil
 }
 
+func (c *registryClient) UndeleteApiSpec(ctx context.Context, in *UndeleteApiSpecRequest, opts ...grpc.CallOption) (*ApiSpec, error) {
+	out := new(ApiSpec)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/UndeleteApiSpec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) BatchGetApiSpecs(ctx context.Context, in *BatchGetApiSpecsRequest, opts ...grpc.CallOption) (*BatchGetApiSpecsResponse, error) {
+	out := new(BatchGetApiSpecsResponse)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/BatchGetApiSpecs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) BatchCreateApiSpecs(ctx context.Context, in *BatchCreateApiSpecsRequest, opts ...grpc.CallOption) (*BatchCreateApiSpecsResponse, error) {
+	out := new(BatchCreateApiSpecsResponse)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/BatchCreateApiSpecs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) BatchUpdateApiSpecs(ctx context.Context, in *BatchUpdateApiSpecsRequest, opts ...grpc.CallOption) (*BatchUpdateApiSpecsResponse, error) {
+	out := new(BatchUpdateApiSpecsResponse)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/BatchUpdateApiSpecs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) BatchDeleteApiSpecs(ctx context.Context, in *BatchDeleteApiSpecsRequest, opts ...grpc.CallOption) (*BatchDeleteApiSpecsResponse, error) {
+	out := new(BatchDeleteApiSpecsResponse)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/BatchDeleteApiSpecs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *registryClient) TagApiSpecRevision(ctx context.Context, in *TagApiSpecRevisionRequest, opts ...grpc.CallOption) (*ApiSpec, error) {
 	out := new(ApiSpec)
 	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/TagApiSpecRevision", in, out, opts...)
@@ -344,6 +653,15 @@ func (c *registryClient) DeleteApiDeployment(ctx context.Context, in *DeleteApiD
 	return out, nil
 }
 
+func (c *registryClient) UndeleteApiDeployment(ctx context.Context, in *UndeleteApiDeploymentRequest, opts ...grpc.CallOption) (*ApiDeployment, error) {
+	out := new(ApiDeployment)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/UndeleteApiDeployment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *registryClient) TagApiDeploymentRevision(ctx context.Context, in *TagApiDeploymentRevisionRequest, opts ...grpc.CallOption) (*ApiDeployment, error) {
 	out := new(ApiDeployment)
 	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/TagApiDeploymentRevision", in, out, opts...)
@@ -407,6 +725,72 @@ func (c *registryClient) GetArtifactContents(ctx context.Context, in *GetArtifac
 	return out, nil
 }
 
+func (c *registryClient) UploadArtifactContents(ctx context.Context, opts ...grpc.CallOption) (Registry_UploadArtifactContentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Registry_ServiceDesc.Streams[2], "/google.cloud.apigeeregistry.v1.Registry/UploadArtifactContents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &registryUploadArtifactContentsClient{stream}
+	return x, nil
+}
+
+type Registry_UploadArtifactContentsClient interface {
+	Send(*UploadArtifactContentsRequest) error
+	CloseAndRecv() (*Artifact, error)
+	grpc.ClientStream
+}
+
+type registryUploadArtifactContentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *registryUploadArtifactContentsClient) Send(m *UploadArtifactContentsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *registryUploadArtifactContentsClient) CloseAndRecv() (*Artifact, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(Artifact)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *registryClient) DownloadArtifactContents(ctx context.Context, in *DownloadArtifactContentsRequest, opts ...grpc.CallOption) (Registry_DownloadArtifactContentsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Registry_ServiceDesc.Streams[3], "/google.cloud.apigeeregistry.v1.Registry/DownloadArtifactContents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &registryDownloadArtifactContentsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Registry_DownloadArtifactContentsClient interface {
+	Recv() (*ContentsChunk, error)
+	grpc.ClientStream
+}
+
+type registryDownloadArtifactContentsClient struct {
+	grpc.ClientStream
+}
+
+func (x *registryDownloadArtifactContentsClient) Recv() (*ContentsChunk, error) {
+	m := new(ContentsChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *registryClient) CreateArtifact(ctx context.Context, in *CreateArtifactRequest, opts ...grpc.CallOption) (*Artifact, error) {
 	out := new(Artifact)
 	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/CreateArtifact", in, out, opts...)
@@ -425,6 +809,15 @@ func (c *registryClient) ReplaceArtifact(ctx context.Context, in *ReplaceArtifac
 	return out, nil
 }
 
+func (c *registryClient) UpdateArtifact(ctx context.Context, in *UpdateArtifactRequest, opts ...grpc.CallOption) (*Artifact, error) {
+	out := new(Artifact)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/UpdateArtifact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *registryClient) DeleteArtifact(ctx context.Context, in *DeleteArtifactRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/DeleteArtifact", in, out, opts...)
@@ -434,95 +827,334 @@ func (c *registryClient) DeleteArtifact(ctx context.Context, in *DeleteArtifactR
 	return out, nil
 }
 
-// RegistryServer is the server API for Registry service.
-// All implementations must embed UnimplementedRegistryServer
-// for forward compatibility
-type RegistryServer interface {
-	// ListApis returns matching APIs.
-	ListApis(context.Context, *ListApisRequest) (*ListApisResponse, error)
-	// GetApi returns a specified API.
-	GetApi(context.Context, *GetApiRequest) (*Api, error)
-	// CreateApi creates a specified API.
-	CreateApi(context.Context, *CreateApiRequest) (*Api, error)
-	// UpdateApi can be used to modify a specified API.
-	UpdateApi(context.Context, *UpdateApiRequest) (*Api, error)
-	// DeleteApi removes a specified API and all of the resources that it
-	// owns.
-	DeleteApi(context.Context, *DeleteApiRequest) (*emptypb.Empty, error)
-	// ListApiVersions returns matching versions.
-	ListApiVersions(context.Context, *ListApiVersionsRequest) (*ListApiVersionsResponse, error)
-	// GetApiVersion returns a specified version.
-	GetApiVersion(context.Context, *GetApiVersionRequest) (*ApiVersion, error)
-	// CreateApiVersion creates a specified version.
-	CreateApiVersion(context.Context, *CreateApiVersionRequest) (*ApiVersion, error)
-	// UpdateApiVersion can be used to modify a specified version.
-	UpdateApiVersion(context.Context, *UpdateApiVersionRequest) (*ApiVersion, error)
-	// DeleteApiVersion removes a specified version and all of the resources that
-	// it owns.
-	DeleteApiVersion(context.Context, *DeleteApiVersionRequest) (*emptypb.Empty, error)
-	// ListApiSpecs returns matching specs.
-	ListApiSpecs(context.Context, *ListApiSpecsRequest) (*ListApiSpecsResponse, error)
-	// GetApiSpec returns a specified spec.
-	GetApiSpec(context.Context, *GetApiSpecRequest) (*ApiSpec, error)
-	// GetApiSpecContents returns the contents of a specified spec.
-	// If specs are stored with GZip compression, the default behavior
-	// is to return the spec uncompressed (the mime_type response field
-	// indicates the exact format returned).
-	GetApiSpecContents(context.Context, *GetApiSpecContentsRequest) (*httpbody.HttpBody, error)
-	// CreateApiSpec creates a specified spec.
-	CreateApiSpec(context.Context, *CreateApiSpecRequest) (*ApiSpec, error)
-	// UpdateApiSpec can be used to modify a specified spec.
-	UpdateApiSpec(context.Context, *UpdateApiSpecRequest) (*ApiSpec, error)
-	// DeleteApiSpec removes a specified spec, all revisions, and all child
-	// resources (e.g. artifacts).
-	DeleteApiSpec(context.Context, *DeleteApiSpecRequest) (*emptypb.Empty, error)
-	// TagApiSpecRevision adds a tag to a specified revision of a spec.
-	TagApiSpecRevision(context.Context, *TagApiSpecRevisionRequest) (*ApiSpec, error)
-	// ListApiSpecRevisions lists all revisions of a spec.
-	// Revisions are returned in descending order of revision creation time.
-	ListApiSpecRevisions(context.Context, *ListApiSpecRevisionsRequest) (*ListApiSpecRevisionsResponse, error)
-	// RollbackApiSpec sets the current revision to a specified prior revision.
-	// Note that this creates a new revision with a new revision ID.
-	RollbackApiSpec(context.Context, *RollbackApiSpecRequest) (*ApiSpec, error)
-	// DeleteApiSpecRevision deletes a revision of a spec.
-	DeleteApiSpecRevision(context.Context, *DeleteApiSpecRevisionRequest) (*ApiSpec, error)
-	// ListApiDeployments returns matching deployments.
-	ListApiDeployments(context.Context, *ListApiDeploymentsRequest) (*ListApiDeploymentsResponse, error)
-	// GetApiDeployment returns a specified deployment.
-	GetApiDeployment(context.Context, *GetApiDeploymentRequest) (*ApiDeployment, error)
-	// CreateApiDeployment creates a specified deployment.
-	CreateApiDeployment(context.Context, *CreateApiDeploymentRequest) (*ApiDeployment, error)
-	// UpdateApiDeployment can be used to modify a specified deployment.
-	UpdateApiDeployment(context.Context, *UpdateApiDeploymentRequest) (*ApiDeployment, error)
-	// DeleteApiDeployment removes a specified deployment, all revisions, and all
-	// child resources (e.g. artifacts).
-	DeleteApiDeployment(context.Context, *DeleteApiDeploymentRequest) (*emptypb.Empty, error)
-	// TagApiDeploymentRevision adds a tag to a specified revision of a
-	// deployment.
-	TagApiDeploymentRevision(context.Context, *TagApiDeploymentRevisionRequest) (*ApiDeployment, error)
-	// ListApiDeploymentRevisions lists all revisions of a deployment.
-	// Revisions are returned in descending order of revision creation time.
-	ListApiDeploymentRevisions(context.Context, *ListApiDeploymentRevisionsRequest) (*ListApiDeploymentRevisionsResponse, error)
-	// RollbackApiDeployment sets the current revision to a specified prior
-	// revision. Note that this creates a new revision with a new revision ID.
-	RollbackApiDeployment(context.Context, *RollbackApiDeploymentRequest) (*ApiDeployment, error)
-	// DeleteApiDeploymentRevision deletes a revision of a deployment.
-	DeleteApiDeploymentRevision(context.Context, *DeleteApiDeploymentRevisionRequest) (*ApiDeployment, error)
-	// ListArtifacts returns matching artifacts.
-	ListArtifacts(context.Context, *ListArtifactsRequest) (*ListArtifactsResponse, error)
-	// GetArtifact returns a specified artifact.
-	GetArtifact(context.Context, *GetArtifactRequest) (*Artifact, error)
-	// GetArtifactContents returns the contents of a specified artifact.
+func (c *registryClient) UndeleteArtifact(ctx context.Context, in *UndeleteArtifactRequest, opts ...grpc.CallOption) (*Artifact, error) {
+	out := new(Artifact)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/UndeleteArtifact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) BatchGetArtifacts(ctx context.Context, in *BatchGetArtifactsRequest, opts ...grpc.CallOption) (*BatchGetArtifactsResponse, error) {
+	out := new(BatchGetArtifactsResponse)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/BatchGetArtifacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) BatchCreateArtifacts(ctx context.Context, in *BatchCreateArtifactsRequest, opts ...grpc.CallOption) (*BatchCreateArtifactsResponse, error) {
+	out := new(BatchCreateArtifactsResponse)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/BatchCreateArtifacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) BatchReplaceArtifacts(ctx context.Context, in *BatchReplaceArtifactsRequest, opts ...grpc.CallOption) (*BatchReplaceArtifactsResponse, error) {
+	out := new(BatchReplaceArtifactsResponse)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/BatchReplaceArtifacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) BatchUpdateArtifacts(ctx context.Context, in *BatchUpdateArtifactsRequest, opts ...grpc.CallOption) (*BatchUpdateArtifactsResponse, error) {
+	out := new(BatchUpdateArtifactsResponse)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/BatchUpdateArtifacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) BatchDeleteArtifacts(ctx context.Context, in *BatchDeleteArtifactsRequest, opts ...grpc.CallOption) (*BatchDeleteArtifactsResponse, error) {
+	out := new(BatchDeleteArtifactsResponse)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/BatchDeleteArtifacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) TagArtifactRevision(ctx context.Context, in *TagArtifactRevisionRequest, opts ...grpc.CallOption) (*Artifact, error) {
+	out := new(Artifact)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/TagArtifactRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) ListArtifactRevisions(ctx context.Context, in *ListArtifactRevisionsRequest, opts ...grpc.CallOption) (*ListArtifactRevisionsResponse, error) {
+	out := new(ListArtifactRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/ListArtifactRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) RollbackArtifact(ctx context.Context, in *RollbackArtifactRequest, opts ...grpc.CallOption) (*Artifact, error) {
+	out := new(Artifact)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/RollbackArtifact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) DeleteArtifactRevision(ctx context.Context, in *DeleteArtifactRevisionRequest, opts ...grpc.CallOption) (*Artifact, error) {
+	out := new(Artifact)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/DeleteArtifactRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registryClient) WatchResources(ctx context.Context, in *WatchResourcesRequest, opts ...grpc.CallOption) (Registry_WatchResourcesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Registry_ServiceDesc.Streams[4], "/google.cloud.apigeeregistry.v1.Registry/WatchResources", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &registryWatchResourcesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Registry_WatchResourcesClient interface {
+	Recv() (*Notification, error)
+	grpc.ClientStream
+}
+
+type registryWatchResourcesClient struct {
+	grpc.ClientStream
+}
+
+func (x *registryWatchResourcesClient) Recv() (*Notification, error) {
+	m := new(Notification)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *registryClient) SearchResources(ctx context.Context, in *SearchResourcesRequest, opts ...grpc.CallOption) (*SearchResourcesResponse, error) {
+	out := new(SearchResourcesResponse)
+	err := c.cc.Invoke(ctx, "/google.cloud.apigeeregistry.v1.Registry/SearchResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RegistryServer is the server API for Registry service.
+// All implementations must embed UnimplementedRegistryServer
+// for forward compatibility
+type RegistryServer interface {
+	// ListApis returns matching APIs.
+	ListApis(context.Context, *ListApisRequest) (*ListApisResponse, error)
+	// GetApi returns a specified API.
+	GetApi(context.Context, *GetApiRequest) (*Api, error)
+	// CreateApi creates a specified API.
+	CreateApi(context.Context, *CreateApiRequest) (*Api, error)
+	// UpdateApi can be used to modify a specified API.
+	UpdateApi(context.Context, *UpdateApiRequest) (*Api, error)
+	// DeleteApi removes a specified API and all of the resources that it
+	// owns.
+	DeleteApi(context.Context, *DeleteApiRequest) (*emptypb.Empty, error)
+	// UndeleteApi restores a deleted API and the resources that were deleted with
+	// it.
+	UndeleteApi(context.Context, *UndeleteApiRequest) (*Api, error)
+	// BatchGetApis returns multiple specified APIs.
+	BatchGetApis(context.Context, *BatchGetApisRequest) (*BatchGetApisResponse, error)
+	// BatchCreateApis creates multiple APIs in a single transaction.
+	// Requests that fail are reported in the results without preventing the
+	// others from being applied.
+	BatchCreateApis(context.Context, *BatchCreateApisRequest) (*BatchCreateApisResponse, error)
+	// BatchUpdateApis modifies multiple APIs in a single transaction.
+	// Requests that fail are reported in the results without preventing the
+	// others from being applied.
+	BatchUpdateApis(context.Context, *BatchUpdateApisRequest) (*BatchUpdateApisResponse, error)
+	// BatchDeleteApis removes multiple APIs in a single transaction.
+	// Requests that fail are reported in the results without preventing the
+	// others from being applied.
+	BatchDeleteApis(context.Context, *BatchDeleteApisRequest) (*BatchDeleteApisResponse, error)
+	// ListApiVersions returns matching versions.
+	ListApiVersions(context.Context, *ListApiVersionsRequest) (*ListApiVersionsResponse, error)
+	// GetApiVersion returns a specified version.
+	GetApiVersion(context.Context, *GetApiVersionRequest) (*ApiVersion, error)
+	// CreateApiVersion creates a specified version.
+	CreateApiVersion(context.Context, *CreateApiVersionRequest) (*ApiVersion, error)
+	// UpdateApiVersion can be used to modify a specified version.
+	UpdateApiVersion(context.Context, *UpdateApiVersionRequest) (*ApiVersion, error)
+	// DeleteApiVersion removes a specified version and all of the resources that
+	// it owns.
+	DeleteApiVersion(context.Context, *DeleteApiVersionRequest) (*emptypb.Empty, error)
+	// UndeleteApiVersion restores a deleted version and the resources that were
+	// deleted with it.
+	UndeleteApiVersion(context.Context, *UndeleteApiVersionRequest) (*ApiVersion, error)
+	// BatchGetApiVersions returns multiple specified versions.
+	BatchGetApiVersions(context.Context, *BatchGetApiVersionsRequest) (*BatchGetApiVersionsResponse, error)
+	// BatchCreateApiVersions creates multiple versions in a single transaction.
+	// Requests that fail are reported in the results without preventing the
+	// others from being applied.
+	BatchCreateApiVersions(context.Context, *BatchCreateApiVersionsRequest) (*BatchCreateApiVersionsResponse, error)
+	// BatchUpdateApiVersions modifies multiple versions in a single transaction.
+	// Requests that fail are reported in the results without preventing the
+	// others from being applied.
+	BatchUpdateApiVersions(context.Context, *BatchUpdateApiVersionsRequest) (*BatchUpdateApiVersionsResponse, error)
+	// BatchDeleteApiVersions removes multiple versions in a single transaction.
+	// Requests that fail are reported in the results without preventing the
+	// others from being applied.
+	BatchDeleteApiVersions(context.Context, *BatchDeleteApiVersionsRequest) (*BatchDeleteApiVersionsResponse, error)
+	// ListApiSpecs returns matching specs.
+	ListApiSpecs(context.Context, *ListApiSpecsRequest) (*ListApiSpecsResponse, error)
+	// GetApiSpec returns a specified spec.
+	GetApiSpec(context.Context, *GetApiSpecRequest) (*ApiSpec, error)
+	// GetApiSpecContents returns the contents of a specified spec.
+	// If specs are stored with GZip compression, the default behavior
+	// is to return the spec uncompressed (the mime_type response field
+	// indicates the exact format returned).
+	GetApiSpecContents(context.Context, *GetApiSpecContentsRequest) (*httpbody.HttpBody, error)
+	// UploadApiSpecContents creates a spec or updates the contents of an
+	// existing spec with contents that are sent in a stream of chunks.
+	// It supports specs that are too large to send in a single message.
+	UploadApiSpecContents(Registry_UploadApiSpecContentsServer) error
+	// DownloadApiSpecContents returns the contents of a specified spec in a
+	// stream of chunks. Contents are returned in the format returned by
+	// GetApiSpecContents.
+	DownloadApiSpecContents(*DownloadApiSpecContentsRequest, Registry_DownloadApiSpecContentsServer) error
+	// CreateApiSpec creates a specified spec.
+	CreateApiSpec(context.Context, *CreateApiSpecRequest) (*ApiSpec, error)
+	// UpdateApiSpec can be used to modify a specified spec.
+	UpdateApiSpec(context.Context, *UpdateApiSpecRequest) (*ApiSpec, error)
+	// DeleteApiSpec removes a specified spec, all revisions, and all child
+	// resources (e.g. artifacts).
+	DeleteApiSpec(context.Context, *DeleteApiSpecRequest) (*emptypb.Empty, error)
+	// UndeleteApiSpec restores a deleted spec and the resources that were deleted
+	// with it.
+	UndeleteApiSpec(context.Context, *UndeleteApiSpecRequest) (*ApiSpec, error)
+	// BatchGetApiSpecs returns multiple specified specs.
+	BatchGetApiSpecs(context.Context, *BatchGetApiSpecsRequest) (*BatchGetApiSpecsResponse, error)
+	// BatchCreateApiSpecs creates multiple specs in a single transaction.
+	// Requests that fail are reported in the results without preventing the
+	// others from being applied.
+	BatchCreateApiSpecs(context.Context, *BatchCreateApiSpecsRequest) (*BatchCreateApiSpecsResponse, error)
+	// BatchUpdateApiSpecs modifies multiple specs in a single transaction.
+	// Requests that fail are reported in the results without preventing the
+	// others from being applied.
+	BatchUpdateApiSpecs(context.Context, *BatchUpdateApiSpecsRequest) (*BatchUpdateApiSpecsResponse, error)
+	// BatchDeleteApiSpecs removes multiple specs in a single transaction.
+	// Requests that fail are reported in the results without preventing the
+	// others from being applied.
+	BatchDeleteApiSpecs(context.Context, *BatchDeleteApiSpecsRequest) (*BatchDeleteApiSpecsResponse, error)
+	// TagApiSpecRevision adds a tag to a specified revision of a spec.
+	TagApiSpecRevision(context.Context, *TagApiSpecRevisionRequest) (*ApiSpec, error)
+	// ListApiSpecRevisions lists all revisions of a spec.
+	// Revisions are returned in descending order of revision creation time.
+	ListApiSpecRevisions(context.Context, *ListApiSpecRevisionsRequest) (*ListApiSpecRevisionsResponse, error)
+	// RollbackApiSpec sets the current revision to a specified prior revision.
+	// Note that this creates a new revision with a new revision ID.
+	RollbackApiSpec(context.Context, *RollbackApiSpecRequest) (*ApiSpec, error)
+	// DeleteApiSpecRevision deletes a revision of a spec.
+	DeleteApiSpecRevision(context.Context, *DeleteApiSpecRevisionRequest) (*ApiSpec, error)
+	// ListApiDeployments returns matching deployments.
+	ListApiDeployments(context.Context, *ListApiDeploymentsRequest) (*ListApiDeploymentsResponse, error)
+	// GetApiDeployment returns a specified deployment.
+	GetApiDeployment(context.Context, *GetApiDeploymentRequest) (*ApiDeployment, error)
+	// CreateApiDeployment creates a specified deployment.
+	CreateApiDeployment(context.Context, *CreateApiDeploymentRequest) (*ApiDeployment, error)
+	// UpdateApiDeployment can be used to modify a specified deployment.
+	UpdateApiDeployment(context.Context, *UpdateApiDeploymentRequest) (*ApiDeployment, error)
+	// DeleteApiDeployment removes a specified deployment, all revisions, and all
+	// child resources (e.g. artifacts).
+	DeleteApiDeployment(context.Context, *DeleteApiDeploymentRequest) (*emptypb.Empty, error)
+	// UndeleteApiDeployment restores a deleted deployment and the resources that
+	// were deleted with it.
+	UndeleteApiDeployment(context.Context, *UndeleteApiDeploymentRequest) (*ApiDeployment, error)
+	// TagApiDeploymentRevision adds a tag to a specified revision of a
+	// deployment.
+	TagApiDeploymentRevision(context.Context, *TagApiDeploymentRevisionRequest) (*ApiDeployment, error)
+	// ListApiDeploymentRevisions lists all revisions of a deployment.
+	// Revisions are returned in descending order of revision creation time.
+	ListApiDeploymentRevisions(context.Context, *ListApiDeploymentRevisionsRequest) (*ListApiDeploymentRevisionsResponse, error)
+	// RollbackApiDeployment sets the current revision to a specified prior
+	// revision. Note that this creates a new revision with a new revision ID.
+	RollbackApiDeployment(context.Context, *RollbackApiDeploymentRequest) (*ApiDeployment, error)
+	// DeleteApiDeploymentRevision deletes a revision of a deployment.
+	DeleteApiDeploymentRevision(context.Context, *DeleteApiDeploymentRevisionRequest) (*ApiDeployment, error)
+	// ListArtifacts returns matching artifacts.
+	ListArtifacts(context.Context, *ListArtifactsRequest) (*ListArtifactsResponse, error)
+	// GetArtifact returns a specified artifact.
+	GetArtifact(context.Context, *GetArtifactRequest) (*Artifact, error)
+	// GetArtifactContents returns the contents of a specified artifact.
 	// If artifacts are stored with GZip compression, the default behavior
 	// is to return the artifact uncompressed (the mime_type response field
 	// indicates the exact format returned).
 	GetArtifactContents(context.Context, *GetArtifactContentsRequest) (*httpbody.HttpBody, error)
+	// UploadArtifactContents creates an artifact or replaces an existing
+	// artifact with contents that are sent in a stream of chunks.
+	// It supports artifacts that are too large to send in a single message.
+	UploadArtifactContents(Registry_UploadArtifactContentsServer) error
+	// DownloadArtifactContents returns the contents of a specified artifact in
+	// a stream of chunks.
+	DownloadArtifactContents(*DownloadArtifactContentsRequest, Registry_DownloadArtifactContentsServer) error
 	// CreateArtifact creates a specified artifact.
 	CreateArtifact(context.Context, *CreateArtifactRequest) (*Artifact, error)
 	// ReplaceArtifact can be used to replace a specified artifact.
 	ReplaceArtifact(context.Context, *ReplaceArtifactRequest) (*Artifact, error)
+	// UpdateArtifact can be used to modify the labels and annotations of a
+	// specified artifact without sending its contents.
+	UpdateArtifact(context.Context, *UpdateArtifactRequest) (*Artifact, error)
 	// DeleteArtifact removes a specified artifact.
 	DeleteArtifact(context.Context, *DeleteArtifactRequest) (*emptypb.Empty, error)
+	// UndeleteArtifact restores a deleted artifact.
+	UndeleteArtifact(context.Context, *UndeleteArtifactRequest) (*Artifact, error)
+	// BatchGetArtifacts returns multiple specified artifacts.
+	BatchGetArtifacts(context.Context, *BatchGetArtifactsRequest) (*BatchGetArtifactsResponse, error)
+	// BatchCreateArtifacts creates multiple artifacts in a single transaction.
+	// Requests that fail are reported in the results without preventing the
+	// others from being applied.
+	BatchCreateArtifacts(context.Context, *BatchCreateArtifactsRequest) (*BatchCreateArtifactsResponse, error)
+	// BatchReplaceArtifacts replaces multiple artifacts in a single transaction.
+	// Requests that fail are reported in the results without preventing the
+	// others from being applied.
+	BatchReplaceArtifacts(context.Context, *BatchReplaceArtifactsRequest) (*BatchReplaceArtifactsResponse, error)
+	// BatchUpdateArtifacts modifies the labels and annotations of multiple
+	// artifacts in a single transaction. Requests that fail are reported in the
+	// results without preventing the others from being applied.
+	BatchUpdateArtifacts(context.Context, *BatchUpdateArtifactsRequest) (*BatchUpdateArtifactsResponse, error)
+	// BatchDeleteArtifacts removes multiple artifacts in a single transaction.
+	// Requests that fail are reported in the results without preventing the
+	// others from being applied.
+	BatchDeleteArtifacts(context.Context, *BatchDeleteArtifactsRequest) (*BatchDeleteArtifactsResponse, error)
+	// TagArtifactRevision adds a tag to a specified revision of an artifact.
+	TagArtifactRevision(context.Context, *TagArtifactRevisionRequest) (*Artifact, error)
+	// ListArtifactRevisions lists all revisions of an artifact.
+	// Revisions are returned in descending order of revision creation time.
+	ListArtifactRevisions(context.Context, *ListArtifactRevisionsRequest) (*ListArtifactRevisionsResponse, error)
+	// RollbackArtifact sets the current revision to a specified prior revision.
+	// Note that this creates a new revision with a new revision ID.
+	RollbackArtifact(context.Context, *RollbackArtifactRequest) (*Artifact, error)
+	// DeleteArtifactRevision deletes a revision of an artifact.
+	DeleteArtifactRevision(context.Context, *DeleteArtifactRevisionRequest) (*Artifact, error)
+	// WatchResources streams notifications of changes to resources that
+	// match a pattern.
+	WatchResources(*WatchResourcesRequest, Registry_WatchResourcesServer) error
+	// SearchResources returns the APIs, versions, specs and deployments that
+	// match a text query, ordered by relevance.
+	SearchResources(context.Context, *SearchResourcesRequest) (*SearchResourcesResponse, error)
 	mustEmbedUnimplementedRegistryServer()
 }
 
@@ -545,6 +1177,21 @@ func (UnimplementedRegistryServer) UpdateApi(context.Context, *UpdateApiRequest)
 func (UnimplementedRegistryServer) DeleteApi(context.Context, *DeleteApiRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteApi not implemented")
 }
+func (UnimplementedRegistryServer) UndeleteApi(context.Context, *UndeleteApiRequest) (*Api, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteApi not implemented")
+}
+func (UnimplementedRegistryServer) BatchGetApis(context.Context, *BatchGetApisRequest) (*BatchGetApisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetApis not implemented")
+}
+func (UnimplementedRegistryServer) BatchCreateApis(context.Context, *BatchCreateApisRequest) (*BatchCreateApisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateApis not implemented")
+}
+func (UnimplementedRegistryServer) BatchUpdateApis(context.Context, *BatchUpdateApisRequest) (*BatchUpdateApisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateApis not implemented")
+}
+func (UnimplementedRegistryServer) BatchDeleteApis(context.Context, *BatchDeleteApisRequest) (*BatchDeleteApisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteApis not implemented")
+}
 func (UnimplementedRegistryServer) ListApiVersions(context.Context, *ListApiVersionsRequest) (*ListApiVersionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListApiVersions not implemented")
 }
@@ -560,6 +1207,21 @@ func (UnimplementedRegistryServer) UpdateApiVersion(context.Context, *UpdateApiV
 func (UnimplementedRegistryServer) DeleteApiVersion(context.Context, *DeleteApiVersionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteApiVersion not implemented")
 }
+func (UnimplementedRegistryServer) UndeleteApiVersion(context.Context, *UndeleteApiVersionRequest) (*ApiVersion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteApiVersion not implemented")
+}
+func (UnimplementedRegistryServer) BatchGetApiVersions(context.Context, *BatchGetApiVersionsRequest) (*BatchGetApiVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetApiVersions not implemented")
+}
+func (UnimplementedRegistryServer) BatchCreateApiVersions(context.Context, *BatchCreateApiVersionsRequest) (*BatchCreateApiVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateApiVersions not implemented")
+}
+func (UnimplementedRegistryServer) BatchUpdateApiVersions(context.Context, *BatchUpdateApiVersionsRequest) (*BatchUpdateApiVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateApiVersions not implemented")
+}
+func (UnimplementedRegistryServer) BatchDeleteApiVersions(context.Context, *BatchDeleteApiVersionsRequest) (*BatchDeleteApiVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteApiVersions not implemented")
+}
 func (UnimplementedRegistryServer) ListApiSpecs(context.Context, *ListApiSpecsRequest) (*ListApiSpecsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListApiSpecs not implemented")
 }
@@ -569,6 +1231,12 @@ func (UnimplementedRegistryServer) GetApiSpec(context.Context, *GetApiSpecReques
 func (UnimplementedRegistryServer) GetApiSpecContents(context.Context, *GetApiSpecContentsRequest) (*httpbody.HttpBody, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetApiSpecContents not implemented")
 }
+func (UnimplementedRegistryServer) UploadApiSpecContents(Registry_UploadApiSpecContentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadApiSpecContents not implemented")
+}
+func (UnimplementedRegistryServer) DownloadApiSpecContents(*DownloadApiSpecContentsRequest, Registry_DownloadApiSpecContentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadApiSpecContents not implemented")
+}
 func (UnimplementedRegistryServer) CreateApiSpec(context.Context, *CreateApiSpecRequest) (*ApiSpec, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateApiSpec not implemented")
 }
@@ -578,6 +1246,21 @@ func (UnimplementedRegistryServer) UpdateApiSpec(context.Context, *UpdateApiSpec
 func (UnimplementedRegistryServer) DeleteApiSpec(context.Context, *DeleteApiSpecRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteApiSpec not implemented")
 }
+func (UnimplementedRegistryServer) UndeleteApiSpec(context.Context, *UndeleteApiSpecRequest) (*ApiSpec, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteApiSpec not implemented")
+}
+func (UnimplementedRegistryServer) BatchGetApiSpecs(context.Context, *BatchGetApiSpecsRequest) (*BatchGetApiSpecsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetApiSpecs not implemented")
+}
+func (UnimplementedRegistryServer) BatchCreateApiSpecs(context.Context, *BatchCreateApiSpecsRequest) (*BatchCreateApiSpecsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateApiSpecs not implemented")
+}
+func (UnimplementedRegistryServer) BatchUpdateApiSpecs(context.Context, *BatchUpdateApiSpecsRequest) (*BatchUpdateApiSpecsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateApiSpecs not implemented")
+}
+func (UnimplementedRegistryServer) BatchDeleteApiSpecs(context.Context, *BatchDeleteApiSpecsRequest) (*BatchDeleteApiSpecsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteApiSpecs not implemented")
+}
 func (UnimplementedRegistryServer) TagApiSpecRevision(context.Context, *TagApiSpecRevisionRequest) (*ApiSpec, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TagApiSpecRevision not implemented")
 }
@@ -605,6 +1288,9 @@ func (UnimplementedRegistryServer) UpdateApiDeployment(context.Context, *UpdateA
 func (UnimplementedRegistryServer) DeleteApiDeployment(context.Context, *DeleteApiDeploymentRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteApiDeployment not implemented")
 }
+func (UnimplementedRegistryServer) UndeleteApiDeployment(context.Context, *UndeleteApiDeploymentRequest) (*ApiDeployment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteApiDeployment not implemented")
+}
 func (UnimplementedRegistryServer) TagApiDeploymentRevision(context.Context, *TagApiDeploymentRevisionRequest) (*ApiDeployment, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TagApiDeploymentRevision not implemented")
 }
@@ -626,15 +1312,60 @@ func (UnimplementedRegistryServer) GetArtifact(context.Context, *GetArtifactRequ
 func (UnimplementedRegistryServer) GetArtifactContents(context.Context, *GetArtifactContentsRequest) (*httpbody.HttpBody, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetArtifactContents not implemented")
 }
+func (UnimplementedRegistryServer) UploadArtifactContents(Registry_UploadArtifactContentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method UploadArtifactContents not implemented")
+}
+func (UnimplementedRegistryServer) DownloadArtifactContents(*DownloadArtifactContentsRequest, Registry_DownloadArtifactContentsServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadArtifactContents not implemented")
+}
 func (UnimplementedRegistryServer) CreateArtifact(context.Context, *CreateArtifactRequest) (*Artifact, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateArtifact not implemented")
 }
 func (UnimplementedRegistryServer) ReplaceArtifact(context.Context, *ReplaceArtifactRequest) (*Artifact, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReplaceArtifact not implemented")
 }
+func (UnimplementedRegistryServer) UpdateArtifact(context.Context, *UpdateArtifactRequest) (*Artifact, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateArtifact not implemented")
+}
 func (UnimplementedRegistryServer) DeleteArtifact(context.Context, *DeleteArtifactRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteArtifact not implemented")
 }
+func (UnimplementedRegistryServer) UndeleteArtifact(context.Context, *UndeleteArtifactRequest) (*Artifact, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteArtifact not implemented")
+}
+func (UnimplementedRegistryServer) BatchGetArtifacts(context.Context, *BatchGetArtifactsRequest) (*BatchGetArtifactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetArtifacts not implemented")
+}
+func (UnimplementedRegistryServer) BatchCreateArtifacts(context.Context, *BatchCreateArtifactsRequest) (*BatchCreateArtifactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateArtifacts not implemented")
+}
+func (UnimplementedRegistryServer) BatchReplaceArtifacts(context.Context, *BatchReplaceArtifactsRequest) (*BatchReplaceArtifactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchReplaceArtifacts not implemented")
+}
+func (UnimplementedRegistryServer) BatchUpdateArtifacts(context.Context, *BatchUpdateArtifactsRequest) (*BatchUpdateArtifactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateArtifacts not implemented")
+}
+func (UnimplementedRegistryServer) BatchDeleteArtifacts(context.Context, *BatchDeleteArtifactsRequest) (*BatchDeleteArtifactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteArtifacts not implemented")
+}
+func (UnimplementedRegistryServer) TagArtifactRevision(context.Context, *TagArtifactRevisionRequest) (*Artifact, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TagArtifactRevision not implemented")
+}
+func (UnimplementedRegistryServer) ListArtifactRevisions(context.Context, *ListArtifactRevisionsRequest) (*ListArtifactRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListArtifactRevisions not implemented")
+}
+func (UnimplementedRegistryServer) RollbackArtifact(context.Context, *RollbackArtifactRequest) (*Artifact, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackArtifact not implemented")
+}
+func (UnimplementedRegistryServer) DeleteArtifactRevision(context.Context, *DeleteArtifactRevisionRequest) (*Artifact, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteArtifactRevision not implemented")
+}
+func (UnimplementedRegistryServer) WatchResources(*WatchResourcesRequest, Registry_WatchResourcesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchResources not implemented")
+}
+func (UnimplementedRegistryServer) SearchResources(context.Context, *SearchResourcesRequest) (*SearchResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchResources not implemented")
+}
 func (UnimplementedRegistryServer) mustEmbedUnimplementedRegistryServer() {}
 
 // UnsafeRegistryServer may be embedded to opt out of forward compatibility for this service.
@@ -738,542 +1469,1161 @@ func _Registry_DeleteApi_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_ListApiVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListApiVersionsRequest)
+func _Registry_UndeleteApi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteApiRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).ListApiVersions(ctx, in)
+		return srv.(RegistryServer).UndeleteApi(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/ListApiVersions",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/UndeleteApi",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).ListApiVersions(ctx, req.(*ListApiVersionsRequest))
+		return srv.(RegistryServer).UndeleteApi(ctx, req.(*UndeleteApiRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_GetApiVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetApiVersionRequest)
+func _Registry_BatchGetApis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetApisRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).GetApiVersion(ctx, in)
+		return srv.(RegistryServer).BatchGetApis(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/GetApiVersion",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/BatchGetApis",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).GetApiVersion(ctx, req.(*GetApiVersionRequest))
+		return srv.(RegistryServer).BatchGetApis(ctx, req.(*BatchGetApisRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_CreateApiVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateApiVersionRequest)
+func _Registry_BatchCreateApis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateApisRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).CreateApiVersion(ctx, in)
+		return srv.(RegistryServer).BatchCreateApis(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/CreateApiVersion",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/BatchCreateApis",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).CreateApiVersion(ctx, req.(*CreateApiVersionRequest))
+		return srv.(RegistryServer).BatchCreateApis(ctx, req.(*BatchCreateApisRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_UpdateApiVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateApiVersionRequest)
+func _Registry_BatchUpdateApis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateApisRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).UpdateApiVersion(ctx, in)
+		return srv.(RegistryServer).BatchUpdateApis(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/UpdateApiVersion",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/BatchUpdateApis",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).UpdateApiVersion(ctx, req.(*UpdateApiVersionRequest))
+		return srv.(RegistryServer).BatchUpdateApis(ctx, req.(*BatchUpdateApisRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_DeleteApiVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteApiVersionRequest)
+func _Registry_BatchDeleteApis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteApisRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).DeleteApiVersion(ctx, in)
+		return srv.(RegistryServer).BatchDeleteApis(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/DeleteApiVersion",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/BatchDeleteApis",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).DeleteApiVersion(ctx, req.(*DeleteApiVersionRequest))
+		return srv.(RegistryServer).BatchDeleteApis(ctx, req.(*BatchDeleteApisRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_ListApiSpecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListApiSpecsRequest)
+func _Registry_ListApiVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiVersionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).ListApiSpecs(ctx, in)
+		return srv.(RegistryServer).ListApiVersions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/ListApiSpecs",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/ListApiVersions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).ListApiSpecs(ctx, req.(*ListApiSpecsRequest))
+		return srv.(RegistryServer).ListApiVersions(ctx, req.(*ListApiVersionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_GetApiSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetApiSpecRequest)
+func _Registry_GetApiVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApiVersionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).GetApiSpec(ctx, in)
+		return srv.(RegistryServer).GetApiVersion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/GetApiSpec",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/GetApiVersion",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).GetApiSpec(ctx, req.(*GetApiSpecRequest))
+		return srv.(RegistryServer).GetApiVersion(ctx, req.(*GetApiVersionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_GetApiSpecContents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetApiSpecContentsRequest)
+func _Registry_CreateApiVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiVersionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).GetApiSpecContents(ctx, in)
+		return srv.(RegistryServer).CreateApiVersion(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/GetApiSpecContents",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/CreateApiVersion",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).GetApiSpecContents(ctx, req.(*GetApiSpecContentsRequest))
+		return srv.(RegistryServer).CreateApiVersion(ctx, req.(*CreateApiVersionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Registry_UpdateApiVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateApiVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).UpdateApiVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/UpdateApiVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).UpdateApiVersion(ctx, req.(*UpdateApiVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_DeleteApiVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApiVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).DeleteApiVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/DeleteApiVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).DeleteApiVersion(ctx, req.(*DeleteApiVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_UndeleteApiVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteApiVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).UndeleteApiVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/UndeleteApiVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).UndeleteApiVersion(ctx, req.(*UndeleteApiVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_BatchGetApiVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetApiVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).BatchGetApiVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/BatchGetApiVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).BatchGetApiVersions(ctx, req.(*BatchGetApiVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_BatchCreateApiVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateApiVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).BatchCreateApiVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/BatchCreateApiVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).BatchCreateApiVersions(ctx, req.(*BatchCreateApiVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_BatchUpdateApiVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateApiVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).BatchUpdateApiVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/BatchUpdateApiVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).BatchUpdateApiVersions(ctx, req.(*BatchUpdateApiVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_BatchDeleteApiVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteApiVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).BatchDeleteApiVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/BatchDeleteApiVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).BatchDeleteApiVersions(ctx, req.(*BatchDeleteApiVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_ListApiSpecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiSpecsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).ListApiSpecs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/ListApiSpecs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).ListApiSpecs(ctx, req.(*ListApiSpecsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_GetApiSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApiSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).GetApiSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/GetApiSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).GetApiSpec(ctx, req.(*GetApiSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_GetApiSpecContents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApiSpecContentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).GetApiSpecContents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/GetApiSpecContents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).GetApiSpecContents(ctx, req.(*GetApiSpecContentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_UploadApiSpecContents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RegistryServer).UploadApiSpecContents(&registryUploadApiSpecContentsServer{stream})
+}
+
+type Registry_UploadApiSpecContentsServer interface {
+	SendAndClose(*ApiSpec) error
+	Recv() (*UploadApiSpecContentsRequest, error)
+	grpc.ServerStream
+}
+
+type registryUploadApiSpecContentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *registryUploadApiSpecContentsServer) SendAndClose(m *ApiSpec) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *registryUploadApiSpecContentsServer) Recv() (*UploadApiSpecContentsRequest, error) {
+	m := new(UploadApiSpecContentsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Registry_DownloadApiSpecContents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadApiSpecContentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RegistryServer).DownloadApiSpecContents(m, &registryDownloadApiSpecContentsServer{stream})
+}
+
+type Registry_DownloadApiSpecContentsServer interface {
+	Send(*ContentsChunk) error
+	grpc.ServerStream
+}
+
+type registryDownloadApiSpecContentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *registryDownloadApiSpecContentsServer) Send(m *ContentsChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Registry_CreateApiSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateApiSpecRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).CreateApiSpec(ctx, in)
+		return srv.(RegistryServer).CreateApiSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/CreateApiSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).CreateApiSpec(ctx, req.(*CreateApiSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_UpdateApiSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateApiSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).UpdateApiSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/UpdateApiSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).UpdateApiSpec(ctx, req.(*UpdateApiSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_DeleteApiSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApiSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).DeleteApiSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/DeleteApiSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).DeleteApiSpec(ctx, req.(*DeleteApiSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_UndeleteApiSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteApiSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).UndeleteApiSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/UndeleteApiSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).UndeleteApiSpec(ctx, req.(*UndeleteApiSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_BatchGetApiSpecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetApiSpecsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).BatchGetApiSpecs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/BatchGetApiSpecs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).BatchGetApiSpecs(ctx, req.(*BatchGetApiSpecsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_BatchCreateApiSpecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateApiSpecsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).BatchCreateApiSpecs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/BatchCreateApiSpecs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).BatchCreateApiSpecs(ctx, req.(*BatchCreateApiSpecsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_BatchUpdateApiSpecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateApiSpecsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).BatchUpdateApiSpecs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/BatchUpdateApiSpecs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).BatchUpdateApiSpecs(ctx, req.(*BatchUpdateApiSpecsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_BatchDeleteApiSpecs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteApiSpecsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).BatchDeleteApiSpecs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/BatchDeleteApiSpecs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).BatchDeleteApiSpecs(ctx, req.(*BatchDeleteApiSpecsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_TagApiSpecRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagApiSpecRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).TagApiSpecRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/TagApiSpecRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).TagApiSpecRevision(ctx, req.(*TagApiSpecRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_ListApiSpecRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiSpecRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).ListApiSpecRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/ListApiSpecRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).ListApiSpecRevisions(ctx, req.(*ListApiSpecRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_RollbackApiSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackApiSpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).RollbackApiSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/RollbackApiSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).RollbackApiSpec(ctx, req.(*RollbackApiSpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_DeleteApiSpecRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApiSpecRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).DeleteApiSpecRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/DeleteApiSpecRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).DeleteApiSpecRevision(ctx, req.(*DeleteApiSpecRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_ListApiDeployments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiDeploymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).ListApiDeployments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/ListApiDeployments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).ListApiDeployments(ctx, req.(*ListApiDeploymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_GetApiDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApiDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).GetApiDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/GetApiDeployment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).GetApiDeployment(ctx, req.(*GetApiDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_CreateApiDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).CreateApiDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/CreateApiDeployment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).CreateApiDeployment(ctx, req.(*CreateApiDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_UpdateApiDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateApiDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).UpdateApiDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/UpdateApiDeployment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).UpdateApiDeployment(ctx, req.(*UpdateApiDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_DeleteApiDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApiDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).DeleteApiDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/DeleteApiDeployment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).DeleteApiDeployment(ctx, req.(*DeleteApiDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_UndeleteApiDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteApiDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).UndeleteApiDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/UndeleteApiDeployment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistryServer).UndeleteApiDeployment(ctx, req.(*UndeleteApiDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Registry_TagApiDeploymentRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagApiDeploymentRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistryServer).TagApiDeploymentRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/CreateApiSpec",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/TagApiDeploymentRevision",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).CreateApiSpec(ctx, req.(*CreateApiSpecRequest))
+		return srv.(RegistryServer).TagApiDeploymentRevision(ctx, req.(*TagApiDeploymentRevisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_UpdateApiSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateApiSpecRequest)
+func _Registry_ListApiDeploymentRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiDeploymentRevisionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).UpdateApiSpec(ctx, in)
+		return srv.(RegistryServer).ListApiDeploymentRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/UpdateApiSpec",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/ListApiDeploymentRevisions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).UpdateApiSpec(ctx, req.(*UpdateApiSpecRequest))
+		return srv.(RegistryServer).ListApiDeploymentRevisions(ctx, req.(*ListApiDeploymentRevisionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_DeleteApiSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteApiSpecRequest)
+func _Registry_RollbackApiDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackApiDeploymentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).DeleteApiSpec(ctx, in)
+		return srv.(RegistryServer).RollbackApiDeployment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/DeleteApiSpec",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/RollbackApiDeployment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).DeleteApiSpec(ctx, req.(*DeleteApiSpecRequest))
+		return srv.(RegistryServer).RollbackApiDeployment(ctx, req.(*RollbackApiDeploymentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_TagApiSpecRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TagApiSpecRevisionRequest)
+func _Registry_DeleteApiDeploymentRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApiDeploymentRevisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).TagApiSpecRevision(ctx, in)
+		return srv.(RegistryServer).DeleteApiDeploymentRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/TagApiSpecRevision",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/DeleteApiDeploymentRevision",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).TagApiSpecRevision(ctx, req.(*TagApiSpecRevisionRequest))
+		return srv.(RegistryServer).DeleteApiDeploymentRevision(ctx, req.(*DeleteApiDeploymentRevisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_ListApiSpecRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListApiSpecRevisionsRequest)
+func _Registry_ListArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArtifactsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).ListApiSpecRevisions(ctx, in)
+		return srv.(RegistryServer).ListArtifacts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/ListApiSpecRevisions",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/ListArtifacts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).ListApiSpecRevisions(ctx, req.(*ListApiSpecRevisionsRequest))
+		return srv.(RegistryServer).ListArtifacts(ctx, req.(*ListArtifactsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_RollbackApiSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RollbackApiSpecRequest)
+func _Registry_GetArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArtifactRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).RollbackApiSpec(ctx, in)
+		return srv.(RegistryServer).GetArtifact(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/RollbackApiSpec",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/GetArtifact",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).RollbackApiSpec(ctx, req.(*RollbackApiSpecRequest))
+		return srv.(RegistryServer).GetArtifact(ctx, req.(*GetArtifactRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_DeleteApiSpecRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteApiSpecRevisionRequest)
+func _Registry_GetArtifactContents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArtifactContentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).DeleteApiSpecRevision(ctx, in)
+		return srv.(RegistryServer).GetArtifactContents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/DeleteApiSpecRevision",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/GetArtifactContents",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).DeleteApiSpecRevision(ctx, req.(*DeleteApiSpecRevisionRequest))
+		return srv.(RegistryServer).GetArtifactContents(ctx, req.(*GetArtifactContentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_ListApiDeployments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListApiDeploymentsRequest)
+func _Registry_UploadArtifactContents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RegistryServer).UploadArtifactContents(&registryUploadArtifactContentsServer{stream})
+}
+
+type Registry_UploadArtifactContentsServer interface {
+	SendAndClose(*Artifact) error
+	Recv() (*UploadArtifactContentsRequest, error)
+	grpc.ServerStream
+}
+
+type registryUploadArtifactContentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *registryUploadArtifactContentsServer) SendAndClose(m *Artifact) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *registryUploadArtifactContentsServer) Recv() (*UploadArtifactContentsRequest, error) {
+	m := new(UploadArtifactContentsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Registry_DownloadArtifactContents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadArtifactContentsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RegistryServer).DownloadArtifactContents(m, &registryDownloadArtifactContentsServer{stream})
+}
+
+type Registry_DownloadArtifactContentsServer interface {
+	Send(*ContentsChunk) error
+	grpc.ServerStream
+}
+
+type registryDownloadArtifactContentsServer struct {
+	grpc.ServerStream
+}
+
+func (x *registryDownloadArtifactContentsServer) Send(m *ContentsChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Registry_CreateArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateArtifactRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).ListApiDeployments(ctx, in)
+		return srv.(RegistryServer).CreateArtifact(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/ListApiDeployments",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/CreateArtifact",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).ListApiDeployments(ctx, req.(*ListApiDeploymentsRequest))
+		return srv.(RegistryServer).CreateArtifact(ctx, req.(*CreateArtifactRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_GetApiDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetApiDeploymentRequest)
+func _Registry_ReplaceArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceArtifactRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).GetApiDeployment(ctx, in)
+		return srv.(RegistryServer).ReplaceArtifact(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/GetApiDeployment",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/ReplaceArtifact",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).GetApiDeployment(ctx, req.(*GetApiDeploymentRequest))
+		return srv.(RegistryServer).ReplaceArtifact(ctx, req.(*ReplaceArtifactRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_CreateApiDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateApiDeploymentRequest)
+func _Registry_UpdateArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateArtifactRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).CreateApiDeployment(ctx, in)
+		return srv.(RegistryServer).UpdateArtifact(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/CreateApiDeployment",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/UpdateArtifact",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).CreateApiDeployment(ctx, req.(*CreateApiDeploymentRequest))
+		return srv.(RegistryServer).UpdateArtifact(ctx, req.(*UpdateArtifactRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_UpdateApiDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateApiDeploymentRequest)
+func _Registry_DeleteArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteArtifactRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).UpdateApiDeployment(ctx, in)
+		return srv.(RegistryServer).DeleteArtifact(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/UpdateApiDeployment",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/DeleteArtifact",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).UpdateApiDeployment(ctx, req.(*UpdateApiDeploymentRequest))
+		return srv.(RegistryServer).DeleteArtifact(ctx, req.(*DeleteArtifactRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_DeleteApiDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteApiDeploymentRequest)
+func _Registry_UndeleteArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteArtifactRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).DeleteApiDeployment(ctx, in)
+		return srv.(RegistryServer).UndeleteArtifact(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/DeleteApiDeployment",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/UndeleteArtifact",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).DeleteApiDeployment(ctx, req.(*DeleteApiDeploymentRequest))
+		return srv.(RegistryServer).UndeleteArtifact(ctx, req.(*UndeleteArtifactRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_TagApiDeploymentRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TagApiDeploymentRevisionRequest)
+func _Registry_BatchGetArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetArtifactsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).TagApiDeploymentRevision(ctx, in)
+		return srv.(RegistryServer).BatchGetArtifacts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/TagApiDeploymentRevision",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/BatchGetArtifacts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).TagApiDeploymentRevision(ctx, req.(*TagApiDeploymentRevisionRequest))
+		return srv.(RegistryServer).BatchGetArtifacts(ctx, req.(*BatchGetArtifactsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_ListApiDeploymentRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListApiDeploymentRevisionsRequest)
+func _Registry_BatchCreateArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateArtifactsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).ListApiDeploymentRevisions(ctx, in)
+		return srv.(RegistryServer).BatchCreateArtifacts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/ListApiDeploymentRevisions",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/BatchCreateArtifacts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).ListApiDeploymentRevisions(ctx, req.(*ListApiDeploymentRevisionsRequest))
+		return srv.(RegistryServer).BatchCreateArtifacts(ctx, req.(*BatchCreateArtifactsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_RollbackApiDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RollbackApiDeploymentRequest)
+func _Registry_BatchReplaceArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchReplaceArtifactsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).RollbackApiDeployment(ctx, in)
+		return srv.(RegistryServer).BatchReplaceArtifacts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/RollbackApiDeployment",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/BatchReplaceArtifacts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).RollbackApiDeployment(ctx, req.(*RollbackApiDeploymentRequest))
+		return srv.(RegistryServer).BatchReplaceArtifacts(ctx, req.(*BatchReplaceArtifactsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_DeleteApiDeploymentRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteApiDeploymentRevisionRequest)
+func _Registry_BatchUpdateArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateArtifactsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).DeleteApiDeploymentRevision(ctx, in)
+		return srv.(RegistryServer).BatchUpdateArtifacts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/DeleteApiDeploymentRevision",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/BatchUpdateArtifacts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).DeleteApiDeploymentRevision(ctx, req.(*DeleteApiDeploymentRevisionRequest))
+		return srv.(RegistryServer).BatchUpdateArtifacts(ctx, req.(*BatchUpdateArtifactsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_ListArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListArtifactsRequest)
+func _Registry_BatchDeleteArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteArtifactsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).ListArtifacts(ctx, in)
+		return srv.(RegistryServer).BatchDeleteArtifacts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/ListArtifacts",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/BatchDeleteArtifacts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).ListArtifacts(ctx, req.(*ListArtifactsRequest))
+		return srv.(RegistryServer).BatchDeleteArtifacts(ctx, req.(*BatchDeleteArtifactsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_GetArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetArtifactRequest)
+func _Registry_TagArtifactRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagArtifactRevisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).GetArtifact(ctx, in)
+		return srv.(RegistryServer).TagArtifactRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/GetArtifact",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/TagArtifactRevision",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).GetArtifact(ctx, req.(*GetArtifactRequest))
+		return srv.(RegistryServer).TagArtifactRevision(ctx, req.(*TagArtifactRevisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_GetArtifactContents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetArtifactContentsRequest)
+func _Registry_ListArtifactRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListArtifactRevisionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).GetArtifactContents(ctx, in)
+		return srv.(RegistryServer).ListArtifactRevisions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/GetArtifactContents",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/ListArtifactRevisions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).GetArtifactContents(ctx, req.(*GetArtifactContentsRequest))
+		return srv.(RegistryServer).ListArtifactRevisions(ctx, req.(*ListArtifactRevisionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_CreateArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateArtifactRequest)
+func _Registry_RollbackArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackArtifactRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).CreateArtifact(ctx, in)
+		return srv.(RegistryServer).RollbackArtifact(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/CreateArtifact",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/RollbackArtifact",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).CreateArtifact(ctx, req.(*CreateArtifactRequest))
+		return srv.(RegistryServer).RollbackArtifact(ctx, req.(*RollbackArtifactRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_ReplaceArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReplaceArtifactRequest)
+func _Registry_DeleteArtifactRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteArtifactRevisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).ReplaceArtifact(ctx, in)
+		return srv.(RegistryServer).DeleteArtifactRevision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/ReplaceArtifact",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/DeleteArtifactRevision",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).ReplaceArtifact(ctx, req.(*ReplaceArtifactRequest))
+		return srv.(RegistryServer).DeleteArtifactRevision(ctx, req.(*DeleteArtifactRevisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Registry_DeleteArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteArtifactRequest)
+func _Registry_WatchResources_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchResourcesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RegistryServer).WatchResources(m, &registryWatchResourcesServer{stream})
+}
+
+type Registry_WatchResourcesServer interface {
+	Send(*Notification) error
+	grpc.ServerStream
+}
+
+type registryWatchResourcesServer struct {
+	grpc.ServerStream
+}
+
+func (x *registryWatchResourcesServer) Send(m *Notification) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Registry_SearchResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchResourcesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RegistryServer).DeleteArtifact(ctx, in)
+		return srv.(RegistryServer).SearchResources(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/DeleteArtifact",
+		FullMethod: "/google.cloud.apigeeregistry.v1.Registry/SearchResources",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RegistryServer).DeleteArtifact(ctx, req.(*DeleteArtifactRequest))
+		return srv.(RegistryServer).SearchResources(ctx, req.(*SearchResourcesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1305,6 +2655,26 @@ var Registry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteApi",
 			Handler:    _Registry_DeleteApi_Handler,
 		},
+		{
+			MethodName: "UndeleteApi",
+			Handler:    _Registry_UndeleteApi_Handler,
+		},
+		{
+			MethodName: "BatchGetApis",
+			Handler:    _Registry_BatchGetApis_Handler,
+		},
+		{
+			MethodName: "BatchCreateApis",
+			Handler:    _Registry_BatchCreateApis_Handler,
+		},
+		{
+			MethodName: "BatchUpdateApis",
+			Handler:    _Registry_BatchUpdateApis_Handler,
+		},
+		{
+			MethodName: "BatchDeleteApis",
+			Handler:    _Registry_BatchDeleteApis_Handler,
+		},
 		{
 			MethodName: "ListApiVersions",
 			Handler:    _Registry_ListApiVersions_Handler,
@@ -1325,6 +2695,26 @@ var Registry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteApiVersion",
 			Handler:    _Registry_DeleteApiVersion_Handler,
 		},
+		{
+			MethodName: "UndeleteApiVersion",
+			Handler:    _Registry_UndeleteApiVersion_Handler,
+		},
+		{
+			MethodName: "BatchGetApiVersions",
+			Handler:    _Registry_BatchGetApiVersions_Handler,
+		},
+		{
+			MethodName: "BatchCreateApiVersions",
+			Handler:    _Registry_BatchCreateApiVersions_Handler,
+		},
+		{
+			MethodName: "BatchUpdateApiVersions",
+			Handler:    _Registry_BatchUpdateApiVersions_Handler,
+		},
+		{
+			MethodName: "BatchDeleteApiVersions",
+			Handler:    _Registry_BatchDeleteApiVersions_Handler,
+		},
 		{
 			MethodName: "ListApiSpecs",
 			Handler:    _Registry_ListApiSpecs_Handler,
@@ -1349,6 +2739,26 @@ var Registry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteApiSpec",
 			Handler:    _Registry_DeleteApiSpec_Handler,
 		},
+		{
+			MethodName: "UndeleteApiSpec",
+			Handler:    _Registry_UndeleteApiSpec_Handler,
+		},
+		{
+			MethodName: "BatchGetApiSpecs",
+			Handler:    _Registry_BatchGetApiSpecs_Handler,
+		},
+		{
+			MethodName: "BatchCreateApiSpecs",
+			Handler:    _Registry_BatchCreateApiSpecs_Handler,
+		},
+		{
+			MethodName: "BatchUpdateApiSpecs",
+			Handler:    _Registry_BatchUpdateApiSpecs_Handler,
+		},
+		{
+			MethodName: "BatchDeleteApiSpecs",
+			Handler:    _Registry_BatchDeleteApiSpecs_Handler,
+		},
 		{
 			MethodName: "TagApiSpecRevision",
 			Handler:    _Registry_TagApiSpecRevision_Handler,
@@ -1385,6 +2795,10 @@ var Registry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteApiDeployment",
 			Handler:    _Registry_DeleteApiDeployment_Handler,
 		},
+		{
+			MethodName: "UndeleteApiDeployment",
+			Handler:    _Registry_UndeleteApiDeployment_Handler,
+		},
 		{
 			MethodName: "TagApiDeploymentRevision",
 			Handler:    _Registry_TagApiDeploymentRevision_Handler,
@@ -1421,11 +2835,85 @@ var Registry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReplaceArtifact",
 			Handler:    _Registry_ReplaceArtifact_Handler,
 		},
+		{
+			MethodName: "UpdateArtifact",
+			Handler:    _Registry_UpdateArtifact_Handler,
+		},
 		{
 			MethodName: "DeleteArtifact",
 			Handler:    _Registry_DeleteArtifact_Handler,
 		},
+		{
+			MethodName: "UndeleteArtifact",
+			Handler:    _Registry_UndeleteArtifact_Handler,
+		},
+		{
+			MethodName: "BatchGetArtifacts",
+			Handler:    _Registry_BatchGetArtifacts_Handler,
+		},
+		{
+			MethodName: "BatchCreateArtifacts",
+			Handler:    _Registry_BatchCreateArtifacts_Handler,
+		},
+		{
+			MethodName: "BatchReplaceArtifacts",
+			Handler:    _Registry_BatchReplaceArtifacts_Handler,
+		},
+		{
+			MethodName: "BatchUpdateArtifacts",
+			Handler:    _Registry_BatchUpdateArtifacts_Handler,
+		},
+		{
+			MethodName: "BatchDeleteArtifacts",
+			Handler:    _Registry_BatchDeleteArtifacts_Handler,
+		},
+		{
+			MethodName: "TagArtifactRevision",
+			Handler:    _Registry_TagArtifactRevision_Handler,
+		},
+		{
+			MethodName: "ListArtifactRevisions",
+			Handler:    _Registry_ListArtifactRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackArtifact",
+			Handler:    _Registry_RollbackArtifact_Handler,
+		},
+		{
+			MethodName: "DeleteArtifactRevision",
+			Handler:    _Registry_DeleteArtifactRevision_Handler,
+		},
+		{
+			MethodName: "SearchResources",
+			Handler:    _Registry_SearchResources_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UploadApiSpecContents",
+			Handler:       _Registry_UploadApiSpecContents_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadApiSpecContents",
+			Handler:       _Registry_DownloadApiSpecContents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadArtifactContents",
+			Handler:       _Registry_UploadArtifactContents_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadArtifactContents",
+			Handler:       _Registry_DownloadArtifactContents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchResources",
+			Handler:       _Registry_WatchResources_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "google/cloud/apigeeregistry/v1/registry_service.proto",
 }
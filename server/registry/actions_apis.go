@@ -16,7 +16,6 @@ package registry
 
 import (
 	"context"
-	"sync"
 
 	"github.com/apigee/registry/rpc"
 	"github.com/apigee/registry/server/registry/internal/storage"
@@ -42,12 +41,19 @@ func (s *RegistryServer) CreateApi(ctx context.Context, req *rpc.CreateApiReques
 }
 
 func (s *RegistryServer) createApi(ctx context.Context, name names.Api, body *rpc.Api) (*rpc.Api, error) {
-	db, err := s.getStorageClient(ctx)
-	if err != nil {
-		return nil, status.Error(codes.Unavailable, err.Error())
+	var message *rpc.Api
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) (err error) {
+		message, err = s.insertApi(ctx, db, name, body)
+		return err
+	}); err != nil {
+		return nil, err
 	}
-	defer db.Close()
 
+	return message, nil
+}
+
+// insertApi creates an API using a client for a transaction that may have other changes.
+func (s *RegistryServer) insertApi(ctx context.Context, db *storage.Client, name names.Api, body *rpc.Api) (*rpc.Api, error) {
 	if _, err := db.GetApi(ctx, name); err == nil {
 		return nil, status.Errorf(codes.AlreadyExists, "API %q already exists", name)
 	} else if !isNotFound(err) {
@@ -68,16 +74,18 @@ func (s *RegistryServer) createApi(ctx context.Context, name names.Api, body *rp
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if err := db.SaveApi(ctx, api); err != nil {
-		return nil, err
-	}
-
 	message, err := api.Message()
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	s.notify(ctx, rpc.Notification_CREATED, name.String())
+	if err := db.SaveApi(ctx, api); err != nil {
+		return nil, err
+	}
+	if err := s.notify(ctx, db, rpc.Notification_CREATED, name.String(), withSnapshot(message)); err != nil {
+		return nil, err
+	}
+
 	return message, nil
 }
 
@@ -87,7 +95,6 @@ func (s *RegistryServer) DeleteApi(ctx context.Context, req *rpc.DeleteApiReques
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	name, err := names.ParseApi(req.GetName())
 	if err != nil {
@@ -99,21 +106,58 @@ func (s *RegistryServer) DeleteApi(ctx context.Context, req *rpc.DeleteApiReques
 		return nil, err
 	}
 
-	if err := db.DeleteApi(ctx, name, req.GetForce()); err != nil {
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := checkETag(name.String(), req.GetEtag(), func() (etagged, error) {
+			return db.ForUpdate().GetApi(ctx, name)
+		}); err != nil {
+			return err
+		}
+		if err := db.DeleteApi(ctx, name, req.GetForce()); err != nil {
+			return err
+		}
+		return s.notify(ctx, db, rpc.Notification_DELETED, name.String())
+	}); err != nil {
 		return nil, err
 	}
 
-	s.notify(ctx, rpc.Notification_DELETED, name.String())
 	return &emptypb.Empty{}, nil
 }
 
+// UndeleteApi handles the corresponding API request.
+func (s *RegistryServer) UndeleteApi(ctx context.Context, req *rpc.UndeleteApiRequest) (*rpc.Api, error) {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	name, err := names.ParseApi(req.GetName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Restoration should only succeed when the parent exists.
+	if _, err := db.GetProject(ctx, name.Project()); err != nil {
+		return nil, undeleteParentError(err, name.String())
+	}
+
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := db.UndeleteApi(ctx, name); err != nil {
+			return err
+		}
+		return s.notify(ctx, db, rpc.Notification_CREATED, name.String())
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.GetApi(ctx, &rpc.GetApiRequest{Name: name.String()})
+}
+
 // GetApi handles the corresponding API request.
 func (s *RegistryServer) GetApi(ctx context.Context, req *rpc.GetApiRequest) (*rpc.Api, error) {
 	db, err := s.getStorageClient(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	name, err := names.ParseApi(req.GetName())
 	if err != nil {
@@ -139,7 +183,6 @@ func (s *RegistryServer) ListApis(ctx context.Context, req *rpc.ListApisRequest)
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	if req.GetPageSize() < 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid page_size %d: must not be negative", req.GetPageSize())
@@ -155,9 +198,11 @@ func (s *RegistryServer) ListApis(ctx context.Context, req *rpc.ListApisRequest)
 	}
 
 	listing, err := db.ListApis(ctx, parent, storage.PageOptions{
-		Size:   req.GetPageSize(),
-		Filter: req.GetFilter(),
-		Token:  req.GetPageToken(),
+		Size:        req.GetPageSize(),
+		Filter:      req.GetFilter(),
+		Order:       req.GetOrderBy(),
+		Token:       req.GetPageToken(),
+		ShowDeleted: req.GetShowDeleted(),
 	})
 	if err != nil {
 		return nil, err
@@ -178,16 +223,8 @@ func (s *RegistryServer) ListApis(ctx context.Context, req *rpc.ListApisRequest)
 	return response, nil
 }
 
-var updateApiMutex sync.Mutex
-
 // UpdateApi handles the corresponding API request.
 func (s *RegistryServer) UpdateApi(ctx context.Context, req *rpc.UpdateApiRequest) (*rpc.Api, error) {
-	db, err := s.getStorageClient(ctx)
-	if err != nil {
-		return nil, status.Error(codes.Unavailable, err.Error())
-	}
-	defer db.Close()
-
 	if req.GetApi() == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid api %v: body must be provided", req.GetApi())
 	} else if err := models.ValidateMask(req.GetApi(), req.GetUpdateMask()); err != nil {
@@ -199,37 +236,39 @@ func (s *RegistryServer) UpdateApi(ctx context.Context, req *rpc.UpdateApiReques
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if req.GetAllowMissing() {
-		// Prevent a race condition that can occur when two updates are made
-		// to the same non-existent resource. The db.Get...() call returns
-		// NotFound for both updates, and after one creates the resource,
-		// the other creation fails. The lock() prevents this by serializing
-		// the get and create operations. Future updates could improve this
-		// with improvements closer to the database level.
-		updateApiMutex.Lock()
-		defer updateApiMutex.Unlock()
-	}
+	var message *rpc.Api
+	if err := s.upsert(ctx, func(ctx context.Context, db *storage.Client) error {
+		api, err := db.ForUpdate().GetApi(ctx, name)
+		if req.GetAllowMissing() && isNotFound(err) && req.GetApi().GetEtag() == "" {
+			message, err = s.insertApi(ctx, db, name, req.GetApi())
+			return err
+		} else if err != nil {
+			return err
+		}
 
-	api, err := db.GetApi(ctx, name)
-	if req.GetAllowMissing() && isNotFound(err) {
-		return s.createApi(ctx, name, req.GetApi())
-	} else if err != nil {
-		return nil, err
-	}
+		if err := checkETag(name.String(), req.GetApi().GetEtag(), func() (etagged, error) {
+			return api, nil
+		}); err != nil {
+			return err
+		}
 
-	if err := api.Update(req.GetApi(), models.ExpandMask(req.GetApi(), req.GetUpdateMask())); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
+		mask := models.ExpandMask(req.GetApi(), req.GetUpdateMask())
+		if err := api.Update(req.GetApi(), mask); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
 
-	if err := db.SaveApi(ctx, api); err != nil {
-		return nil, err
-	}
+		message, err = api.Message()
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
 
-	message, err := api.Message()
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		if err := db.SaveApi(ctx, api); err != nil {
+			return err
+		}
+		return s.notify(ctx, db, rpc.Notification_UPDATED, name.String(), withSnapshot(message), withUpdateMask(mask))
+	}); err != nil {
+		return nil, err
 	}
 
-	s.notify(ctx, rpc.Notification_UPDATED, name.String())
 	return message, nil
 }
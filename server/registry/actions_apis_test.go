@@ -88,7 +88,7 @@ func TestCreateApi(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, created, opts) {
@@ -334,7 +334,7 @@ func TestGetApi(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, got, opts) {
@@ -492,6 +492,51 @@ func TestListApis(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "label filtering",
+			seed: []*rpc.Api{
+				{
+					Name:   "projects/my-project/locations/global/apis/api1",
+					Labels: map[string]string{"team": "a", "tier": "1"},
+				},
+				{
+					Name:   "projects/my-project/locations/global/apis/api2",
+					Labels: map[string]string{"team": "b", "tier": "1"},
+				},
+				{
+					Name:   "projects/my-project/locations/global/apis/api3",
+					Labels: map[string]string{"tier": "a"},
+				},
+			},
+			req: &rpc.ListApisRequest{
+				Parent: "projects/my-project/locations/global",
+				Filter: "has(labels.team) && labels.team == 'a'",
+			},
+			want: &rpc.ListApisResponse{
+				Apis: []*rpc.Api{
+					{
+						Name:   "projects/my-project/locations/global/apis/api1",
+						Labels: map[string]string{"team": "a", "tier": "1"},
+					},
+				},
+			},
+		},
+		{
+			desc: "create time filtering",
+			seed: []*rpc.Api{
+				{Name: "projects/my-project/locations/global/apis/api1"},
+				{Name: "projects/my-project/locations/global/apis/api2"},
+			},
+			req: &rpc.ListApisRequest{
+				Parent: "projects/my-project/locations/global",
+				Filter: "create_time > timestamp('2021-01-01T00:00:00Z') && api_id.startsWith('api2')",
+			},
+			want: &rpc.ListApisResponse{
+				Apis: []*rpc.Api{
+					{Name: "projects/my-project/locations/global/apis/api2"},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -510,7 +555,7 @@ func TestListApis(t *testing.T) {
 			opts := cmp.Options{
 				protocmp.Transform(),
 				protocmp.IgnoreFields(new(rpc.ListApisResponse), "next_page_token"),
-				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time", "etag"),
 				protocmp.SortRepeated(func(a, b *rpc.Api) bool {
 					return a.GetName() < b.GetName()
 				}),
@@ -564,6 +609,30 @@ func TestListApisResponseCodes(t *testing.T) {
 			},
 			want: codes.InvalidArgument,
 		},
+		{
+			desc: "unknown order_by field",
+			req: &rpc.ListApisRequest{
+				Parent:  "projects/-/locations/global",
+				OrderBy: "unknown_field",
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "unsortable order_by field",
+			req: &rpc.ListApisRequest{
+				Parent:  "projects/-/locations/global",
+				OrderBy: "labels",
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "invalid order_by direction",
+			req: &rpc.ListApisRequest{
+				Parent:  "projects/-/locations/global",
+				OrderBy: "display_name descending",
+			},
+			want: codes.InvalidArgument,
+		},
 	}
 
 	for _, test := range tests {
@@ -677,7 +746,7 @@ func TestListApisSequence(t *testing.T) {
 
 	opts := cmp.Options{
 		protocmp.Transform(),
-		protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time"),
+		protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time", "etag"),
 		cmpopts.SortSlices(func(a, b *rpc.Api) bool {
 			return a.GetName() < b.GetName()
 		}),
@@ -690,6 +759,102 @@ func TestListApisSequence(t *testing.T) {
 
 // This test prevents the list sequence from ending before a known filter match is listed.
 // For simplicity, it does not guarantee the resource is returned on a later page.
+func TestListApisOrdering(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	seed := []*rpc.Api{
+		{Name: "projects/my-project/locations/global/apis/api1", DisplayName: "b"},
+		{Name: "projects/my-project/locations/global/apis/api2", DisplayName: "c"},
+		{Name: "projects/my-project/locations/global/apis/api3", DisplayName: "a"},
+		{Name: "projects/my-project/locations/global/apis/api4", DisplayName: "b"},
+	}
+	if err := seeder.SeedApis(ctx, server, seed...); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	req := &rpc.ListApisRequest{
+		Parent:   "projects/my-project/locations/global",
+		PageSize: 1,
+		OrderBy:  "display_name desc",
+	}
+
+	listed := make([]string, 0, len(seed))
+	for {
+		got, err := server.ListApis(ctx, req)
+		if err != nil {
+			t.Fatalf("ListApis(%+v) returned error: %s", req, err)
+		}
+		for _, api := range got.GetApis() {
+			listed = append(listed, api.GetName())
+		}
+		if got.GetNextPageToken() == "" {
+			break
+		}
+		req.PageToken = got.GetNextPageToken()
+	}
+
+	// Resources with equal values are ordered by name.
+	want := []string{
+		"projects/my-project/locations/global/apis/api2",
+		"projects/my-project/locations/global/apis/api1",
+		"projects/my-project/locations/global/apis/api4",
+		"projects/my-project/locations/global/apis/api3",
+	}
+	if diff := cmp.Diff(want, listed); diff != "" {
+		t.Errorf("ListApis(%+v) returned unexpected order (-want +got):\n%s", req, diff)
+	}
+
+	req.OrderBy = "update_time"
+	if _, err := server.ListApis(ctx, req); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListApis(%+v) returned status code %q, want %q: %v", req, status.Code(err), codes.InvalidArgument, err)
+	}
+}
+
+func TestListApisSequenceAfterDelete(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	seed := []*rpc.Api{
+		{Name: "projects/my-project/locations/global/apis/api1"},
+		{Name: "projects/my-project/locations/global/apis/api2"},
+		{Name: "projects/my-project/locations/global/apis/api3"},
+	}
+	if err := seeder.SeedApis(ctx, server, seed...); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	req := &rpc.ListApisRequest{
+		Parent:   "projects/my-project/locations/global",
+		PageSize: 1,
+	}
+
+	first, err := server.ListApis(ctx, req)
+	if err != nil {
+		t.Fatalf("ListApis(%+v) returned error: %s", req, err)
+	}
+
+	// Deleting a listed resource should not cause the next page to skip any resources.
+	for _, api := range first.GetApis() {
+		if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: api.GetName()}); err != nil {
+			t.Fatalf("Setup: DeleteApi(%q) returned error: %s", api.GetName(), err)
+		}
+	}
+
+	req.PageToken = first.GetNextPageToken()
+	got, err := server.ListApis(ctx, req)
+	if err != nil {
+		t.Fatalf("ListApis(%+v) returned error: %s", req, err)
+	}
+
+	want := []*rpc.Api{{Name: "projects/my-project/locations/global/apis/api2"}}
+	opts := cmp.Options{
+		protocmp.Transform(),
+		protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time", "etag"),
+	}
+	if !cmp.Equal(want, got.GetApis(), opts) {
+		t.Errorf("ListApis(%+v) returned unexpected diff (-want +got):\n%s", req, cmp.Diff(want, got.GetApis(), opts))
+	}
+}
+
 func TestListApisLargeCollectionFiltering(t *testing.T) {
 	ctx := context.Background()
 	server := defaultTestServer(t)
@@ -862,7 +1027,7 @@ func TestUpdateApi(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Api), "create_time", "update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, updated, opts) {
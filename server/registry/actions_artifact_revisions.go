@@ -0,0 +1,242 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/apigee/registry/server/registry/names"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// ListArtifactRevisions handles the corresponding API request.
+func (s *RegistryServer) ListArtifactRevisions(ctx context.Context, req *rpc.ListArtifactRevisionsRequest) (*rpc.ListArtifactRevisionsResponse, error) {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	if req.GetPageSize() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page_size %d: must not be negative", req.GetPageSize())
+	} else if req.GetPageSize() > 1000 {
+		req.PageSize = 1000
+	} else if req.GetPageSize() == 0 {
+		req.PageSize = 50
+	}
+
+	parent, err := names.ParseArtifact(req.GetName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Listing should only succeed on artifacts that currently exist.
+	if _, err := db.GetArtifact(ctx, parent); err != nil {
+		return nil, err
+	}
+
+	listing, err := db.ListArtifactRevisions(ctx, parent, storage.PageOptions{
+		Size:  req.GetPageSize(),
+		Token: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &rpc.ListArtifactRevisionsResponse{
+		Artifacts:     make([]*rpc.Artifact, len(listing.Artifacts)),
+		NextPageToken: listing.Token,
+	}
+
+	revisionIDs := make([]string, len(listing.Artifacts))
+	for i, artifact := range listing.Artifacts {
+		revisionIDs[i] = artifact.RevisionID
+	}
+
+	tags, err := db.GetArtifactRevisionTags(ctx, revisionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	tagsByRev := artifactTagsByRevision(tags)
+	for i, artifact := range listing.Artifacts {
+		response.Artifacts[i], err = artifact.Message(artifact.RevisionName(), tagsByRev[artifact.RevisionName()])
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return response, nil
+}
+
+// DeleteArtifactRevision handles the corresponding API request.
+func (s *RegistryServer) DeleteArtifactRevision(ctx context.Context, req *rpc.DeleteArtifactRevisionRequest) (*rpc.Artifact, error) {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	name, err := names.ParseArtifactRevision(req.GetName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	revision, err := db.GetArtifactRevision(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Use the retrieved revision ID, which is necessary to ensure the actual revision is deleted.
+	name = name.Artifact().Revision(revision.RevisionID)
+
+	// The only revision of an artifact can't be deleted, because the artifact would be left without contents.
+	listing, err := db.ListArtifactRevisions(ctx, name.Artifact(), storage.PageOptions{Size: 1})
+	if err != nil {
+		return nil, err
+	} else if listing.Token == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot delete the only revision of %q, delete the artifact instead", name.Artifact())
+	}
+
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := db.DeleteArtifactRevision(ctx, name); err != nil {
+			return err
+		}
+		return s.notify(ctx, db, rpc.Notification_DELETED, name.String())
+	}); err != nil {
+		return nil, err
+	}
+
+	// Return the latest revision of the current artifact.
+	return s.getArtifact(ctx, name.Artifact())
+}
+
+// TagArtifactRevision handles the corresponding API request.
+func (s *RegistryServer) TagArtifactRevision(ctx context.Context, req *rpc.TagArtifactRevisionRequest) (*rpc.Artifact, error) {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	if req.GetTag() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag %q, must not be empty", req.GetTag())
+	} else if len(req.GetTag()) > 40 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag %q, must be 40 characters or less", req.GetTag())
+	}
+
+	// Parse the requested artifact revision name, which may include a tag name.
+	name, err := names.ParseArtifactRevision(req.GetName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	revision, err := db.GetArtifactRevision(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Use the retrieved revision ID, which is necessary to ensure the new tag is associated with a revision ID, not another tag.
+	name = name.Artifact().Revision(revision.RevisionID)
+
+	tag := models.NewArtifactRevisionTag(name, req.GetTag())
+	var message *rpc.Artifact
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := db.SaveArtifactRevisionTag(ctx, tag); err != nil {
+			return err
+		}
+
+		tags, err := artifactRevisionTags(ctx, db, name)
+		if err != nil {
+			return err
+		}
+
+		message, err = revision.Message(tag.String(), tags)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		return s.notify(ctx, db, rpc.Notification_UPDATED, name.String(),
+			withSnapshot(message), withUpdateMask(&fieldmaskpb.FieldMask{Paths: []string{"revision_tags"}}))
+	}); err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+// RollbackArtifact handles the corresponding API request.
+func (s *RegistryServer) RollbackArtifact(ctx context.Context, req *rpc.RollbackArtifactRequest) (*rpc.Artifact, error) {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	if req.GetRevisionId() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid revision ID %q, must not be empty", req.GetRevisionId())
+	}
+
+	parent, err := names.ParseArtifact(req.GetName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Get the target artifact revision to use as a base for the new rollback revision.
+	name := parent.Revision(req.GetRevisionId())
+	target, err := db.GetArtifactRevision(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	// The revision that is current before the rollback is reported in the notification.
+	current, err := db.GetArtifact(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+
+	// Save a new rollback revision based on the target revision.
+	rollback := target.NewRevision()
+	message, err := rollback.Message(rollback.RevisionName(), []string{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := db.SaveArtifactRevision(ctx, rollback); err != nil {
+			return err
+		}
+
+		blob, err := db.GetArtifactRevisionContents(ctx, name)
+		if err != nil {
+			return err
+		}
+
+		// The rollback revision shares the blob of the target revision.
+		if err := db.SaveArtifactRevisionContents(ctx, rollback, blob.Contents); err != nil {
+			return err
+		}
+
+		if err := s.pruneArtifactRevisions(ctx, db, parent); err != nil {
+			return err
+		}
+		return s.notify(ctx, db, rpc.Notification_CREATED, rollback.RevisionName(),
+			withSnapshot(message), withPreviousRevision(current.RevisionID))
+	}); err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
@@ -0,0 +1,364 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/test/seeder"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const revisedArtifactName = "projects/my-project/locations/global/apis/my-api/artifacts/my-artifact"
+
+// seedArtifactRevisions creates an artifact with a revision for each of the provided contents.
+// The revisions are returned in order of creation.
+func seedArtifactRevisions(ctx context.Context, t *testing.T, server *RegistryServer, contents ...string) []*rpc.Artifact {
+	t.Helper()
+	if err := seeder.SeedArtifacts(ctx, server, &rpc.Artifact{
+		Name:     revisedArtifactName,
+		MimeType: "text/plain",
+		Contents: []byte(contents[0]),
+	}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	first, err := server.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: revisedArtifactName})
+	if err != nil {
+		t.Fatalf("Setup: GetArtifact() returned error: %s", err)
+	}
+
+	revisions := []*rpc.Artifact{first}
+	for _, c := range contents[1:] {
+		revision, err := server.ReplaceArtifact(ctx, &rpc.ReplaceArtifactRequest{
+			Artifact: &rpc.Artifact{
+				Name:     revisedArtifactName,
+				MimeType: "text/plain",
+				Contents: []byte(c),
+			},
+		})
+		if err != nil {
+			t.Fatalf("Setup: ReplaceArtifact() returned error: %s", err)
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions
+}
+
+func artifactRevisionContents(ctx context.Context, t *testing.T, server *RegistryServer, name string) string {
+	t.Helper()
+	body, err := server.GetArtifactContents(ctx, &rpc.GetArtifactContentsRequest{Name: name})
+	if err != nil {
+		t.Fatalf("GetArtifactContents(%q) returned error: %s", name, err)
+	}
+	return string(body.GetData())
+}
+
+func TestReplaceArtifactRevisions(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	revisions := seedArtifactRevisions(ctx, t, server, "one", "two")
+
+	if revisions[0].GetRevisionId() == revisions[1].GetRevisionId() {
+		t.Fatalf("ReplaceArtifact() with new contents kept revision %q", revisions[0].GetRevisionId())
+	}
+	if !revisions[0].GetCreateTime().AsTime().Equal(revisions[1].GetCreateTime().AsTime()) {
+		t.Errorf("ReplaceArtifact() changed create_time from %s to %s", revisions[0].GetCreateTime().AsTime(), revisions[1].GetCreateTime().AsTime())
+	}
+
+	t.Run("same contents", func(t *testing.T) {
+		req := &rpc.ReplaceArtifactRequest{
+			Artifact: &rpc.Artifact{
+				Name:     revisedArtifactName,
+				MimeType: "text/plain",
+				Contents: []byte("two"),
+				Labels:   map[string]string{"reviewed": "true"},
+			},
+		}
+
+		got, err := server.ReplaceArtifact(ctx, req)
+		if err != nil {
+			t.Fatalf("ReplaceArtifact(%+v) returned error: %s", req, err)
+		}
+		if got.GetRevisionId() != revisions[1].GetRevisionId() {
+			t.Errorf("ReplaceArtifact(%+v) returned revision %q, want %q", req, got.GetRevisionId(), revisions[1].GetRevisionId())
+		}
+
+		listing, err := server.ListArtifactRevisions(ctx, &rpc.ListArtifactRevisionsRequest{Name: revisedArtifactName})
+		if err != nil {
+			t.Fatalf("ListArtifactRevisions() returned error: %s", err)
+		}
+		if len(listing.GetArtifacts()) != 2 {
+			t.Errorf("ListArtifactRevisions() returned %d revisions, want 2", len(listing.GetArtifacts()))
+		}
+	})
+
+	t.Run("revision contents", func(t *testing.T) {
+		for i, want := range []string{"one", "two"} {
+			name := fmt.Sprintf("%s@%s", revisedArtifactName, revisions[i].GetRevisionId())
+			if got := artifactRevisionContents(ctx, t, server, name); got != want {
+				t.Errorf("GetArtifactContents(%q) returned %q, want %q", name, got, want)
+			}
+		}
+		if got := artifactRevisionContents(ctx, t, server, revisedArtifactName); got != "two" {
+			t.Errorf("GetArtifactContents(%q) returned %q, want %q", revisedArtifactName, got, "two")
+		}
+	})
+}
+
+func TestListArtifactRevisions(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	revisions := seedArtifactRevisions(ctx, t, server, "one", "two", "three")
+
+	want := make([]string, 0, len(revisions))
+	for i := len(revisions) - 1; i >= 0; i-- {
+		want = append(want, fmt.Sprintf("%s@%s", revisedArtifactName, revisions[i].GetRevisionId()))
+	}
+
+	got := make([]string, 0, len(revisions))
+	req := &rpc.ListArtifactRevisionsRequest{Name: revisedArtifactName, PageSize: 2}
+	for {
+		resp, err := server.ListArtifactRevisions(ctx, req)
+		if err != nil {
+			t.Fatalf("ListArtifactRevisions(%+v) returned error: %s", req, err)
+		}
+		for _, a := range resp.GetArtifacts() {
+			got = append(got, a.GetName())
+		}
+		if resp.GetNextPageToken() == "" {
+			break
+		}
+		req.PageToken = resp.GetNextPageToken()
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ListArtifactRevisions() returned unexpected diff (-want +got):\n%s", diff)
+	}
+
+	t.Run("missing artifact", func(t *testing.T) {
+		req := &rpc.ListArtifactRevisionsRequest{Name: "projects/my-project/locations/global/apis/my-api/artifacts/missing"}
+		if _, err := server.ListArtifactRevisions(ctx, req); status.Code(err) != codes.NotFound {
+			t.Errorf("ListArtifactRevisions(%+v) returned status code %q, want %q: %v", req, status.Code(err), codes.NotFound, err)
+		}
+	})
+
+	t.Run("ListArtifacts", func(t *testing.T) {
+		resp, err := server.ListArtifacts(ctx, &rpc.ListArtifactsRequest{Parent: "projects/my-project/locations/global/apis/my-api"})
+		if err != nil {
+			t.Fatalf("ListArtifacts() returned error: %s", err)
+		}
+		if len(resp.GetArtifacts()) != 1 {
+			t.Fatalf("ListArtifacts() returned %d artifacts, want 1", len(resp.GetArtifacts()))
+		}
+		if got := resp.GetArtifacts()[0].GetRevisionId(); got != revisions[2].GetRevisionId() {
+			t.Errorf("ListArtifacts() returned revision %q, want %q", got, revisions[2].GetRevisionId())
+		}
+	})
+}
+
+func TestTagArtifactRevision(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	revisions := seedArtifactRevisions(ctx, t, server, "one", "two")
+
+	req := &rpc.TagArtifactRevisionRequest{
+		Name: fmt.Sprintf("%s@%s", revisedArtifactName, revisions[0].GetRevisionId()),
+		Tag:  "stable",
+	}
+	got, err := server.TagArtifactRevision(ctx, req)
+	if err != nil {
+		t.Fatalf("TagArtifactRevision(%+v) returned error: %s", req, err)
+	}
+
+	if want := revisedArtifactName + "@stable"; got.GetName() != want {
+		t.Errorf("TagArtifactRevision(%+v) returned name %q, want %q", req, got.GetName(), want)
+	}
+	if diff := cmp.Diff([]string{"stable"}, got.GetRevisionTags()); diff != "" {
+		t.Errorf("TagArtifactRevision(%+v) returned unexpected tags (-want +got):\n%s", req, diff)
+	}
+
+	t.Run("GetArtifact", func(t *testing.T) {
+		got, err := server.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: revisedArtifactName + "@stable"})
+		if err != nil {
+			t.Fatalf("GetArtifact() returned error: %s", err)
+		}
+		if got.GetRevisionId() != revisions[0].GetRevisionId() {
+			t.Errorf("GetArtifact() returned revision %q, want %q", got.GetRevisionId(), revisions[0].GetRevisionId())
+		}
+		if contents := artifactRevisionContents(ctx, t, server, revisedArtifactName+"@stable"); contents != "one" {
+			t.Errorf("GetArtifactContents() returned %q, want %q", contents, "one")
+		}
+	})
+
+	t.Run("ListArtifactRevisions", func(t *testing.T) {
+		resp, err := server.ListArtifactRevisions(ctx, &rpc.ListArtifactRevisionsRequest{Name: revisedArtifactName})
+		if err != nil {
+			t.Fatalf("ListArtifactRevisions() returned error: %s", err)
+		}
+		want := [][]string{nil, {"stable"}}
+		got := [][]string{resp.GetArtifacts()[0].GetRevisionTags(), resp.GetArtifacts()[1].GetRevisionTags()}
+		if diff := cmp.Diff(want, got); diff != "" {
+			t.Errorf("ListArtifactRevisions() returned unexpected tags (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("invalid tag", func(t *testing.T) {
+		req := &rpc.TagArtifactRevisionRequest{Name: req.GetName()}
+		if _, err := server.TagArtifactRevision(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("TagArtifactRevision(%+v) returned status code %q, want %q: %v", req, status.Code(err), codes.InvalidArgument, err)
+		}
+	})
+}
+
+func TestRollbackArtifact(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	revisions := seedArtifactRevisions(ctx, t, server, "one", "two")
+
+	req := &rpc.RollbackArtifactRequest{
+		Name:       revisedArtifactName,
+		RevisionId: revisions[0].GetRevisionId(),
+	}
+	got, err := server.RollbackArtifact(ctx, req)
+	if err != nil {
+		t.Fatalf("RollbackArtifact(%+v) returned error: %s", req, err)
+	}
+
+	for _, r := range revisions {
+		if got.GetRevisionId() == r.GetRevisionId() {
+			t.Errorf("RollbackArtifact(%+v) returned existing revision %q", req, r.GetRevisionId())
+		}
+	}
+	opts := cmp.Options{
+		protocmp.Transform(),
+		protocmp.IgnoreFields(new(rpc.Artifact), "name", "revision_id", "revision_create_time", "update_time", "etag"),
+	}
+	if diff := cmp.Diff(revisions[0], got, opts); diff != "" {
+		t.Errorf("RollbackArtifact(%+v) returned unexpected diff (-want +got):\n%s", req, diff)
+	}
+
+	current, err := server.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: revisedArtifactName})
+	if err != nil {
+		t.Fatalf("GetArtifact() returned error: %s", err)
+	}
+	if current.GetRevisionId() != got.GetRevisionId() {
+		t.Errorf("GetArtifact() returned revision %q, want %q", current.GetRevisionId(), got.GetRevisionId())
+	}
+	if contents := artifactRevisionContents(ctx, t, server, revisedArtifactName); contents != "one" {
+		t.Errorf("GetArtifactContents() returned %q, want %q", contents, "one")
+	}
+
+	t.Run("missing revision", func(t *testing.T) {
+		req := &rpc.RollbackArtifactRequest{Name: revisedArtifactName, RevisionId: "missing"}
+		if _, err := server.RollbackArtifact(ctx, req); status.Code(err) != codes.NotFound {
+			t.Errorf("RollbackArtifact(%+v) returned status code %q, want %q: %v", req, status.Code(err), codes.NotFound, err)
+		}
+	})
+}
+
+func TestDeleteArtifactRevision(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	revisions := seedArtifactRevisions(ctx, t, server, "one", "two")
+
+	req := &rpc.DeleteArtifactRevisionRequest{
+		Name: fmt.Sprintf("%s@%s", revisedArtifactName, revisions[1].GetRevisionId()),
+	}
+	got, err := server.DeleteArtifactRevision(ctx, req)
+	if err != nil {
+		t.Fatalf("DeleteArtifactRevision(%+v) returned error: %s", req, err)
+	}
+	if got.GetRevisionId() != revisions[0].GetRevisionId() {
+		t.Errorf("DeleteArtifactRevision(%+v) returned revision %q, want %q", req, got.GetRevisionId(), revisions[0].GetRevisionId())
+	}
+
+	if _, err := server.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: req.GetName()}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetArtifact(%q) returned status code %q, want %q: %v", req.GetName(), status.Code(err), codes.NotFound, err)
+	}
+
+	t.Run("only revision", func(t *testing.T) {
+		req := &rpc.DeleteArtifactRevisionRequest{
+			Name: fmt.Sprintf("%s@%s", revisedArtifactName, revisions[0].GetRevisionId()),
+		}
+		if _, err := server.DeleteArtifactRevision(ctx, req); status.Code(err) != codes.FailedPrecondition {
+			t.Errorf("DeleteArtifactRevision(%+v) returned status code %q, want %q: %v", req, status.Code(err), codes.FailedPrecondition, err)
+		}
+	})
+}
+
+func TestArtifactRevisionLimit(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	revisions := seedArtifactRevisions(ctx, t, server, "one", "two")
+
+	if _, err := server.TagArtifactRevision(ctx, &rpc.TagArtifactRevisionRequest{
+		Name: fmt.Sprintf("%s@%s", revisedArtifactName, revisions[0].GetRevisionId()),
+		Tag:  "first",
+	}); err != nil {
+		t.Fatalf("Setup: TagArtifactRevision() returned error: %s", err)
+	}
+
+	if _, err := server.UpdateProject(ctx, &rpc.UpdateProjectRequest{
+		Project:    &rpc.Project{Name: "projects/my-project", ArtifactRevisionLimit: 2},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"artifact_revision_limit"}},
+	}); err != nil {
+		t.Fatalf("Setup: UpdateProject() returned error: %s", err)
+	}
+
+	for _, c := range []string{"three", "four"} {
+		r, err := server.ReplaceArtifact(ctx, &rpc.ReplaceArtifactRequest{
+			Artifact: &rpc.Artifact{Name: revisedArtifactName, MimeType: "text/plain", Contents: []byte(c)},
+		})
+		if err != nil {
+			t.Fatalf("ReplaceArtifact() returned error: %s", err)
+		}
+		revisions = append(revisions, r)
+	}
+
+	resp, err := server.ListArtifactRevisions(ctx, &rpc.ListArtifactRevisionsRequest{Name: revisedArtifactName})
+	if err != nil {
+		t.Fatalf("ListArtifactRevisions() returned error: %s", err)
+	}
+
+	// The two newest revisions are retained with the tagged revision.
+	want := []string{revisions[3].GetRevisionId(), revisions[2].GetRevisionId(), revisions[0].GetRevisionId()}
+	got := make([]string, 0)
+	for _, a := range resp.GetArtifacts() {
+		got = append(got, a.GetRevisionId())
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ListArtifactRevisions() returned unexpected diff (-want +got):\n%s", diff)
+	}
+
+	name := fmt.Sprintf("%s@%s", revisedArtifactName, revisions[1].GetRevisionId())
+	if _, err := server.GetArtifactContents(ctx, &rpc.GetArtifactContentsRequest{Name: name}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetArtifactContents(%q) returned status code %q, want %q: %v", name, status.Code(err), codes.NotFound, err)
+	}
+
+	t.Run("negative limit", func(t *testing.T) {
+		req := &rpc.UpdateProjectRequest{Project: &rpc.Project{Name: "projects/my-project", ArtifactRevisionLimit: -1}}
+		if _, err := server.UpdateProject(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("UpdateProject(%+v) returned status code %q, want %q: %v", req, status.Code(err), codes.InvalidArgument, err)
+		}
+	})
+}
@@ -26,6 +26,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 type artifactParent interface {
@@ -50,12 +51,6 @@ func parseArtifactParent(name string) (artifactParent, error) {
 
 // CreateArtifact handles the corresponding API request.
 func (s *RegistryServer) CreateArtifact(ctx context.Context, req *rpc.CreateArtifactRequest) (*rpc.Artifact, error) {
-	db, err := s.getStorageClient(ctx)
-	if err != nil {
-		return nil, status.Error(codes.Unavailable, err.Error())
-	}
-	defer db.Close()
-
 	if req.GetArtifact() == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid artifact %+v: body must be provided", req.GetArtifact())
 	}
@@ -65,7 +60,19 @@ func (s *RegistryServer) CreateArtifact(ctx context.Context, req *rpc.CreateArti
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	name := parent.Artifact(req.GetArtifactId())
+	var message *rpc.Artifact
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) (err error) {
+		message, err = s.insertArtifact(ctx, db, parent.Artifact(req.GetArtifactId()), req.GetArtifact())
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+// insertArtifact creates an artifact using a client for a transaction that may have other changes.
+func (s *RegistryServer) insertArtifact(ctx context.Context, db *storage.Client, name names.Artifact, body *rpc.Artifact) (*rpc.Artifact, error) {
 	if _, err := db.GetArtifact(ctx, name); err == nil {
 		return nil, status.Errorf(codes.AlreadyExists, "artifact %q already exists", name)
 	} else if !isNotFound(err) {
@@ -76,6 +83,11 @@ func (s *RegistryServer) CreateArtifact(ctx context.Context, req *rpc.CreateArti
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	parent, err := parseArtifactParent(name.Parent())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	// Creation should only succeed when the parent exists.
 	switch parent := parent.(type) {
 	case names.Project:
@@ -100,20 +112,27 @@ func (s *RegistryServer) CreateArtifact(ctx context.Context, req *rpc.CreateArti
 		}
 	}
 
-	artifact, err := models.NewArtifact(name, req.GetArtifact())
+	artifact, err := models.NewArtifact(name, body)
 	if err != nil {
 		return nil, err
 	}
-	if err := db.SaveArtifact(ctx, artifact); err != nil {
-		return nil, err
+
+	message, err := artifact.Message(name.String(), []string{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	if err := db.SaveArtifactContents(ctx, artifact, req.Artifact.GetContents()); err != nil {
+	if err := db.SaveArtifactRevision(ctx, artifact); err != nil {
+		return nil, err
+	}
+	if err := db.SaveArtifactRevisionContents(ctx, artifact, body.GetContents()); err != nil {
+		return nil, err
+	}
+	if err := s.notify(ctx, db, rpc.Notification_CREATED, name.String(), withSnapshot(message)); err != nil {
 		return nil, err
 	}
 
-	s.notify(ctx, rpc.Notification_CREATED, name.String())
-	return artifact.Message(), nil
+	return message, nil
 }
 
 // DeleteArtifact handles the corresponding API request.
@@ -122,7 +141,6 @@ func (s *RegistryServer) DeleteArtifact(ctx context.Context, req *rpc.DeleteArti
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	name, err := names.ParseArtifact(req.GetName())
 	if err != nil {
@@ -134,54 +152,152 @@ func (s *RegistryServer) DeleteArtifact(ctx context.Context, req *rpc.DeleteArti
 		return nil, err
 	}
 
-	if err := db.DeleteArtifact(ctx, name); err != nil {
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := checkETag(name.String(), req.GetEtag(), func() (etagged, error) {
+			return db.ForUpdate().GetArtifact(ctx, name)
+		}); err != nil {
+			return err
+		}
+		if err := db.DeleteArtifact(ctx, name); err != nil {
+			return err
+		}
+		return s.notify(ctx, db, rpc.Notification_DELETED, name.String())
+	}); err != nil {
 		return nil, err
 	}
 
-	s.notify(ctx, rpc.Notification_DELETED, name.String())
 	return &emptypb.Empty{}, nil
 }
 
-// GetArtifact handles the corresponding API request.
-func (s *RegistryServer) GetArtifact(ctx context.Context, req *rpc.GetArtifactRequest) (*rpc.Artifact, error) {
+// UndeleteArtifact handles the corresponding API request.
+func (s *RegistryServer) UndeleteArtifact(ctx context.Context, req *rpc.UndeleteArtifactRequest) (*rpc.Artifact, error) {
 	db, err := s.getStorageClient(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	name, err := names.ParseArtifact(req.GetName())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	parent, err := parseArtifactParent(name.Parent())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Restoration should only succeed when the parent exists.
+	switch parent := parent.(type) {
+	case names.Project:
+		_, err = db.GetProject(ctx, parent)
+	case names.Api:
+		_, err = db.GetApi(ctx, parent)
+	case names.Version:
+		_, err = db.GetVersion(ctx, parent)
+	case names.Spec:
+		_, err = db.GetSpec(ctx, parent)
+	case names.Deployment:
+		_, err = db.GetDeployment(ctx, parent)
+	}
+	if err != nil {
+		return nil, undeleteParentError(err, name.String())
+	}
+
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := db.UndeleteArtifact(ctx, name); err != nil {
+			return err
+		}
+		return s.notify(ctx, db, rpc.Notification_CREATED, name.String())
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.getArtifact(ctx, name)
+}
+
+// GetArtifact handles the corresponding API request.
+func (s *RegistryServer) GetArtifact(ctx context.Context, req *rpc.GetArtifactRequest) (*rpc.Artifact, error) {
+	if name, err := names.ParseArtifact(req.GetName()); err == nil {
+		return s.getArtifact(ctx, name)
+	} else if name, err := names.ParseArtifactRevision(req.GetName()); err == nil {
+		return s.getArtifactRevision(ctx, name)
+	}
+
+	return nil, status.Errorf(codes.InvalidArgument, "invalid resource name %q, must be an artifact or revision", req.GetName())
+}
+
+func (s *RegistryServer) getArtifact(ctx context.Context, name names.Artifact) (*rpc.Artifact, error) {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
 	artifact, err := db.GetArtifact(ctx, name)
 	if err != nil {
 		return nil, err
 	}
 
-	return artifact.Message(), nil
+	tags, err := artifactRevisionTags(ctx, db, name.Revision(artifact.RevisionID))
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := artifact.Message(name.String(), tags)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return message, nil
 }
 
-// GetArtifactContents handles the corresponding API request.
-func (s *RegistryServer) GetArtifactContents(ctx context.Context, req *rpc.GetArtifactContentsRequest) (*httpbody.HttpBody, error) {
+func (s *RegistryServer) getArtifactRevision(ctx context.Context, name names.ArtifactRevision) (*rpc.Artifact, error) {
 	db, err := s.getStorageClient(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
-	name, err := names.ParseArtifact(req.GetName())
+	revision, err := db.GetArtifactRevision(ctx, name)
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, err
 	}
 
-	artifact, err := db.GetArtifact(ctx, name)
+	tags, err := artifactRevisionTags(ctx, db, name.Artifact().Revision(revision.RevisionID))
 	if err != nil {
 		return nil, err
 	}
 
-	blob, err := db.GetArtifactContents(ctx, name)
+	message, err := revision.Message(name.String(), tags)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return message, nil
+}
+
+// GetArtifactContents handles the corresponding API request.
+func (s *RegistryServer) GetArtifactContents(ctx context.Context, req *rpc.GetArtifactContentsRequest) (*httpbody.HttpBody, error) {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	var artifact *models.Artifact
+	var revisionName names.ArtifactRevision
+	if name, err := names.ParseArtifact(req.GetName()); err == nil {
+		if artifact, err = db.GetArtifact(ctx, name); err != nil {
+			return nil, err
+		}
+		revisionName = name.Revision(artifact.RevisionID)
+	} else if name, err := names.ParseArtifactRevision(req.GetName()); err == nil {
+		if artifact, err = db.GetArtifactRevision(ctx, name); err != nil {
+			return nil, err
+		}
+		revisionName = name
+	} else {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid resource name %q, must be an artifact or revision", req.GetName())
+	}
+
+	blob, err := db.GetArtifactRevisionContents(ctx, revisionName)
 	if err != nil {
 		return nil, err
 	}
@@ -198,7 +314,6 @@ func (s *RegistryServer) ListArtifacts(ctx context.Context, req *rpc.ListArtifac
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	if req.GetPageSize() < 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid page_size %d: must not be negative", req.GetPageSize())
@@ -217,33 +332,43 @@ func (s *RegistryServer) ListArtifacts(ctx context.Context, req *rpc.ListArtifac
 	switch parent := parent.(type) {
 	case names.Project:
 		listing, err = db.ListProjectArtifacts(ctx, parent, storage.PageOptions{
-			Size:   req.GetPageSize(),
-			Filter: req.GetFilter(),
-			Token:  req.GetPageToken(),
+			Size:        req.GetPageSize(),
+			Filter:      req.GetFilter(),
+			Order:       req.GetOrderBy(),
+			Token:       req.GetPageToken(),
+			ShowDeleted: req.GetShowDeleted(),
 		})
 	case names.Api:
 		listing, err = db.ListApiArtifacts(ctx, parent, storage.PageOptions{
-			Size:   req.GetPageSize(),
-			Filter: req.GetFilter(),
-			Token:  req.GetPageToken(),
+			Size:        req.GetPageSize(),
+			Filter:      req.GetFilter(),
+			Order:       req.GetOrderBy(),
+			Token:       req.GetPageToken(),
+			ShowDeleted: req.GetShowDeleted(),
 		})
 	case names.Version:
 		listing, err = db.ListVersionArtifacts(ctx, parent, storage.PageOptions{
-			Size:   req.GetPageSize(),
-			Filter: req.GetFilter(),
-			Token:  req.GetPageToken(),
+			Size:        req.GetPageSize(),
+			Filter:      req.GetFilter(),
+			Order:       req.GetOrderBy(),
+			Token:       req.GetPageToken(),
+			ShowDeleted: req.GetShowDeleted(),
 		})
 	case names.Spec:
 		listing, err = db.ListSpecArtifacts(ctx, parent, storage.PageOptions{
-			Size:   req.GetPageSize(),
-			Filter: req.GetFilter(),
-			Token:  req.GetPageToken(),
+			Size:        req.GetPageSize(),
+			Filter:      req.GetFilter(),
+			Order:       req.GetOrderBy(),
+			Token:       req.GetPageToken(),
+			ShowDeleted: req.GetShowDeleted(),
 		})
 	case names.Deployment:
 		listing, err = db.ListDeploymentArtifacts(ctx, parent, storage.PageOptions{
-			Size:   req.GetPageSize(),
-			Filter: req.GetFilter(),
-			Token:  req.GetPageToken(),
+			Size:        req.GetPageSize(),
+			Filter:      req.GetFilter(),
+			Order:       req.GetOrderBy(),
+			Token:       req.GetPageToken(),
+			ShowDeleted: req.GetShowDeleted(),
 		})
 	}
 	if err != nil {
@@ -255,8 +380,22 @@ func (s *RegistryServer) ListArtifacts(ctx context.Context, req *rpc.ListArtifac
 		NextPageToken: listing.Token,
 	}
 
+	revisionIDs := make([]string, len(listing.Artifacts))
 	for i, artifact := range listing.Artifacts {
-		response.Artifacts[i] = artifact.Message()
+		revisionIDs[i] = artifact.RevisionID
+	}
+
+	tags, err := db.GetArtifactRevisionTags(ctx, revisionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	tagsByRev := artifactTagsByRevision(tags)
+	for i, artifact := range listing.Artifacts {
+		response.Artifacts[i], err = artifact.Message(artifact.Name(), tagsByRev[artifact.RevisionName()])
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
 	}
 
 	return response, nil
@@ -264,34 +403,191 @@ func (s *RegistryServer) ListArtifacts(ctx context.Context, req *rpc.ListArtifac
 
 // ReplaceArtifact handles the corresponding API request.
 func (s *RegistryServer) ReplaceArtifact(ctx context.Context, req *rpc.ReplaceArtifactRequest) (*rpc.Artifact, error) {
-	db, err := s.getStorageClient(ctx)
+	name, err := names.ParseArtifact(req.Artifact.GetName())
 	if err != nil {
-		return nil, status.Error(codes.Unavailable, err.Error())
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var message *rpc.Artifact
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		// Replacement should only succeed on artifacts that currently exist.
+		// The artifact is locked so that concurrent replacements are applied one after another.
+		current, err := db.ForUpdate().GetArtifact(ctx, name)
+		if err != nil {
+			return err
+		}
+
+		message, err = s.replaceArtifact(ctx, db, current, name, req.GetArtifact())
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+// replaceArtifact replaces the current revision of an artifact using a client for a transaction that may have other changes.
+// The current revision should be locked by the transaction.
+func (s *RegistryServer) replaceArtifact(ctx context.Context, db *storage.Client, current *models.Artifact, name names.Artifact, body *rpc.Artifact) (*rpc.Artifact, error) {
+	if err := checkETag(name.String(), body.GetEtag(), func() (etagged, error) {
+		return current, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	artifact, err := models.NewArtifact(name, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// A new revision is only committed when the contents change.
+	// Otherwise the current revision is updated in place.
+	artifact.CreateTime = current.CreateTime
+	newRevision := artifact.Hash != current.Hash || artifact.MimeType != current.MimeType
+	if !newRevision {
+		artifact.RevisionID = current.RevisionID
+		artifact.RevisionCreateTime = current.RevisionCreateTime
+		artifact.BlobHash = current.BlobHash
+	}
+
+	if err := db.SaveArtifactRevision(ctx, artifact); err != nil {
+		return nil, err
+	}
+
+	if newRevision {
+		if err := db.SaveArtifactRevisionContents(ctx, artifact, body.GetContents()); err != nil {
+			return nil, err
+		}
+		if err := s.pruneArtifactRevisions(ctx, db, name); err != nil {
+			return nil, err
+		}
+	}
+
+	tags, err := artifactRevisionTags(ctx, db, name.Revision(artifact.RevisionID))
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := artifact.Message(name.String(), tags)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := s.notify(ctx, db, rpc.Notification_UPDATED, name.String(),
+		withSnapshot(message), withPreviousRevision(current.RevisionID)); err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+// UpdateArtifact handles the corresponding API request.
+func (s *RegistryServer) UpdateArtifact(ctx context.Context, req *rpc.UpdateArtifactRequest) (*rpc.Artifact, error) {
+	if req.GetArtifact() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid artifact %+v: body must be provided", req.GetArtifact())
+	} else if err := models.ValidateMask(req.GetArtifact(), req.GetUpdateMask()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid update_mask %v: %s", req.GetUpdateMask(), err)
+	}
+
+	mask, err := artifactUpdateMask(req.GetArtifact(), req.GetUpdateMask())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid update_mask %v: %s", req.GetUpdateMask(), err)
 	}
-	defer db.Close()
 
 	name, err := names.ParseArtifact(req.Artifact.GetName())
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// Replacement should only succeed on artifacts that currently exist.
-	if _, err := db.GetArtifact(ctx, name); err != nil {
+	var message *rpc.Artifact
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		artifact, err := db.ForUpdate().GetArtifact(ctx, name)
+		if err != nil {
+			return err
+		}
+
+		if err := checkETag(name.String(), req.GetArtifact().GetEtag(), func() (etagged, error) {
+			return artifact, nil
+		}); err != nil {
+			return err
+		}
+
+		// The current revision is updated in place because its contents don't change.
+		if err := artifact.Update(req.GetArtifact(), mask); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		if err := db.SaveArtifactRevision(ctx, artifact); err != nil {
+			return err
+		}
+
+		tags, err := artifactRevisionTags(ctx, db, name.Revision(artifact.RevisionID))
+		if err != nil {
+			return err
+		}
+
+		message, err = artifact.Message(name.String(), tags)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		return s.notify(ctx, db, rpc.Notification_UPDATED, name.String(),
+			withSnapshot(message), withPreviousRevision(artifact.RevisionID), withUpdateMask(mask))
+	}); err != nil {
 		return nil, err
 	}
 
-	artifact, err := models.NewArtifact(name, req.GetArtifact())
+	return message, nil
+}
+
+// artifactUpdateMask returns the fields of an artifact that an update changes.
+// Only labels and annotations can be updated; other fields are changed by replacing the artifact.
+func artifactUpdateMask(artifact *rpc.Artifact, mask *fieldmaskpb.FieldMask) (*fieldmaskpb.FieldMask, error) {
+	explicit := len(mask.GetPaths()) > 0 && !(len(mask.GetPaths()) == 1 && mask.Paths[0] == "*")
+	updated := &fieldmaskpb.FieldMask{}
+	for _, field := range models.ExpandMask(artifact, mask).GetPaths() {
+		switch field {
+		case "labels", "annotations":
+			updated.Paths = append(updated.Paths, field)
+		default:
+			if explicit {
+				return nil, fmt.Errorf("field %q can't be updated, only labels and annotations", field)
+			}
+		}
+	}
+
+	return updated, nil
+}
+
+// pruneArtifactRevisions deletes the oldest revisions of an artifact that exceed the limit of its project.
+func (s *RegistryServer) pruneArtifactRevisions(ctx context.Context, db *storage.Client, name names.Artifact) error {
+	project, err := db.GetProject(ctx, names.Project{ProjectID: name.ProjectID()})
 	if err != nil {
-		return nil, err
+		return err
 	}
-	if err := db.SaveArtifact(ctx, artifact); err != nil {
+
+	return db.PruneArtifactRevisions(ctx, name, project.ArtifactRevisionLimit)
+}
+
+func artifactRevisionTags(ctx context.Context, db *storage.Client, name names.ArtifactRevision) ([]string, error) {
+	allTags, err := db.GetArtifactRevisionTags(ctx, []string{name.RevisionID})
+	if err != nil {
 		return nil, err
 	}
 
-	if err := db.SaveArtifactContents(ctx, artifact, req.Artifact.GetContents()); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+	tags := make([]string, 0)
+	for _, tag := range allTags {
+		if tag.RevisionName() == name.String() {
+			tags = append(tags, tag.Tag)
+		}
+	}
+
+	return tags, nil
+}
+
+func artifactTagsByRevision(tags []models.ArtifactRevisionTag) map[string][]string {
+	revTags := make(map[string][]string, len(tags))
+	for _, tag := range tags {
+		rev := tag.RevisionName()
+		revTags[rev] = append(revTags[rev], tag.Tag)
 	}
 
-	s.notify(ctx, rpc.Notification_UPDATED, name.String())
-	return artifact.Message(), nil
+	return revTags
 }
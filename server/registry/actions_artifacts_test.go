@@ -15,8 +15,10 @@
 package registry
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/apigee/registry/rpc"
@@ -25,7 +27,9 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 var (
@@ -48,7 +52,7 @@ func TestCreateArtifact(t *testing.T) {
 				ArtifactId: "my-artifact",
 				Artifact: &rpc.Artifact{
 					MimeType:  "application/json",
-					SizeBytes: int32(len(artifactContents)),
+					SizeBytes: int64(len(artifactContents)),
 					Hash:      sha256hash(artifactContents),
 					Contents:  artifactContents,
 				},
@@ -56,7 +60,7 @@ func TestCreateArtifact(t *testing.T) {
 			want: &rpc.Artifact{
 				Name:      "projects/my-project/locations/global/artifacts/my-artifact",
 				MimeType:  "application/json",
-				SizeBytes: int32(len(artifactContents)),
+				SizeBytes: int64(len(artifactContents)),
 				Hash:      sha256hash(artifactContents),
 			},
 		},
@@ -68,7 +72,7 @@ func TestCreateArtifact(t *testing.T) {
 				ArtifactId: "my-artifact",
 				Artifact: &rpc.Artifact{
 					MimeType:  "application/json",
-					SizeBytes: int32(len(artifactContents)),
+					SizeBytes: int64(len(artifactContents)),
 					Hash:      sha256hash(artifactContents),
 					Contents:  artifactContents,
 				},
@@ -76,7 +80,7 @@ func TestCreateArtifact(t *testing.T) {
 			want: &rpc.Artifact{
 				Name:      "projects/my-project/locations/global/apis/my-api/artifacts/my-artifact",
 				MimeType:  "application/json",
-				SizeBytes: int32(len(artifactContents)),
+				SizeBytes: int64(len(artifactContents)),
 				Hash:      sha256hash(artifactContents),
 			},
 		},
@@ -88,7 +92,7 @@ func TestCreateArtifact(t *testing.T) {
 				ArtifactId: "my-artifact",
 				Artifact: &rpc.Artifact{
 					MimeType:  "application/json",
-					SizeBytes: int32(len(artifactContents)),
+					SizeBytes: int64(len(artifactContents)),
 					Hash:      sha256hash(artifactContents),
 					Contents:  artifactContents,
 				},
@@ -96,7 +100,7 @@ func TestCreateArtifact(t *testing.T) {
 			want: &rpc.Artifact{
 				Name:      "projects/my-project/locations/global/apis/my-api/versions/my-version/artifacts/my-artifact",
 				MimeType:  "application/json",
-				SizeBytes: int32(len(artifactContents)),
+				SizeBytes: int64(len(artifactContents)),
 				Hash:      sha256hash(artifactContents),
 			},
 		},
@@ -108,7 +112,7 @@ func TestCreateArtifact(t *testing.T) {
 				ArtifactId: "my-artifact",
 				Artifact: &rpc.Artifact{
 					MimeType:  "application/json",
-					SizeBytes: int32(len(artifactContents)),
+					SizeBytes: int64(len(artifactContents)),
 					Hash:      sha256hash(artifactContents),
 					Contents:  artifactContents,
 				},
@@ -116,7 +120,7 @@ func TestCreateArtifact(t *testing.T) {
 			want: &rpc.Artifact{
 				Name:      "projects/my-project/locations/global/apis/my-api/deployments/my-deployment/artifacts/my-artifact",
 				MimeType:  "application/json",
-				SizeBytes: int32(len(artifactContents)),
+				SizeBytes: int64(len(artifactContents)),
 				Hash:      sha256hash(artifactContents),
 			},
 		},
@@ -128,7 +132,7 @@ func TestCreateArtifact(t *testing.T) {
 				ArtifactId: "my-artifact",
 				Artifact: &rpc.Artifact{
 					MimeType:  "application/json",
-					SizeBytes: int32(len(artifactContents)),
+					SizeBytes: int64(len(artifactContents)),
 					Hash:      sha256hash(artifactContents),
 					Contents:  artifactContents,
 				},
@@ -136,7 +140,7 @@ func TestCreateArtifact(t *testing.T) {
 			want: &rpc.Artifact{
 				Name:      "projects/my-project/locations/global/apis/my-api/versions/my-version/specs/my-spec/artifacts/my-artifact",
 				MimeType:  "application/json",
-				SizeBytes: int32(len(artifactContents)),
+				SizeBytes: int64(len(artifactContents)),
 				Hash:      sha256hash(artifactContents),
 			},
 		},
@@ -157,7 +161,7 @@ func TestCreateArtifact(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Artifact), "revision_id", "create_time", "revision_create_time", "update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, created, opts) {
@@ -399,7 +403,7 @@ func TestGetArtifact(t *testing.T) {
 			seed: &rpc.Artifact{
 				Name:      "projects/my-project/locations/global/artifacts/my-artifact",
 				MimeType:  "application/json",
-				SizeBytes: int32(len(artifactContents)),
+				SizeBytes: int64(len(artifactContents)),
 				Hash:      sha256hash(artifactContents),
 				Contents:  artifactContents,
 			},
@@ -409,7 +413,7 @@ func TestGetArtifact(t *testing.T) {
 			want: &rpc.Artifact{
 				Name:      "projects/my-project/locations/global/artifacts/my-artifact",
 				MimeType:  "application/json",
-				SizeBytes: int32(len(artifactContents)),
+				SizeBytes: int64(len(artifactContents)),
 				Hash:      sha256hash(artifactContents),
 			},
 		},
@@ -430,7 +434,7 @@ func TestGetArtifact(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Artifact), "revision_id", "create_time", "revision_create_time", "update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, got, opts) {
@@ -670,6 +674,54 @@ func TestListArtifacts(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "label filtering",
+			seed: []*rpc.Artifact{
+				{
+					Name:   "projects/my-project/locations/global/apis/my-api/versions/v1/artifacts/artifact1",
+					Labels: map[string]string{"generator": "controller"},
+				},
+				{
+					Name:   "projects/my-project/locations/global/apis/my-api/versions/v1/artifacts/artifact2",
+					Labels: map[string]string{"generator": "user"},
+				},
+				{Name: "projects/my-project/locations/global/apis/my-api/versions/v1/artifacts/artifact3"},
+			},
+			req: &rpc.ListArtifactsRequest{
+				Parent: "projects/my-project/locations/global/apis/my-api/versions/v1",
+				Filter: "has(labels.generator) && labels.generator == 'controller'",
+			},
+			want: &rpc.ListArtifactsResponse{
+				Artifacts: []*rpc.Artifact{
+					{
+						Name:   "projects/my-project/locations/global/apis/my-api/versions/v1/artifacts/artifact1",
+						Labels: map[string]string{"generator": "controller"},
+					},
+				},
+			},
+		},
+		{
+			desc: "annotation filtering",
+			seed: []*rpc.Artifact{
+				{
+					Name:        "projects/my-project/locations/global/apis/my-api/versions/v1/artifacts/artifact1",
+					Annotations: map[string]string{"linter": "spectral"},
+				},
+				{Name: "projects/my-project/locations/global/apis/my-api/versions/v1/artifacts/artifact2"},
+			},
+			req: &rpc.ListArtifactsRequest{
+				Parent: "projects/my-project/locations/global/apis/my-api/versions/v1",
+				Filter: "has(annotations.linter)",
+			},
+			want: &rpc.ListArtifactsResponse{
+				Artifacts: []*rpc.Artifact{
+					{
+						Name:        "projects/my-project/locations/global/apis/my-api/versions/v1/artifacts/artifact1",
+						Annotations: map[string]string{"linter": "spectral"},
+					},
+				},
+			},
+		},
 		{
 			desc: "artifacts owned by a deployment",
 			seed: []*rpc.Artifact{
@@ -707,7 +759,7 @@ func TestListArtifacts(t *testing.T) {
 			opts := cmp.Options{
 				protocmp.Transform(),
 				protocmp.IgnoreFields(new(rpc.ListArtifactsResponse), "next_page_token"),
-				protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Artifact), "revision_id", "create_time", "revision_create_time", "update_time", "etag"),
 				protocmp.SortRepeated(func(a, b *rpc.Artifact) bool {
 					return a.GetName() < b.GetName()
 				}),
@@ -888,7 +940,7 @@ func TestListArtifactsSequence(t *testing.T) {
 
 	opts := cmp.Options{
 		protocmp.Transform(),
-		protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time"),
+		protocmp.IgnoreFields(new(rpc.Artifact), "revision_id", "create_time", "revision_create_time", "update_time", "etag"),
 		cmpopts.SortSlices(func(a, b *rpc.Artifact) bool {
 			return a.GetName() < b.GetName()
 		}),
@@ -951,16 +1003,28 @@ func TestReplaceArtifact(t *testing.T) {
 				Artifact: &rpc.Artifact{
 					Name:      "projects/my-project/locations/global/artifacts/my-artifact",
 					MimeType:  "application/json",
-					SizeBytes: int32(len(artifactContents)),
+					SizeBytes: int64(len(artifactContents)),
 					Hash:      sha256hash(artifactContents),
 					Contents:  artifactContents,
+					Labels: map[string]string{
+						"label-key": "label-value",
+					},
+					Annotations: map[string]string{
+						"annotation-key": "annotation-value",
+					},
 				},
 			},
 			want: &rpc.Artifact{
 				Name:      "projects/my-project/locations/global/artifacts/my-artifact",
 				MimeType:  "application/json",
-				SizeBytes: int32(len(artifactContents)),
+				SizeBytes: int64(len(artifactContents)),
 				Hash:      sha256hash(artifactContents),
+				Labels: map[string]string{
+					"label-key": "label-value",
+				},
+				Annotations: map[string]string{
+					"annotation-key": "annotation-value",
+				},
 			},
 		},
 	}
@@ -980,7 +1044,7 @@ func TestReplaceArtifact(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.Artifact), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Artifact), "revision_id", "create_time", "revision_create_time", "update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, updated, opts) {
@@ -1054,6 +1118,168 @@ func TestReplaceArtifactResponseCodes(t *testing.T) {
 	}
 }
 
+func TestUpdateArtifact(t *testing.T) {
+	const name = "projects/my-project/locations/global/artifacts/my-artifact"
+	seed := &rpc.Artifact{
+		Name:        name,
+		MimeType:    "application/json",
+		Contents:    artifactContents,
+		Labels:      map[string]string{"label-key": "label-value"},
+		Annotations: map[string]string{"annotation-key": "annotation-value"},
+	}
+	tests := []struct {
+		desc string
+		req  *rpc.UpdateArtifactRequest
+		want *rpc.Artifact
+	}{
+		{
+			desc: "implicit mask",
+			req: &rpc.UpdateArtifactRequest{
+				Artifact: &rpc.Artifact{
+					Name:   name,
+					Labels: map[string]string{"new-key": "new-value"},
+				},
+			},
+			want: &rpc.Artifact{
+				Labels:      map[string]string{"new-key": "new-value"},
+				Annotations: map[string]string{"annotation-key": "annotation-value"},
+			},
+		},
+		{
+			desc: "field specific mask",
+			req: &rpc.UpdateArtifactRequest{
+				Artifact: &rpc.Artifact{
+					Name:        name,
+					Labels:      map[string]string{"new-key": "new-value"},
+					Annotations: map[string]string{"ignored-key": "ignored-value"},
+				},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"labels"}},
+			},
+			want: &rpc.Artifact{
+				Labels:      map[string]string{"new-key": "new-value"},
+				Annotations: map[string]string{"annotation-key": "annotation-value"},
+			},
+		},
+		{
+			desc: "full replacement wildcard mask",
+			req: &rpc.UpdateArtifactRequest{
+				Artifact: &rpc.Artifact{
+					Name:        name,
+					Annotations: map[string]string{"new-key": "new-value"},
+				},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"*"}},
+			},
+			want: &rpc.Artifact{
+				Annotations: map[string]string{"new-key": "new-value"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ctx := context.Background()
+			server := defaultTestServer(t)
+			if err := seeder.SeedArtifacts(ctx, server, seed); err != nil {
+				t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+			}
+
+			original, err := server.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: name})
+			if err != nil {
+				t.Fatalf("Setup: GetArtifact(%q) returned error: %s", name, err)
+			}
+
+			updated, err := server.UpdateArtifact(ctx, test.req)
+			if err != nil {
+				t.Fatalf("UpdateArtifact(%+v) returned error: %s", test.req, err)
+			}
+
+			// The contents and revision of the artifact don't change.
+			want := proto.Clone(original).(*rpc.Artifact)
+			want.Labels = test.want.GetLabels()
+			want.Annotations = test.want.GetAnnotations()
+			opts := cmp.Options{
+				protocmp.Transform(),
+				protocmp.IgnoreFields(new(rpc.Artifact), "update_time", "etag"),
+			}
+			if !cmp.Equal(want, updated, opts) {
+				t.Errorf("UpdateArtifact(%+v) returned unexpected diff (-want +got):\n%s", test.req, cmp.Diff(want, updated, opts))
+			}
+
+			contents, err := server.GetArtifactContents(ctx, &rpc.GetArtifactContentsRequest{Name: name})
+			if err != nil {
+				t.Fatalf("GetArtifactContents(%q) returned error: %s", name, err)
+			}
+			if !bytes.Equal(contents.GetData(), artifactContents) {
+				t.Errorf("GetArtifactContents(%q) returned %q, want %q", name, contents.GetData(), artifactContents)
+			}
+		})
+	}
+}
+
+func TestUpdateArtifactResponseCodes(t *testing.T) {
+	const name = "projects/my-project/locations/global/artifacts/my-artifact"
+	tests := []struct {
+		desc string
+		req  *rpc.UpdateArtifactRequest
+		want codes.Code
+	}{
+		{
+			desc: "resource not found",
+			req: &rpc.UpdateArtifactRequest{
+				Artifact: &rpc.Artifact{Name: "projects/my-project/locations/global/artifacts/doesnt-exist"},
+			},
+			want: codes.NotFound,
+		},
+		{
+			desc: "missing resource body",
+			req:  &rpc.UpdateArtifactRequest{},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "missing resource name",
+			req:  &rpc.UpdateArtifactRequest{Artifact: &rpc.Artifact{}},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "nonexistent field in mask",
+			req: &rpc.UpdateArtifactRequest{
+				Artifact:   &rpc.Artifact{Name: name},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"this field does not exist"}},
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "contents in mask",
+			req: &rpc.UpdateArtifactRequest{
+				Artifact:   &rpc.Artifact{Name: name, Contents: []byte("new")},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"labels", "contents"}},
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "stale etag",
+			req: &rpc.UpdateArtifactRequest{
+				Artifact: &rpc.Artifact{Name: name, Etag: "stale"},
+			},
+			want: codes.Aborted,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			ctx := context.Background()
+			server := defaultTestServer(t)
+			if err := seeder.SeedArtifacts(ctx, server, &rpc.Artifact{Name: name}); err != nil {
+				t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+			}
+
+			if _, err := server.UpdateArtifact(ctx, test.req); status.Code(err) != test.want {
+				t.Errorf("UpdateArtifact(%+v) returned status code %q, want %q: %v", test.req, status.Code(err), test.want, err)
+			}
+		})
+	}
+}
+
 func TestDeleteArtifact(t *testing.T) {
 	tests := []struct {
 		desc string
@@ -1122,3 +1348,52 @@ func TestDeleteArtifactResponseCodes(t *testing.T) {
 		})
 	}
 }
+
+func TestConcurrentReplaceArtifact(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	if err := seeder.SeedProjects(ctx, server, &rpc.Project{Name: "projects/my-project"}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+	artifact, err := server.CreateArtifact(ctx, &rpc.CreateArtifactRequest{
+		Parent:     "projects/my-project/locations/global",
+		ArtifactId: "a",
+		Artifact:   &rpc.Artifact{Contents: []byte("contents")},
+	})
+	if err != nil {
+		t.Fatalf("Setup: CreateArtifact() returned error: %s", err)
+	}
+
+	// Each replacement is applied to the artifact left by the one before it.
+	const count = 10
+	errs := make([]error, count)
+	var wg sync.WaitGroup
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = server.ReplaceArtifact(ctx, &rpc.ReplaceArtifactRequest{
+				Artifact: &rpc.Artifact{
+					Name:     artifact.GetName(),
+					Contents: []byte(fmt.Sprintf("contents-%d", i)),
+				},
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("ReplaceArtifact() request %d returned error: %s", i, err)
+		}
+	}
+
+	got, err := server.ListArtifactRevisions(ctx, &rpc.ListArtifactRevisionsRequest{Name: artifact.GetName()})
+	if err != nil {
+		t.Fatalf("ListArtifactRevisions() returned error: %s", err)
+	}
+	if len(got.GetArtifacts()) != count+1 {
+		t.Errorf("ListArtifactRevisions() returned %d revisions, want %d", len(got.GetArtifacts()), count+1)
+	}
+}
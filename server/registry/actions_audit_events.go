@@ -0,0 +1,105 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListAuditEvents handles the corresponding API request.
+func (s *RegistryServer) ListAuditEvents(ctx context.Context, req *rpc.ListAuditEventsRequest) (*rpc.ListAuditEventsResponse, error) {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	if req.GetPageSize() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page_size %d: must not be negative", req.GetPageSize())
+	} else if req.GetPageSize() > 1000 {
+		req.PageSize = 1000
+	} else if req.GetPageSize() == 0 {
+		req.PageSize = 50
+	}
+
+	filter, err := auditEventFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	listing, err := db.ListAuditEvents(ctx, storage.PageOptions{
+		Size:   req.GetPageSize(),
+		Filter: filter,
+		Token:  req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &rpc.ListAuditEventsResponse{
+		AuditEvents:   make([]*rpc.AuditEvent, len(listing.AuditEvents)),
+		NextPageToken: listing.Token,
+	}
+
+	for i, event := range listing.AuditEvents {
+		response.AuditEvents[i] = event.Message()
+	}
+
+	return response, nil
+}
+
+// auditEventFilter returns a filter expression that combines the filter of a request with its other conditions.
+// Page tokens are only valid for the same filter, so they are also only valid for the same conditions.
+func auditEventFilter(req *rpc.ListAuditEventsRequest) (string, error) {
+	conditions := make([]string, 0)
+	if req.GetPattern() != "" {
+		pattern, err := parseResourcePattern(req.GetPattern())
+		if err != nil {
+			return "", status.Error(codes.InvalidArgument, err.Error())
+		}
+		conditions = append(conditions, pattern.filter())
+	}
+
+	if req.GetPrincipal() != "" {
+		conditions = append(conditions, fmt.Sprintf("principal == %q", req.GetPrincipal()))
+	}
+
+	if req.StartTime != nil {
+		if err := req.GetStartTime().CheckValid(); err != nil {
+			return "", status.Errorf(codes.InvalidArgument, "invalid start_time: %s", err)
+		}
+		conditions = append(conditions, fmt.Sprintf("time >= timestamp(%q)", req.GetStartTime().AsTime().Format(time.RFC3339Nano)))
+	}
+
+	if req.EndTime != nil {
+		if err := req.GetEndTime().CheckValid(); err != nil {
+			return "", status.Errorf(codes.InvalidArgument, "invalid end_time: %s", err)
+		}
+		conditions = append(conditions, fmt.Sprintf("time < timestamp(%q)", req.GetEndTime().AsTime().Format(time.RFC3339Nano)))
+	}
+
+	if req.GetFilter() != "" {
+		conditions = append(conditions, "("+req.GetFilter()+")")
+	}
+
+	return strings.Join(conditions, " && "), nil
+}
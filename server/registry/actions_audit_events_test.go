@@ -0,0 +1,382 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/log"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/test/seeder"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// listAuditEvents returns all of the audit events listed by a request.
+func listAuditEvents(ctx context.Context, t *testing.T, server *RegistryServer, req *rpc.ListAuditEventsRequest) []*rpc.AuditEvent {
+	t.Helper()
+	got := make([]*rpc.AuditEvent, 0)
+	for {
+		resp, err := server.ListAuditEvents(ctx, req)
+		if err != nil {
+			t.Fatalf("ListAuditEvents(%+v) returned error: %s", req, err)
+		}
+		got = append(got, resp.GetAuditEvents()...)
+		if req.PageToken = resp.GetNextPageToken(); req.PageToken == "" {
+			return got
+		}
+	}
+}
+
+// ignoreAuditEventIdentity ignores the fields of audit events that are assigned when they are recorded.
+var ignoreAuditEventIdentity = protocmp.IgnoreFields(new(rpc.AuditEvent), "id", "time")
+
+func TestUnaryAuditor(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	auditor := server.UnaryAuditor()
+
+	call := func(ctx context.Context, method string, req interface{}, handler grpc.UnaryHandler) {
+		t.Helper()
+		info := &grpc.UnaryServerInfo{FullMethod: method}
+		_, _ = auditor(ctx, req, info, handler)
+	}
+
+	alice := log.NewRequestIDContext(log.NewSubjectContext(ctx, "alice"), "request1")
+	call(alice, adminService+"CreateProject",
+		&rpc.CreateProjectRequest{ProjectId: "a", Project: &rpc.Project{DisplayName: "A", Description: "First"}},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return server.CreateProject(ctx, req.(*rpc.CreateProjectRequest))
+		})
+
+	bob := log.NewRequestIDContext(log.NewSubjectContext(ctx, "bob"), "request2")
+	call(bob, adminService+"UpdateProject",
+		&rpc.UpdateProjectRequest{
+			Project:    &rpc.Project{Name: "projects/a", DisplayName: "Renamed", Description: "Ignored"},
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+		},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return server.UpdateProject(ctx, req.(*rpc.UpdateProjectRequest))
+		})
+
+	// Reads aren't audited.
+	call(bob, adminService+"GetProject", &rpc.GetProjectRequest{Name: "projects/a"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return server.GetProject(ctx, req.(*rpc.GetProjectRequest))
+		})
+	call(bob, registryService+"ListApis", &rpc.ListApisRequest{Parent: "projects/a/locations/global"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return server.ListApis(ctx, req.(*rpc.ListApisRequest))
+		})
+
+	// Failed calls are audited with their outcome.
+	call(bob, registryService+"DeleteApi", &rpc.DeleteApiRequest{Name: "projects/a/locations/global/apis/missing"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return server.DeleteApi(ctx, req.(*rpc.DeleteApiRequest))
+		})
+	call(bob, registryService+"CreateApi", &rpc.CreateApiRequest{Parent: "projects/a/locations/global", ApiId: "x"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.PermissionDenied, "denied")
+		})
+
+	want := []*rpc.AuditEvent{
+		{
+			Principal:  "alice",
+			Method:     "CreateProject",
+			Resource:   "projects/a",
+			RequestId:  "request1",
+			StatusCode: "OK",
+			Fields:     []string{"description", "display_name"},
+		},
+		{
+			Principal:  "bob",
+			Method:     "UpdateProject",
+			Resource:   "projects/a",
+			RequestId:  "request2",
+			StatusCode: "OK",
+			Fields:     []string{"display_name"},
+		},
+		{
+			Principal:    "bob",
+			Method:       "DeleteApi",
+			Resource:     "projects/a/locations/global/apis/missing",
+			RequestId:    "request2",
+			StatusCode:   "NotFound",
+			ErrorMessage: `"projects/a/locations/global/apis/missing" not found in database`,
+		},
+		{
+			Principal:    "bob",
+			Method:       "CreateApi",
+			Resource:     "projects/a/locations/global",
+			RequestId:    "request2",
+			StatusCode:   "PermissionDenied",
+			ErrorMessage: "denied",
+		},
+	}
+
+	got := listAuditEvents(ctx, t, server, &rpc.ListAuditEventsRequest{})
+	if diff := cmp.Diff(want, got, protocmp.Transform(), ignoreAuditEventIdentity); diff != "" {
+		t.Errorf("ListAuditEvents() returned unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestStreamAuditor(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	if err := seeder.SeedVersions(ctx, server, &rpc.ApiVersion{Name: "projects/a/locations/global/apis/x/versions/v"}); err != nil {
+		t.Fatalf("Setup: failed to seed resources: %s", err)
+	}
+
+	const spec = "projects/a/locations/global/apis/x/versions/v/specs/s"
+	contents := []byte("openapi: 3.0.0")
+	sum := sha256.Sum256(contents)
+	upload := func(ctx context.Context, handler grpc.StreamHandler) {
+		stream := &specUploadStream{ctx: ctx, requests: []*rpc.UploadApiSpecContentsRequest{
+			{ApiSpec: &rpc.ApiSpec{Name: spec, MimeType: "application/x.openapi"}},
+			{Chunk: contents, Checksum: hex.EncodeToString(sum[:])},
+		}}
+		info := &grpc.StreamServerInfo{FullMethod: registryService + "UploadApiSpecContents", IsClientStream: true}
+		_ = server.StreamAuditor()(server, stream, info, handler)
+	}
+
+	// The generated handler serves UploadApiSpecContents on the stream that the auditor wraps.
+	upload(log.NewSubjectContext(ctx, "alice"), rpc.Registry_ServiceDesc.Streams[0].Handler)
+	upload(log.NewSubjectContext(ctx, "bob"), func(srv interface{}, ss grpc.ServerStream) error {
+		if err := ss.RecvMsg(new(rpc.UploadApiSpecContentsRequest)); err != nil {
+			return err
+		}
+		return status.Error(codes.PermissionDenied, "denied")
+	})
+
+	// Streams that only read aren't audited.
+	info := &grpc.StreamServerInfo{FullMethod: registryService + "DownloadApiSpecContents", IsServerStream: true}
+	_ = server.StreamAuditor()(server, &specUploadStream{ctx: ctx}, info,
+		func(srv interface{}, ss grpc.ServerStream) error { return nil })
+
+	want := []*rpc.AuditEvent{
+		{
+			Principal:  "alice",
+			Method:     "UploadApiSpecContents",
+			Resource:   spec,
+			StatusCode: "OK",
+			Fields:     []string{"mime_type"},
+		},
+		{
+			Principal:    "bob",
+			Method:       "UploadApiSpecContents",
+			Resource:     spec,
+			StatusCode:   "PermissionDenied",
+			ErrorMessage: "denied",
+			Fields:       []string{"mime_type"},
+		},
+	}
+
+	got := listAuditEvents(ctx, t, server, &rpc.ListAuditEventsRequest{})
+	if diff := cmp.Diff(want, got, protocmp.Transform(), ignoreAuditEventIdentity); diff != "" {
+		t.Errorf("ListAuditEvents() returned unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestBatchAuditEvents(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	const parent = "projects/a/locations/global"
+	if err := seeder.SeedApis(ctx, server, &rpc.Api{Name: parent + "/apis/x"}); err != nil {
+		t.Fatalf("Setup: failed to seed resources: %s", err)
+	}
+
+	auditor := server.UnaryAuditor()
+	alice := log.NewSubjectContext(ctx, "alice")
+	_, _ = auditor(alice, &rpc.BatchDeleteApisRequest{
+		Parent: parent,
+		Requests: []*rpc.DeleteApiRequest{
+			{Name: parent + "/apis/x"},
+			{Name: parent + "/apis/missing"},
+		},
+	}, &grpc.UnaryServerInfo{FullMethod: registryService + "BatchDeleteApis"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return server.BatchDeleteApis(ctx, req.(*rpc.BatchDeleteApisRequest))
+		})
+
+	_, _ = auditor(alice, &rpc.BatchCreateApisRequest{
+		Parent: parent,
+		Requests: []*rpc.CreateApiRequest{
+			{ApiId: "y", Api: &rpc.Api{DisplayName: "Y"}},
+			{ApiId: "Invalid ID", Api: &rpc.Api{}},
+		},
+	}, &grpc.UnaryServerInfo{FullMethod: registryService + "BatchCreateApis"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return server.BatchCreateApis(ctx, req.(*rpc.BatchCreateApisRequest))
+		})
+
+	// Every request of a batch that fails as a whole is recorded with the error of the batch.
+	_, _ = auditor(alice, &rpc.BatchUpdateApisRequest{
+		Parent: parent,
+		Requests: []*rpc.UpdateApiRequest{
+			{Api: &rpc.Api{Name: parent + "/apis/y"}},
+			{Api: &rpc.Api{Name: parent + "/apis/z"}},
+		},
+	}, &grpc.UnaryServerInfo{FullMethod: registryService + "BatchUpdateApis"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.PermissionDenied, "denied")
+		})
+
+	got := make([]string, 0)
+	for _, event := range listAuditEvents(ctx, t, server, &rpc.ListAuditEventsRequest{}) {
+		got = append(got, strings.Join([]string{event.GetMethod(), event.GetResource(), event.GetStatusCode()}, " "))
+	}
+	want := []string{
+		"BatchDeleteApis " + parent + "/apis/x OK",
+		"BatchDeleteApis " + parent + "/apis/missing NotFound",
+		"BatchCreateApis " + parent + "/apis/y OK",
+		"BatchCreateApis " + parent + " InvalidArgument",
+		"BatchUpdateApis " + parent + "/apis/y PermissionDenied",
+		"BatchUpdateApis " + parent + "/apis/z PermissionDenied",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ListAuditEvents() returned unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestListAuditEvents(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	audit := func(principal, method, resource string) {
+		server.audit(log.NewSubjectContext(ctx, principal), registryService+method, &rpc.GetApiRequest{Name: resource}, nil, nil)
+	}
+
+	audit("alice", "DeleteApi", "projects/a/locations/global/apis/x")
+	audit("alice", "UpdateApiSpec", "projects/a/locations/global/apis/x/versions/1.0.0/specs/openapi.yaml")
+	audit("bob", "DeleteApi", "projects/b/locations/global/apis/x")
+	time.Sleep(10 * time.Millisecond)
+	start := time.Now()
+	audit("bob", "TagApiSpecRevision", "projects/a/locations/global/apis/x/versions/1.0.0/specs/openapi.yaml@abc")
+	audit("carol", "DeleteApi", "projects/a/locations/global/apis/xyz")
+
+	tests := []struct {
+		desc string
+		req  *rpc.ListAuditEventsRequest
+		want []string
+	}{
+		{
+			desc: "all events",
+			req:  &rpc.ListAuditEventsRequest{PageSize: 2},
+			want: []string{"alice", "alice", "bob", "bob", "carol"},
+		},
+		{
+			desc: "events of a resource",
+			req:  &rpc.ListAuditEventsRequest{Pattern: "projects/a/locations/global/apis/x"},
+			want: []string{"alice"},
+		},
+		{
+			desc: "events of resources matching a pattern",
+			req:  &rpc.ListAuditEventsRequest{Pattern: "projects/-/locations/global/apis/x", PageSize: 1},
+			want: []string{"alice", "bob"},
+		},
+		{
+			desc: "events of revisions of a resource",
+			req:  &rpc.ListAuditEventsRequest{Pattern: "projects/a/locations/global/apis/-/versions/-/specs/openapi.yaml"},
+			want: []string{"alice", "bob"},
+		},
+		{
+			desc: "events of a principal",
+			req:  &rpc.ListAuditEventsRequest{Principal: "bob"},
+			want: []string{"bob", "bob"},
+		},
+		{
+			desc: "events since a time",
+			req:  &rpc.ListAuditEventsRequest{StartTime: timestamppb.New(start)},
+			want: []string{"bob", "carol"},
+		},
+		{
+			desc: "events before a time",
+			req:  &rpc.ListAuditEventsRequest{EndTime: timestamppb.New(start)},
+			want: []string{"alice", "alice", "bob"},
+		},
+		{
+			desc: "filtered events",
+			req:  &rpc.ListAuditEventsRequest{Principal: "alice", Filter: `method == "DeleteApi" || method == "DeleteProject"`},
+			want: []string{"alice"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got := make([]string, 0)
+			for _, event := range listAuditEvents(ctx, t, server, test.req) {
+				got = append(got, event.GetPrincipal())
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("ListAuditEvents(%+v) returned unexpected diff (-want +got):\n%s", test.req, diff)
+			}
+		})
+	}
+}
+
+func TestListAuditEventsResponseCodes(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	tests := []struct {
+		desc string
+		req  *rpc.ListAuditEventsRequest
+		want codes.Code
+	}{
+		{
+			desc: "negative page size",
+			req:  &rpc.ListAuditEventsRequest{PageSize: -1},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "invalid pattern",
+			req:  &rpc.ListAuditEventsRequest{Pattern: "apis/x"},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "invalid start time",
+			req:  &rpc.ListAuditEventsRequest{StartTime: &timestamppb.Timestamp{Nanos: -1}},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "invalid filter",
+			req:  &rpc.ListAuditEventsRequest{Filter: "unknown == 1"},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "invalid page token",
+			req:  &rpc.ListAuditEventsRequest{PageToken: "invalid"},
+			want: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			if _, err := server.ListAuditEvents(ctx, test.req); status.Code(err) != test.want {
+				t.Errorf("ListAuditEvents(%+v) returned status code %q, want %q: %v", test.req, status.Code(err), test.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,557 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"strings"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/apigee/registry/server/registry/names"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxBatchSize is the maximum number of requests in a batch.
+const maxBatchSize = 1000
+
+// batchTransactionKey is the context key of the storage client for the transaction of a batch.
+type batchTransactionKey struct{}
+
+// batch calls fn for each of n requests in a single transaction.
+// Each request is applied in a nested transaction, so requests that fail are rolled back
+// without preventing the others from being applied. fn should record the result of each request.
+func (s *RegistryServer) batch(ctx context.Context, n int, fn func(ctx context.Context, i int) error) error {
+	return s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		for i := 0; i < n; i++ {
+			// Errors are reported in the results of the requests.
+			_ = db.Transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+				return fn(context.WithValue(ctx, batchTransactionKey{}, db), i)
+			})
+		}
+		return ctx.Err()
+	})
+}
+
+// batchPattern returns a pattern that matches resources in a collection of the parent of a batch.
+func batchPattern(parent, collection string) resourcePattern {
+	return resourcePattern(strings.Split(strings.ToLower(parent+"/"+collection+"/-"), "/"))
+}
+
+// checkBatch returns an error if a batch has too many requests or any of their resources aren't in the parent of the batch.
+func checkBatch(pattern resourcePattern, resources []string) error {
+	if len(resources) > maxBatchSize {
+		return status.Errorf(codes.InvalidArgument, "invalid batch of %d requests: must have at most %d requests", len(resources), maxBatchSize)
+	}
+
+	for _, name := range resources {
+		if !pattern.Matches(name) {
+			return status.Errorf(codes.InvalidArgument, "invalid resource %q: must belong to the parent of the batch", name)
+		}
+	}
+
+	return nil
+}
+
+// resultStatus returns the status of a request in a batch.
+func resultStatus(err error) *spb.Status {
+	if err == nil {
+		return status.New(codes.OK, "").Proto()
+	}
+	return status.Convert(err).Proto()
+}
+
+// BatchGetApis handles the corresponding API request.
+func (s *RegistryServer) BatchGetApis(ctx context.Context, req *rpc.BatchGetApisRequest) (*rpc.BatchGetApisResponse, error) {
+	if _, err := names.ParseProjectWithLocation(req.GetParent()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err := checkBatch(batchPattern(req.GetParent(), "apis"), req.GetNames()); err != nil {
+		return nil, err
+	}
+
+	response := &rpc.BatchGetApisResponse{
+		Results: make([]*rpc.ApiResult, len(req.GetNames())),
+	}
+
+	if err := s.batch(ctx, len(req.GetNames()), func(ctx context.Context, i int) error {
+		api, err := s.GetApi(ctx, &rpc.GetApiRequest{Name: req.GetNames()[i]})
+		response.Results[i] = &rpc.ApiResult{Api: api, Status: resultStatus(err)}
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// BatchCreateApis handles the corresponding API request.
+func (s *RegistryServer) BatchCreateApis(ctx context.Context, req *rpc.BatchCreateApisRequest) (*rpc.BatchCreateApisResponse, error) {
+	if _, err := names.ParseProjectWithLocation(req.GetParent()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resources := make([]string, len(req.GetRequests()))
+	for i, r := range req.GetRequests() {
+		if r.GetParent() == "" {
+			r.Parent = req.GetParent()
+		}
+		resources[i] = r.GetParent() + "/apis/" + r.GetApiId()
+	}
+	if err := checkBatch(batchPattern(req.GetParent(), "apis"), resources); err != nil {
+		return nil, err
+	}
+
+	response := &rpc.BatchCreateApisResponse{
+		Results: make([]*rpc.ApiResult, len(req.GetRequests())),
+	}
+
+	if err := s.batch(ctx, len(req.GetRequests()), func(ctx context.Context, i int) error {
+		api, err := s.CreateApi(ctx, req.GetRequests()[i])
+		response.Results[i] = &rpc.ApiResult{Api: api, Status: resultStatus(err)}
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// BatchUpdateApis handles the corresponding API request.
+func (s *RegistryServer) BatchUpdateApis(ctx context.Context, req *rpc.BatchUpdateApisRequest) (*rpc.BatchUpdateApisResponse, error) {
+	if _, err := names.ParseProjectWithLocation(req.GetParent()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resources := make([]string, len(req.GetRequests()))
+	for i, r := range req.GetRequests() {
+		resources[i] = r.GetApi().GetName()
+	}
+	if err := checkBatch(batchPattern(req.GetParent(), "apis"), resources); err != nil {
+		return nil, err
+	}
+
+	response := &rpc.BatchUpdateApisResponse{
+		Results: make([]*rpc.ApiResult, len(req.GetRequests())),
+	}
+
+	if err := s.batch(ctx, len(req.GetRequests()), func(ctx context.Context, i int) error {
+		api, err := s.UpdateApi(ctx, req.GetRequests()[i])
+		response.Results[i] = &rpc.ApiResult{Api: api, Status: resultStatus(err)}
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// BatchDeleteApis handles the corresponding API request.
+func (s *RegistryServer) BatchDeleteApis(ctx context.Context, req *rpc.BatchDeleteApisRequest) (*rpc.BatchDeleteApisResponse, error) {
+	if _, err := names.ParseProjectWithLocation(req.GetParent()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resources := make([]string, len(req.GetRequests()))
+	for i, r := range req.GetRequests() {
+		resources[i] = r.GetName()
+	}
+	if err := checkBatch(batchPattern(req.GetParent(), "apis"), resources); err != nil {
+		return nil, err
+	}
+
+	response := &rpc.BatchDeleteApisResponse{
+		Statuses: make([]*spb.Status, len(req.GetRequests())),
+	}
+
+	if err := s.batch(ctx, len(req.GetRequests()), func(ctx context.Context, i int) error {
+		_, err := s.DeleteApi(ctx, req.GetRequests()[i])
+		response.Statuses[i] = resultStatus(err)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// BatchGetApiVersions handles the corresponding API request.
+func (s *RegistryServer) BatchGetApiVersions(ctx context.Context, req *rpc.BatchGetApiVersionsRequest) (*rpc.BatchGetApiVersionsResponse, error) {
+	if _, err := names.ParseApi(req.GetParent()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err := checkBatch(batchPattern(req.GetParent(), "versions"), req.GetNames()); err != nil {
+		return nil, err
+	}
+
+	response := &rpc.BatchGetApiVersionsResponse{
+		Results: make([]*rpc.ApiVersionResult, len(req.GetNames())),
+	}
+
+	if err := s.batch(ctx, len(req.GetNames()), func(ctx context.Context, i int) error {
+		version, err := s.GetApiVersion(ctx, &rpc.GetApiVersionRequest{Name: req.GetNames()[i]})
+		response.Results[i] = &rpc.ApiVersionResult{ApiVersion: version, Status: resultStatus(err)}
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// BatchCreateApiVersions handles the corresponding API request.
+func (s *RegistryServer) BatchCreateApiVersions(ctx context.Context, req *rpc.BatchCreateApiVersionsRequest) (*rpc.BatchCreateApiVersionsResponse, error) {
+	if _, err := names.ParseApi(req.GetParent()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resources := make([]string, len(req.GetRequests()))
+	for i, r := range req.GetRequests() {
+		if r.GetParent() == "" {
+			r.Parent = req.GetParent()
+		}
+		resources[i] = r.GetParent() + "/versions/" + r.GetApiVersionId()
+	}
+	if err := checkBatch(batchPattern(req.GetParent(), "versions"), resources); err != nil {
+		return nil, err
+	}
+
+	response := &rpc.BatchCreateApiVersionsResponse{
+		Results: make([]*rpc.ApiVersionResult, len(req.GetRequests())),
+	}
+
+	if err := s.batch(ctx, len(req.GetRequests()), func(ctx context.Context, i int) error {
+		version, err := s.CreateApiVersion(ctx, req.GetRequests()[i])
+		response.Results[i] = &rpc.ApiVersionResult{ApiVersion: version, Status: resultStatus(err)}
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// BatchUpdateApiVersions handles the corresponding API request.
+func (s *RegistryServer) BatchUpdateApiVersions(ctx context.Context, req *rpc.BatchUpdateApiVersionsRequest) (*rpc.BatchUpdateApiVersionsResponse, error) {
+	if _, err := names.ParseApi(req.GetParent()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resources := make([]string, len(req.GetRequests()))
+	for i, r := range req.GetRequests() {
+		resources[i] = r.GetApiVersion().GetName()
+	}
+	if err := checkBatch(batchPattern(req.GetParent(), "versions"), resources); err != nil {
+		return nil, err
+	}
+
+	response := &rpc.BatchUpdateApiVersionsResponse{
+		Results: make([]*rpc.ApiVersionResult, len(req.GetRequests())),
+	}
+
+	if err := s.batch(ctx, len(req.GetRequests()), func(ctx context.Context, i int) error {
+		version, err := s.UpdateApiVersion(ctx, req.GetRequests()[i])
+		response.Results[i] = &rpc.ApiVersionResult{ApiVersion: version, Status: resultStatus(err)}
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// BatchDeleteApiVersions handles the corresponding API request.
+func (s *RegistryServer) BatchDeleteApiVersions(ctx context.Context, req *rpc.BatchDeleteApiVersionsRequest) (*rpc.BatchDeleteApiVersionsResponse, error) {
+	if _, err := names.ParseApi(req.GetParent()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resources := make([]string, len(req.GetRequests()))
+	for i, r := range req.GetRequests() {
+		resources[i] = r.GetName()
+	}
+	if err := checkBatch(batchPattern(req.GetParent(), "versions"), resources); err != nil {
+		return nil, err
+	}
+
+	response := &rpc.BatchDeleteApiVersionsResponse{
+		Statuses: make([]*spb.Status, len(req.GetRequests())),
+	}
+
+	if err := s.batch(ctx, len(req.GetRequests()), func(ctx context.Context, i int) error {
+		_, err := s.DeleteApiVersion(ctx, req.GetRequests()[i])
+		response.Statuses[i] = resultStatus(err)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// BatchGetApiSpecs handles the corresponding API request.
+func (s *RegistryServer) BatchGetApiSpecs(ctx context.Context, req *rpc.BatchGetApiSpecsRequest) (*rpc.BatchGetApiSpecsResponse, error) {
+	if _, err := names.ParseVersion(req.GetParent()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err := checkBatch(batchPattern(req.GetParent(), "specs"), req.GetNames()); err != nil {
+		return nil, err
+	}
+
+	response := &rpc.BatchGetApiSpecsResponse{
+		Results: make([]*rpc.ApiSpecResult, len(req.GetNames())),
+	}
+
+	if err := s.batch(ctx, len(req.GetNames()), func(ctx context.Context, i int) error {
+		spec, err := s.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: req.GetNames()[i]})
+		response.Results[i] = &rpc.ApiSpecResult{ApiSpec: spec, Status: resultStatus(err)}
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// BatchCreateApiSpecs handles the corresponding API request.
+func (s *RegistryServer) BatchCreateApiSpecs(ctx context.Context, req *rpc.BatchCreateApiSpecsRequest) (*rpc.BatchCreateApiSpecsResponse, error) {
+	if _, err := names.ParseVersion(req.GetParent()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resources := make([]string, len(req.GetRequests()))
+	for i, r := range req.GetRequests() {
+		if r.GetParent() == "" {
+			r.Parent = req.GetParent()
+		}
+		resources[i] = r.GetParent() + "/specs/" + r.GetApiSpecId()
+	}
+	if err := checkBatch(batchPattern(req.GetParent(), "specs"), resources); err != nil {
+		return nil, err
+	}
+
+	response := &rpc.BatchCreateApiSpecsResponse{
+		Results: make([]*rpc.ApiSpecResult, len(req.GetRequests())),
+	}
+
+	if err := s.batch(ctx, len(req.GetRequests()), func(ctx context.Context, i int) error {
+		spec, err := s.CreateApiSpec(ctx, req.GetRequests()[i])
+		response.Results[i] = &rpc.ApiSpecResult{ApiSpec: spec, Status: resultStatus(err)}
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// BatchUpdateApiSpecs handles the corresponding API request.
+func (s *RegistryServer) BatchUpdateApiSpecs(ctx context.Context, req *rpc.BatchUpdateApiSpecsRequest) (*rpc.BatchUpdateApiSpecsResponse, error) {
+	if _, err := names.ParseVersion(req.GetParent()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resources := make([]string, len(req.GetRequests()))
+	for i, r := range req.GetRequests() {
+		resources[i] = r.GetApiSpec().GetName()
+	}
+	if err := checkBatch(batchPattern(req.GetParent(), "specs"), resources); err != nil {
+		return nil, err
+	}
+
+	response := &rpc.BatchUpdateApiSpecsResponse{
+		Results: make([]*rpc.ApiSpecResult, len(req.GetRequests())),
+	}
+
+	if err := s.batch(ctx, len(req.GetRequests()), func(ctx context.Context, i int) error {
+		spec, err := s.UpdateApiSpec(ctx, req.GetRequests()[i])
+		response.Results[i] = &rpc.ApiSpecResult{ApiSpec: spec, Status: resultStatus(err)}
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// BatchDeleteApiSpecs handles the corresponding API request.
+func (s *RegistryServer) BatchDeleteApiSpecs(ctx context.Context, req *rpc.BatchDeleteApiSpecsRequest) (*rpc.BatchDeleteApiSpecsResponse, error) {
+	if _, err := names.ParseVersion(req.GetParent()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resources := make([]string, len(req.GetRequests()))
+	for i, r := range req.GetRequests() {
+		resources[i] = r.GetName()
+	}
+	if err := checkBatch(batchPattern(req.GetParent(), "specs"), resources); err != nil {
+		return nil, err
+	}
+
+	response := &rpc.BatchDeleteApiSpecsResponse{
+		Statuses: make([]*spb.Status, len(req.GetRequests())),
+	}
+
+	if err := s.batch(ctx, len(req.GetRequests()), func(ctx context.Context, i int) error {
+		_, err := s.DeleteApiSpec(ctx, req.GetRequests()[i])
+		response.Statuses[i] = resultStatus(err)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// BatchGetArtifacts handles the corresponding API request.
+func (s *RegistryServer) BatchGetArtifacts(ctx context.Context, req *rpc.BatchGetArtifactsRequest) (*rpc.BatchGetArtifactsResponse, error) {
+	if _, err := parseArtifactParent(req.GetParent()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err := checkBatch(batchPattern(req.GetParent(), "artifacts"), req.GetNames()); err != nil {
+		return nil, err
+	}
+
+	response := &rpc.BatchGetArtifactsResponse{
+		Results: make([]*rpc.ArtifactResult, len(req.GetNames())),
+	}
+
+	if err := s.batch(ctx, len(req.GetNames()), func(ctx context.Context, i int) error {
+		artifact, err := s.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: req.GetNames()[i]})
+		response.Results[i] = &rpc.ArtifactResult{Artifact: artifact, Status: resultStatus(err)}
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// BatchCreateArtifacts handles the corresponding API request.
+func (s *RegistryServer) BatchCreateArtifacts(ctx context.Context, req *rpc.BatchCreateArtifactsRequest) (*rpc.BatchCreateArtifactsResponse, error) {
+	if _, err := parseArtifactParent(req.GetParent()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resources := make([]string, len(req.GetRequests()))
+	for i, r := range req.GetRequests() {
+		if r.GetParent() == "" {
+			r.Parent = req.GetParent()
+		}
+		resources[i] = r.GetParent() + "/artifacts/" + r.GetArtifactId()
+	}
+	if err := checkBatch(batchPattern(req.GetParent(), "artifacts"), resources); err != nil {
+		return nil, err
+	}
+
+	response := &rpc.BatchCreateArtifactsResponse{
+		Results: make([]*rpc.ArtifactResult, len(req.GetRequests())),
+	}
+
+	if err := s.batch(ctx, len(req.GetRequests()), func(ctx context.Context, i int) error {
+		artifact, err := s.CreateArtifact(ctx, req.GetRequests()[i])
+		response.Results[i] = &rpc.ArtifactResult{Artifact: artifact, Status: resultStatus(err)}
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// BatchReplaceArtifacts handles the corresponding API request.
+func (s *RegistryServer) BatchReplaceArtifacts(ctx context.Context, req *rpc.BatchReplaceArtifactsRequest) (*rpc.BatchReplaceArtifactsResponse, error) {
+	if _, err := parseArtifactParent(req.GetParent()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resources := make([]string, len(req.GetRequests()))
+	for i, r := range req.GetRequests() {
+		resources[i] = r.GetArtifact().GetName()
+	}
+	if err := checkBatch(batchPattern(req.GetParent(), "artifacts"), resources); err != nil {
+		return nil, err
+	}
+
+	response := &rpc.BatchReplaceArtifactsResponse{
+		Results: make([]*rpc.ArtifactResult, len(req.GetRequests())),
+	}
+
+	if err := s.batch(ctx, len(req.GetRequests()), func(ctx context.Context, i int) error {
+		artifact, err := s.ReplaceArtifact(ctx, req.GetRequests()[i])
+		response.Results[i] = &rpc.ArtifactResult{Artifact: artifact, Status: resultStatus(err)}
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// BatchUpdateArtifacts handles the corresponding API request.
+func (s *RegistryServer) BatchUpdateArtifacts(ctx context.Context, req *rpc.BatchUpdateArtifactsRequest) (*rpc.BatchUpdateArtifactsResponse, error) {
+	if _, err := parseArtifactParent(req.GetParent()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resources := make([]string, len(req.GetRequests()))
+	for i, r := range req.GetRequests() {
+		resources[i] = r.GetArtifact().GetName()
+	}
+	if err := checkBatch(batchPattern(req.GetParent(), "artifacts"), resources); err != nil {
+		return nil, err
+	}
+
+	response := &rpc.BatchUpdateArtifactsResponse{
+		Results: make([]*rpc.ArtifactResult, len(req.GetRequests())),
+	}
+
+	if err := s.batch(ctx, len(req.GetRequests()), func(ctx context.Context, i int) error {
+		artifact, err := s.UpdateArtifact(ctx, req.GetRequests()[i])
+		response.Results[i] = &rpc.ArtifactResult{Artifact: artifact, Status: resultStatus(err)}
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// BatchDeleteArtifacts handles the corresponding API request.
+func (s *RegistryServer) BatchDeleteArtifacts(ctx context.Context, req *rpc.BatchDeleteArtifactsRequest) (*rpc.BatchDeleteArtifactsResponse, error) {
+	if _, err := parseArtifactParent(req.GetParent()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resources := make([]string, len(req.GetRequests()))
+	for i, r := range req.GetRequests() {
+		resources[i] = r.GetName()
+	}
+	if err := checkBatch(batchPattern(req.GetParent(), "artifacts"), resources); err != nil {
+		return nil, err
+	}
+
+	response := &rpc.BatchDeleteArtifactsResponse{
+		Statuses: make([]*spb.Status, len(req.GetRequests())),
+	}
+
+	if err := s.batch(ctx, len(req.GetRequests()), func(ctx context.Context, i int) error {
+		_, err := s.DeleteArtifact(ctx, req.GetRequests()[i])
+		response.Statuses[i] = resultStatus(err)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
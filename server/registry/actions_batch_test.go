@@ -0,0 +1,310 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/test/seeder"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// resultCodes returns the codes of the statuses of batch results.
+func resultCodes(statuses ...*spb.Status) []codes.Code {
+	result := make([]codes.Code, len(statuses))
+	for i, s := range statuses {
+		result[i] = codes.Code(s.GetCode())
+	}
+	return result
+}
+
+func checkCodes(t *testing.T, method string, got, want []codes.Code) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s returned %d results, want %d", method, len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("%s returned status code %q for request %d, want %q", method, got[i], i, want[i])
+		}
+	}
+}
+
+func TestBatchApis(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	if err := seeder.SeedApis(ctx, server, &rpc.Api{Name: "projects/my-project/locations/global/apis/existing"}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	const parent = "projects/my-project/locations/global"
+	created, err := server.BatchCreateApis(ctx, &rpc.BatchCreateApisRequest{
+		Parent: parent,
+		Requests: []*rpc.CreateApiRequest{
+			{ApiId: "a", Api: &rpc.Api{DisplayName: "A"}},
+			{ApiId: "existing", Api: &rpc.Api{}},
+			{ApiId: "Invalid!", Api: &rpc.Api{}},
+			{Parent: parent, ApiId: "b", Api: &rpc.Api{DisplayName: "B"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("BatchCreateApis() returned error: %s", err)
+	}
+	var statuses []*spb.Status
+	for _, r := range created.GetResults() {
+		statuses = append(statuses, r.GetStatus())
+	}
+	checkCodes(t, "BatchCreateApis()", resultCodes(statuses...), []codes.Code{codes.OK, codes.AlreadyExists, codes.InvalidArgument, codes.OK})
+	if got := created.GetResults()[0].GetApi().GetName(); got != parent+"/apis/a" {
+		t.Errorf("BatchCreateApis() returned API %q, want %q", got, parent+"/apis/a")
+	}
+
+	got, err := server.BatchGetApis(ctx, &rpc.BatchGetApisRequest{
+		Parent: parent,
+		Names:  []string{parent + "/apis/b", parent + "/apis/missing", parent + "/apis/a"},
+	})
+	if err != nil {
+		t.Fatalf("BatchGetApis() returned error: %s", err)
+	}
+	statuses = nil
+	for _, r := range got.GetResults() {
+		statuses = append(statuses, r.GetStatus())
+	}
+	checkCodes(t, "BatchGetApis()", resultCodes(statuses...), []codes.Code{codes.OK, codes.NotFound, codes.OK})
+	if got.GetResults()[0].GetApi().GetDisplayName() != "B" || got.GetResults()[2].GetApi().GetDisplayName() != "A" {
+		t.Errorf("BatchGetApis() returned %v, want APIs in the order of the requested names", got.GetResults())
+	}
+
+	updated, err := server.BatchUpdateApis(ctx, &rpc.BatchUpdateApisRequest{
+		Parent: parent,
+		Requests: []*rpc.UpdateApiRequest{
+			{Api: &rpc.Api{Name: parent + "/apis/a", DisplayName: "A2"}},
+			{Api: &rpc.Api{Name: parent + "/apis/b", DisplayName: "B2", Etag: "stale"}},
+			{Api: &rpc.Api{Name: parent + "/apis/c"}, AllowMissing: true},
+		},
+	})
+	if err != nil {
+		t.Fatalf("BatchUpdateApis() returned error: %s", err)
+	}
+	statuses = nil
+	for _, r := range updated.GetResults() {
+		statuses = append(statuses, r.GetStatus())
+	}
+	checkCodes(t, "BatchUpdateApis()", resultCodes(statuses...), []codes.Code{codes.OK, codes.Aborted, codes.OK})
+
+	deleted, err := server.BatchDeleteApis(ctx, &rpc.BatchDeleteApisRequest{
+		Parent: parent,
+		Requests: []*rpc.DeleteApiRequest{
+			{Name: parent + "/apis/a"},
+			{Name: parent + "/apis/missing"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("BatchDeleteApis() returned error: %s", err)
+	}
+	checkCodes(t, "BatchDeleteApis()", resultCodes(deleted.GetStatuses()...), []codes.Code{codes.OK, codes.NotFound})
+
+	// Failed requests don't prevent the others from being applied.
+	list, err := server.ListApis(ctx, &rpc.ListApisRequest{Parent: parent})
+	if err != nil {
+		t.Fatalf("ListApis() returned error: %s", err)
+	}
+	want := map[string]string{
+		parent + "/apis/b":        "B",
+		parent + "/apis/c":        "",
+		parent + "/apis/existing": "",
+	}
+	if len(list.GetApis()) != len(want) {
+		t.Errorf("ListApis() returned %d APIs, want %d", len(list.GetApis()), len(want))
+	}
+	for _, api := range list.GetApis() {
+		if displayName, ok := want[api.GetName()]; !ok || api.GetDisplayName() != displayName {
+			t.Errorf("ListApis() returned %q with display_name %q, want %v", api.GetName(), api.GetDisplayName(), want)
+		}
+	}
+}
+
+func TestBatchApiSpecsWithWildcardParent(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	versions := []*rpc.ApiVersion{
+		{Name: "projects/my-project/locations/global/apis/a/versions/v1"},
+		{Name: "projects/my-project/locations/global/apis/b/versions/v1"},
+	}
+	if err := seeder.SeedVersions(ctx, server, versions...); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	const parent = "projects/my-project/locations/global/apis/-/versions/-"
+	requests := []*rpc.UpdateApiSpecRequest{
+		{ApiSpec: &rpc.ApiSpec{Name: versions[0].GetName() + "/specs/s", Contents: []byte("a")}, AllowMissing: true},
+		{ApiSpec: &rpc.ApiSpec{Name: versions[1].GetName() + "/specs/s", Contents: []byte("b")}, AllowMissing: true},
+		{ApiSpec: &rpc.ApiSpec{Name: "projects/my-project/locations/global/apis/c/versions/v1/specs/s"}, AllowMissing: true},
+	}
+	updated, err := server.BatchUpdateApiSpecs(ctx, &rpc.BatchUpdateApiSpecsRequest{Parent: parent, Requests: requests})
+	if err != nil {
+		t.Fatalf("BatchUpdateApiSpecs() returned error: %s", err)
+	}
+	var statuses []*spb.Status
+	for _, r := range updated.GetResults() {
+		statuses = append(statuses, r.GetStatus())
+	}
+	checkCodes(t, "BatchUpdateApiSpecs()", resultCodes(statuses...), []codes.Code{codes.OK, codes.OK, codes.NotFound})
+
+	for _, r := range requests[:2] {
+		body, err := server.GetApiSpecContents(ctx, &rpc.GetApiSpecContentsRequest{Name: r.GetApiSpec().GetName()})
+		if err != nil {
+			t.Fatalf("GetApiSpecContents(%q) returned error: %s", r.GetApiSpec().GetName(), err)
+		}
+		if string(body.GetData()) != string(r.GetApiSpec().GetContents()) {
+			t.Errorf("GetApiSpecContents(%q) returned %q, want %q", r.GetApiSpec().GetName(), body.GetData(), r.GetApiSpec().GetContents())
+		}
+	}
+}
+
+func TestBatchArtifacts(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	if err := seeder.SeedProjects(ctx, server, &rpc.Project{Name: "projects/my-project"}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	const parent = "projects/my-project/locations/global"
+	requests := make([]*rpc.CreateArtifactRequest, 3)
+	for i := range requests {
+		requests[i] = &rpc.CreateArtifactRequest{
+			ArtifactId: fmt.Sprintf("x%d", i),
+			Artifact:   &rpc.Artifact{Contents: []byte(fmt.Sprintf("contents-%d", i))},
+		}
+	}
+	created, err := server.BatchCreateArtifacts(ctx, &rpc.BatchCreateArtifactsRequest{Parent: parent, Requests: requests})
+	if err != nil {
+		t.Fatalf("BatchCreateArtifacts() returned error: %s", err)
+	}
+
+	replaced, err := server.BatchReplaceArtifacts(ctx, &rpc.BatchReplaceArtifactsRequest{
+		Parent: parent,
+		Requests: []*rpc.ReplaceArtifactRequest{
+			{Artifact: &rpc.Artifact{Name: parent + "/artifacts/x0", Contents: []byte("new"), Etag: created.GetResults()[0].GetArtifact().GetEtag()}},
+			{Artifact: &rpc.Artifact{Name: parent + "/artifacts/x1", Contents: []byte("new"), Etag: "stale"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("BatchReplaceArtifacts() returned error: %s", err)
+	}
+	var statuses []*spb.Status
+	for _, r := range replaced.GetResults() {
+		statuses = append(statuses, r.GetStatus())
+	}
+	checkCodes(t, "BatchReplaceArtifacts()", resultCodes(statuses...), []codes.Code{codes.OK, codes.Aborted})
+
+	updated, err := server.BatchUpdateArtifacts(ctx, &rpc.BatchUpdateArtifactsRequest{
+		Parent: parent,
+		Requests: []*rpc.UpdateArtifactRequest{
+			{Artifact: &rpc.Artifact{Name: parent + "/artifacts/x0", Labels: map[string]string{"k": "v"}}, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"labels"}}},
+			{Artifact: &rpc.Artifact{Name: parent + "/artifacts/x2", Labels: map[string]string{"k": "v"}}, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"contents"}}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("BatchUpdateArtifacts() returned error: %s", err)
+	}
+	statuses = nil
+	for _, r := range updated.GetResults() {
+		statuses = append(statuses, r.GetStatus())
+	}
+	checkCodes(t, "BatchUpdateArtifacts()", resultCodes(statuses...), []codes.Code{codes.OK, codes.InvalidArgument})
+	if got, want := updated.GetResults()[0].GetArtifact().GetRevisionId(), replaced.GetResults()[0].GetArtifact().GetRevisionId(); got != want {
+		t.Errorf("BatchUpdateArtifacts() returned revision %q, want %q", got, want)
+	}
+
+	deleted, err := server.BatchDeleteArtifacts(ctx, &rpc.BatchDeleteArtifactsRequest{
+		Parent: parent,
+		Requests: []*rpc.DeleteArtifactRequest{
+			{Name: parent + "/artifacts/x1"},
+			{Name: parent + "/artifacts/x2"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("BatchDeleteArtifacts() returned error: %s", err)
+	}
+	checkCodes(t, "BatchDeleteArtifacts()", resultCodes(deleted.GetStatuses()...), []codes.Code{codes.OK, codes.OK})
+
+	got, err := server.BatchGetArtifacts(ctx, &rpc.BatchGetArtifactsRequest{
+		Parent: parent,
+		Names:  []string{parent + "/artifacts/x0", parent + "/artifacts/x1"},
+	})
+	if err != nil {
+		t.Fatalf("BatchGetArtifacts() returned error: %s", err)
+	}
+	statuses = nil
+	for _, r := range got.GetResults() {
+		statuses = append(statuses, r.GetStatus())
+	}
+	checkCodes(t, "BatchGetArtifacts()", resultCodes(statuses...), []codes.Code{codes.OK, codes.NotFound})
+	if got.GetResults()[0].GetArtifact().GetHash() == created.GetResults()[0].GetArtifact().GetHash() {
+		t.Errorf("BatchGetArtifacts() returned the hash of the replaced contents")
+	}
+}
+
+func TestBatchRequestErrors(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	tooMany := make([]string, maxBatchSize+1)
+	for i := range tooMany {
+		tooMany[i] = fmt.Sprintf("projects/my-project/locations/global/apis/a%d", i)
+	}
+
+	tests := []struct {
+		desc string
+		req  *rpc.BatchGetApisRequest
+	}{
+		{
+			desc: "invalid parent",
+			req:  &rpc.BatchGetApisRequest{Parent: "projects/my-project/locations/global/apis/a"},
+		},
+		{
+			desc: "name outside of parent",
+			req: &rpc.BatchGetApisRequest{
+				Parent: "projects/my-project/locations/global",
+				Names:  []string{"projects/other-project/locations/global/apis/a"},
+			},
+		},
+		{
+			desc: "too many requests",
+			req: &rpc.BatchGetApisRequest{
+				Parent: "projects/my-project/locations/global",
+				Names:  tooMany,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			if _, err := server.BatchGetApis(ctx, test.req); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("BatchGetApis() returned status code %q, want %q: %v", status.Code(err), codes.InvalidArgument, err)
+			}
+		})
+	}
+}
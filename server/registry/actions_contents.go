@@ -0,0 +1,221 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/apigee/registry/server/registry/names"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// contentsChunkSize is the maximum size of the chunks sent by download streams.
+const contentsChunkSize = 1 << 20
+
+// defaultMaxUploadSize is the maximum size of uploaded contents if the server doesn't configure one.
+const defaultMaxUploadSize = 1 << 30
+
+// uploadRequest is a message of an upload stream.
+type uploadRequest interface {
+	GetChunk() []byte
+	GetChecksum() string
+}
+
+// receiveContents receives the messages of an upload stream until the client closes it.
+// It returns the contents of all chunks after verifying them with the checksum that was sent.
+// Streams are rejected as soon as their contents exceed maxSize bytes.
+func receiveContents(recv func() (uploadRequest, error), maxSize int64) ([]byte, error) {
+	var (
+		contents bytes.Buffer
+		checksum string
+	)
+
+	for {
+		req, err := recv()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		if c := req.GetChecksum(); c != "" {
+			if checksum != "" && c != checksum {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid checksum %q: stream already sent checksum %q", c, checksum)
+			}
+			checksum = c
+		}
+		if int64(contents.Len())+int64(len(req.GetChunk())) > maxSize {
+			return nil, status.Errorf(codes.ResourceExhausted, "invalid contents: must be at most %d bytes", maxSize)
+		}
+		contents.Write(req.GetChunk())
+	}
+
+	if checksum == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid checksum: must be sent with the contents")
+	}
+	if actual := sha256Checksum(contents.Bytes()); actual != checksum {
+		return nil, status.Errorf(codes.DataLoss, "checksum %q does not match received contents with checksum %q", checksum, actual)
+	}
+
+	return contents.Bytes(), nil
+}
+
+// sendContents sends contents in a stream of chunks.
+// The first chunk also describes the complete contents.
+func sendContents(body *httpbody.HttpBody, send func(*rpc.ContentsChunk) error) error {
+	data := body.GetData()
+	chunk := &rpc.ContentsChunk{
+		ContentType: body.GetContentType(),
+		SizeBytes:   int64(len(data)),
+		Checksum:    sha256Checksum(data),
+	}
+
+	for {
+		n := len(data)
+		if n > contentsChunkSize {
+			n = contentsChunkSize
+		}
+		chunk.Data, data = data[:n], data[n:]
+		if err := send(chunk); err != nil {
+			return err
+		}
+		if len(data) == 0 {
+			return nil
+		}
+		chunk = &rpc.ContentsChunk{}
+	}
+}
+
+// sha256Checksum returns the checksum that verifies streamed contents.
+func sha256Checksum(contents []byte) string {
+	sum := sha256.Sum256(contents)
+	return hex.EncodeToString(sum[:])
+}
+
+// UploadApiSpecContents handles the corresponding API request.
+func (s *RegistryServer) UploadApiSpecContents(stream rpc.Registry_UploadApiSpecContentsServer) error {
+	var spec *rpc.ApiSpec
+	contents, err := receiveContents(func() (uploadRequest, error) {
+		req, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if spec == nil {
+			if req.GetApiSpec() == nil {
+				return nil, status.Error(codes.InvalidArgument, "invalid api_spec: must be sent in the first message")
+			} else if len(req.GetApiSpec().GetContents()) > 0 {
+				return nil, status.Error(codes.InvalidArgument, "invalid api_spec: contents must be sent in chunks")
+			}
+			spec = proto.Clone(req.GetApiSpec()).(*rpc.ApiSpec)
+		}
+		return req, nil
+	}, s.maxUploadSize)
+	if err != nil {
+		return err
+	} else if spec == nil {
+		return status.Error(codes.InvalidArgument, "invalid api_spec: must be sent in the first message")
+	}
+
+	spec.Contents = contents
+	response, err := s.UpdateApiSpec(stream.Context(), &rpc.UpdateApiSpecRequest{
+		ApiSpec:      spec,
+		AllowMissing: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(response)
+}
+
+// DownloadApiSpecContents handles the corresponding API request.
+func (s *RegistryServer) DownloadApiSpecContents(req *rpc.DownloadApiSpecContentsRequest, stream rpc.Registry_DownloadApiSpecContentsServer) error {
+	body, err := s.GetApiSpecContents(stream.Context(), &rpc.GetApiSpecContentsRequest{Name: req.GetName()})
+	if err != nil {
+		return err
+	}
+
+	return sendContents(body, stream.Send)
+}
+
+// UploadArtifactContents handles the corresponding API request.
+func (s *RegistryServer) UploadArtifactContents(stream rpc.Registry_UploadArtifactContentsServer) error {
+	var artifact *rpc.Artifact
+	contents, err := receiveContents(func() (uploadRequest, error) {
+		req, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if artifact == nil {
+			if req.GetArtifact() == nil {
+				return nil, status.Error(codes.InvalidArgument, "invalid artifact: must be sent in the first message")
+			} else if len(req.GetArtifact().GetContents()) > 0 {
+				return nil, status.Error(codes.InvalidArgument, "invalid artifact: contents must be sent in chunks")
+			}
+			artifact = proto.Clone(req.GetArtifact()).(*rpc.Artifact)
+		}
+		return req, nil
+	}, s.maxUploadSize)
+	if err != nil {
+		return err
+	} else if artifact == nil {
+		return status.Error(codes.InvalidArgument, "invalid artifact: must be sent in the first message")
+	}
+
+	name, err := names.ParseArtifact(artifact.GetName())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// The artifact is created or replaced in a transaction that is retried if a concurrent upload creates it first.
+	artifact.Contents = contents
+	var response *rpc.Artifact
+	if err := s.upsert(stream.Context(), func(ctx context.Context, db *storage.Client) error {
+		current, err := db.ForUpdate().GetArtifact(ctx, name)
+		if isNotFound(err) && artifact.GetEtag() == "" {
+			response, err = s.insertArtifact(ctx, db, name, artifact)
+			return err
+		} else if err != nil {
+			return err
+		}
+
+		response, err = s.replaceArtifact(ctx, db, current, name, artifact)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(response)
+}
+
+// DownloadArtifactContents handles the corresponding API request.
+func (s *RegistryServer) DownloadArtifactContents(req *rpc.DownloadArtifactContentsRequest, stream rpc.Registry_DownloadArtifactContentsServer) error {
+	body, err := s.GetArtifactContents(stream.Context(), &rpc.GetArtifactContentsRequest{Name: req.GetName()})
+	if err != nil {
+		return err
+	}
+
+	return sendContents(body, stream.Send)
+}
@@ -0,0 +1,343 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/test/seeder"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// specUploadStream sends a fixed sequence of requests to UploadApiSpecContents.
+// It can also be served through interceptors and the generated stream handler.
+type specUploadStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*rpc.UploadApiSpecContentsRequest
+	response *rpc.ApiSpec
+}
+
+func (s *specUploadStream) Context() context.Context {
+	if s.ctx == nil {
+		return context.Background()
+	}
+	return s.ctx
+}
+
+func (s *specUploadStream) Recv() (*rpc.UploadApiSpecContentsRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *specUploadStream) SendAndClose(spec *rpc.ApiSpec) error {
+	s.response = spec
+	return nil
+}
+
+func (s *specUploadStream) RecvMsg(m interface{}) error {
+	req, err := s.Recv()
+	if err != nil {
+		return err
+	}
+	proto.Merge(m.(proto.Message), req)
+	return nil
+}
+
+func (s *specUploadStream) SendMsg(m interface{}) error {
+	return s.SendAndClose(m.(*rpc.ApiSpec))
+}
+
+// artifactUploadStream sends a fixed sequence of requests to UploadArtifactContents.
+type artifactUploadStream struct {
+	grpc.ServerStream
+	requests []*rpc.UploadArtifactContentsRequest
+	response *rpc.Artifact
+}
+
+func (s *artifactUploadStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *artifactUploadStream) Recv() (*rpc.UploadArtifactContentsRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *artifactUploadStream) SendAndClose(artifact *rpc.Artifact) error {
+	s.response = artifact
+	return nil
+}
+
+// downloadStream collects the chunks sent by download streams.
+type downloadStream struct {
+	grpc.ServerStream
+	chunks []*rpc.ContentsChunk
+}
+
+func (s *downloadStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *downloadStream) Send(chunk *rpc.ContentsChunk) error {
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+// contents returns the concatenated data of the received chunks.
+func (s *downloadStream) contents() []byte {
+	var b bytes.Buffer
+	for _, chunk := range s.chunks {
+		b.Write(chunk.GetData())
+	}
+	return b.Bytes()
+}
+
+func TestSpecContentsStreaming(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	if err := seeder.SeedVersions(ctx, server, &rpc.ApiVersion{Name: "projects/my-project/locations/global/apis/a/versions/v"}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	// The contents span more than two chunks.
+	contents := bytes.Repeat([]byte("openapi: 3.0.0\n"), 2*contentsChunkSize/10)
+	spec := &rpc.ApiSpec{
+		Name:     "projects/my-project/locations/global/apis/a/versions/v/specs/s",
+		MimeType: "application/x.openapi;version=3",
+	}
+
+	upload := &specUploadStream{requests: []*rpc.UploadApiSpecContentsRequest{
+		{ApiSpec: spec, Chunk: contents[:contentsChunkSize]},
+		{Chunk: contents[contentsChunkSize:]},
+		{Checksum: sha256Checksum(contents)},
+	}}
+	if err := server.UploadApiSpecContents(upload); err != nil {
+		t.Fatalf("UploadApiSpecContents() returned error: %s", err)
+	}
+	if got, want := upload.response.GetSizeBytes(), int64(len(contents)); got != want {
+		t.Errorf("UploadApiSpecContents() returned size_bytes %d, want %d", got, want)
+	}
+
+	download := &downloadStream{}
+	if err := server.DownloadApiSpecContents(&rpc.DownloadApiSpecContentsRequest{Name: spec.GetName()}, download); err != nil {
+		t.Fatalf("DownloadApiSpecContents() returned error: %s", err)
+	}
+	if len(download.chunks) != 3 {
+		t.Errorf("DownloadApiSpecContents() sent %d chunks, want 3", len(download.chunks))
+	}
+	first := download.chunks[0]
+	if first.GetContentType() != spec.GetMimeType() {
+		t.Errorf("DownloadApiSpecContents() returned content_type %q, want %q", first.GetContentType(), spec.GetMimeType())
+	}
+	if first.GetSizeBytes() != int64(len(contents)) {
+		t.Errorf("DownloadApiSpecContents() returned size_bytes %d, want %d", first.GetSizeBytes(), len(contents))
+	}
+	if first.GetChecksum() != sha256Checksum(contents) {
+		t.Errorf("DownloadApiSpecContents() returned checksum %q, want %q", first.GetChecksum(), sha256Checksum(contents))
+	}
+	if !bytes.Equal(download.contents(), contents) {
+		t.Errorf("DownloadApiSpecContents() returned unexpected contents")
+	}
+
+	// Uploading the same contents again doesn't create a revision.
+	upload = &specUploadStream{requests: []*rpc.UploadApiSpecContentsRequest{
+		{ApiSpec: &rpc.ApiSpec{Name: spec.GetName()}, Chunk: contents, Checksum: sha256Checksum(contents)},
+	}}
+	if err := server.UploadApiSpecContents(upload); err != nil {
+		t.Fatalf("UploadApiSpecContents() returned error: %s", err)
+	}
+	revisions, err := server.ListApiSpecRevisions(ctx, &rpc.ListApiSpecRevisionsRequest{Name: spec.GetName()})
+	if err != nil {
+		t.Fatalf("ListApiSpecRevisions() returned error: %s", err)
+	}
+	if len(revisions.GetApiSpecs()) != 1 {
+		t.Errorf("ListApiSpecRevisions() returned %d revisions, want 1", len(revisions.GetApiSpecs()))
+	}
+}
+
+func TestArtifactContentsStreaming(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	if err := seeder.SeedProjects(ctx, server, &rpc.Project{Name: "projects/my-project"}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	name := "projects/my-project/locations/global/artifacts/a"
+	for _, contents := range [][]byte{[]byte("created"), []byte("replaced")} {
+		upload := &artifactUploadStream{requests: []*rpc.UploadArtifactContentsRequest{
+			{Artifact: &rpc.Artifact{Name: name, MimeType: "text/plain"}, Chunk: contents[:3]},
+			{Chunk: contents[3:], Checksum: sha256Checksum(contents)},
+		}}
+		if err := server.UploadArtifactContents(upload); err != nil {
+			t.Fatalf("UploadArtifactContents() returned error: %s", err)
+		}
+
+		download := &downloadStream{}
+		if err := server.DownloadArtifactContents(&rpc.DownloadArtifactContentsRequest{Name: name}, download); err != nil {
+			t.Fatalf("DownloadArtifactContents() returned error: %s", err)
+		}
+		if !bytes.Equal(download.contents(), contents) {
+			t.Errorf("DownloadArtifactContents() returned contents %q, want %q", download.contents(), contents)
+		}
+		if got := download.chunks[0].GetChecksum(); got != sha256Checksum(contents) {
+			t.Errorf("DownloadArtifactContents() returned checksum %q, want %q", got, sha256Checksum(contents))
+		}
+	}
+}
+
+func TestConcurrentArtifactContentsUploads(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	if err := seeder.SeedProjects(ctx, server, &rpc.Project{Name: "projects/my-project"}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	// Every upload either creates the artifact or replaces the artifact that another upload created.
+	const count = 10
+	name := "projects/my-project/locations/global/artifacts/a"
+	errs := make([]error, count)
+	var wg sync.WaitGroup
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			contents := []byte(fmt.Sprintf("contents-%d", i))
+			errs[i] = server.UploadArtifactContents(&artifactUploadStream{requests: []*rpc.UploadArtifactContentsRequest{
+				{Artifact: &rpc.Artifact{Name: name}, Chunk: contents, Checksum: sha256Checksum(contents)},
+			}})
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("UploadArtifactContents() request %d returned error: %s", i, err)
+		}
+	}
+
+	got, err := server.ListArtifactRevisions(ctx, &rpc.ListArtifactRevisionsRequest{Name: name})
+	if err != nil {
+		t.Fatalf("ListArtifactRevisions() returned error: %s", err)
+	}
+	if len(got.GetArtifacts()) != count {
+		t.Errorf("ListArtifactRevisions() returned %d revisions, want %d", len(got.GetArtifacts()), count)
+	}
+}
+
+func TestContentsStreamingResponseCodes(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	if err := seeder.SeedVersions(ctx, server, &rpc.ApiVersion{Name: "projects/my-project/locations/global/apis/a/versions/v"}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	spec := &rpc.ApiSpec{Name: "projects/my-project/locations/global/apis/a/versions/v/specs/s"}
+	tests := []struct {
+		desc     string
+		requests []*rpc.UploadApiSpecContentsRequest
+		want     codes.Code
+	}{
+		{
+			desc: "checksum mismatch",
+			requests: []*rpc.UploadApiSpecContentsRequest{
+				{ApiSpec: spec, Chunk: []byte("contents"), Checksum: sha256Checksum([]byte("other contents"))},
+			},
+			want: codes.DataLoss,
+		},
+		{
+			desc: "missing checksum",
+			requests: []*rpc.UploadApiSpecContentsRequest{
+				{ApiSpec: spec, Chunk: []byte("contents")},
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "conflicting checksums",
+			requests: []*rpc.UploadApiSpecContentsRequest{
+				{ApiSpec: spec, Chunk: []byte("contents"), Checksum: sha256Checksum([]byte("contents"))},
+				{Checksum: sha256Checksum([]byte("other contents"))},
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "missing spec",
+			requests: []*rpc.UploadApiSpecContentsRequest{
+				{Chunk: []byte("contents"), Checksum: sha256Checksum([]byte("contents"))},
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "contents in spec",
+			requests: []*rpc.UploadApiSpecContentsRequest{
+				{ApiSpec: &rpc.ApiSpec{Name: spec.GetName(), Contents: []byte("contents")}, Checksum: sha256Checksum([]byte("contents"))},
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "empty stream",
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "contents too large",
+			requests: []*rpc.UploadApiSpecContentsRequest{
+				{ApiSpec: spec, Chunk: bytes.Repeat([]byte("x"), 1024)},
+				{Chunk: []byte("x"), Checksum: sha256Checksum(bytes.Repeat([]byte("x"), 1025))},
+			},
+			want: codes.ResourceExhausted,
+		},
+	}
+
+	server.maxUploadSize = 1024
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			err := server.UploadApiSpecContents(&specUploadStream{requests: test.requests})
+			if status.Code(err) != test.want {
+				t.Errorf("UploadApiSpecContents() returned status code %q, want %q: %v", status.Code(err), test.want, err)
+			}
+		})
+	}
+
+	if _, err := server.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: spec.GetName()}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetApiSpec() after failed uploads returned status code %q, want %q: %v", status.Code(err), codes.NotFound, err)
+	}
+
+	err := server.DownloadArtifactContents(&rpc.DownloadArtifactContentsRequest{Name: "projects/my-project/locations/global/artifacts/missing"}, &downloadStream{})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("DownloadArtifactContents() returned status code %q, want %q: %v", status.Code(err), codes.NotFound, err)
+	}
+}
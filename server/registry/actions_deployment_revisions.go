@@ -23,6 +23,7 @@ import (
 	"github.com/apigee/registry/server/registry/names"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 // ListApiDeploymentRevisions handles the corresponding API request.
@@ -31,7 +32,6 @@ func (s *RegistryServer) ListApiDeploymentRevisions(ctx context.Context, req *rp
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	if req.GetPageSize() < 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid page_size %d: must not be negative", req.GetPageSize())
@@ -81,7 +81,6 @@ func (s *RegistryServer) DeleteApiDeploymentRevision(ctx context.Context, req *r
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	name, err := names.ParseDeploymentRevision(req.GetName())
 	if err != nil {
@@ -100,12 +99,15 @@ func (s *RegistryServer) DeleteApiDeploymentRevision(ctx context.Context, req *r
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if err := db.DeleteDeploymentRevision(ctx, name); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := db.DeleteDeploymentRevision(ctx, name); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		return s.notify(ctx, db, rpc.Notification_DELETED, name.String())
+	}); err != nil {
+		return nil, err
 	}
 
-	s.notify(ctx, rpc.Notification_DELETED, name.String())
-
 	// return the latest revision of the current deployment
 	deployment, err := s.getApiDeployment(ctx, name.Deployment())
 	if err != nil {
@@ -122,7 +124,6 @@ func (s *RegistryServer) TagApiDeploymentRevision(ctx context.Context, req *rpc.
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	if req.GetTag() == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid tag %q, must not be empty", req.GetTag())
@@ -149,21 +150,27 @@ func (s *RegistryServer) TagApiDeploymentRevision(ctx context.Context, req *rpc.
 	}
 
 	tag := models.NewDeploymentRevisionTag(name, req.GetTag())
-	if err := db.SaveDeploymentRevisionTag(ctx, tag); err != nil {
-		return nil, err
-	}
+	var message *rpc.ApiDeployment
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := db.SaveDeploymentRevisionTag(ctx, tag); err != nil {
+			return err
+		}
 
-	tags, err := deploymentRevisionTags(ctx, db, name)
-	if err != nil {
-		return nil, err
-	}
+		tags, err := deploymentRevisionTags(ctx, db, name)
+		if err != nil {
+			return err
+		}
 
-	message, err := revision.BasicMessage(tag.String(), tags)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		message, err = revision.BasicMessage(tag.String(), tags)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		return s.notify(ctx, db, rpc.Notification_UPDATED, name.String(),
+			withSnapshot(message), withUpdateMask(&fieldmaskpb.FieldMask{Paths: []string{"revision_tags"}}))
+	}); err != nil {
+		return nil, err
 	}
 
-	s.notify(ctx, rpc.Notification_UPDATED, name.String())
 	return message, nil
 }
 
@@ -173,7 +180,6 @@ func (s *RegistryServer) RollbackApiDeployment(ctx context.Context, req *rpc.Rol
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	if req.GetRevisionId() == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid revision ID %q, must not be empty", req.GetRevisionId())
@@ -191,17 +197,28 @@ func (s *RegistryServer) RollbackApiDeployment(ctx context.Context, req *rpc.Rol
 		return nil, err
 	}
 
-	// Save a new rollback revision based on the target revision.
-	rollback := target.NewRevision()
-	if err := db.SaveDeploymentRevision(ctx, rollback); err != nil {
+	// The revision that is current before the rollback is reported in the notification.
+	current, err := db.GetDeployment(ctx, parent)
+	if err != nil {
 		return nil, err
 	}
 
+	// Save a new rollback revision based on the target revision.
+	rollback := target.NewRevision()
 	message, err := rollback.BasicMessage(rollback.RevisionName(), []string{})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	s.notify(ctx, rpc.Notification_CREATED, rollback.RevisionName())
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := db.SaveDeploymentRevision(ctx, rollback); err != nil {
+			return err
+		}
+		return s.notify(ctx, db, rpc.Notification_CREATED, rollback.RevisionName(),
+			withSnapshot(message), withPreviousRevision(current.RevisionID))
+	}); err != nil {
+		return nil, err
+	}
+
 	return message, nil
 }
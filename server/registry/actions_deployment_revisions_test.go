@@ -216,7 +216,7 @@ func TestRollbackApiDeployment(t *testing.T) {
 
 	opts := cmp.Options{
 		protocmp.Transform(),
-		protocmp.IgnoreFields(new(rpc.ApiDeployment), "revision_id", "revision_create_time", "revision_update_time"),
+		protocmp.IgnoreFields(new(rpc.ApiDeployment), "revision_id", "revision_create_time", "revision_update_time", "etag"),
 	}
 
 	if !cmp.Equal(want, rollback, opts) {
@@ -308,6 +308,7 @@ func TestListApiDeploymentRevisions(t *testing.T) {
 		RevisionCreateTime: firstRevision.GetRevisionCreateTime(),
 		RevisionUpdateTime: firstRevision.GetRevisionUpdateTime(),
 		RevisionId:         firstRevision.GetRevisionId(),
+		Etag:               firstRevision.GetEtag(),
 	}
 
 	updateReq := &rpc.UpdateApiDeploymentRequest{
@@ -329,6 +330,7 @@ func TestListApiDeploymentRevisions(t *testing.T) {
 		RevisionCreateTime: secondRevision.GetRevisionCreateTime(),
 		RevisionUpdateTime: secondRevision.GetRevisionUpdateTime(),
 		RevisionId:         secondRevision.GetRevisionId(),
+		Etag:               secondRevision.GetEtag(),
 	}
 
 	opts := cmp.Options{
@@ -417,7 +419,7 @@ func TestUpdateApiDeploymentRevisions(t *testing.T) {
 
 	opts := cmp.Options{
 		protocmp.Transform(),
-		protocmp.IgnoreFields(new(rpc.ApiDeployment), "revision_id", "create_time", "revision_create_time", "revision_update_time"),
+		protocmp.IgnoreFields(new(rpc.ApiDeployment), "revision_id", "create_time", "revision_create_time", "revision_update_time", "etag"),
 	}
 
 	t.Run("modify revision without content changes", func(t *testing.T) {
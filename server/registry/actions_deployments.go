@@ -16,7 +16,6 @@ package registry
 
 import (
 	"context"
-	"sync"
 
 	"github.com/apigee/registry/rpc"
 	"github.com/apigee/registry/server/registry/internal/storage"
@@ -42,12 +41,19 @@ func (s *RegistryServer) CreateApiDeployment(ctx context.Context, req *rpc.Creat
 }
 
 func (s *RegistryServer) createDeployment(ctx context.Context, name names.Deployment, body *rpc.ApiDeployment) (*rpc.ApiDeployment, error) {
-	db, err := s.getStorageClient(ctx)
-	if err != nil {
-		return nil, status.Error(codes.Unavailable, err.Error())
+	var message *rpc.ApiDeployment
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) (err error) {
+		message, err = s.insertDeployment(ctx, db, name, body)
+		return err
+	}); err != nil {
+		return nil, err
 	}
-	defer db.Close()
 
+	return message, nil
+}
+
+// insertDeployment creates an API deployment using a client for a transaction that may have other changes.
+func (s *RegistryServer) insertDeployment(ctx context.Context, db *storage.Client, name names.Deployment, body *rpc.ApiDeployment) (*rpc.ApiDeployment, error) {
 	if _, err := db.GetDeployment(ctx, name); err == nil {
 		return nil, status.Errorf(codes.AlreadyExists, "API deployment %q already exists", name)
 	} else if !isNotFound(err) {
@@ -68,16 +74,18 @@ func (s *RegistryServer) createDeployment(ctx context.Context, name names.Deploy
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if err := db.SaveDeploymentRevision(ctx, deployment); err != nil {
-		return nil, err
-	}
-
 	message, err := deployment.BasicMessage(name.String(), []string{})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	s.notify(ctx, rpc.Notification_CREATED, deployment.RevisionName())
+	if err := db.SaveDeploymentRevision(ctx, deployment); err != nil {
+		return nil, err
+	}
+	if err := s.notify(ctx, db, rpc.Notification_CREATED, deployment.RevisionName(), withSnapshot(message)); err != nil {
+		return nil, err
+	}
+
 	return message, nil
 }
 
@@ -87,7 +95,6 @@ func (s *RegistryServer) DeleteApiDeployment(ctx context.Context, req *rpc.Delet
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	name, err := names.ParseDeployment(req.GetName())
 	if err != nil {
@@ -99,14 +106,52 @@ func (s *RegistryServer) DeleteApiDeployment(ctx context.Context, req *rpc.Delet
 		return nil, err
 	}
 
-	if err := db.DeleteDeployment(ctx, name, req.GetForce()); err != nil {
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := checkETag(name.String(), req.GetEtag(), func() (etagged, error) {
+			return db.ForUpdate().GetDeployment(ctx, name)
+		}); err != nil {
+			return err
+		}
+		if err := db.DeleteDeployment(ctx, name, req.GetForce()); err != nil {
+			return err
+		}
+		return s.notify(ctx, db, rpc.Notification_DELETED, name.String())
+	}); err != nil {
 		return nil, err
 	}
 
-	s.notify(ctx, rpc.Notification_DELETED, name.String())
 	return &emptypb.Empty{}, nil
 }
 
+// UndeleteApiDeployment handles the corresponding API request.
+func (s *RegistryServer) UndeleteApiDeployment(ctx context.Context, req *rpc.UndeleteApiDeploymentRequest) (*rpc.ApiDeployment, error) {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	name, err := names.ParseDeployment(req.GetName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Restoration should only succeed when the parent exists.
+	if _, err := db.GetApi(ctx, name.Api()); err != nil {
+		return nil, undeleteParentError(err, name.String())
+	}
+
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := db.UndeleteDeployment(ctx, name); err != nil {
+			return err
+		}
+		return s.notify(ctx, db, rpc.Notification_CREATED, name.String())
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.getApiDeployment(ctx, name)
+}
+
 // GetApiDeployment handles the corresponding API request.
 func (s *RegistryServer) GetApiDeployment(ctx context.Context, req *rpc.GetApiDeploymentRequest) (*rpc.ApiDeployment, error) {
 	if name, err := names.ParseDeployment(req.GetName()); err == nil {
@@ -123,7 +168,6 @@ func (s *RegistryServer) getApiDeployment(ctx context.Context, name names.Deploy
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	deployment, err := db.GetDeployment(ctx, name)
 	if err != nil {
@@ -148,7 +192,6 @@ func (s *RegistryServer) getApiDeploymentRevision(ctx context.Context, name name
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	revision, err := db.GetDeploymentRevision(ctx, name)
 	if err != nil {
@@ -174,7 +217,6 @@ func (s *RegistryServer) ListApiDeployments(ctx context.Context, req *rpc.ListAp
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	if req.GetPageSize() < 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid page_size %d: must not be negative", req.GetPageSize())
@@ -190,9 +232,11 @@ func (s *RegistryServer) ListApiDeployments(ctx context.Context, req *rpc.ListAp
 	}
 
 	listing, err := db.ListDeployments(ctx, parent, storage.PageOptions{
-		Size:   req.GetPageSize(),
-		Filter: req.GetFilter(),
-		Token:  req.GetPageToken(),
+		Size:        req.GetPageSize(),
+		Filter:      req.GetFilter(),
+		Order:       req.GetOrderBy(),
+		Token:       req.GetPageToken(),
+		ShowDeleted: req.GetShowDeleted(),
 	})
 	if err != nil {
 		return nil, err
@@ -219,16 +263,8 @@ func (s *RegistryServer) ListApiDeployments(ctx context.Context, req *rpc.ListAp
 	return response, nil
 }
 
-var updateDeploymentMutex sync.Mutex
-
 // UpdateApiDeployment handles the corresponding API request.
 func (s *RegistryServer) UpdateApiDeployment(ctx context.Context, req *rpc.UpdateApiDeploymentRequest) (*rpc.ApiDeployment, error) {
-	db, err := s.getStorageClient(ctx)
-	if err != nil {
-		return nil, status.Error(codes.Unavailable, err.Error())
-	}
-	defer db.Close()
-
 	if req.GetApiDeployment() == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid api_deployment %+v: body must be provided", req.GetApiDeployment())
 	} else if err := models.ValidateMask(req.GetApiDeployment(), req.GetUpdateMask()); err != nil {
@@ -240,46 +276,49 @@ func (s *RegistryServer) UpdateApiDeployment(ctx context.Context, req *rpc.Updat
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if req.GetAllowMissing() {
-		// Prevent a race condition that can occur when two updates are made
-		// to the same non-existent resource. The db.Get...() call returns
-		// NotFound for both updates, and after one creates the resource,
-		// the other creation fails. The lock() prevents this by serializing
-		// the get and create operations. Future updates could improve this
-		// with improvements closer to the database level.
-		updateDeploymentMutex.Lock()
-		defer updateDeploymentMutex.Unlock()
-	}
+	var message *rpc.ApiDeployment
+	if err := s.upsert(ctx, func(ctx context.Context, db *storage.Client) error {
+		deployment, err := db.ForUpdate().GetDeployment(ctx, name)
+		if req.GetAllowMissing() && isNotFound(err) && req.GetApiDeployment().GetEtag() == "" {
+			message, err = s.insertDeployment(ctx, db, name, req.GetApiDeployment())
+			return err
+		} else if err != nil {
+			return err
+		}
 
-	deployment, err := db.GetDeployment(ctx, name)
-	if req.GetAllowMissing() && isNotFound(err) {
-		return s.createDeployment(ctx, name, req.GetApiDeployment())
-	} else if err != nil {
-		return nil, err
-	}
+		if err := checkETag(name.String(), req.GetApiDeployment().GetEtag(), func() (etagged, error) {
+			return deployment, nil
+		}); err != nil {
+			return err
+		}
 
-	// Apply the update to the deployment - possibly changing the revision ID.
-	maskExpansion := models.ExpandMask(req.GetApiDeployment(), req.GetUpdateMask())
-	if err := deployment.Update(req.GetApiDeployment(), maskExpansion); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
+		// Apply the update to the deployment - possibly changing the revision ID.
+		previousRevisionID := deployment.RevisionID
+		maskExpansion := models.ExpandMask(req.GetApiDeployment(), req.GetUpdateMask())
+		if err := deployment.Update(req.GetApiDeployment(), maskExpansion); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
 
-	// Save the updated/current deployment. This creates a new revision or updates the previous one.
-	if err := db.SaveDeploymentRevision(ctx, deployment); err != nil {
-		return nil, err
-	}
+		tags, err := deploymentRevisionTags(ctx, db, name.Revision(deployment.RevisionID))
+		if err != nil {
+			return err
+		}
 
-	tags, err := deploymentRevisionTags(ctx, db, name.Revision(deployment.RevisionID))
-	if err != nil {
-		return nil, err
-	}
+		message, err = deployment.BasicMessage(name.String(), tags)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
 
-	message, err := deployment.BasicMessage(name.String(), tags)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		// Save the updated/current deployment. This creates a new revision or updates the previous one.
+		if err := db.SaveDeploymentRevision(ctx, deployment); err != nil {
+			return err
+		}
+		return s.notify(ctx, db, rpc.Notification_UPDATED, deployment.RevisionName(),
+			withSnapshot(message), withPreviousRevision(previousRevisionID), withUpdateMask(maskExpansion))
+	}); err != nil {
+		return nil, err
 	}
 
-	s.notify(ctx, rpc.Notification_UPDATED, deployment.RevisionName())
 	return message, nil
 }
 
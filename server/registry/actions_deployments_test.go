@@ -81,7 +81,7 @@ func TestCreateApiDeployment(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.ApiDeployment), "revision_id", "create_time", "revision_create_time", "revision_update_time"),
+				protocmp.IgnoreFields(new(rpc.ApiDeployment), "revision_id", "create_time", "revision_create_time", "revision_update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, created, opts) {
@@ -338,7 +338,7 @@ func TestGetApiDeployment(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.ApiDeployment), "revision_id", "create_time", "revision_create_time", "revision_update_time"),
+				protocmp.IgnoreFields(new(rpc.ApiDeployment), "revision_id", "create_time", "revision_create_time", "revision_update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, got, opts) {
@@ -573,7 +573,7 @@ func TestListApiDeployments(t *testing.T) {
 			opts := cmp.Options{
 				protocmp.Transform(),
 				protocmp.IgnoreFields(new(rpc.ListApiDeploymentsResponse), "next_page_token"),
-				protocmp.IgnoreFields(new(rpc.ApiDeployment), "revision_id", "create_time", "revision_create_time", "revision_update_time"),
+				protocmp.IgnoreFields(new(rpc.ApiDeployment), "revision_id", "create_time", "revision_create_time", "revision_update_time", "etag"),
 				protocmp.SortRepeated(func(a, b *rpc.ApiDeployment) bool {
 					return a.GetName() < b.GetName()
 				}),
@@ -747,7 +747,7 @@ func TestListApiDeploymentsSequence(t *testing.T) {
 
 	opts := cmp.Options{
 		protocmp.Transform(),
-		protocmp.IgnoreFields(new(rpc.ApiDeployment), "revision_id", "create_time", "revision_create_time", "revision_update_time"),
+		protocmp.IgnoreFields(new(rpc.ApiDeployment), "revision_id", "create_time", "revision_create_time", "revision_update_time", "etag"),
 		cmpopts.SortSlices(func(a, b *rpc.ApiDeployment) bool {
 			return a.GetName() < b.GetName()
 		}),
@@ -934,7 +934,7 @@ func TestUpdateApiDeployment(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.ApiDeployment), "revision_id", "create_time", "revision_create_time", "revision_update_time"),
+				protocmp.IgnoreFields(new(rpc.ApiDeployment), "revision_id", "create_time", "revision_create_time", "revision_update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, updated, opts) {
@@ -0,0 +1,158 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/test/seeder"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestETags(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	version := &rpc.ApiVersion{Name: "projects/my-project/locations/global/apis/a/versions/v"}
+	if err := seeder.SeedVersions(ctx, server, version); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	read, err := server.GetApiVersion(ctx, &rpc.GetApiVersionRequest{Name: version.GetName()})
+	if err != nil {
+		t.Fatalf("GetApiVersion() returned error: %s", err)
+	}
+	if read.GetEtag() == "" {
+		t.Fatalf("GetApiVersion() returned no etag")
+	}
+
+	listed, err := server.ListApiVersions(ctx, &rpc.ListApiVersionsRequest{Parent: "projects/my-project/locations/global/apis/a"})
+	if err != nil {
+		t.Fatalf("ListApiVersions() returned error: %s", err)
+	}
+	if got := listed.GetApiVersions()[0].GetEtag(); got != read.GetEtag() {
+		t.Errorf("ListApiVersions() returned etag %q, want %q", got, read.GetEtag())
+	}
+
+	// An update with the current etag succeeds and changes the etag.
+	updated, err := server.UpdateApiVersion(ctx, &rpc.UpdateApiVersionRequest{
+		ApiVersion: &rpc.ApiVersion{Name: version.GetName(), DisplayName: "first", Etag: read.GetEtag()},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateApiVersion() with current etag returned error: %s", err)
+	}
+	if updated.GetEtag() == read.GetEtag() {
+		t.Errorf("UpdateApiVersion() returned unchanged etag %q", updated.GetEtag())
+	}
+
+	// Requests with the etag of the original version fail.
+	_, err = server.UpdateApiVersion(ctx, &rpc.UpdateApiVersionRequest{
+		ApiVersion: &rpc.ApiVersion{Name: version.GetName(), DisplayName: "second", Etag: read.GetEtag()},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+	})
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("UpdateApiVersion() with stale etag returned status code %q, want %q: %v", status.Code(err), codes.Aborted, err)
+	}
+	_, err = server.DeleteApiVersion(ctx, &rpc.DeleteApiVersionRequest{Name: version.GetName(), Etag: read.GetEtag()})
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("DeleteApiVersion() with stale etag returned status code %q, want %q: %v", status.Code(err), codes.Aborted, err)
+	}
+
+	got, err := server.GetApiVersion(ctx, &rpc.GetApiVersionRequest{Name: version.GetName()})
+	if err != nil {
+		t.Fatalf("GetApiVersion() returned error: %s", err)
+	}
+	if got.GetDisplayName() != "first" || got.GetEtag() != updated.GetEtag() {
+		t.Errorf("GetApiVersion() returned %v after failed requests, want display_name %q and etag %q", got, "first", updated.GetEtag())
+	}
+
+	if _, err := server.DeleteApiVersion(ctx, &rpc.DeleteApiVersionRequest{Name: version.GetName(), Etag: got.GetEtag()}); err != nil {
+		t.Errorf("DeleteApiVersion() with current etag returned error: %s", err)
+	}
+}
+
+func TestETagsOfRevisions(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	deployment := &rpc.ApiDeployment{Name: "projects/my-project/locations/global/apis/a/deployments/d"}
+	artifact := &rpc.Artifact{Name: "projects/my-project/locations/global/artifacts/x", Contents: []byte("first")}
+	if err := seeder.SeedRegistry(ctx, server, deployment, artifact); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	read, err := server.GetApiDeployment(ctx, &rpc.GetApiDeploymentRequest{Name: deployment.GetName()})
+	if err != nil {
+		t.Fatalf("GetApiDeployment() returned error: %s", err)
+	}
+
+	// A change that creates a new revision also changes the etag.
+	updated, err := server.UpdateApiDeployment(ctx, &rpc.UpdateApiDeploymentRequest{
+		ApiDeployment: &rpc.ApiDeployment{Name: deployment.GetName(), EndpointUri: "https://example.com", Etag: read.GetEtag()},
+		UpdateMask:    &fieldmaskpb.FieldMask{Paths: []string{"endpoint_uri"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateApiDeployment() with current etag returned error: %s", err)
+	}
+	if updated.GetRevisionId() == read.GetRevisionId() || updated.GetEtag() == read.GetEtag() {
+		t.Errorf("UpdateApiDeployment() returned revision %q with etag %q, want a new revision and etag", updated.GetRevisionId(), updated.GetEtag())
+	}
+	_, err = server.UpdateApiDeployment(ctx, &rpc.UpdateApiDeploymentRequest{
+		ApiDeployment: &rpc.ApiDeployment{Name: deployment.GetName(), DisplayName: "stale", Etag: read.GetEtag()},
+		UpdateMask:    &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+	})
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("UpdateApiDeployment() with stale etag returned status code %q, want %q: %v", status.Code(err), codes.Aborted, err)
+	}
+
+	current, err := server.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: artifact.GetName()})
+	if err != nil {
+		t.Fatalf("GetArtifact() returned error: %s", err)
+	}
+	if _, err := server.ReplaceArtifact(ctx, &rpc.ReplaceArtifactRequest{
+		Artifact: &rpc.Artifact{Name: artifact.GetName(), Contents: []byte("second"), Etag: current.GetEtag()},
+	}); err != nil {
+		t.Fatalf("ReplaceArtifact() with current etag returned error: %s", err)
+	}
+	_, err = server.ReplaceArtifact(ctx, &rpc.ReplaceArtifactRequest{
+		Artifact: &rpc.Artifact{Name: artifact.GetName(), Contents: []byte("third"), Etag: current.GetEtag()},
+	})
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("ReplaceArtifact() with stale etag returned status code %q, want %q: %v", status.Code(err), codes.Aborted, err)
+	}
+}
+
+func TestUpdateMissingResourceWithETag(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	if err := seeder.SeedProjects(ctx, server, &rpc.Project{Name: "projects/my-project"}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	// An etag means that the client expects the API to exist, so it isn't created.
+	_, err := server.UpdateApi(ctx, &rpc.UpdateApiRequest{
+		Api:          &rpc.Api{Name: "projects/my-project/locations/global/apis/a", Etag: "1234"},
+		AllowMissing: true,
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("UpdateApi() of missing API with etag returned status code %q, want %q: %v", status.Code(err), codes.NotFound, err)
+	}
+}
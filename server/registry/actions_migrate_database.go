@@ -18,36 +18,71 @@ import (
 	"context"
 
 	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage"
 	"google.golang.org/genproto/googleapis/longrunning"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // MigrateDatabase handles the corresponding API request.
+// The migration runs in the background, and the returned operation reports its progress.
 func (s *RegistryServer) MigrateDatabase(ctx context.Context, req *rpc.MigrateDatabaseRequest) (*longrunning.Operation, error) {
 	if req.Kind != "" && req.Kind != "auto" {
 		return nil, status.Errorf(codes.InvalidArgument, "unsupported migration kind %q", req.Kind)
 	}
+	target := int(req.GetVersion())
+	if target == 0 {
+		target = storage.LatestSchemaVersion
+	} else if target < storage.BaselineSchemaVersion || target > storage.LatestSchemaVersion {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid version %d: must be between %d and %d", target, storage.BaselineSchemaVersion, storage.LatestSchemaVersion)
+	}
+
 	db, err := s.getStorageClient(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
-
-	err = db.Migrate(req.Kind)
+	version, err := db.SchemaVersion(ctx)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, err
 	}
 
-	metadata, _ := anypb.New(&rpc.MigrateDatabaseMetadata{})
-	response, _ := anypb.New(&rpc.MigrateDatabaseResponse{
-		Message: "OK",
+	metadata := &rpc.MigrateDatabaseMetadata{
+		StartVersion:   int32(version),
+		TargetVersion:  int32(target),
+		CurrentVersion: int32(version),
+		StartTime:      timestamppb.Now(),
+	}
+	op, err := s.operations.start(ctx, "migrate-", metadata, func(ctx context.Context, update operationUpdate) (proto.Message, error) {
+		err := s.db.Migrate(ctx, target, func(p storage.MigrationProgress) error {
+			// Progress is saved when migrations complete, because the database can't be
+			// written to outside of a migration's transaction while it is running.
+			save := int32(p.Version) != metadata.CurrentVersion
+			metadata.CurrentVersion = int32(p.Version)
+			metadata.TablesProcessed = p.TablesProcessed
+			metadata.RowsMigrated = p.RowsMigrated
+			return update(metadata, save)
+		})
+		metadata.EndTime = timestamppb.Now()
+		if uerr := update(metadata, false); err == nil {
+			err = uerr
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &rpc.MigrateDatabaseResponse{
+			Message: "OK",
+			Version: metadata.CurrentVersion,
+		}, nil
 	})
-	return &longrunning.Operation{
-		Name:     "migrate",
-		Metadata: metadata,
-		Done:     true,
-		Result:   &longrunning.Operation_Response{Response: response},
-	}, nil
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := op.Message()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return message, nil
 }
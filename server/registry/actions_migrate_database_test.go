@@ -0,0 +1,243 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// migrateDatabase migrates the server's database and returns the metadata and response of the finished operation.
+func migrateDatabase(ctx context.Context, t *testing.T, server *RegistryServer, version int32) (*rpc.MigrateDatabaseMetadata, *rpc.MigrateDatabaseResponse) {
+	t.Helper()
+	op, err := server.MigrateDatabase(ctx, &rpc.MigrateDatabaseRequest{Version: version})
+	if err != nil {
+		t.Fatalf("MigrateDatabase(%d) returned error: %s", version, err)
+	}
+	op = waitForOperation(ctx, t, server, op.GetName())
+	if op.GetError() != nil {
+		t.Fatalf("MigrateDatabase(%d) failed: %s", version, op.GetError().GetMessage())
+	}
+
+	metadata := new(rpc.MigrateDatabaseMetadata)
+	if err := op.GetMetadata().UnmarshalTo(metadata); err != nil {
+		t.Fatalf("MigrateDatabase(%d) returned invalid metadata: %s", version, err)
+	}
+	response := new(rpc.MigrateDatabaseResponse)
+	if err := op.GetResponse().UnmarshalTo(response); err != nil {
+		t.Fatalf("MigrateDatabase(%d) returned invalid response: %s", version, err)
+	}
+	return metadata, response
+}
+
+// hasTable returns true if the server's database has a table with the given name.
+func hasTable(ctx context.Context, t *testing.T, server *RegistryServer, name string) bool {
+	t.Helper()
+	resp, err := server.GetStorage(ctx, &emptypb.Empty{})
+	if err != nil {
+		t.Fatalf("GetStorage() returned error: %s", err)
+	}
+	for _, c := range resp.GetCollections() {
+		if c.GetName() == name {
+			return true
+		}
+	}
+	return false
+}
+
+func TestMigrateDatabase(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	seedSearchResources(ctx, t, server)
+
+	latest := int32(storage.LatestSchemaVersion)
+	if _, got := migrateDatabase(ctx, t, server, 0); got.GetVersion() != latest {
+		t.Errorf("MigrateDatabase() of a new database returned version %d, want %d", got.GetVersion(), latest)
+	}
+
+	// Reverting the migrations after the baseline drops the search documents.
+	metadata, response := migrateDatabase(ctx, t, server, storage.BaselineSchemaVersion)
+	if response.GetVersion() != storage.BaselineSchemaVersion {
+		t.Errorf("MigrateDatabase(%d) returned version %d", storage.BaselineSchemaVersion, response.GetVersion())
+	}
+	want := &rpc.MigrateDatabaseMetadata{
+		StartVersion:    latest,
+		TargetVersion:   storage.BaselineSchemaVersion,
+		CurrentVersion:  storage.BaselineSchemaVersion,
+		TablesProcessed: int64(latest - storage.BaselineSchemaVersion),
+	}
+	opts := cmp.Options{protocmp.Transform(), protocmp.IgnoreFields(want, "start_time", "end_time")}
+	if diff := cmp.Diff(want, metadata, opts); diff != "" {
+		t.Errorf("MigrateDatabase(%d) returned unexpected metadata diff (-want +got):\n%s", storage.BaselineSchemaVersion, diff)
+	}
+	if hasTable(ctx, t, server, "search_documents") {
+		t.Errorf("MigrateDatabase(%d) kept the search_documents table", storage.BaselineSchemaVersion)
+	}
+	if _, err := server.GetApi(ctx, &rpc.GetApiRequest{Name: searchParent + "/apis/billing"}); err != nil {
+		t.Errorf("GetApi() after reverting migrations returned error: %s", err)
+	}
+
+	// Migrating back indexes the existing resources.
+	metadata, response = migrateDatabase(ctx, t, server, 0)
+	if response.GetVersion() != latest {
+		t.Errorf("MigrateDatabase() returned version %d, want %d", response.GetVersion(), latest)
+	}
+	if metadata.GetRowsMigrated() == 0 {
+		t.Errorf("MigrateDatabase() reported no migrated rows after indexing resources")
+	}
+	names := []string{searchParent + "/apis/billing/versions/v1/specs/openapi"}
+	if diff := cmp.Diff(names, searchNames(ctx, t, server, "listInvoices")); diff != "" {
+		t.Errorf("SearchResources() after migrating returned unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestMigrateDatabaseResponseCodes(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	tests := []struct {
+		desc string
+		req  *rpc.MigrateDatabaseRequest
+		want codes.Code
+	}{
+		{
+			desc: "unsupported kind",
+			req:  &rpc.MigrateDatabaseRequest{Kind: "manual"},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "negative version",
+			req:  &rpc.MigrateDatabaseRequest{Version: -1},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "unknown version",
+			req:  &rpc.MigrateDatabaseRequest{Version: int32(storage.LatestSchemaVersion) + 1},
+			want: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			if _, err := server.MigrateDatabase(ctx, test.req); status.Code(err) != test.want {
+				t.Errorf("MigrateDatabase(%+v) returned status code %q, want %q: %v", test.req, status.Code(err), test.want, err)
+			}
+		})
+	}
+}
+
+func TestNewRefusesNewerSchema(t *testing.T) {
+	config := Config{
+		Database: "sqlite3",
+		DBConfig: fmt.Sprintf("%s/registry.db", t.TempDir()),
+	}
+	server, err := New(config)
+	if err != nil {
+		t.Fatalf("Setup: New() returned error: %s", err)
+	}
+	server.Close()
+
+	// A later release of the server migrates the database.
+	db, err := gorm.Open(sqlite.Open(config.DBConfig), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("Setup: failed to open database: %s", err)
+	}
+	if err := db.Create(&models.SchemaMigration{Version: storage.LatestSchemaVersion + 1, ApplyTime: time.Now()}).Error; err != nil {
+		t.Fatalf("Setup: failed to record migration: %s", err)
+	}
+	if sqlDB, err := db.DB(); err == nil {
+		sqlDB.Close()
+	}
+
+	if server, err := New(config); status.Code(err) != codes.FailedPrecondition {
+		if err == nil {
+			server.Close()
+		}
+		t.Errorf("New() with a newer schema returned status code %q, want %q: %v", status.Code(err), codes.FailedPrecondition, err)
+	}
+}
+
+func TestNewMigratesLegacyDatabase(t *testing.T) {
+	ctx := context.Background()
+	config := Config{
+		Database: "sqlite3",
+		DBConfig: fmt.Sprintf("%s/registry.db", t.TempDir()),
+	}
+
+	// Databases created before schema versions were recorded had artifacts without revisions,
+	// with contents in blobs that were keyed by the names of their artifacts.
+	db, err := gorm.Open(sqlite.Open(config.DBConfig), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	if err != nil {
+		t.Fatalf("Setup: failed to open database: %s", err)
+	}
+	name := "projects/my-project/locations/global/artifacts/a"
+	for _, stmt := range []string{
+		"CREATE TABLE projects (key text, project_id text, display_name text, description text, create_time datetime, update_time datetime, PRIMARY KEY (key))",
+		"CREATE TABLE artifacts (key text, project_id text, api_id text, version_id text, spec_id text, artifact_id text, create_time datetime, update_time datetime, mime_type text, size_in_bytes integer, hash text, PRIMARY KEY (key))",
+		"CREATE TABLE blobs (key text, project_id text, api_id text, version_id text, spec_id text, artifact_id text, size_in_bytes integer, hash text, contents blob, PRIMARY KEY (key))",
+		"INSERT INTO projects (key, project_id, create_time, update_time) VALUES ('projects/my-project', 'my-project', '2021-01-01 00:00:00', '2021-01-01 00:00:00')",
+		"INSERT INTO artifacts (key, project_id, artifact_id, create_time, update_time, mime_type, size_in_bytes) VALUES ('" + name + "', 'my-project', 'a', '2021-01-01 00:00:00', '2021-01-01 00:00:00', 'text/plain', 8)",
+		"INSERT INTO blobs (key, project_id, artifact_id, size_in_bytes, contents) VALUES ('" + name + "', 'my-project', 'a', 8, 'contents')",
+	} {
+		if err := db.Exec(stmt).Error; err != nil {
+			t.Fatalf("Setup: failed to create legacy database: %s", err)
+		}
+	}
+	if sqlDB, err := db.DB(); err == nil {
+		sqlDB.Close()
+	}
+
+	server, err := New(config)
+	if err != nil {
+		t.Fatalf("New() with a legacy database returned error: %s", err)
+	}
+	t.Cleanup(server.Close)
+
+	revisions, err := server.ListArtifactRevisions(ctx, &rpc.ListArtifactRevisionsRequest{Name: name})
+	if err != nil {
+		t.Fatalf("ListArtifactRevisions(%q) returned error: %s", name, err)
+	}
+	if len(revisions.GetArtifacts()) != 1 {
+		t.Errorf("ListArtifactRevisions(%q) returned %d revisions, want 1", name, len(revisions.GetArtifacts()))
+	}
+	contents, err := server.GetArtifactContents(ctx, &rpc.GetArtifactContentsRequest{Name: name})
+	if err != nil {
+		t.Fatalf("GetArtifactContents(%q) returned error: %s", name, err)
+	}
+	if got := string(contents.GetData()); got != "contents" {
+		t.Errorf("GetArtifactContents(%q) returned %q, want %q", name, got, "contents")
+	}
+	if hasTable(ctx, t, server, "legacy_blobs") {
+		t.Errorf("New() kept the legacy_blobs table after migrating its blobs")
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// GetOperation handles the corresponding API request.
+// Operations that are running on this server are returned with their latest progress.
+func (s *RegistryServer) GetOperation(ctx context.Context, req *longrunning.GetOperationRequest) (*longrunning.Operation, error) {
+	if op, ok := s.operations.get(req.GetName()); ok {
+		return operationMessage(op)
+	}
+
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	op, err := db.GetOperation(ctx, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	return operationMessage(op)
+}
+
+// ListOperations handles the corresponding API request.
+func (s *RegistryServer) ListOperations(ctx context.Context, req *longrunning.ListOperationsRequest) (*longrunning.ListOperationsResponse, error) {
+	if req.GetName() != "" && req.GetName() != "operations" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name %q: must be \"operations\"", req.GetName())
+	}
+
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	if req.GetPageSize() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page_size %d: must not be negative", req.GetPageSize())
+	} else if req.GetPageSize() > 1000 {
+		req.PageSize = 1000
+	} else if req.GetPageSize() == 0 {
+		req.PageSize = 50
+	}
+
+	listing, err := db.ListOperations(ctx, storage.PageOptions{
+		Size:   req.GetPageSize(),
+		Filter: req.GetFilter(),
+		Token:  req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &longrunning.ListOperationsResponse{
+		Operations:    make([]*longrunning.Operation, len(listing.Operations)),
+		NextPageToken: listing.Token,
+	}
+
+	for i := range listing.Operations {
+		op := &listing.Operations[i]
+		if running, ok := s.operations.get(op.Key); ok {
+			op = running
+		}
+		response.Operations[i], err = operationMessage(op)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return response, nil
+}
+
+// CancelOperation handles the corresponding API request.
+// Operations running on other servers are cancelled when they next save their progress.
+func (s *RegistryServer) CancelOperation(ctx context.Context, req *longrunning.CancelOperationRequest) (*emptypb.Empty, error) {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	if err := db.CancelOperation(ctx, req.GetName()); err != nil {
+		return nil, err
+	}
+	s.operations.cancel(req.GetName())
+	return &emptypb.Empty{}, nil
+}
+
+// DeleteOperation handles the corresponding API request.
+// Running operations can't be deleted; they should be cancelled first.
+func (s *RegistryServer) DeleteOperation(ctx context.Context, req *longrunning.DeleteOperationRequest) (*emptypb.Empty, error) {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	if err := db.DeleteOperation(ctx, req.GetName()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func operationMessage(op *models.Operation) (*longrunning.Operation, error) {
+	message, err := op.Message()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return message, nil
+}
@@ -0,0 +1,246 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// waitForOperation polls an operation until it is done.
+func waitForOperation(ctx context.Context, t *testing.T, server *RegistryServer, name string) *longrunning.Operation {
+	t.Helper()
+	deadline := time.Now().Add(30 * time.Second)
+	for {
+		op, err := server.GetOperation(ctx, &longrunning.GetOperationRequest{Name: name})
+		if err != nil {
+			t.Fatalf("GetOperation(%q) returned error: %s", name, err)
+		}
+		if op.GetDone() {
+			return op
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Operation %q is not done after 30 seconds", name)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// startBlockingOperation starts an operation that reports progress and then runs until it is cancelled.
+func startBlockingOperation(ctx context.Context, t *testing.T, server *RegistryServer, prefix string) (string, chan struct{}) {
+	t.Helper()
+	started := make(chan struct{})
+	op, err := server.operations.start(ctx, prefix, &rpc.MigrateDatabaseMetadata{}, func(ctx context.Context, update operationUpdate) (proto.Message, error) {
+		if err := update(&rpc.MigrateDatabaseMetadata{RowsMigrated: 1}, false); err != nil {
+			return nil, err
+		}
+		close(started)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+	if err != nil {
+		t.Fatalf("Setup: failed to start operation: %s", err)
+	}
+	return op.Key, started
+}
+
+func TestCancelOperation(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	name, started := startBlockingOperation(ctx, t, server, "test-")
+	<-started
+
+	// Running operations report their latest progress.
+	op, err := server.GetOperation(ctx, &longrunning.GetOperationRequest{Name: name})
+	if err != nil {
+		t.Fatalf("GetOperation(%q) returned error: %s", name, err)
+	}
+	metadata := new(rpc.MigrateDatabaseMetadata)
+	if err := op.GetMetadata().UnmarshalTo(metadata); err != nil {
+		t.Fatalf("GetOperation(%q) returned invalid metadata: %s", name, err)
+	}
+	if op.GetDone() || metadata.GetRowsMigrated() != 1 {
+		t.Errorf("GetOperation(%q) returned %+v, want a running operation with progress", name, op)
+	}
+
+	// Only one operation with the same prefix runs at a time.
+	if _, err := server.operations.start(ctx, "test-", &rpc.MigrateDatabaseMetadata{}, nil); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("start() of a second operation returned status code %q, want %q", status.Code(err), codes.FailedPrecondition)
+	}
+
+	// Running operations can't be deleted.
+	if _, err := server.DeleteOperation(ctx, &longrunning.DeleteOperationRequest{Name: name}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("DeleteOperation(%q) of a running operation returned status code %q, want %q", name, status.Code(err), codes.FailedPrecondition)
+	}
+
+	if _, err := server.CancelOperation(ctx, &longrunning.CancelOperationRequest{Name: name}); err != nil {
+		t.Fatalf("CancelOperation(%q) returned error: %s", name, err)
+	}
+	op = waitForOperation(ctx, t, server, name)
+	if got := codes.Code(op.GetError().GetCode()); got != codes.Canceled {
+		t.Errorf("Cancelled operation finished with status code %q, want %q", got, codes.Canceled)
+	}
+	if _, err := server.DeleteOperation(ctx, &longrunning.DeleteOperationRequest{Name: name}); err != nil {
+		t.Errorf("DeleteOperation(%q) of a cancelled operation returned error: %s", name, err)
+	}
+}
+
+func TestListOperations(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	running, started := startBlockingOperation(ctx, t, server, "test-")
+	<-started
+	migration, err := server.MigrateDatabase(ctx, &rpc.MigrateDatabaseRequest{})
+	if err != nil {
+		t.Fatalf("MigrateDatabase() returned error: %s", err)
+	}
+	done := waitForOperation(ctx, t, server, migration.GetName())
+
+	tests := []struct {
+		desc   string
+		filter string
+		want   []string
+	}{
+		{
+			desc: "all operations",
+			want: []string{running, done.GetName()},
+		},
+		{
+			desc:   "operations by name",
+			filter: `name.startsWith("operations/migrate-")`,
+			want:   []string{done.GetName()},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got := make([]string, 0)
+			req := &longrunning.ListOperationsRequest{Name: "operations", Filter: test.filter, PageSize: 1}
+			for {
+				resp, err := server.ListOperations(ctx, req)
+				if err != nil {
+					t.Fatalf("ListOperations(%+v) returned error: %s", req, err)
+				}
+				for _, op := range resp.GetOperations() {
+					got = append(got, op.GetName())
+				}
+				if req.PageToken = resp.GetNextPageToken(); req.PageToken == "" {
+					break
+				}
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("ListOperations(%q) returned unexpected diff (-want +got):\n%s", test.filter, diff)
+			}
+		})
+	}
+
+	if _, err := server.DeleteOperation(ctx, &longrunning.DeleteOperationRequest{Name: done.GetName()}); err != nil {
+		t.Fatalf("DeleteOperation(%q) returned error: %s", done.GetName(), err)
+	}
+	resp, err := server.ListOperations(ctx, &longrunning.ListOperationsRequest{})
+	if err != nil {
+		t.Fatalf("ListOperations() returned error: %s", err)
+	}
+	if len(resp.GetOperations()) != 1 || resp.GetOperations()[0].GetName() != running {
+		t.Errorf("ListOperations() after deleting %q returned %v", done.GetName(), resp.GetOperations())
+	}
+}
+
+func TestOperationsResponseCodes(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	const missing = "operations/missing"
+
+	if _, err := server.GetOperation(ctx, &longrunning.GetOperationRequest{Name: missing}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetOperation(%q) returned status code %q, want %q", missing, status.Code(err), codes.NotFound)
+	}
+	if _, err := server.CancelOperation(ctx, &longrunning.CancelOperationRequest{Name: missing}); status.Code(err) != codes.NotFound {
+		t.Errorf("CancelOperation(%q) returned status code %q, want %q", missing, status.Code(err), codes.NotFound)
+	}
+	if _, err := server.DeleteOperation(ctx, &longrunning.DeleteOperationRequest{Name: missing}); status.Code(err) != codes.NotFound {
+		t.Errorf("DeleteOperation(%q) returned status code %q, want %q", missing, status.Code(err), codes.NotFound)
+	}
+	if _, err := server.ListOperations(ctx, &longrunning.ListOperationsRequest{Name: "projects"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListOperations() of projects returned status code %q, want %q", status.Code(err), codes.InvalidArgument)
+	}
+	if _, err := server.ListOperations(ctx, &longrunning.ListOperationsRequest{PageSize: -1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListOperations() with a negative page size returned status code %q, want %q", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestAbortUnfinishedOperations(t *testing.T) {
+	ctx := context.Background()
+	config := Config{
+		Database: "sqlite3",
+		DBConfig: fmt.Sprintf("%s/registry.db", t.TempDir()),
+	}
+	server, err := New(config)
+	if err != nil {
+		t.Fatalf("Setup: failed to create server: %s", err)
+	}
+
+	// One operation is recorded as running by a server that stopped without finishing it,
+	// and another by a server that still renews it.
+	stopped, err := models.NewOperation("operations/test-stopped", "stopped-server", &rpc.MigrateDatabaseMetadata{})
+	if err != nil {
+		t.Fatalf("Setup: NewOperation() returned error: %s", err)
+	}
+	stopped.HeartbeatTime = time.Now().Add(-2 * operationLease).UTC()
+	running, err := models.NewOperation("operations/test-running", "running-server", &rpc.MigrateDatabaseMetadata{})
+	if err != nil {
+		t.Fatalf("Setup: NewOperation() returned error: %s", err)
+	}
+	for _, op := range []*models.Operation{stopped, running} {
+		if err := server.db.CreateOperation(ctx, op); err != nil {
+			t.Fatalf("Setup: CreateOperation() returned error: %s", err)
+		}
+	}
+	server.Close()
+
+	restarted, err := New(config)
+	if err != nil {
+		t.Fatalf("Setup: failed to restart server: %s", err)
+	}
+	t.Cleanup(restarted.Close)
+
+	got, err := restarted.GetOperation(ctx, &longrunning.GetOperationRequest{Name: stopped.Key})
+	if err != nil {
+		t.Fatalf("GetOperation(%q) returned error: %s", stopped.Key, err)
+	}
+	if !got.GetDone() || codes.Code(got.GetError().GetCode()) != codes.Aborted {
+		t.Errorf("GetOperation(%q) returned %+v, want an operation aborted by the restart", stopped.Key, got)
+	}
+
+	// Operations of other servers that are still running aren't aborted.
+	got, err = restarted.GetOperation(ctx, &longrunning.GetOperationRequest{Name: running.Key})
+	if err != nil {
+		t.Fatalf("GetOperation(%q) returned error: %s", running.Key, err)
+	}
+	if got.GetDone() {
+		t.Errorf("GetOperation(%q) returned %+v, want an operation that is still running", running.Key, got)
+	}
+}
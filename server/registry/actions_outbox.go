@@ -0,0 +1,79 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListOutboxEvents handles the corresponding API request.
+func (s *RegistryServer) ListOutboxEvents(ctx context.Context, req *rpc.ListOutboxEventsRequest) (*rpc.ListOutboxEventsResponse, error) {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	if req.GetPageSize() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page_size %d: must not be negative", req.GetPageSize())
+	} else if req.GetPageSize() > 1000 {
+		req.PageSize = 1000
+	} else if req.GetPageSize() == 0 {
+		req.PageSize = 50
+	}
+
+	listing, err := db.ListEvents(ctx, storage.PageOptions{
+		Size:   req.GetPageSize(),
+		Filter: req.GetFilter(),
+		Token:  req.GetPageToken(),
+	}, req.GetIncludeDelivered())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &rpc.ListOutboxEventsResponse{
+		Events:        make([]*rpc.OutboxEvent, len(listing.Events)),
+		NextPageToken: listing.Token,
+	}
+
+	for i, event := range listing.Events {
+		response.Events[i], err = event.Message()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return response, nil
+}
+
+// ReplayOutboxEvents handles the corresponding API request.
+func (s *RegistryServer) ReplayOutboxEvents(ctx context.Context, req *rpc.ReplayOutboxEventsRequest) (*rpc.ReplayOutboxEventsResponse, error) {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	count, err := db.ReplayEvents(ctx, req.GetFilter(), req.GetIncludeDelivered())
+	if err != nil {
+		return nil, err
+	}
+
+	s.outbox.wake()
+	return &rpc.ReplayOutboxEventsResponse{Count: count}, nil
+}
@@ -0,0 +1,315 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/log"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/test/seeder"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// testNotifier records the notifications it publishes and fails while failing is set.
+type testNotifier struct {
+	mu            sync.Mutex
+	failing       bool
+	notifications []*rpc.Notification
+}
+
+func (n *testNotifier) Publish(ctx context.Context, notifications []*rpc.Notification) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.failing {
+		return errors.New("notifier is failing")
+	}
+	n.notifications = append(n.notifications, notifications...)
+	return nil
+}
+
+func (n *testNotifier) Close() error {
+	return nil
+}
+
+func (n *testNotifier) setFailing(failing bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.failing = failing
+}
+
+// wait returns the notifications published once there are at least count of them.
+func (n *testNotifier) wait(t *testing.T, count int) []*rpc.Notification {
+	t.Helper()
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+		n.mu.Lock()
+		if len(n.notifications) >= count {
+			defer n.mu.Unlock()
+			return n.notifications
+		}
+		n.mu.Unlock()
+	}
+	t.Fatalf("Timed out waiting for %d notifications", count)
+	return nil
+}
+
+func outboxTestServer(t *testing.T, n *testNotifier) *RegistryServer {
+	t.Helper()
+	server, err := New(Config{
+		Database:          "sqlite3",
+		DBConfig:          fmt.Sprintf("%s/registry.db", t.TempDir()),
+		Notifier:          n,
+		OutboxInterval:    10 * time.Millisecond,
+		OutboxMaxAttempts: 2,
+	})
+	if err != nil {
+		t.Fatalf("Setup: failed to create server: %s", err)
+	}
+	t.Cleanup(server.Close)
+	return server
+}
+
+// waitForEvents returns the undelivered events once there are count of them with at least attempts failures.
+func waitForEvents(t *testing.T, server *RegistryServer, count int, attempts int32) []*rpc.OutboxEvent {
+	t.Helper()
+	ctx := context.Background()
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+		got, err := server.ListOutboxEvents(ctx, &rpc.ListOutboxEventsRequest{
+			Filter: fmt.Sprintf("attempts >= %d", attempts),
+		})
+		if err != nil {
+			t.Fatalf("ListOutboxEvents() returned error: %s", err)
+		}
+		if len(got.GetEvents()) == count {
+			return got.GetEvents()
+		}
+	}
+	t.Fatalf("Timed out waiting for %d events with %d attempts", count, attempts)
+	return nil
+}
+
+func TestOutboxDelivery(t *testing.T) {
+	ctx := context.Background()
+	n := &testNotifier{}
+	server := outboxTestServer(t, n)
+
+	if err := seeder.SeedApis(ctx, server, &rpc.Api{Name: "projects/my-project/locations/global/apis/a"}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	want := []*rpc.Notification{
+		{Change: rpc.Notification_CREATED, Resource: "projects/my-project"},
+		{Change: rpc.Notification_CREATED, Resource: "projects/my-project/locations/global/apis/a"},
+	}
+	opts := cmp.Options{
+		protocmp.Transform(),
+		protocmp.IgnoreFields(new(rpc.Notification), "change_time", "snapshot"),
+	}
+	if diff := cmp.Diff(want, n.wait(t, len(want)), opts); diff != "" {
+		t.Errorf("Notifier received unexpected diff (-want +got):\n%s", diff)
+	}
+
+	got, err := server.ListOutboxEvents(ctx, &rpc.ListOutboxEventsRequest{})
+	if err != nil {
+		t.Fatalf("ListOutboxEvents() returned error: %s", err)
+	}
+	if len(got.GetEvents()) != 0 {
+		t.Errorf("ListOutboxEvents() returned %d undelivered events, want none", len(got.GetEvents()))
+	}
+
+	got, err = server.ListOutboxEvents(ctx, &rpc.ListOutboxEventsRequest{IncludeDelivered: true})
+	if err != nil {
+		t.Fatalf("ListOutboxEvents() returned error: %s", err)
+	}
+	for _, e := range got.GetEvents() {
+		if e.GetDeliverTime() == nil {
+			t.Errorf("ListOutboxEvents() returned delivered event %d without deliver_time", e.GetId())
+		}
+	}
+}
+
+func TestOutboxRetryAndReplay(t *testing.T) {
+	ctx := context.Background()
+	n := &testNotifier{failing: true}
+	server := outboxTestServer(t, n)
+
+	if _, err := server.CreateProject(ctx, &rpc.CreateProjectRequest{ProjectId: "my-project", Project: &rpc.Project{}}); err != nil {
+		t.Fatalf("Setup: CreateProject() returned error: %s", err)
+	}
+
+	// The event is abandoned after the configured number of attempts.
+	events := waitForEvents(t, server, 1, 2)
+	if got := events[0].GetLastError(); got != "notifier is failing" {
+		t.Errorf("ListOutboxEvents() returned last_error %q, want %q", got, "notifier is failing")
+	}
+
+	n.setFailing(false)
+	resp, err := server.ReplayOutboxEvents(ctx, &rpc.ReplayOutboxEventsRequest{Filter: "resource == 'projects/my-project'"})
+	if err != nil {
+		t.Fatalf("ReplayOutboxEvents() returned error: %s", err)
+	}
+	if resp.GetCount() != 1 {
+		t.Errorf("ReplayOutboxEvents() replayed %d events, want 1", resp.GetCount())
+	}
+
+	want := []*rpc.Notification{
+		{Change: rpc.Notification_CREATED, Resource: "projects/my-project"},
+	}
+	opts := cmp.Options{
+		protocmp.Transform(),
+		protocmp.IgnoreFields(new(rpc.Notification), "change_time", "snapshot"),
+	}
+	if diff := cmp.Diff(want, n.wait(t, len(want)), opts); diff != "" {
+		t.Errorf("Notifier received unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestOutboxFailedMutation(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	if err := seeder.SeedApis(ctx, server, &rpc.Api{Name: "projects/my-project/locations/global/apis/a"}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	// Deletion fails because the project has children, so no notification is recorded.
+	if _, err := server.DeleteProject(ctx, &rpc.DeleteProjectRequest{Name: "projects/my-project"}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("DeleteProject() returned status code %q, want %q: %v", status.Code(err), codes.FailedPrecondition, err)
+	}
+
+	got, err := server.ListOutboxEvents(ctx, &rpc.ListOutboxEventsRequest{
+		IncludeDelivered: true,
+		Filter:           "change == 'DELETED'",
+	})
+	if err != nil {
+		t.Fatalf("ListOutboxEvents() returned error: %s", err)
+	}
+	if len(got.GetEvents()) != 0 {
+		t.Errorf("ListOutboxEvents() returned %d events for a failed deletion, want none", len(got.GetEvents()))
+	}
+}
+
+func TestOutboxResponseCodes(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	if _, err := server.ListOutboxEvents(ctx, &rpc.ListOutboxEventsRequest{PageSize: -1}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListOutboxEvents() with negative page_size returned status code %q, want %q: %v", status.Code(err), codes.InvalidArgument, err)
+	}
+	if _, err := server.ListOutboxEvents(ctx, &rpc.ListOutboxEventsRequest{Filter: "this filter is not valid"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListOutboxEvents() with invalid filter returned status code %q, want %q: %v", status.Code(err), codes.InvalidArgument, err)
+	}
+	if _, err := server.ReplayOutboxEvents(ctx, &rpc.ReplayOutboxEventsRequest{Filter: "this filter is not valid"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ReplayOutboxEvents() with invalid filter returned status code %q, want %q: %v", status.Code(err), codes.InvalidArgument, err)
+	}
+}
+
+func TestNotificationDetails(t *testing.T) {
+	ctx := log.NewSubjectContext(context.Background(), "test-caller")
+	n := &testNotifier{}
+	server := outboxTestServer(t, n)
+
+	spec := &rpc.ApiSpec{
+		Name:     "projects/my-project/locations/global/apis/a/versions/v/specs/s",
+		Contents: []byte("original"),
+	}
+	if err := seeder.SeedSpecs(ctx, server, spec); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+	created, err := server.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: spec.GetName()})
+	if err != nil {
+		t.Fatalf("Setup: GetApiSpec() returned error: %s", err)
+	}
+
+	updated, err := server.UpdateApiSpec(ctx, &rpc.UpdateApiSpecRequest{
+		ApiSpec: &rpc.ApiSpec{
+			Name:     spec.GetName(),
+			Contents: []byte("updated"),
+		},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"contents"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateApiSpec() returned error: %s", err)
+	}
+
+	// The project, API, version and spec are created before the spec is updated.
+	got := n.wait(t, 5)[4]
+	snapshot, err := anypb.New(updated)
+	if err != nil {
+		t.Fatalf("anypb.New() returned error: %s", err)
+	}
+	want := &rpc.Notification{
+		Change:             rpc.Notification_UPDATED,
+		Resource:           fmt.Sprintf("%s@%s", spec.GetName(), updated.GetRevisionId()),
+		Snapshot:           snapshot,
+		PreviousRevisionId: created.GetRevisionId(),
+		UpdateMask:         &fieldmaskpb.FieldMask{Paths: []string{"contents"}},
+		Caller:             "test-caller",
+	}
+	opts := cmp.Options{
+		protocmp.Transform(),
+		protocmp.IgnoreFields(new(rpc.Notification), "change_time"),
+	}
+	if diff := cmp.Diff(want, got, opts); diff != "" {
+		t.Errorf("Notifier received unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestOutboxDeliveryAcrossServers(t *testing.T) {
+	ctx := context.Background()
+	n := &testNotifier{}
+	db := fmt.Sprintf("%s/registry.db", t.TempDir())
+
+	// Servers that share a database deliver each notification once between them.
+	var servers []*RegistryServer
+	for i := 0; i < 2; i++ {
+		server, err := New(Config{
+			Database:       "sqlite3",
+			DBConfig:       db,
+			Notifier:       n,
+			OutboxInterval: 10 * time.Millisecond,
+		})
+		if err != nil {
+			t.Fatalf("Setup: failed to create server: %s", err)
+		}
+		t.Cleanup(server.Close)
+		servers = append(servers, server)
+	}
+
+	const count = 20
+	for i := 0; i < count; i++ {
+		req := &rpc.CreateProjectRequest{ProjectId: fmt.Sprintf("p%d", i), Project: &rpc.Project{}}
+		if _, err := servers[i%2].CreateProject(ctx, req); err != nil {
+			t.Fatalf("Setup: CreateProject(%q) returned error: %s", req.GetProjectId(), err)
+		}
+	}
+
+	// Once no events are left undelivered, each notification should have been published once.
+	waitForEvents(t, servers[0], 0, 0)
+	got := n.wait(t, count)
+	if len(got) != count {
+		t.Errorf("Notifier received %d notifications, want %d", len(got), count)
+	}
+}
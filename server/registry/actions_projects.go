@@ -16,7 +16,6 @@ package registry
 
 import (
 	"context"
-	"sync"
 
 	"github.com/apigee/registry/rpc"
 	"github.com/apigee/registry/server/registry/internal/storage"
@@ -37,12 +36,19 @@ func (s *RegistryServer) CreateProject(ctx context.Context, req *rpc.CreateProje
 }
 
 func (s *RegistryServer) createProject(ctx context.Context, name names.Project, body *rpc.Project) (*rpc.Project, error) {
-	db, err := s.getStorageClient(ctx)
-	if err != nil {
-		return nil, status.Error(codes.Unavailable, err.Error())
+	var message *rpc.Project
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) (err error) {
+		message, err = s.insertProject(ctx, db, name, body)
+		return err
+	}); err != nil {
+		return nil, err
 	}
-	defer db.Close()
 
+	return message, nil
+}
+
+// insertProject creates a project using a client for a transaction that may have other changes.
+func (s *RegistryServer) insertProject(ctx context.Context, db *storage.Client, name names.Project, body *rpc.Project) (*rpc.Project, error) {
 	if _, err := db.GetProject(ctx, name); err == nil {
 		return nil, status.Errorf(codes.AlreadyExists, "project %q already exists", name)
 	} else if !isNotFound(err) {
@@ -53,13 +59,20 @@ func (s *RegistryServer) createProject(ctx context.Context, name names.Project,
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	if body.GetArtifactRevisionLimit() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid artifact_revision_limit %d: must not be negative", body.GetArtifactRevisionLimit())
+	}
+
 	project := models.NewProject(name, body)
+	message := project.Message()
 	if err := db.SaveProject(ctx, project); err != nil {
 		return nil, err
 	}
+	if err := s.notify(ctx, db, rpc.Notification_CREATED, name.String(), withSnapshot(message)); err != nil {
+		return nil, err
+	}
 
-	s.notify(ctx, rpc.Notification_CREATED, name.String())
-	return project.Message(), nil
+	return message, nil
 }
 
 // DeleteProject handles the corresponding API request.
@@ -68,7 +81,6 @@ func (s *RegistryServer) DeleteProject(ctx context.Context, req *rpc.DeleteProje
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	name, err := names.ParseProject(req.GetName())
 	if err != nil {
@@ -80,21 +92,48 @@ func (s *RegistryServer) DeleteProject(ctx context.Context, req *rpc.DeleteProje
 		return nil, err
 	}
 
-	if err := db.DeleteProject(ctx, name, req.GetForce()); err != nil {
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := checkETag(name.String(), req.GetEtag(), func() (etagged, error) {
+			return db.ForUpdate().GetProject(ctx, name)
+		}); err != nil {
+			return err
+		}
+		if err := db.DeleteProject(ctx, name, req.GetForce()); err != nil {
+			return err
+		}
+		return s.notify(ctx, db, rpc.Notification_DELETED, name.String())
+	}); err != nil {
 		return nil, err
 	}
 
-	s.notify(ctx, rpc.Notification_DELETED, name.String())
 	return &emptypb.Empty{}, nil
 }
 
+// UndeleteProject handles the corresponding API request.
+func (s *RegistryServer) UndeleteProject(ctx context.Context, req *rpc.UndeleteProjectRequest) (*rpc.Project, error) {
+	name, err := names.ParseProject(req.GetName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := db.UndeleteProject(ctx, name); err != nil {
+			return err
+		}
+		return s.notify(ctx, db, rpc.Notification_CREATED, name.String())
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.GetProject(ctx, &rpc.GetProjectRequest{Name: name.String()})
+}
+
 // GetProject handles the corresponding API request.
 func (s *RegistryServer) GetProject(ctx context.Context, req *rpc.GetProjectRequest) (*rpc.Project, error) {
 	db, err := s.getStorageClient(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	name, err := names.ParseProject(req.GetName())
 	if err != nil {
@@ -115,7 +154,6 @@ func (s *RegistryServer) ListProjects(ctx context.Context, req *rpc.ListProjects
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	if req.GetPageSize() < 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid page_size %d: must not be negative", req.GetPageSize())
@@ -126,9 +164,12 @@ func (s *RegistryServer) ListProjects(ctx context.Context, req *rpc.ListProjects
 	}
 
 	listing, err := db.ListProjects(ctx, storage.PageOptions{
-		Size:   req.GetPageSize(),
-		Filter: req.GetFilter(),
-		Token:  req.GetPageToken(),
+		Size:        req.GetPageSize(),
+		Filter:      req.GetFilter(),
+		Order:       req.GetOrderBy(),
+		Token:       req.GetPageToken(),
+		ShowDeleted: req.GetShowDeleted(),
+		ProjectIDs:  visibleProjectIDs(ctx),
 	})
 	if err != nil {
 		return nil, err
@@ -146,20 +187,14 @@ func (s *RegistryServer) ListProjects(ctx context.Context, req *rpc.ListProjects
 	return response, nil
 }
 
-var updateProjectMutex sync.Mutex
-
 // UpdateProject handles the corresponding API request.
 func (s *RegistryServer) UpdateProject(ctx context.Context, req *rpc.UpdateProjectRequest) (*rpc.Project, error) {
-	db, err := s.getStorageClient(ctx)
-	if err != nil {
-		return nil, status.Error(codes.Unavailable, err.Error())
-	}
-	defer db.Close()
-
 	if req.GetProject() == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid project %+v: body must be provided", req.GetProject())
 	} else if err := models.ValidateMask(req.GetProject(), req.GetUpdateMask()); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid update_mask %v: %s", req.GetUpdateMask(), err)
+	} else if req.GetProject().GetArtifactRevisionLimit() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid artifact_revision_limit %d: must not be negative", req.GetProject().GetArtifactRevisionLimit())
 	}
 
 	name, err := names.ParseProject(req.GetProject().GetName())
@@ -167,29 +202,32 @@ func (s *RegistryServer) UpdateProject(ctx context.Context, req *rpc.UpdateProje
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if req.GetAllowMissing() {
-		// Prevent a race condition that can occur when two updates are made
-		// to the same non-existent resource. The db.Get...() call returns
-		// NotFound for both updates, and after one creates the resource,
-		// the other creation fails. The lock() prevents this by serializing
-		// the get and create operations. Future updates could improve this
-		// with improvements closer to the database level.
-		updateProjectMutex.Lock()
-		defer updateProjectMutex.Unlock()
-	}
-
-	project, err := db.GetProject(ctx, name)
-	if req.GetAllowMissing() && isNotFound(err) {
-		return s.createProject(ctx, name, req.GetProject())
-	} else if err != nil {
-		return nil, err
-	}
-
-	project.Update(req.GetProject(), models.ExpandMask(req.GetProject(), req.GetUpdateMask()))
-	if err := db.SaveProject(ctx, project); err != nil {
+	var message *rpc.Project
+	if err := s.upsert(ctx, func(ctx context.Context, db *storage.Client) error {
+		project, err := db.ForUpdate().GetProject(ctx, name)
+		if req.GetAllowMissing() && isNotFound(err) && req.GetProject().GetEtag() == "" {
+			message, err = s.insertProject(ctx, db, name, req.GetProject())
+			return err
+		} else if err != nil {
+			return err
+		}
+
+		if err := checkETag(name.String(), req.GetProject().GetEtag(), func() (etagged, error) {
+			return project, nil
+		}); err != nil {
+			return err
+		}
+
+		mask := models.ExpandMask(req.GetProject(), req.GetUpdateMask())
+		project.Update(req.GetProject(), mask)
+		message = project.Message()
+		if err := db.SaveProject(ctx, project); err != nil {
+			return err
+		}
+		return s.notify(ctx, db, rpc.Notification_UPDATED, name.String(), withSnapshot(message), withUpdateMask(mask))
+	}); err != nil {
 		return nil, err
 	}
 
-	s.notify(ctx, rpc.Notification_UPDATED, name.String())
-	return project.Message(), nil
+	return message, nil
 }
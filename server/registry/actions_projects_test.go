@@ -64,7 +64,7 @@ func TestCreateProject(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.Project), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Project), "create_time", "update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, created, opts) {
@@ -262,7 +262,7 @@ func TestGetProject(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.Project), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Project), "create_time", "update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, got, opts) {
@@ -412,7 +412,7 @@ func TestListProjects(t *testing.T) {
 			opts := cmp.Options{
 				protocmp.Transform(),
 				protocmp.IgnoreFields(new(rpc.ListProjectsResponse), "next_page_token"),
-				protocmp.IgnoreFields(new(rpc.Project), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Project), "create_time", "update_time", "etag"),
 				test.extraOpts,
 			}
 
@@ -567,7 +567,7 @@ func TestListProjectsSequence(t *testing.T) {
 
 	opts := cmp.Options{
 		protocmp.Transform(),
-		protocmp.IgnoreFields(new(rpc.Project), "create_time", "update_time"),
+		protocmp.IgnoreFields(new(rpc.Project), "create_time", "update_time", "etag"),
 		cmpopts.SortSlices(func(a, b *rpc.Project) bool {
 			return a.GetName() < b.GetName()
 		}),
@@ -751,7 +751,7 @@ func TestUpdateProject(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.Project), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.Project), "create_time", "update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, updated, opts) {
@@ -0,0 +1,146 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/apigee/registry/server/registry/names"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ListRoleBindings handles the corresponding API request.
+func (s *RegistryServer) ListRoleBindings(ctx context.Context, req *rpc.ListRoleBindingsRequest) (*rpc.ListRoleBindingsResponse, error) {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	projectID, err := bindingProjectID(req.GetProject())
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetPageSize() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page_size %d: must not be negative", req.GetPageSize())
+	} else if req.GetPageSize() > 1000 {
+		req.PageSize = 1000
+	} else if req.GetPageSize() == 0 {
+		req.PageSize = 50
+	}
+
+	listing, err := db.ListRoleBindings(ctx, projectID, storage.PageOptions{
+		Size:   req.GetPageSize(),
+		Filter: req.GetFilter(),
+		Token:  req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &rpc.ListRoleBindingsResponse{
+		RoleBindings:  make([]*rpc.RoleBinding, len(listing.RoleBindings)),
+		NextPageToken: listing.Token,
+	}
+
+	for i, binding := range listing.RoleBindings {
+		response.RoleBindings[i] = binding.Message()
+	}
+
+	return response, nil
+}
+
+// SetRoleBinding handles the corresponding API request.
+func (s *RegistryServer) SetRoleBinding(ctx context.Context, req *rpc.SetRoleBindingRequest) (*rpc.RoleBinding, error) {
+	body := req.GetRoleBinding()
+	if body == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role_binding %+v: body must be provided", body)
+	} else if body.GetPrincipal() == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid principal: must not be empty")
+	} else if _, ok := rpc.RoleBinding_Role_name[int32(body.GetRole())]; !ok || body.GetRole() == rpc.RoleBinding_ROLE_UNSPECIFIED {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role %s: must be one of [VIEWER, EDITOR, ADMIN]", body.GetRole())
+	}
+
+	projectID, err := bindingProjectID(body.GetProject())
+	if err != nil {
+		return nil, err
+	}
+
+	var message *rpc.RoleBinding
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		// Bindings in projects are deleted when the projects are purged, so they can't be made before the projects exist.
+		if projectID != "" {
+			if _, err := db.GetProject(ctx, names.Project{ProjectID: projectID}); err != nil {
+				return err
+			}
+		}
+
+		if err := db.SaveRoleBinding(ctx, models.NewRoleBinding(projectID, body)); err != nil {
+			return err
+		}
+
+		binding, err := db.GetRoleBinding(ctx, projectID, body.GetPrincipal())
+		if err != nil {
+			return err
+		}
+		message = binding.Message()
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+// DeleteRoleBinding handles the corresponding API request.
+func (s *RegistryServer) DeleteRoleBinding(ctx context.Context, req *rpc.DeleteRoleBindingRequest) (*emptypb.Empty, error) {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	if req.GetPrincipal() == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid principal: must not be empty")
+	}
+
+	projectID, err := bindingProjectID(req.GetProject())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.DeleteRoleBinding(ctx, projectID, req.GetPrincipal()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// bindingProjectID returns the ID of the project of a binding, which is empty for bindings in the whole registry.
+func bindingProjectID(project string) (string, error) {
+	if project == "" {
+		return "", nil
+	}
+
+	name, err := names.ParseProject(project)
+	if err != nil {
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+	return name.ProjectID, nil
+}
@@ -0,0 +1,242 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/test/seeder"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+// setRoleBinding grants a role to a principal.
+func setRoleBinding(ctx context.Context, t *testing.T, server *RegistryServer, project, principal string, role rpc.RoleBinding_Role) *rpc.RoleBinding {
+	t.Helper()
+	req := &rpc.SetRoleBindingRequest{
+		RoleBinding: &rpc.RoleBinding{Project: project, Principal: principal, Role: role},
+	}
+	binding, err := server.SetRoleBinding(ctx, req)
+	if err != nil {
+		t.Fatalf("SetRoleBinding(%+v) returned error: %s", req, err)
+	}
+	return binding
+}
+
+// listRoleBindings returns the principals and roles of the bindings listed by a request.
+func listRoleBindings(ctx context.Context, t *testing.T, server *RegistryServer, req *rpc.ListRoleBindingsRequest) []*rpc.RoleBinding {
+	t.Helper()
+	got := make([]*rpc.RoleBinding, 0)
+	for {
+		resp, err := server.ListRoleBindings(ctx, req)
+		if err != nil {
+			t.Fatalf("ListRoleBindings(%+v) returned error: %s", req, err)
+		}
+		for _, b := range resp.GetRoleBindings() {
+			got = append(got, &rpc.RoleBinding{Project: b.GetProject(), Principal: b.GetPrincipal(), Role: b.GetRole()})
+		}
+		if req.PageToken = resp.GetNextPageToken(); req.PageToken == "" {
+			return got
+		}
+	}
+}
+
+func TestRoleBindings(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	if err := seeder.SeedProjects(ctx, server, &rpc.Project{Name: "projects/a"}, &rpc.Project{Name: "projects/b"}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	setRoleBinding(ctx, t, server, "", "root", rpc.RoleBinding_ADMIN)
+	setRoleBinding(ctx, t, server, "projects/b", "bob", rpc.RoleBinding_VIEWER)
+	created := setRoleBinding(ctx, t, server, "projects/a", "alice", rpc.RoleBinding_VIEWER)
+	setRoleBinding(ctx, t, server, "projects/a", "bob", rpc.RoleBinding_EDITOR)
+
+	// Setting the binding of a principal replaces its role.
+	updated := setRoleBinding(ctx, t, server, "projects/a", "alice", rpc.RoleBinding_ADMIN)
+	if updated.GetRole() != rpc.RoleBinding_ADMIN {
+		t.Errorf("SetRoleBinding() returned role %s, want %s", updated.GetRole(), rpc.RoleBinding_ADMIN)
+	}
+	if !updated.GetCreateTime().AsTime().Equal(created.GetCreateTime().AsTime()) {
+		t.Errorf("SetRoleBinding() changed create_time from %s to %s", created.GetCreateTime().AsTime(), updated.GetCreateTime().AsTime())
+	}
+
+	tests := []struct {
+		desc string
+		req  *rpc.ListRoleBindingsRequest
+		want []*rpc.RoleBinding
+	}{
+		{
+			desc: "all bindings",
+			req:  &rpc.ListRoleBindingsRequest{PageSize: 1},
+			want: []*rpc.RoleBinding{
+				{Principal: "root", Role: rpc.RoleBinding_ADMIN},
+				{Project: "projects/a", Principal: "alice", Role: rpc.RoleBinding_ADMIN},
+				{Project: "projects/a", Principal: "bob", Role: rpc.RoleBinding_EDITOR},
+				{Project: "projects/b", Principal: "bob", Role: rpc.RoleBinding_VIEWER},
+			},
+		},
+		{
+			desc: "bindings in a project",
+			req:  &rpc.ListRoleBindingsRequest{Project: "projects/a"},
+			want: []*rpc.RoleBinding{
+				{Project: "projects/a", Principal: "alice", Role: rpc.RoleBinding_ADMIN},
+				{Project: "projects/a", Principal: "bob", Role: rpc.RoleBinding_EDITOR},
+			},
+		},
+		{
+			desc: "filtered bindings",
+			req:  &rpc.ListRoleBindingsRequest{Filter: `principal == "bob" && role == "VIEWER"`},
+			want: []*rpc.RoleBinding{
+				{Project: "projects/b", Principal: "bob", Role: rpc.RoleBinding_VIEWER},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			got := listRoleBindings(ctx, t, server, test.req)
+			if diff := cmp.Diff(test.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("ListRoleBindings(%+v) returned unexpected diff (-want +got):\n%s", test.req, diff)
+			}
+		})
+	}
+
+	req := &rpc.DeleteRoleBindingRequest{Project: "projects/a", Principal: "bob"}
+	if _, err := server.DeleteRoleBinding(ctx, req); err != nil {
+		t.Fatalf("DeleteRoleBinding(%+v) returned error: %s", req, err)
+	}
+	if _, err := server.DeleteRoleBinding(ctx, req); status.Code(err) != codes.NotFound {
+		t.Errorf("DeleteRoleBinding(%+v) of a deleted binding returned status code %q, want %q", req, status.Code(err), codes.NotFound)
+	}
+
+	// Bindings in a project are deleted when it is purged.
+	if _, err := server.DeleteProject(ctx, &rpc.DeleteProjectRequest{Name: "projects/b"}); err != nil {
+		t.Fatalf("DeleteProject() returned error: %s", err)
+	}
+	purgeDeleted(ctx, t, server)
+	want := []*rpc.RoleBinding{
+		{Principal: "root", Role: rpc.RoleBinding_ADMIN},
+		{Project: "projects/a", Principal: "alice", Role: rpc.RoleBinding_ADMIN},
+	}
+	if diff := cmp.Diff(want, listRoleBindings(ctx, t, server, &rpc.ListRoleBindingsRequest{}), protocmp.Transform()); diff != "" {
+		t.Errorf("ListRoleBindings() after purging a project returned unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestRoleBindingsResponseCodes(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	tests := []struct {
+		desc string
+		call func() error
+		want codes.Code
+	}{
+		{
+			desc: "set without a body",
+			call: func() error {
+				_, err := server.SetRoleBinding(ctx, &rpc.SetRoleBindingRequest{})
+				return err
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "set without a principal",
+			call: func() error {
+				_, err := server.SetRoleBinding(ctx, &rpc.SetRoleBindingRequest{
+					RoleBinding: &rpc.RoleBinding{Role: rpc.RoleBinding_VIEWER},
+				})
+				return err
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "set without a role",
+			call: func() error {
+				_, err := server.SetRoleBinding(ctx, &rpc.SetRoleBindingRequest{
+					RoleBinding: &rpc.RoleBinding{Principal: "alice"},
+				})
+				return err
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "set with an invalid project",
+			call: func() error {
+				_, err := server.SetRoleBinding(ctx, &rpc.SetRoleBindingRequest{
+					RoleBinding: &rpc.RoleBinding{Project: "projects/a/locations/global", Principal: "alice", Role: rpc.RoleBinding_VIEWER},
+				})
+				return err
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "set in a missing project",
+			call: func() error {
+				_, err := server.SetRoleBinding(ctx, &rpc.SetRoleBindingRequest{
+					RoleBinding: &rpc.RoleBinding{Project: "projects/missing", Principal: "alice", Role: rpc.RoleBinding_VIEWER},
+				})
+				return err
+			},
+			want: codes.NotFound,
+		},
+		{
+			desc: "delete without a principal",
+			call: func() error {
+				_, err := server.DeleteRoleBinding(ctx, &rpc.DeleteRoleBindingRequest{})
+				return err
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "delete a missing binding",
+			call: func() error {
+				_, err := server.DeleteRoleBinding(ctx, &rpc.DeleteRoleBindingRequest{Principal: "alice"})
+				return err
+			},
+			want: codes.NotFound,
+		},
+		{
+			desc: "list with a negative page size",
+			call: func() error {
+				_, err := server.ListRoleBindings(ctx, &rpc.ListRoleBindingsRequest{PageSize: -1})
+				return err
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "list with an invalid filter",
+			call: func() error {
+				_, err := server.ListRoleBindings(ctx, &rpc.ListRoleBindingsRequest{Filter: "unknown == 1"})
+				return err
+			},
+			want: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			if err := test.call(); status.Code(err) != test.want {
+				t.Errorf("returned status code %q, want %q: %v", status.Code(err), test.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/apigee/registry/server/registry/names"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SearchResources handles the corresponding API request.
+func (s *RegistryServer) SearchResources(ctx context.Context, req *rpc.SearchResourcesRequest) (*rpc.SearchResourcesResponse, error) {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	if req.GetPageSize() < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page_size %d: must not be negative", req.GetPageSize())
+	} else if req.GetPageSize() > 1000 {
+		req.PageSize = 1000
+	} else if req.GetPageSize() == 0 {
+		req.PageSize = 50
+	}
+
+	parent, err := names.ParseProjectWithLocation(req.GetParent())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	listing, err := db.Search(ctx, parent, req.GetQuery(), storage.PageOptions{
+		Size:  req.GetPageSize(),
+		Token: req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &rpc.SearchResourcesResponse{
+		Results:       make([]*rpc.SearchResult, len(listing.Results)),
+		NextPageToken: listing.Token,
+	}
+
+	for i, result := range listing.Results {
+		response.Results[i] = &rpc.SearchResult{
+			Name:        result.Key,
+			DisplayName: result.DisplayName,
+			Description: result.Description,
+			Score:       float32(result.Score),
+		}
+	}
+
+	return response, nil
+}
+
+// index updates the search document of a changed resource in the transaction that changes it.
+// Deleted resources are excluded from searches until they are restored, so their documents are kept.
+// Changes to revisions update the documents of their resources, which describe their current revisions.
+func (s *RegistryServer) index(ctx context.Context, db *storage.Client, resource string) error {
+	var err error
+	if name, perr := names.ParseApi(resource); perr == nil {
+		err = db.IndexApi(ctx, name)
+	} else if name, perr := names.ParseVersion(resource); perr == nil {
+		err = db.IndexVersion(ctx, name)
+	} else if name, perr := names.ParseSpecRevision(resource); perr == nil {
+		err = db.IndexSpec(ctx, name.Spec())
+	} else if name, perr := names.ParseSpec(resource); perr == nil {
+		err = db.IndexSpec(ctx, name)
+	} else if name, perr := names.ParseDeploymentRevision(resource); perr == nil {
+		err = db.IndexDeployment(ctx, name.Deployment())
+	} else if name, perr := names.ParseDeployment(resource); perr == nil {
+		err = db.IndexDeployment(ctx, name)
+	}
+
+	if isNotFound(err) {
+		return nil
+	}
+	return err
+}
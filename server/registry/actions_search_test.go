@@ -0,0 +1,354 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"testing"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/test/seeder"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+const searchParent = "projects/my-project/locations/global"
+
+const invoicesOpenAPI = `openapi: 3.0.0
+info:
+  title: Billing
+  version: 1.0.0
+paths:
+  /v1/invoices:
+    get:
+      operationId: listInvoices
+      responses:
+        '200':
+          description: OK
+components:
+  schemas:
+    PaymentMethod:
+      type: object
+`
+
+const shippingProto = `syntax = "proto3";
+package shipping.v1;
+service Shipping {
+  rpc TrackParcel(TrackParcelRequest) returns (Parcel);
+}
+message TrackParcelRequest {
+  string parcel_id = 1;
+}
+message Parcel {
+  string carrier = 1;
+}
+`
+
+func gzipped(t *testing.T, contents string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write([]byte(contents)); err != nil {
+		t.Fatalf("Setup: failed to gzip contents: %s", err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("Setup: failed to gzip contents: %s", err)
+	}
+	return buf.Bytes()
+}
+
+func zipped(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, contents := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("Setup: failed to zip contents: %s", err)
+		}
+		if _, err := w.Write([]byte(contents)); err != nil {
+			t.Fatalf("Setup: failed to zip contents: %s", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("Setup: failed to zip contents: %s", err)
+	}
+	return buf.Bytes()
+}
+
+func seedSearchResources(ctx context.Context, t *testing.T, server *RegistryServer) {
+	t.Helper()
+	if err := seeder.SeedRegistry(ctx, server,
+		&rpc.Api{
+			Name:        searchParent + "/apis/billing",
+			DisplayName: "Invoices",
+			Description: "Sends invoices to customers.",
+			Labels:      map[string]string{"team": "finance"},
+		},
+		&rpc.Api{
+			Name:        searchParent + "/apis/shipping",
+			DisplayName: "Shipping",
+			Description: "Tracks parcels.",
+			Annotations: map[string]string{"owner": "logistics-team"},
+		},
+		&rpc.ApiSpec{
+			Name:     searchParent + "/apis/billing/versions/v1/specs/openapi",
+			Filename: "openapi.yaml",
+			MimeType: "application/x.openapi+gzip;version=3.0.0",
+			Contents: gzipped(t, invoicesOpenAPI),
+		},
+		&rpc.ApiSpec{
+			Name:     searchParent + "/apis/shipping/versions/v1/specs/protos",
+			Filename: "protos.zip",
+			MimeType: "application/x.protobuf+zip",
+			Contents: zipped(t, map[string]string{"shipping/v1/shipping.proto": shippingProto}),
+		},
+		&rpc.ApiDeployment{
+			Name:             searchParent + "/apis/shipping/deployments/prod",
+			DisplayName:      "Production",
+			IntendedAudience: "Parcel carriers",
+		},
+	); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+}
+
+func searchNames(ctx context.Context, t *testing.T, server *RegistryServer, query string) []string {
+	t.Helper()
+	got, err := server.SearchResources(ctx, &rpc.SearchResourcesRequest{Parent: searchParent, Query: query})
+	if err != nil {
+		t.Fatalf("SearchResources(%q) returned error: %s", query, err)
+	}
+	names := make([]string, 0)
+	for _, r := range got.GetResults() {
+		names = append(names, r.GetName())
+	}
+	return names
+}
+
+func TestSearchResources(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	seedSearchResources(ctx, t, server)
+
+	tests := []struct {
+		desc  string
+		query string
+		want  []string
+	}{
+		{
+			desc:  "display names rank above contents",
+			query: "invoice",
+			want: []string{
+				searchParent + "/apis/billing",
+				searchParent + "/apis/billing/versions/v1/specs/openapi",
+			},
+		},
+		{
+			desc:  "operation ids",
+			query: "listInvoices",
+			want: []string{
+				searchParent + "/apis/billing/versions/v1/specs/openapi",
+			},
+		},
+		{
+			desc:  "schema names",
+			query: "payment method",
+			want: []string{
+				searchParent + "/apis/billing/versions/v1/specs/openapi",
+			},
+		},
+		{
+			desc:  "proto methods and messages",
+			query: "parcel request",
+			want: []string{
+				searchParent + "/apis/shipping/versions/v1/specs/protos",
+			},
+		},
+		{
+			desc:  "labels",
+			query: "finance",
+			want: []string{
+				searchParent + "/apis/billing",
+			},
+		},
+		{
+			desc:  "annotations",
+			query: "logistics",
+			want: []string{
+				searchParent + "/apis/shipping",
+			},
+		},
+		{
+			desc:  "deployments",
+			query: "carriers",
+			want: []string{
+				searchParent + "/apis/shipping/deployments/prod",
+				searchParent + "/apis/shipping/versions/v1/specs/protos",
+			},
+		},
+		{
+			desc:  "all words must match",
+			query: "invoices parcels",
+			want:  []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			if diff := cmp.Diff(test.want, searchNames(ctx, t, server, test.query)); diff != "" {
+				t.Errorf("SearchResources(%q) returned unexpected diff (-want +got):\n%s", test.query, diff)
+			}
+		})
+	}
+}
+
+func TestSearchResourcesIndexesChanges(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	seedSearchResources(ctx, t, server)
+
+	const api = searchParent + "/apis/billing"
+	if _, err := server.UpdateApi(ctx, &rpc.UpdateApiRequest{
+		Api:        &rpc.Api{Name: api, DisplayName: "Receipts"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+	}); err != nil {
+		t.Fatalf("UpdateApi() returned error: %s", err)
+	}
+	if got := searchNames(ctx, t, server, "receipts"); !cmp.Equal(got, []string{api}) {
+		t.Errorf("SearchResources() after update returned %v, want %v", got, []string{api})
+	}
+
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: api, Force: true}); err != nil {
+		t.Fatalf("DeleteApi() returned error: %s", err)
+	}
+	if got := searchNames(ctx, t, server, "invoices"); len(got) != 0 {
+		t.Errorf("SearchResources() after delete returned %v, want no results", got)
+	}
+
+	if _, err := server.UndeleteApi(ctx, &rpc.UndeleteApiRequest{Name: api}); err != nil {
+		t.Fatalf("UndeleteApi() returned error: %s", err)
+	}
+	if got := searchNames(ctx, t, server, "listInvoices"); len(got) != 1 {
+		t.Errorf("SearchResources() after undelete returned %v, want the restored spec", got)
+	}
+
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: api, Force: true}); err != nil {
+		t.Fatalf("DeleteApi() returned error: %s", err)
+	}
+	purgeDeleted(ctx, t, server)
+	if _, err := server.CreateApi(ctx, &rpc.CreateApiRequest{Parent: searchParent, ApiId: "billing", Api: &rpc.Api{}}); err != nil {
+		t.Fatalf("CreateApi() returned error: %s", err)
+	}
+	if got := searchNames(ctx, t, server, "receipts"); len(got) != 0 {
+		t.Errorf("SearchResources() after purge returned %v, want no results", got)
+	}
+}
+
+func TestSearchResourcesSequence(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	apis := make([]*rpc.Api, 0)
+	for _, id := range []string{"a", "b", "c", "d", "e"} {
+		apis = append(apis, &rpc.Api{Name: searchParent + "/apis/" + id, Description: "Manages orders."})
+	}
+	if err := seeder.SeedApis(ctx, server, apis...); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	want := searchNames(ctx, t, server, "orders")
+	if len(want) != len(apis) {
+		t.Fatalf("SearchResources() returned %d results, want %d", len(want), len(apis))
+	}
+
+	got := make([]string, 0)
+	req := &rpc.SearchResourcesRequest{Parent: searchParent, Query: "orders", PageSize: 2}
+	for {
+		page, err := server.SearchResources(ctx, req)
+		if err != nil {
+			t.Fatalf("SearchResources(%+v) returned error: %s", req, err)
+		}
+		for _, r := range page.GetResults() {
+			got = append(got, r.GetName())
+		}
+		if page.GetNextPageToken() == "" {
+			break
+		}
+		req.PageToken = page.GetNextPageToken()
+	}
+
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("SearchResources() pages returned unexpected diff (-want +got):\n%s", diff)
+	}
+
+	req.Query = "other"
+	if _, err := server.SearchResources(ctx, req); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("SearchResources() with a changed query returned status code %q, want %q", status.Code(err), codes.InvalidArgument)
+	}
+}
+
+func TestSearchResourcesResponseCodes(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	if err := seeder.SeedProjects(ctx, server, &rpc.Project{Name: "projects/my-project"}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	tests := []struct {
+		desc string
+		req  *rpc.SearchResourcesRequest
+		want codes.Code
+	}{
+		{
+			desc: "parent not found",
+			req:  &rpc.SearchResourcesRequest{Parent: "projects/other-project/locations/global", Query: "orders"},
+			want: codes.NotFound,
+		},
+		{
+			desc: "invalid parent",
+			req:  &rpc.SearchResourcesRequest{Parent: "invalid", Query: "orders"},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "missing query",
+			req:  &rpc.SearchResourcesRequest{Parent: searchParent, Query: " - "},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "negative page size",
+			req:  &rpc.SearchResourcesRequest{Parent: searchParent, Query: "orders", PageSize: -1},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "invalid page token",
+			req:  &rpc.SearchResourcesRequest{Parent: searchParent, Query: "orders", PageToken: "invalid"},
+			want: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			if _, err := server.SearchResources(ctx, test.req); status.Code(err) != test.want {
+				t.Errorf("SearchResources(%+v) returned status code %q, want %q: %v", test.req, status.Code(err), test.want, err)
+			}
+		})
+	}
+}
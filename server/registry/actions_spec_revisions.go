@@ -23,6 +23,7 @@ import (
 	"github.com/apigee/registry/server/registry/names"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 // ListApiSpecRevisions handles the corresponding API request.
@@ -31,7 +32,6 @@ func (s *RegistryServer) ListApiSpecRevisions(ctx context.Context, req *rpc.List
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	if req.GetPageSize() < 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid page_size %d: must not be negative", req.GetPageSize())
@@ -81,7 +81,6 @@ func (s *RegistryServer) DeleteApiSpecRevision(ctx context.Context, req *rpc.Del
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	name, err := names.ParseSpecRevision(req.GetName())
 	if err != nil {
@@ -100,12 +99,15 @@ func (s *RegistryServer) DeleteApiSpecRevision(ctx context.Context, req *rpc.Del
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if err := db.DeleteSpecRevision(ctx, name); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := db.DeleteSpecRevision(ctx, name); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		return s.notify(ctx, db, rpc.Notification_DELETED, name.String())
+	}); err != nil {
+		return nil, err
 	}
 
-	s.notify(ctx, rpc.Notification_DELETED, name.String())
-
 	// return the latest revision of the current spec
 	spec, err := s.getApiSpec(ctx, name.Spec())
 	if err != nil {
@@ -122,7 +124,6 @@ func (s *RegistryServer) TagApiSpecRevision(ctx context.Context, req *rpc.TagApi
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	if req.GetTag() == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid tag %q, must not be empty", req.GetTag())
@@ -149,21 +150,27 @@ func (s *RegistryServer) TagApiSpecRevision(ctx context.Context, req *rpc.TagApi
 	}
 
 	tag := models.NewSpecRevisionTag(name, req.GetTag())
-	if err := db.SaveSpecRevisionTag(ctx, tag); err != nil {
-		return nil, err
-	}
+	var message *rpc.ApiSpec
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := db.SaveSpecRevisionTag(ctx, tag); err != nil {
+			return err
+		}
 
-	tags, err := revisionTags(ctx, db, name)
-	if err != nil {
-		return nil, err
-	}
+		tags, err := revisionTags(ctx, db, name)
+		if err != nil {
+			return err
+		}
 
-	message, err := revision.BasicMessage(tag.String(), tags)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		message, err = revision.BasicMessage(tag.String(), tags)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		return s.notify(ctx, db, rpc.Notification_UPDATED, name.String(),
+			withSnapshot(message), withUpdateMask(&fieldmaskpb.FieldMask{Paths: []string{"revision_tags"}}))
+	}); err != nil {
+		return nil, err
 	}
 
-	s.notify(ctx, rpc.Notification_UPDATED, name.String())
 	return message, nil
 }
 
@@ -173,7 +180,6 @@ func (s *RegistryServer) RollbackApiSpec(ctx context.Context, req *rpc.RollbackA
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	if req.GetRevisionId() == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid revision ID %q, must not be empty", req.GetRevisionId())
@@ -191,28 +197,38 @@ func (s *RegistryServer) RollbackApiSpec(ctx context.Context, req *rpc.RollbackA
 		return nil, err
 	}
 
-	// Save a new rollback revision based on the target revision.
-	rollback := target.NewRevision()
-	if err := db.SaveSpecRevision(ctx, rollback); err != nil {
-		return nil, err
-	}
-
-	blob, err := db.GetSpecRevisionContents(ctx, name)
+	// The revision that is current before the rollback is reported in the notification.
+	current, err := db.GetSpec(ctx, parent)
 	if err != nil {
 		return nil, err
 	}
 
-	// Save a new copy of the target revision blob for the rollback revision.
-	blob.RevisionID = name.RevisionID
-	if err := db.SaveSpecRevisionContents(ctx, rollback, blob.Contents); err != nil {
-		return nil, err
-	}
-
+	// Save a new rollback revision based on the target revision.
+	rollback := target.NewRevision()
 	message, err := rollback.BasicMessage(rollback.RevisionName(), []string{})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	s.notify(ctx, rpc.Notification_CREATED, rollback.RevisionName())
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := db.SaveSpecRevision(ctx, rollback); err != nil {
+			return err
+		}
+
+		blob, err := db.GetSpecRevisionContents(ctx, name)
+		if err != nil {
+			return err
+		}
+
+		// The rollback revision shares the blob of the target revision.
+		if err := db.SaveSpecRevisionContents(ctx, rollback, blob.Contents); err != nil {
+			return err
+		}
+		return s.notify(ctx, db, rpc.Notification_CREATED, rollback.RevisionName(),
+			withSnapshot(message), withPreviousRevision(current.RevisionID))
+	}); err != nil {
+		return nil, err
+	}
+
 	return message, nil
 }
@@ -212,7 +212,7 @@ func TestRollbackApiSpec(t *testing.T) {
 
 	opts := cmp.Options{
 		protocmp.Transform(),
-		protocmp.IgnoreFields(new(rpc.ApiSpec), "revision_id", "revision_create_time", "revision_update_time"),
+		protocmp.IgnoreFields(new(rpc.ApiSpec), "revision_id", "revision_create_time", "revision_update_time", "etag"),
 	}
 
 	if !cmp.Equal(want, rollback, opts) {
@@ -304,6 +304,7 @@ func TestListApiSpecRevisions(t *testing.T) {
 		RevisionCreateTime: firstRevision.GetRevisionCreateTime(),
 		RevisionUpdateTime: firstRevision.GetRevisionUpdateTime(),
 		RevisionId:         firstRevision.GetRevisionId(),
+		Etag:               firstRevision.GetEtag(),
 	}
 
 	updateReq := &rpc.UpdateApiSpecRequest{
@@ -311,7 +312,7 @@ func TestListApiSpecRevisions(t *testing.T) {
 			Name:      firstRevision.GetName(),
 			Contents:  specContents,
 			Hash:      sha256hash(specContents),
-			SizeBytes: int32(len(specContents)),
+			SizeBytes: int64(len(specContents)),
 		},
 	}
 
@@ -328,6 +329,7 @@ func TestListApiSpecRevisions(t *testing.T) {
 		RevisionCreateTime: secondRevision.GetRevisionCreateTime(),
 		RevisionUpdateTime: secondRevision.GetRevisionUpdateTime(),
 		RevisionId:         secondRevision.GetRevisionId(),
+		Etag:               secondRevision.GetEtag(),
 	}
 
 	opts := cmp.Options{
@@ -416,7 +418,7 @@ func TestUpdateApiSpecRevisions(t *testing.T) {
 
 	opts := cmp.Options{
 		protocmp.Transform(),
-		protocmp.IgnoreFields(new(rpc.ApiSpec), "revision_id", "create_time", "revision_create_time", "revision_update_time"),
+		protocmp.IgnoreFields(new(rpc.ApiSpec), "revision_id", "create_time", "revision_create_time", "revision_update_time", "etag"),
 	}
 
 	t.Run("modify revision without content changes", func(t *testing.T) {
@@ -448,7 +450,7 @@ func TestUpdateApiSpecRevisions(t *testing.T) {
 			},
 		}
 		want := proto.Clone(created).(*rpc.ApiSpec)
-		want.SizeBytes = int32(len(req.ApiSpec.GetContents()))
+		want.SizeBytes = int64(len(req.ApiSpec.GetContents()))
 		want.Hash = sha256hash(req.ApiSpec.GetContents())
 
 		got, err := server.UpdateApiSpec(ctx, req)
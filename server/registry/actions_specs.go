@@ -18,7 +18,6 @@ import (
 	"context"
 	"github.com/getkin/kin-openapi/openapi3"
 	"strings"
-	"sync"
 
 	"github.com/apigee/registry/rpc"
 	"github.com/apigee/registry/server/registry/internal/storage"
@@ -28,7 +27,6 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
-	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 // CreateApiSpec handles the corresponding API request.
@@ -46,12 +44,19 @@ func (s *RegistryServer) CreateApiSpec(ctx context.Context, req *rpc.CreateApiSp
 }
 
 func (s *RegistryServer) createSpec(ctx context.Context, name names.Spec, body *rpc.ApiSpec) (*rpc.ApiSpec, error) {
-	db, err := s.getStorageClient(ctx)
-	if err != nil {
-		return nil, status.Error(codes.Unavailable, err.Error())
+	var message *rpc.ApiSpec
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) (err error) {
+		message, err = s.insertSpec(ctx, db, name, body)
+		return err
+	}); err != nil {
+		return nil, err
 	}
-	defer db.Close()
 
+	return message, nil
+}
+
+// insertSpec creates an API spec using a client for a transaction that may have other changes.
+func (s *RegistryServer) insertSpec(ctx context.Context, db *storage.Client, name names.Spec, body *rpc.ApiSpec) (*rpc.ApiSpec, error) {
 	if _, err := db.GetSpec(ctx, name); err == nil {
 		return nil, status.Errorf(codes.AlreadyExists, "API spec %q already exists", name)
 	} else if !isNotFound(err) {
@@ -72,20 +77,21 @@ func (s *RegistryServer) createSpec(ctx context.Context, name names.Spec, body *
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	message, err := spec.BasicMessage(name.String(), []string{})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	if err := db.SaveSpecRevision(ctx, spec); err != nil {
 		return nil, err
 	}
-
 	if err := db.SaveSpecRevisionContents(ctx, spec, body.GetContents()); err != nil {
 		return nil, err
 	}
-
-	message, err := spec.BasicMessage(name.String(), []string{})
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+	if err := s.notify(ctx, db, rpc.Notification_CREATED, spec.RevisionName(), withSnapshot(message)); err != nil {
+		return nil, err
 	}
 
-	s.notify(ctx, rpc.Notification_CREATED, spec.RevisionName())
 	return message, nil
 }
 
@@ -95,7 +101,6 @@ func (s *RegistryServer) DeleteApiSpec(ctx context.Context, req *rpc.DeleteApiSp
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	name, err := names.ParseSpec(req.GetName())
 	if err != nil {
@@ -107,14 +112,52 @@ func (s *RegistryServer) DeleteApiSpec(ctx context.Context, req *rpc.DeleteApiSp
 		return nil, err
 	}
 
-	if err := db.DeleteSpec(ctx, name, req.GetForce()); err != nil {
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := checkETag(name.String(), req.GetEtag(), func() (etagged, error) {
+			return db.ForUpdate().GetSpec(ctx, name)
+		}); err != nil {
+			return err
+		}
+		if err := db.DeleteSpec(ctx, name, req.GetForce()); err != nil {
+			return err
+		}
+		return s.notify(ctx, db, rpc.Notification_DELETED, name.String())
+	}); err != nil {
 		return nil, err
 	}
 
-	s.notify(ctx, rpc.Notification_DELETED, name.String())
 	return &emptypb.Empty{}, nil
 }
 
+// UndeleteApiSpec handles the corresponding API request.
+func (s *RegistryServer) UndeleteApiSpec(ctx context.Context, req *rpc.UndeleteApiSpecRequest) (*rpc.ApiSpec, error) {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	name, err := names.ParseSpec(req.GetName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Restoration should only succeed when the parent exists.
+	if _, err := db.GetVersion(ctx, name.Version()); err != nil {
+		return nil, undeleteParentError(err, name.String())
+	}
+
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := db.UndeleteSpec(ctx, name); err != nil {
+			return err
+		}
+		return s.notify(ctx, db, rpc.Notification_CREATED, name.String())
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.getApiSpec(ctx, name)
+}
+
 // GetApiSpec handles the corresponding API request.
 func (s *RegistryServer) GetApiSpec(ctx context.Context, req *rpc.GetApiSpecRequest) (*rpc.ApiSpec, error) {
 	if name, err := names.ParseSpec(req.GetName()); err == nil {
@@ -131,7 +174,6 @@ func (s *RegistryServer) getApiSpec(ctx context.Context, name names.Spec) (*rpc.
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	spec, err := db.GetSpec(ctx, name)
 	if err != nil {
@@ -156,7 +198,6 @@ func (s *RegistryServer) getApiSpecRevision(ctx context.Context, name names.Spec
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	revision, err := db.GetSpecRevision(ctx, name)
 	if err != nil {
@@ -182,7 +223,6 @@ func (s *RegistryServer) GetApiSpecContents(ctx context.Context, req *rpc.GetApi
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	// split the results
 	pathOp := strings.Split(req.GetName(), "#")
@@ -276,7 +316,6 @@ func (s *RegistryServer) ListApiSpecs(ctx context.Context, req *rpc.ListApiSpecs
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	if req.GetPageSize() < 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid page_size %d: must not be negative", req.GetPageSize())
@@ -292,9 +331,11 @@ func (s *RegistryServer) ListApiSpecs(ctx context.Context, req *rpc.ListApiSpecs
 	}
 
 	listing, err := db.ListSpecs(ctx, parent, storage.PageOptions{
-		Size:   req.GetPageSize(),
-		Filter: req.GetFilter(),
-		Token:  req.GetPageToken(),
+		Size:        req.GetPageSize(),
+		Filter:      req.GetFilter(),
+		Order:       req.GetOrderBy(),
+		Token:       req.GetPageToken(),
+		ShowDeleted: req.GetShowDeleted(),
 	})
 	if err != nil {
 		return nil, err
@@ -321,16 +362,8 @@ func (s *RegistryServer) ListApiSpecs(ctx context.Context, req *rpc.ListApiSpecs
 	return response, nil
 }
 
-var updateSpecMutex sync.Mutex
-
 // UpdateApiSpec handles the corresponding API request.
 func (s *RegistryServer) UpdateApiSpec(ctx context.Context, req *rpc.UpdateApiSpecRequest) (*rpc.ApiSpec, error) {
-	db, err := s.getStorageClient(ctx)
-	if err != nil {
-		return nil, status.Error(codes.Unavailable, err.Error())
-	}
-	defer db.Close()
-
 	if req.GetApiSpec() == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid api_spec %+v: body must be provided", req.GetApiSpec())
 	} else if err := models.ValidateMask(req.GetApiSpec(), req.GetUpdateMask()); err != nil {
@@ -342,53 +375,56 @@ func (s *RegistryServer) UpdateApiSpec(ctx context.Context, req *rpc.UpdateApiSp
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if req.GetAllowMissing() {
-		// Prevent a race condition that can occur when two updates are made
-		// to the same non-existent resource. The db.Get...() call returns
-		// NotFound for both updates, and after one creates the resource,
-		// the other creation fails. The lock() prevents this by serializing
-		// the get and create operations. Future updates could improve this
-		// with improvements closer to the database level.
-		updateSpecMutex.Lock()
-		defer updateSpecMutex.Unlock()
-	}
+	var message *rpc.ApiSpec
+	if err := s.upsert(ctx, func(ctx context.Context, db *storage.Client) error {
+		spec, err := db.ForUpdate().GetSpec(ctx, name)
+		if req.GetAllowMissing() && isNotFound(err) && req.GetApiSpec().GetEtag() == "" {
+			message, err = s.insertSpec(ctx, db, name, req.GetApiSpec())
+			return err
+		} else if err != nil {
+			return err
+		}
 
-	spec, err := db.GetSpec(ctx, name)
-	if req.GetAllowMissing() && isNotFound(err) {
-		return s.createSpec(ctx, name, req.GetApiSpec())
-	} else if err != nil {
-		return nil, err
-	}
+		if err := checkETag(name.String(), req.GetApiSpec().GetEtag(), func() (etagged, error) {
+			return spec, nil
+		}); err != nil {
+			return err
+		}
 
-	// Apply the update to the spec - possibly changing the revision ID.
-	maskExpansion := models.ExpandMask(req.GetApiSpec(), req.GetUpdateMask())
-	if err := spec.Update(req.GetApiSpec(), maskExpansion); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
+		// Apply the update to the spec - possibly changing the revision ID.
+		previousRevisionID := spec.RevisionID
+		maskExpansion := models.ExpandMask(req.GetApiSpec(), req.GetUpdateMask())
+		if err := spec.Update(req.GetApiSpec(), maskExpansion); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
 
-	// Save the updated/current spec. This creates a new revision or updates the previous one.
-	if err := db.SaveSpecRevision(ctx, spec); err != nil {
-		return nil, err
-	}
+		tags, err := revisionTags(ctx, db, name.Revision(spec.RevisionID))
+		if err != nil {
+			return err
+		}
 
-	// If the spec contents were updated, save a new blob.
-	if len(fieldmaskpb.Intersect(maskExpansion, &fieldmaskpb.FieldMask{Paths: []string{"contents"}}).GetPaths()) > 0 {
-		if err := db.SaveSpecRevisionContents(ctx, spec, req.ApiSpec.GetContents()); err != nil {
-			return nil, err
+		message, err = spec.BasicMessage(name.String(), tags)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
 		}
-	}
 
-	tags, err := revisionTags(ctx, db, name.Revision(spec.RevisionID))
-	if err != nil {
-		return nil, err
-	}
+		// Save the updated/current spec. This creates a new revision or updates the previous one.
+		if err := db.SaveSpecRevision(ctx, spec); err != nil {
+			return err
+		}
 
-	message, err := spec.BasicMessage(name.String(), tags)
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		// If the spec contents were changed, the new revision references their blob.
+		if spec.RevisionID != previousRevisionID {
+			if err := db.SaveSpecRevisionContents(ctx, spec, req.ApiSpec.GetContents()); err != nil {
+				return err
+			}
+		}
+		return s.notify(ctx, db, rpc.Notification_UPDATED, spec.RevisionName(),
+			withSnapshot(message), withPreviousRevision(previousRevisionID), withUpdateMask(maskExpansion))
+	}); err != nil {
+		return nil, err
 	}
 
-	s.notify(ctx, rpc.Notification_UPDATED, spec.RevisionName())
 	return message, nil
 }
 
@@ -18,6 +18,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/apigee/registry/rpc"
@@ -71,7 +72,7 @@ func TestCreateApiSpec(t *testing.T) {
 				Filename:     "openapi.json",
 				Description:  "My Description",
 				MimeType:     "application/x.openapi;version=3.0.0",
-				SizeBytes:    int32(len(specContents)),
+				SizeBytes:    int64(len(specContents)),
 				Hash:         sha256hash(specContents),
 				SourceUri:    "https://www.example.com/openapi.json",
 				RevisionTags: []string{},
@@ -100,7 +101,7 @@ func TestCreateApiSpec(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.ApiSpec), "revision_id", "create_time", "revision_create_time", "revision_update_time"),
+				protocmp.IgnoreFields(new(rpc.ApiSpec), "revision_id", "create_time", "revision_create_time", "revision_update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, created, opts) {
@@ -337,7 +338,7 @@ func TestGetApiSpec(t *testing.T) {
 				Filename:     "openapi.json",
 				Description:  "My API Spec",
 				MimeType:     "application/x.openapi;version=3.0.0",
-				SizeBytes:    int32(len(specContents)),
+				SizeBytes:    int64(len(specContents)),
 				Hash:         sha256hash(specContents),
 				SourceUri:    "https://www.example.com/openapi.json",
 				RevisionTags: []string{},
@@ -366,7 +367,7 @@ func TestGetApiSpec(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.ApiSpec), "revision_id", "create_time", "revision_create_time", "revision_update_time"),
+				protocmp.IgnoreFields(new(rpc.ApiSpec), "revision_id", "create_time", "revision_create_time", "revision_update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, got, opts) {
@@ -529,7 +530,7 @@ func TestListApiSpecs(t *testing.T) {
 					{
 						Name:      "projects/my-project/locations/global/apis/my-api/versions/v1/specs/spec1",
 						Hash:      sha256hash(specContents),
-						SizeBytes: int32(len(specContents)),
+						SizeBytes: int64(len(specContents)),
 					},
 					{
 						Name: "projects/my-project/locations/global/apis/my-api/versions/v1/specs/spec2",
@@ -717,7 +718,7 @@ func TestListApiSpecs(t *testing.T) {
 			opts := cmp.Options{
 				protocmp.Transform(),
 				protocmp.IgnoreFields(new(rpc.ListApiSpecsResponse), "next_page_token"),
-				protocmp.IgnoreFields(new(rpc.ApiSpec), "revision_id", "create_time", "revision_create_time", "revision_update_time"),
+				protocmp.IgnoreFields(new(rpc.ApiSpec), "revision_id", "create_time", "revision_create_time", "revision_update_time", "etag"),
 				protocmp.SortRepeated(func(a, b *rpc.ApiSpec) bool {
 					return a.GetName() < b.GetName()
 				}),
@@ -898,7 +899,7 @@ func TestListApiSpecsSequence(t *testing.T) {
 
 	opts := cmp.Options{
 		protocmp.Transform(),
-		protocmp.IgnoreFields(new(rpc.ApiSpec), "revision_id", "create_time", "revision_create_time", "revision_update_time"),
+		protocmp.IgnoreFields(new(rpc.ApiSpec), "revision_id", "create_time", "revision_create_time", "revision_update_time", "etag"),
 		cmpopts.SortSlices(func(a, b *rpc.ApiSpec) bool {
 			return a.GetName() < b.GetName()
 		}),
@@ -1085,7 +1086,7 @@ func TestUpdateApiSpec(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.ApiSpec), "revision_id", "create_time", "revision_create_time", "revision_update_time"),
+				protocmp.IgnoreFields(new(rpc.ApiSpec), "revision_id", "create_time", "revision_create_time", "revision_update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, updated, opts) {
@@ -1300,3 +1301,46 @@ func TestDeleteApiSpecCascading(t *testing.T) {
 		t.Errorf("GetArtifact(%q) returned status code %q, want %q: %s", artifact.GetName(), status.Code(err), codes.NotFound, err)
 	}
 }
+
+func TestConcurrentUpdateApiSpecAllowMissing(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	version := &rpc.ApiVersion{Name: "projects/my-project/locations/global/apis/a/versions/v"}
+	if err := seeder.SeedVersions(ctx, server, version); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	// Every request either creates the spec or updates the spec that another request created.
+	const count = 10
+	errs := make([]error, count)
+	var wg sync.WaitGroup
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = server.UpdateApiSpec(ctx, &rpc.UpdateApiSpecRequest{
+				ApiSpec: &rpc.ApiSpec{
+					Name:     version.GetName() + "/specs/s",
+					Contents: []byte(fmt.Sprintf("contents-%d", i)),
+				},
+				AllowMissing: true,
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			t.Errorf("UpdateApiSpec() request %d returned error: %s", i, err)
+		}
+	}
+
+	got, err := server.ListApiSpecRevisions(ctx, &rpc.ListApiSpecRevisionsRequest{Name: version.GetName() + "/specs/s"})
+	if err != nil {
+		t.Fatalf("ListApiSpecRevisions() returned error: %s", err)
+	}
+	if len(got.GetApiSpecs()) != count {
+		t.Errorf("ListApiSpecRevisions() returned %d revisions, want %d", len(got.GetApiSpecs()), count)
+	}
+}
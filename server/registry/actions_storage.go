@@ -16,6 +16,7 @@ package registry
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/apigee/registry/rpc"
 	"google.golang.org/grpc/codes"
@@ -29,7 +30,6 @@ func (s *RegistryServer) GetStorage(ctx context.Context, req *emptypb.Empty) (*r
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 	tableNames, err := db.TableNames()
 	if err != nil {
 		return nil, err
@@ -52,3 +52,13 @@ func (s *RegistryServer) GetStorage(ctx context.Context, req *emptypb.Empty) (*r
 		Collections: collections,
 	}, nil
 }
+
+// DBStats returns statistics of the server's database connection pool.
+// They are empty if the server is closed.
+func (s *RegistryServer) DBStats() sql.DBStats {
+	if s.db == nil {
+		return sql.DBStats{}
+	}
+	stats, _ := s.db.PoolStats()
+	return stats
+}
@@ -15,12 +15,22 @@
 package registry
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/test/seeder"
 	"github.com/google/go-cmp/cmp"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func TestGetStorage(t *testing.T) {
@@ -36,7 +46,7 @@ func TestGetStorage(t *testing.T) {
 
 	// Ensure that we get the set of tables that we expect.
 	// Tables should be returned in alphabetical order.
-	want := []string{"apis", "artifacts", "blobs", "deployment_revision_tags", "deployments", "projects", "spec_revision_tags", "specs", "versions"}
+	want := []string{"apis", "artifact_revision_tags", "artifacts", "audit_events", "blobs", "deployment_revision_tags", "deployments", "events", "operations", "projects", "role_bindings", "schema_migrations", "search_documents", "spec_revision_tags", "specs", "versions"}
 	got := make([]string, 0)
 	for _, c := range resp.Collections {
 		got = append(got, c.Name)
@@ -45,3 +55,178 @@ func TestGetStorage(t *testing.T) {
 		t.Errorf("GetStorage(%+v) returned unexpected diff (-want +got):\n%s", req, cmp.Diff(want, got, protocmp.Transform()))
 	}
 }
+
+// blobCount returns the number of blobs stored by the server.
+func blobCount(ctx context.Context, t *testing.T, server *RegistryServer) int64 {
+	t.Helper()
+	resp, err := server.GetStorage(ctx, &emptypb.Empty{})
+	if err != nil {
+		t.Fatalf("GetStorage() returned error: %s", err)
+	}
+	for _, c := range resp.GetCollections() {
+		if c.GetName() == "blobs" {
+			return c.GetCount()
+		}
+	}
+	t.Fatalf("GetStorage() returned no blobs collection")
+	return 0
+}
+
+func TestBlobDeduplication(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	contents := []byte("shared contents")
+	specs := []*rpc.ApiSpec{
+		{Name: "projects/my-project/locations/global/apis/a/versions/v1/specs/s", Contents: contents},
+		{Name: "projects/my-project/locations/global/apis/a/versions/v2/specs/s", Contents: contents},
+	}
+	artifact := &rpc.Artifact{Name: "projects/my-project/locations/global/artifacts/a", Contents: contents}
+	if err := seeder.SeedRegistry(ctx, server, specs[0], specs[1], artifact); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	if got := blobCount(ctx, t, server); got != 1 {
+		t.Errorf("Registry stores %d blobs for identical contents, want 1", got)
+	}
+
+	// Updating the contents of a spec creates a new revision with a new blob.
+	_, err := server.UpdateApiSpec(ctx, &rpc.UpdateApiSpecRequest{
+		ApiSpec:    &rpc.ApiSpec{Name: specs[0].GetName(), Contents: []byte("updated contents")},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"contents"}},
+	})
+	if err != nil {
+		t.Fatalf("UpdateApiSpec() returned error: %s", err)
+	}
+	if got := blobCount(ctx, t, server); got != 2 {
+		t.Errorf("Registry stores %d blobs after an update, want 2", got)
+	}
+
+	// Blobs remain while they are referenced.
+	if _, err := server.DeleteApiSpec(ctx, &rpc.DeleteApiSpecRequest{Name: specs[1].GetName()}); err != nil {
+		t.Fatalf("DeleteApiSpec() returned error: %s", err)
+	}
+	if _, err := server.DeleteArtifact(ctx, &rpc.DeleteArtifactRequest{Name: artifact.GetName()}); err != nil {
+		t.Fatalf("DeleteArtifact() returned error: %s", err)
+	}
+	if got := blobCount(ctx, t, server); got != 2 {
+		t.Errorf("Registry stores %d blobs while both are referenced, want 2", got)
+	}
+
+	body, err := server.GetApiSpecContents(ctx, &rpc.GetApiSpecContentsRequest{Name: specs[0].GetName()})
+	if err != nil {
+		t.Fatalf("GetApiSpecContents() returned error: %s", err)
+	}
+	if !bytes.Equal(body.GetData(), []byte("updated contents")) {
+		t.Errorf("GetApiSpecContents() returned %q, want %q", body.GetData(), "updated contents")
+	}
+
+	// Blobs are deleted when their last reference is purged.
+	if _, err := server.DeleteProject(ctx, &rpc.DeleteProjectRequest{Name: "projects/my-project", Force: true}); err != nil {
+		t.Fatalf("DeleteProject() returned error: %s", err)
+	}
+	if got := blobCount(ctx, t, server); got != 2 {
+		t.Errorf("Registry stores %d blobs before deleted references are purged, want 2", got)
+	}
+	purgeDeleted(ctx, t, server)
+	if got := blobCount(ctx, t, server); got != 0 {
+		t.Errorf("Registry stores %d blobs after all references are deleted, want 0", got)
+	}
+}
+
+func TestBlobDeduplicationCompression(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	contents := []byte("shared contents")
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(contents); err != nil {
+		t.Fatalf("Setup: failed to compress contents: %s", err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("Setup: failed to compress contents: %s", err)
+	}
+
+	// Specs with the same uncompressed contents have the same hash, but store different blobs.
+	specs := []*rpc.ApiSpec{
+		{Name: "projects/my-project/locations/global/apis/a/versions/v/specs/plain", Contents: contents},
+		{Name: "projects/my-project/locations/global/apis/a/versions/v/specs/zipped", Contents: buf.Bytes(), MimeType: "application/x.openapi+gzip"},
+	}
+	if err := seeder.SeedSpecs(ctx, server, specs...); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	if got := blobCount(ctx, t, server); got != 2 {
+		t.Errorf("Registry stores %d blobs for compressed and uncompressed contents, want 2", got)
+	}
+
+	for _, spec := range specs {
+		body, err := server.GetApiSpecContents(ctx, &rpc.GetApiSpecContentsRequest{Name: spec.GetName()})
+		if err != nil {
+			t.Fatalf("GetApiSpecContents(%q) returned error: %s", spec.GetName(), err)
+		}
+		if !bytes.Equal(body.GetData(), contents) {
+			t.Errorf("GetApiSpecContents(%q) returned %q, want %q", spec.GetName(), body.GetData(), contents)
+		}
+	}
+}
+
+func TestFileBlobStore(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	server, err := New(Config{
+		Database:      "sqlite3",
+		DBConfig:      fmt.Sprintf("%s/registry.db", t.TempDir()),
+		BlobStore:     "filesystem",
+		BlobDirectory: dir,
+	})
+	if err != nil {
+		t.Fatalf("Setup: failed to create server: %s", err)
+	}
+	t.Cleanup(server.Close)
+
+	contents := []byte("stored in a file")
+	spec := &rpc.ApiSpec{
+		Name:     "projects/my-project/locations/global/apis/a/versions/v/specs/s",
+		Contents: contents,
+	}
+	if err := seeder.SeedSpecs(ctx, server, spec); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	hash := sha256.Sum256(contents)
+	path := filepath.Join(dir, hex.EncodeToString(hash[:1]), hex.EncodeToString(hash[:]))
+	if got, err := os.ReadFile(path); err != nil {
+		t.Errorf("Blob store has no file for contents: %s", err)
+	} else if !bytes.Equal(got, contents) {
+		t.Errorf("Blob store file holds %q, want %q", got, contents)
+	}
+
+	body, err := server.GetApiSpecContents(ctx, &rpc.GetApiSpecContentsRequest{Name: spec.GetName()})
+	if err != nil {
+		t.Fatalf("GetApiSpecContents() returned error: %s", err)
+	}
+	if !bytes.Equal(body.GetData(), contents) {
+		t.Errorf("GetApiSpecContents() returned %q, want %q", body.GetData(), contents)
+	}
+
+	if _, err := server.DeleteApiSpec(ctx, &rpc.DeleteApiSpecRequest{Name: spec.GetName()}); err != nil {
+		t.Fatalf("DeleteApiSpec() returned error: %s", err)
+	}
+	purgeDeleted(ctx, t, server)
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("Blob store file exists after its blob was deleted: %v", err)
+	}
+}
+
+func TestUnsupportedBlobStore(t *testing.T) {
+	_, err := New(Config{
+		Database:  "sqlite3",
+		DBConfig:  fmt.Sprintf("%s/registry.db", t.TempDir()),
+		BlobStore: "s3",
+	})
+	if err == nil {
+		t.Errorf("New() with unsupported blob store succeeded, expected error")
+	}
+}
@@ -0,0 +1,255 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/test/seeder"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// purgeDeleted permanently removes all deleted resources.
+func purgeDeleted(ctx context.Context, t *testing.T, server *RegistryServer) {
+	t.Helper()
+	if _, err := server.db.PurgeDeletedResources(ctx, time.Now().Add(time.Second)); err != nil {
+		t.Fatalf("PurgeDeletedResources() returned error: %s", err)
+	}
+}
+
+func TestSoftDelete(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	spec := &rpc.ApiSpec{
+		Name:     "projects/my-project/locations/global/apis/a/versions/v/specs/s",
+		Contents: []byte("contents"),
+	}
+	if err := seeder.SeedSpecs(ctx, server, spec); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+	if _, err := server.CreateArtifact(ctx, &rpc.CreateArtifactRequest{
+		Parent:     spec.GetName(),
+		ArtifactId: "x",
+		Artifact:   &rpc.Artifact{},
+	}); err != nil {
+		t.Fatalf("Setup: CreateArtifact() returned error: %s", err)
+	}
+
+	const api = "projects/my-project/locations/global/apis/a"
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: api, Force: true}); err != nil {
+		t.Fatalf("DeleteApi() returned error: %s", err)
+	}
+
+	if _, err := server.GetApi(ctx, &rpc.GetApiRequest{Name: api}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetApi() of deleted API returned status code %q, want %q", status.Code(err), codes.NotFound)
+	}
+	if _, err := server.GetApiSpec(ctx, &rpc.GetApiSpecRequest{Name: spec.GetName()}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetApiSpec() of deleted spec returned status code %q, want %q", status.Code(err), codes.NotFound)
+	}
+
+	apis, err := server.ListApis(ctx, &rpc.ListApisRequest{Parent: "projects/my-project/locations/global"})
+	if err != nil {
+		t.Fatalf("ListApis() returned error: %s", err)
+	}
+	if len(apis.GetApis()) != 0 {
+		t.Errorf("ListApis() returned %d APIs, want 0", len(apis.GetApis()))
+	}
+
+	apis, err = server.ListApis(ctx, &rpc.ListApisRequest{Parent: "projects/my-project/locations/global", ShowDeleted: true})
+	if err != nil {
+		t.Fatalf("ListApis() returned error: %s", err)
+	}
+	if len(apis.GetApis()) != 1 || apis.GetApis()[0].GetDeleteTime() == nil {
+		t.Errorf("ListApis() with show_deleted returned %v, want one API with a delete_time", apis.GetApis())
+	}
+
+	specs, err := server.ListApiSpecs(ctx, &rpc.ListApiSpecsRequest{Parent: "projects/my-project/locations/global/apis/-/versions/-", ShowDeleted: true})
+	if err != nil {
+		t.Fatalf("ListApiSpecs() returned error: %s", err)
+	}
+	if len(specs.GetApiSpecs()) != 1 || specs.GetApiSpecs()[0].GetDeleteTime() == nil {
+		t.Errorf("ListApiSpecs() with show_deleted returned %v, want one spec with a delete_time", specs.GetApiSpecs())
+	}
+
+	got, err := server.UndeleteApi(ctx, &rpc.UndeleteApiRequest{Name: api})
+	if err != nil {
+		t.Fatalf("UndeleteApi() returned error: %s", err)
+	}
+	if got.GetName() != api || got.GetDeleteTime() != nil {
+		t.Errorf("UndeleteApi() returned %v, want a live API named %q", got, api)
+	}
+
+	body, err := server.GetApiSpecContents(ctx, &rpc.GetApiSpecContentsRequest{Name: spec.GetName()})
+	if err != nil {
+		t.Fatalf("GetApiSpecContents() of restored spec returned error: %s", err)
+	}
+	if string(body.GetData()) != "contents" {
+		t.Errorf("GetApiSpecContents() of restored spec returned %q, want %q", body.GetData(), "contents")
+	}
+	if _, err := server.GetArtifact(ctx, &rpc.GetArtifactRequest{Name: spec.GetName() + "/artifacts/x"}); err != nil {
+		t.Errorf("GetArtifact() of restored artifact returned error: %s", err)
+	}
+}
+
+func TestUndeleteKeepsEarlierDeletions(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	versions := []*rpc.ApiVersion{
+		{Name: "projects/my-project/locations/global/apis/a/versions/v1"},
+		{Name: "projects/my-project/locations/global/apis/a/versions/v2"},
+	}
+	if err := seeder.SeedVersions(ctx, server, versions...); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+
+	// The version was deleted on its own, so it stays deleted when the API is restored.
+	if _, err := server.DeleteApiVersion(ctx, &rpc.DeleteApiVersionRequest{Name: versions[0].GetName()}); err != nil {
+		t.Fatalf("DeleteApiVersion() returned error: %s", err)
+	}
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: "projects/my-project/locations/global/apis/a", Force: true}); err != nil {
+		t.Fatalf("DeleteApi() returned error: %s", err)
+	}
+	if _, err := server.UndeleteApi(ctx, &rpc.UndeleteApiRequest{Name: "projects/my-project/locations/global/apis/a"}); err != nil {
+		t.Fatalf("UndeleteApi() returned error: %s", err)
+	}
+
+	if _, err := server.GetApiVersion(ctx, &rpc.GetApiVersionRequest{Name: versions[0].GetName()}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetApiVersion(%q) returned status code %q, want %q", versions[0].GetName(), status.Code(err), codes.NotFound)
+	}
+	if _, err := server.GetApiVersion(ctx, &rpc.GetApiVersionRequest{Name: versions[1].GetName()}); err != nil {
+		t.Errorf("GetApiVersion(%q) returned error: %s", versions[1].GetName(), err)
+	}
+}
+
+func TestUndeleteResponseCodes(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	if err := seeder.SeedVersions(ctx, server,
+		&rpc.ApiVersion{Name: "projects/my-project/locations/global/apis/a/versions/v"},
+		&rpc.ApiVersion{Name: "projects/my-project/locations/global/apis/b/versions/v"},
+	); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+	if _, err := server.DeleteApiVersion(ctx, &rpc.DeleteApiVersionRequest{Name: "projects/my-project/locations/global/apis/b/versions/v"}); err != nil {
+		t.Fatalf("Setup: DeleteApiVersion() returned error: %s", err)
+	}
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: "projects/my-project/locations/global/apis/b"}); err != nil {
+		t.Fatalf("Setup: DeleteApi() returned error: %s", err)
+	}
+
+	tests := []struct {
+		desc string
+		name string
+		want codes.Code
+	}{
+		{
+			desc: "live version",
+			name: "projects/my-project/locations/global/apis/a/versions/v",
+			want: codes.AlreadyExists,
+		},
+		{
+			desc: "missing version",
+			name: "projects/my-project/locations/global/apis/a/versions/missing",
+			want: codes.NotFound,
+		},
+		{
+			desc: "deleted parent",
+			name: "projects/my-project/locations/global/apis/b/versions/v",
+			want: codes.FailedPrecondition,
+		},
+		{
+			desc: "invalid name",
+			name: "projects/my-project/locations/global/apis/a",
+			want: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			_, err := server.UndeleteApiVersion(ctx, &rpc.UndeleteApiVersionRequest{Name: test.name})
+			if status.Code(err) != test.want {
+				t.Errorf("UndeleteApiVersion(%q) returned status code %q, want %q: %v", test.name, status.Code(err), test.want, err)
+			}
+		})
+	}
+}
+
+func TestRecreateDeletedResource(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	project := &rpc.Project{Name: "projects/my-project", DisplayName: "first"}
+	if err := seeder.SeedProjects(ctx, server, project); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+	if _, err := server.DeleteProject(ctx, &rpc.DeleteProjectRequest{Name: project.GetName()}); err != nil {
+		t.Fatalf("DeleteProject() returned error: %s", err)
+	}
+
+	got, err := server.CreateProject(ctx, &rpc.CreateProjectRequest{
+		ProjectId: "my-project",
+		Project:   &rpc.Project{DisplayName: "second"},
+	})
+	if err != nil {
+		t.Fatalf("CreateProject() over a deleted project returned error: %s", err)
+	}
+	if got.GetDisplayName() != "second" || got.GetDeleteTime() != nil {
+		t.Errorf("CreateProject() returned %v, want a live project named %q", got, "second")
+	}
+
+	if _, err := server.UndeleteProject(ctx, &rpc.UndeleteProjectRequest{Name: project.GetName()}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("UndeleteProject() of a recreated project returned status code %q, want %q", status.Code(err), codes.AlreadyExists)
+	}
+}
+
+func TestPurgeDeletedResources(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	artifact := &rpc.Artifact{
+		Name:     "projects/my-project/locations/global/artifacts/x",
+		Contents: []byte("contents"),
+	}
+	if err := seeder.SeedArtifacts(ctx, server, artifact); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+	if _, err := server.DeleteArtifact(ctx, &rpc.DeleteArtifactRequest{Name: artifact.GetName()}); err != nil {
+		t.Fatalf("DeleteArtifact() returned error: %s", err)
+	}
+
+	// Resources deleted within the retention period are kept.
+	count, err := server.db.PurgeDeletedResources(ctx, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("PurgeDeletedResources() returned error: %s", err)
+	}
+	if count != 0 {
+		t.Errorf("PurgeDeletedResources() removed %d rows deleted within the retention period, want 0", count)
+	}
+
+	purgeDeleted(ctx, t, server)
+	if got := blobCount(ctx, t, server); got != 0 {
+		t.Errorf("Registry stores %d blobs after purging, want 0", got)
+	}
+	if _, err := server.UndeleteArtifact(ctx, &rpc.UndeleteArtifactRequest{Name: artifact.GetName()}); status.Code(err) != codes.NotFound {
+		t.Errorf("UndeleteArtifact() of a purged artifact returned status code %q, want %q", status.Code(err), codes.NotFound)
+	}
+}
@@ -16,7 +16,6 @@ package registry
 
 import (
 	"context"
-	"sync"
 
 	"github.com/apigee/registry/rpc"
 	"github.com/apigee/registry/server/registry/internal/storage"
@@ -42,12 +41,19 @@ func (s *RegistryServer) CreateApiVersion(ctx context.Context, req *rpc.CreateAp
 }
 
 func (s *RegistryServer) createApiVersion(ctx context.Context, name names.Version, body *rpc.ApiVersion) (*rpc.ApiVersion, error) {
-	db, err := s.getStorageClient(ctx)
-	if err != nil {
-		return nil, status.Error(codes.Unavailable, err.Error())
+	var message *rpc.ApiVersion
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) (err error) {
+		message, err = s.insertApiVersion(ctx, db, name, body)
+		return err
+	}); err != nil {
+		return nil, err
 	}
-	defer db.Close()
 
+	return message, nil
+}
+
+// insertApiVersion creates an API version using a client for a transaction that may have other changes.
+func (s *RegistryServer) insertApiVersion(ctx context.Context, db *storage.Client, name names.Version, body *rpc.ApiVersion) (*rpc.ApiVersion, error) {
 	if _, err := db.GetVersion(ctx, name); err == nil {
 		return nil, status.Errorf(codes.AlreadyExists, "API version %q already exists", name)
 	} else if !isNotFound(err) {
@@ -68,16 +74,18 @@ func (s *RegistryServer) createApiVersion(ctx context.Context, name names.Versio
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if err := db.SaveVersion(ctx, version); err != nil {
-		return nil, err
-	}
-
 	message, err := version.Message()
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	s.notify(ctx, rpc.Notification_CREATED, name.String())
+	if err := db.SaveVersion(ctx, version); err != nil {
+		return nil, err
+	}
+	if err := s.notify(ctx, db, rpc.Notification_CREATED, name.String(), withSnapshot(message)); err != nil {
+		return nil, err
+	}
+
 	return message, nil
 }
 
@@ -87,7 +95,6 @@ func (s *RegistryServer) DeleteApiVersion(ctx context.Context, req *rpc.DeleteAp
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	name, err := names.ParseVersion(req.GetName())
 	if err != nil {
@@ -99,21 +106,58 @@ func (s *RegistryServer) DeleteApiVersion(ctx context.Context, req *rpc.DeleteAp
 		return nil, err
 	}
 
-	if err := db.DeleteVersion(ctx, name, req.GetForce()); err != nil {
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := checkETag(name.String(), req.GetEtag(), func() (etagged, error) {
+			return db.ForUpdate().GetVersion(ctx, name)
+		}); err != nil {
+			return err
+		}
+		if err := db.DeleteVersion(ctx, name, req.GetForce()); err != nil {
+			return err
+		}
+		return s.notify(ctx, db, rpc.Notification_DELETED, name.String())
+	}); err != nil {
 		return nil, err
 	}
 
-	s.notify(ctx, rpc.Notification_DELETED, name.String())
 	return &emptypb.Empty{}, nil
 }
 
+// UndeleteApiVersion handles the corresponding API request.
+func (s *RegistryServer) UndeleteApiVersion(ctx context.Context, req *rpc.UndeleteApiVersionRequest) (*rpc.ApiVersion, error) {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	name, err := names.ParseVersion(req.GetName())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Restoration should only succeed when the parent exists.
+	if _, err := db.GetApi(ctx, name.Api()); err != nil {
+		return nil, undeleteParentError(err, name.String())
+	}
+
+	if err := s.transaction(ctx, func(ctx context.Context, db *storage.Client) error {
+		if err := db.UndeleteVersion(ctx, name); err != nil {
+			return err
+		}
+		return s.notify(ctx, db, rpc.Notification_CREATED, name.String())
+	}); err != nil {
+		return nil, err
+	}
+
+	return s.GetApiVersion(ctx, &rpc.GetApiVersionRequest{Name: name.String()})
+}
+
 // GetApiVersion handles the corresponding API request.
 func (s *RegistryServer) GetApiVersion(ctx context.Context, req *rpc.GetApiVersionRequest) (*rpc.ApiVersion, error) {
 	db, err := s.getStorageClient(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	name, err := names.ParseVersion(req.GetName())
 	if err != nil {
@@ -139,7 +183,6 @@ func (s *RegistryServer) ListApiVersions(ctx context.Context, req *rpc.ListApiVe
 	if err != nil {
 		return nil, status.Error(codes.Unavailable, err.Error())
 	}
-	defer db.Close()
 
 	if req.GetPageSize() < 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid page_size %d: must not be negative", req.GetPageSize())
@@ -155,9 +198,11 @@ func (s *RegistryServer) ListApiVersions(ctx context.Context, req *rpc.ListApiVe
 	}
 
 	listing, err := db.ListVersions(ctx, parent, storage.PageOptions{
-		Size:   req.GetPageSize(),
-		Filter: req.GetFilter(),
-		Token:  req.GetPageToken(),
+		Size:        req.GetPageSize(),
+		Filter:      req.GetFilter(),
+		Order:       req.GetOrderBy(),
+		Token:       req.GetPageToken(),
+		ShowDeleted: req.GetShowDeleted(),
 	})
 	if err != nil {
 		return nil, err
@@ -178,16 +223,8 @@ func (s *RegistryServer) ListApiVersions(ctx context.Context, req *rpc.ListApiVe
 	return response, nil
 }
 
-var updateVersionMutex sync.Mutex
-
 // UpdateApiVersion handles the corresponding API request.
 func (s *RegistryServer) UpdateApiVersion(ctx context.Context, req *rpc.UpdateApiVersionRequest) (*rpc.ApiVersion, error) {
-	db, err := s.getStorageClient(ctx)
-	if err != nil {
-		return nil, status.Error(codes.Unavailable, err.Error())
-	}
-	defer db.Close()
-
 	if req.GetApiVersion() == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid api_version %+v: body must be provided", req.GetApiVersion())
 	} else if err := models.ValidateMask(req.GetApiVersion(), req.GetUpdateMask()); err != nil {
@@ -199,37 +236,39 @@ func (s *RegistryServer) UpdateApiVersion(ctx context.Context, req *rpc.UpdateAp
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if req.GetAllowMissing() {
-		// Prevent a race condition that can occur when two updates are made
-		// to the same non-existent resource. The db.Get...() call returns
-		// NotFound for both updates, and after one creates the resource,
-		// the other creation fails. The lock() prevents this by serializing
-		// the get and create operations. Future updates could improve this
-		// with improvements closer to the database level.
-		updateVersionMutex.Lock()
-		defer updateVersionMutex.Unlock()
-	}
+	var message *rpc.ApiVersion
+	if err := s.upsert(ctx, func(ctx context.Context, db *storage.Client) error {
+		version, err := db.ForUpdate().GetVersion(ctx, name)
+		if req.GetAllowMissing() && isNotFound(err) && req.GetApiVersion().GetEtag() == "" {
+			message, err = s.insertApiVersion(ctx, db, name, req.GetApiVersion())
+			return err
+		} else if err != nil {
+			return err
+		}
 
-	version, err := db.GetVersion(ctx, name)
-	if req.GetAllowMissing() && isNotFound(err) {
-		return s.createApiVersion(ctx, name, req.GetApiVersion())
-	} else if err != nil {
-		return nil, err
-	}
+		if err := checkETag(name.String(), req.GetApiVersion().GetEtag(), func() (etagged, error) {
+			return version, nil
+		}); err != nil {
+			return err
+		}
 
-	if err := version.Update(req.GetApiVersion(), models.ExpandMask(req.GetApiVersion(), req.GetUpdateMask())); err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
+		mask := models.ExpandMask(req.GetApiVersion(), req.GetUpdateMask())
+		if err := version.Update(req.GetApiVersion(), mask); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
 
-	if err := db.SaveVersion(ctx, version); err != nil {
-		return nil, err
-	}
+		message, err = version.Message()
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
 
-	message, err := version.Message()
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		if err := db.SaveVersion(ctx, version); err != nil {
+			return err
+		}
+		return s.notify(ctx, db, rpc.Notification_UPDATED, name.String(), withSnapshot(message), withUpdateMask(mask))
+	}); err != nil {
+		return nil, err
 	}
 
-	s.notify(ctx, rpc.Notification_UPDATED, name.String())
 	return message, nil
 }
@@ -86,7 +86,7 @@ func TestCreateApiVersion(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.ApiVersion), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.ApiVersion), "create_time", "update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, created, opts) {
@@ -330,7 +330,7 @@ func TestGetApiVersion(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.ApiVersion), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.ApiVersion), "create_time", "update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, got, opts) {
@@ -536,7 +536,7 @@ func TestListApiVersions(t *testing.T) {
 			opts := cmp.Options{
 				protocmp.Transform(),
 				protocmp.IgnoreFields(new(rpc.ListApiVersionsResponse), "next_page_token"),
-				protocmp.IgnoreFields(new(rpc.ApiVersion), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.ApiVersion), "create_time", "update_time", "etag"),
 				protocmp.SortRepeated(func(a, b *rpc.ApiVersion) bool {
 					return a.GetName() < b.GetName()
 				}),
@@ -710,7 +710,7 @@ func TestListApiVersionsSequence(t *testing.T) {
 
 	opts := cmp.Options{
 		protocmp.Transform(),
-		protocmp.IgnoreFields(new(rpc.ApiVersion), "create_time", "update_time"),
+		protocmp.IgnoreFields(new(rpc.ApiVersion), "create_time", "update_time", "etag"),
 		cmpopts.SortSlices(func(a, b *rpc.ApiVersion) bool {
 			return a.GetName() < b.GetName()
 		}),
@@ -895,7 +895,7 @@ func TestUpdateApiVersion(t *testing.T) {
 
 			opts := cmp.Options{
 				protocmp.Transform(),
-				protocmp.IgnoreFields(new(rpc.ApiVersion), "create_time", "update_time"),
+				protocmp.IgnoreFields(new(rpc.ApiVersion), "create_time", "update_time", "etag"),
 			}
 
 			if !cmp.Equal(test.want, updated, opts) {
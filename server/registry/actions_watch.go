@@ -0,0 +1,154 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"errors"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage/filtering"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var notificationFields = []filtering.Field{
+	{Name: "change", Type: filtering.String},
+	{Name: "resource", Type: filtering.String},
+	{Name: "change_time", Type: filtering.Timestamp},
+}
+
+// WatchResources handles the corresponding API request.
+func (s *RegistryServer) WatchResources(req *rpc.WatchResourcesRequest, stream rpc.Registry_WatchResourcesServer) error {
+	pattern, err := parseResourcePattern(req.GetPattern())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	filter, err := filtering.NewFilter(req.GetFilter(), notificationFields)
+	if err != nil {
+		return err
+	}
+
+	var after int64
+	if req.GetResumeToken() != "" {
+		if after, err = parseResumeToken(req.GetResumeToken()); err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	// The watcher is added before the backlog is read, so changes recorded in between aren't missed.
+	w, err := s.watches.watch()
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	defer s.watches.unwatch(w)
+
+	var backlog []*rpc.Notification
+	if after > 0 {
+		if backlog, err = s.watchBacklog(stream.Context(), after); err != nil {
+			return err
+		}
+	}
+
+	send := func(n *rpc.Notification) error {
+		if !pattern.Matches(n.GetResource()) {
+			return nil
+		}
+
+		match, err := filter.Matches(map[string]interface{}{
+			"change":      n.GetChange().String(),
+			"resource":    n.GetResource(),
+			"change_time": n.GetChangeTime().AsTime(),
+		})
+		if err != nil {
+			return err
+		} else if !match {
+			return nil
+		}
+
+		return stream.Send(n)
+	}
+
+	// Notifications that are in the backlog and also published to the watcher are only sent once.
+	sent := make(map[string]bool, len(backlog))
+	for _, n := range backlog {
+		sent[n.GetResumeToken()] = true
+		if err := send(n); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case n := <-w.notifications:
+			if sent[n.GetResumeToken()] {
+				delete(sent, n.GetResumeToken())
+				continue
+			}
+			if err := send(n); err != nil {
+				return err
+			}
+		case <-w.done:
+			if errors.Is(w.err, errWatchesStopped) {
+				return status.Error(codes.Unavailable, w.err.Error())
+			}
+			// Clients can resume from the last notification they received.
+			return status.Error(codes.Aborted, w.err.Error())
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// watchBacklog returns the notifications of the events that were recorded after the event with a given ID.
+// Events are read from the database, so watchers can resume on any server that shares it.
+func (s *RegistryServer) watchBacklog(ctx context.Context, after int64) ([]*rpc.Notification, error) {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	// The event of the resume token is read too, to check that it hasn't been purged.
+	events, err := db.EventsAfter(ctx, after-1, nil, watchBacklogLimit+2)
+	if err != nil {
+		return nil, err
+	}
+
+	token := resumeToken(after)
+	if len(events) == 0 || events[0].ID != after {
+		last, err := db.LastEventID(ctx)
+		if err != nil {
+			return nil, err
+		} else if after > last {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid resume token %q", token)
+		}
+		return nil, status.Errorf(codes.OutOfRange, "invalid resume token %q: %s", token, errResumeTokenExpired)
+	} else if len(events) > watchBacklogLimit+1 {
+		return nil, status.Errorf(codes.OutOfRange, "invalid resume token %q: more than %d notifications followed it", token, watchBacklogLimit)
+	}
+
+	backlog := make([]*rpc.Notification, 0, len(events)-1)
+	for _, e := range events[1:] {
+		n, err := e.NotificationMessage()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		n.ResumeToken = resumeToken(e.ID)
+		backlog = append(backlog, n)
+	}
+
+	return backlog, nil
+}
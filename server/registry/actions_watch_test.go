@@ -0,0 +1,292 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/test/seeder"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+// watchStream collects the notifications sent by WatchResources.
+type watchStream struct {
+	grpc.ServerStream
+	ctx context.Context
+
+	mu            sync.Mutex
+	notifications []*rpc.Notification
+}
+
+func (s *watchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchStream) Send(n *rpc.Notification) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.notifications = append(s.notifications, n)
+	return nil
+}
+
+// wait returns the notifications received once there are at least n of them.
+func (s *watchStream) wait(t *testing.T, n int) []*rpc.Notification {
+	t.Helper()
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+		s.mu.Lock()
+		if len(s.notifications) >= n {
+			defer s.mu.Unlock()
+			return s.notifications
+		}
+		s.mu.Unlock()
+	}
+	t.Fatalf("Timed out waiting for %d notifications", n)
+	return nil
+}
+
+// watch starts WatchResources and returns once the watch is established.
+func watch(t *testing.T, server *RegistryServer, req *rpc.WatchResourcesRequest) *watchStream {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &watchStream{ctx: ctx}
+
+	done := make(chan error, 1)
+	go func() { done <- server.WatchResources(req, stream) }()
+	t.Cleanup(func() {
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("WatchResources(%+v) returned error: %s", req, err)
+		}
+	})
+
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+		server.watches.mu.Lock()
+		count := len(server.watches.watchers)
+		server.watches.mu.Unlock()
+		if count > 0 {
+			return stream
+		}
+	}
+	t.Fatalf("Timed out waiting for WatchResources(%+v) to start", req)
+	return nil
+}
+
+func TestWatchResources(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	stream := watch(t, server, &rpc.WatchResourcesRequest{
+		Pattern: "projects/my-project/locations/global/apis/-",
+		Filter:  "change != 'UPDATED'",
+	})
+
+	if err := seeder.SeedVersions(ctx, server, &rpc.ApiVersion{Name: "projects/my-project/locations/global/apis/a/versions/v"}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+	if _, err := server.UpdateApi(ctx, &rpc.UpdateApiRequest{Api: &rpc.Api{Name: "projects/my-project/locations/global/apis/a"}}); err != nil {
+		t.Fatalf("Setup: UpdateApi() returned error: %s", err)
+	}
+	if _, err := server.DeleteApi(ctx, &rpc.DeleteApiRequest{Name: "projects/my-project/locations/global/apis/a", Force: true}); err != nil {
+		t.Fatalf("Setup: DeleteApi() returned error: %s", err)
+	}
+
+	want := []*rpc.Notification{
+		{Change: rpc.Notification_CREATED, Resource: "projects/my-project/locations/global/apis/a"},
+		{Change: rpc.Notification_DELETED, Resource: "projects/my-project/locations/global/apis/a"},
+	}
+	got := stream.wait(t, len(want))
+	opts := cmp.Options{
+		protocmp.Transform(),
+		protocmp.IgnoreFields(new(rpc.Notification), "change_time", "resume_token", "snapshot"),
+	}
+	if diff := cmp.Diff(want, got, opts); diff != "" {
+		t.Errorf("WatchResources() sent unexpected diff (-want +got):\n%s", diff)
+	}
+
+	// Resuming from the first notification sends the notifications that followed it.
+	resumed := watch(t, server, &rpc.WatchResourcesRequest{
+		Pattern:     "projects/my-project/locations/global/apis/-",
+		Filter:      "change != 'UPDATED'",
+		ResumeToken: got[0].GetResumeToken(),
+	})
+	if diff := cmp.Diff(want[1:], resumed.wait(t, 1), opts); diff != "" {
+		t.Errorf("WatchResources() sent unexpected diff after resuming (-want +got):\n%s", diff)
+	}
+}
+
+func TestWatchResourcesResponseCodes(t *testing.T) {
+	tests := []struct {
+		desc string
+		req  *rpc.WatchResourcesRequest
+		want codes.Code
+	}{
+		{
+			desc: "missing pattern",
+			req:  &rpc.WatchResourcesRequest{},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "invalid pattern",
+			req: &rpc.WatchResourcesRequest{
+				Pattern: "projects/my-project/locations/global/invalid/-",
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "invalid filter",
+			req: &rpc.WatchResourcesRequest{
+				Pattern: "projects/-",
+				Filter:  "this filter is not valid",
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "invalid resume token",
+			req: &rpc.WatchResourcesRequest{
+				Pattern:     "projects/-",
+				ResumeToken: "this token is not valid",
+			},
+			want: codes.InvalidArgument,
+		},
+		{
+			desc: "resume token of an event that wasn't recorded",
+			req: &rpc.WatchResourcesRequest{
+				Pattern:     "projects/-",
+				ResumeToken: "1000",
+			},
+			want: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			server := defaultTestServer(t)
+			stream := &watchStream{ctx: context.Background()}
+			if err := server.WatchResources(test.req, stream); status.Code(err) != test.want {
+				t.Errorf("WatchResources(%+v) returned status code %q, want %q: %v", test.req, status.Code(err), test.want, err)
+			}
+		})
+	}
+}
+
+func TestWatchResourcesExpiredResumeToken(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+	for _, id := range []string{"p0", "p1"} {
+		if _, err := server.CreateProject(ctx, &rpc.CreateProjectRequest{ProjectId: id, Project: &rpc.Project{}}); err != nil {
+			t.Fatalf("Setup: CreateProject(%q) returned error: %s", id, err)
+		}
+	}
+
+	// Resume tokens can't be used once their events are purged.
+	if err := server.db.DeliverEvents(ctx, []int64{1}, time.Now()); err != nil {
+		t.Fatalf("Setup: DeliverEvents() returned error: %s", err)
+	}
+	if _, err := server.db.PurgeEvents(ctx, time.Now().Add(time.Minute)); err != nil {
+		t.Fatalf("Setup: PurgeEvents() returned error: %s", err)
+	}
+
+	req := &rpc.WatchResourcesRequest{Pattern: "projects/-", ResumeToken: "1"}
+	stream := &watchStream{ctx: ctx}
+	if err := server.WatchResources(req, stream); status.Code(err) != codes.OutOfRange {
+		t.Errorf("WatchResources(%+v) returned status code %q, want %q: %v", req, status.Code(err), codes.OutOfRange, err)
+	}
+}
+
+func TestResourcePattern(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		resource string
+		want     bool
+	}{
+		{"projects/-", "projects/p", true},
+		{"projects/p/locations/global/apis/-", "projects/p/locations/global/apis/a", true},
+		{"projects/p/locations/global/apis/-", "projects/q/locations/global/apis/a", false},
+		{"projects/p/locations/global/apis/-", "projects/p/locations/global/apis/a/versions/v", false},
+		{"projects/-/locations/global/apis/-/versions/-/specs/s", "projects/p/locations/global/apis/a/versions/v/specs/s@1234", true},
+		{"projects/p/locations/global/apis/a/versions/v/specs/s@1234", "projects/p/locations/global/apis/a/versions/v/specs/s@5678", false},
+		{"projects/p/locations/global/artifacts/-", "projects/P/locations/global/artifacts/x", true},
+	}
+
+	for _, test := range tests {
+		p, err := parseResourcePattern(test.pattern)
+		if err != nil {
+			t.Fatalf("parseResourcePattern(%q) returned error: %s", test.pattern, err)
+		}
+		if got := p.Matches(test.resource); got != test.want {
+			t.Errorf("parseResourcePattern(%q).Matches(%q) returned %t, want %t", test.pattern, test.resource, got, test.want)
+		}
+	}
+}
+
+func TestWatchResourcesAcrossServers(t *testing.T) {
+	ctx := context.Background()
+	db := fmt.Sprintf("%s/registry.db", t.TempDir())
+
+	// Watchers of a server are sent the changes made by other servers that share its database.
+	var servers []*RegistryServer
+	for i := 0; i < 2; i++ {
+		server, err := New(Config{
+			Database:       "sqlite3",
+			DBConfig:       db,
+			OutboxInterval: 10 * time.Millisecond,
+		})
+		if err != nil {
+			t.Fatalf("Setup: failed to create server: %s", err)
+		}
+		t.Cleanup(server.Close)
+		servers = append(servers, server)
+	}
+	streams := []*watchStream{
+		watch(t, servers[0], &rpc.WatchResourcesRequest{Pattern: "projects/-"}),
+		watch(t, servers[1], &rpc.WatchResourcesRequest{Pattern: "projects/-"}),
+	}
+
+	for i, server := range servers {
+		req := &rpc.CreateProjectRequest{ProjectId: fmt.Sprintf("p%d", i), Project: &rpc.Project{}}
+		if _, err := server.CreateProject(ctx, req); err != nil {
+			t.Fatalf("Setup: CreateProject(%q) returned error: %s", req.GetProjectId(), err)
+		}
+	}
+
+	want := []*rpc.Notification{
+		{Change: rpc.Notification_CREATED, Resource: "projects/p0"},
+		{Change: rpc.Notification_CREATED, Resource: "projects/p1"},
+	}
+	opts := cmp.Options{
+		protocmp.Transform(),
+		protocmp.IgnoreFields(new(rpc.Notification), "change_time", "resume_token", "snapshot"),
+	}
+	for i, stream := range streams {
+		if diff := cmp.Diff(want, stream.wait(t, len(want)), opts); diff != "" {
+			t.Errorf("WatchResources() of server %d sent unexpected diff (-want +got):\n%s", i, diff)
+		}
+	}
+
+	// Watchers can resume on another server from a notification sent by one server.
+	token := streams[0].wait(t, len(want))[0].GetResumeToken()
+	resumed := watch(t, servers[1], &rpc.WatchResourcesRequest{Pattern: "projects/-", ResumeToken: token})
+	if diff := cmp.Diff(want[1:], resumed.wait(t, 1), opts); diff != "" {
+		t.Errorf("WatchResources() sent unexpected diff after resuming on another server (-want +got):\n%s", diff)
+	}
+}
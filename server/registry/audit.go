@@ -0,0 +1,271 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/apigee/registry/log"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// auditedMethod returns true for the methods of the Registry and Admin services that can change the registry.
+func auditedMethod(fullMethod string) bool {
+	var method string
+	switch {
+	case strings.HasPrefix(fullMethod, registryService):
+		method = strings.TrimPrefix(fullMethod, registryService)
+	case strings.HasPrefix(fullMethod, adminService):
+		method = strings.TrimPrefix(fullMethod, adminService)
+	default:
+		return false
+	}
+
+	for _, prefix := range readPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// UnaryAuditor returns a gRPC server interceptor that records an audit event for every call that can change the registry,
+// whether or not the call succeeds. It should follow the call logger, so that events include the IDs of requests in call logs,
+// and precede the authorizer, so that calls that are denied are also recorded.
+func (s *RegistryServer) UnaryAuditor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !auditedMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		resp, err := handler(ctx, req)
+		s.audit(ctx, info.FullMethod, req, resp, err)
+		return resp, err
+	}
+}
+
+// StreamAuditor returns a gRPC server interceptor that records an audit event for every streaming call that can change
+// the registry, like the uploads of contents. Events name the resource of the first message of the stream.
+// It should precede the authorizer, so that calls that are denied are also recorded.
+func (s *RegistryServer) StreamAuditor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !auditedMethod(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		stream := &auditedStream{ServerStream: ss}
+		err := handler(srv, stream)
+		s.audit(ss.Context(), info.FullMethod, stream.req, stream.resp, err)
+		return err
+	}
+}
+
+// auditedStream keeps the first message received from and the last message sent to the caller of a stream.
+type auditedStream struct {
+	grpc.ServerStream
+	req, resp interface{}
+}
+
+func (s *auditedStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.req == nil {
+		s.req = m
+	}
+	return err
+}
+
+func (s *auditedStream) SendMsg(m interface{}) error {
+	s.resp = m
+	return s.ServerStream.SendMsg(m)
+}
+
+// audit records the audit events of a call. Failures are logged, because the call has already been handled.
+func (s *RegistryServer) audit(ctx context.Context, fullMethod string, req, resp interface{}, err error) {
+	events := []*rpc.AuditEvent{s.auditEvent(ctx, fullMethod, req, resp, err)}
+	if m, ok := req.(proto.Message); ok {
+		if items, ok := batchItems(m, resp, err); ok {
+			// Requests in a batch are recorded separately, so each resource has its own event and outcome.
+			// Resources of requests that don't name their parents are in the parent of the batch.
+			events = make([]*rpc.AuditEvent, len(items))
+			for i, item := range items {
+				events[i] = s.auditEvent(ctx, fullMethod, item.req, item.resp, item.err)
+				if events[i].Resource == "" {
+					events[i].Resource = requestResource(m.ProtoReflect())
+				}
+			}
+		}
+	}
+
+	// Events are recorded even if the caller has stopped waiting for the response.
+	db, dbErr := s.getStorageClient(context.Background())
+	for _, event := range events {
+		if dbErr == nil {
+			dbErr = db.SaveAuditEvent(ctx, models.NewAuditEvent(event))
+		}
+		if dbErr != nil {
+			log.FromContext(ctx).WithError(dbErr).Errorf("Failed to record audit event for %s of %q.", event.Method, event.Resource)
+			dbErr = nil
+		}
+	}
+}
+
+// auditEvent returns the audit event of a call, or of a request in a batch.
+func (s *RegistryServer) auditEvent(ctx context.Context, fullMethod string, req, resp interface{}, err error) *rpc.AuditEvent {
+	event := &rpc.AuditEvent{
+		Time:       timestamppb.Now(),
+		Principal:  s.principal(ctx),
+		Method:     filepath.Base(fullMethod),
+		RequestId:  log.RequestID(ctx),
+		StatusCode: status.Code(err).String(),
+	}
+	if err != nil {
+		event.ErrorMessage = status.Convert(err).Message()
+	}
+	if r, ok := resp.(resourceResponse); ok && err == nil && r.GetName() != "" {
+		event.Resource = r.GetName()
+	}
+	if m, ok := req.(proto.Message); ok {
+		if event.Resource == "" {
+			event.Resource = requestResource(m.ProtoReflect())
+		}
+		event.Fields = requestFields(m.ProtoReflect())
+	}
+	return event
+}
+
+// batchItem is a request in a batch and its outcome.
+type batchItem struct {
+	req, resp interface{}
+	err       error
+}
+
+// batchItems returns the requests of a batch with the results that the response reports for them,
+// or false if the request isn't a batch. If the whole batch failed, every request has the error of the batch.
+func batchItems(req proto.Message, resp interface{}, err error) ([]batchItem, bool) {
+	m := req.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName("requests")
+	if fd == nil || !fd.IsList() || fd.Kind() != protoreflect.MessageKind {
+		return nil, false
+	}
+
+	// Results are either resources with statuses or, for deletions, only statuses.
+	var results protoreflect.List
+	if r, ok := resp.(proto.Message); ok && err == nil {
+		rm := r.ProtoReflect()
+		for _, name := range []protoreflect.Name{"results", "statuses"} {
+			if rfd := rm.Descriptor().Fields().ByName(name); rfd != nil && rfd.IsList() && rfd.Kind() == protoreflect.MessageKind {
+				results = rm.Get(rfd).List()
+			}
+		}
+	}
+
+	requests := m.Get(fd).List()
+	items := make([]batchItem, requests.Len())
+	for i := range items {
+		items[i] = batchItem{req: requests.Get(i).Message().Interface(), err: err}
+		if results == nil || i >= results.Len() {
+			continue
+		}
+
+		result := results.Get(i).Message()
+		if st, ok := result.Interface().(*spb.Status); ok {
+			items[i].err = status.ErrorProto(st)
+			continue
+		}
+		result.Range(func(f protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			if f.Kind() != protoreflect.MessageKind || f.IsList() || f.IsMap() {
+				return true
+			}
+			if st, ok := v.Message().Interface().(*spb.Status); ok {
+				items[i].err = status.ErrorProto(st)
+			} else {
+				items[i].resp = v.Message().Interface()
+			}
+			return true
+		})
+	}
+	return items, true
+}
+
+// resourceResponse is a response that names the resource that was changed.
+type resourceResponse interface{ GetName() string }
+
+// requestResource returns the name of the resource of a request, which is the first of its name, parent or project fields
+// that is set, either in the request or in the resource that it holds.
+func requestResource(m protoreflect.Message) string {
+	for _, field := range []protoreflect.Name{"name", "parent", "project"} {
+		if v := stringField(m, field); v != "" {
+			return v
+		}
+
+		fields := m.Descriptor().Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() || !m.Has(fd) {
+				continue
+			}
+			if v := stringField(m.Get(fd).Message(), field); v != "" {
+				return v
+			}
+		}
+	}
+	return ""
+}
+
+func stringField(m protoreflect.Message, name protoreflect.Name) string {
+	fd := m.Descriptor().Fields().ByName(name)
+	if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+		return ""
+	}
+	return m.Get(fd).String()
+}
+
+// requestFields returns the paths in the update mask of a request or, if it has none,
+// the fields that are set in the resource that it holds.
+func requestFields(m protoreflect.Message) []string {
+	fields := m.Descriptor().Fields()
+	if fd := fields.ByName("update_mask"); fd != nil && m.Has(fd) {
+		if mask, ok := m.Get(fd).Message().Interface().(*fieldmaskpb.FieldMask); ok && len(mask.GetPaths()) > 0 {
+			return mask.GetPaths()
+		}
+	}
+
+	var set []string
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() || fd.IsMap() || fd.Name() == "update_mask" || !m.Has(fd) {
+			continue
+		}
+		m.Get(fd).Message().Range(func(f protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+			if f.Name() != "name" {
+				set = append(set, string(f.Name()))
+			}
+			return true
+		})
+	}
+	sort.Strings(set)
+	return set
+}
@@ -0,0 +1,177 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package authenticator verifies the credentials of requests to the registry server.
+package authenticator
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/apigee/registry/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// apiKeyHeader is the request metadata key of API keys that aren't sent as bearer tokens.
+const apiKeyHeader = "x-goog-api-key"
+
+// Options configures an authenticator.
+type Options struct {
+	// Issuer is the issuer that bearer tokens must be issued by.
+	// It is required if JWKSFile is set.
+	Issuer string
+	// Audience is an audience that bearer tokens must be intended for.
+	// If unset, the audience of tokens isn't checked.
+	Audience string
+	// JWKSFile is the path of a JSON Web Key Set holding the keys that bearer tokens are signed with.
+	// If unset, only API keys are accepted.
+	JWKSFile string
+	// APIKeys maps static API keys to the subjects that they authenticate.
+	// API keys can be sent as bearer tokens or in the "x-goog-api-key" header.
+	APIKeys map[string]string
+}
+
+// Authenticator verifies bearer tokens and API keys, and identifies the subjects that they were issued to.
+type Authenticator struct {
+	opts Options
+	keys []jwk
+	now  func() time.Time
+}
+
+// New returns an authenticator that accepts the credentials described by its options.
+func New(opts Options) (*Authenticator, error) {
+	a := &Authenticator{opts: opts, now: time.Now}
+	if opts.JWKSFile == "" && len(opts.APIKeys) == 0 {
+		return nil, errors.New("a JWKS file or API keys are required")
+	}
+
+	for key, subject := range opts.APIKeys {
+		if key == "" || subject == "" {
+			return nil, errors.New("API keys and their subjects must not be empty")
+		}
+	}
+
+	if opts.JWKSFile != "" {
+		if opts.Issuer == "" {
+			return nil, errors.New("an issuer is required to verify tokens")
+		}
+		b, err := ioutil.ReadFile(opts.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		a.keys, err = parseJWKS(b)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %s", opts.JWKSFile, err)
+		}
+	}
+
+	return a, nil
+}
+
+// Authenticate returns the subject identified by the credentials of an incoming request.
+// It fails with UNAUTHENTICATED if the request has no credentials or they aren't accepted.
+func (a *Authenticator) Authenticate(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if keys := md.Get(apiKeyHeader); len(keys) > 0 {
+		if subject, ok := a.apiKeySubject(keys[0]); ok {
+			return subject, nil
+		}
+		return "", status.Error(codes.Unauthenticated, "invalid API key")
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", status.Error(codes.Unauthenticated, "request has no credentials")
+	}
+	scheme, token := splitAuthorization(values[0])
+	if !strings.EqualFold(scheme, "bearer") || token == "" {
+		return "", status.Error(codes.Unauthenticated, "authorization must be a bearer token")
+	}
+
+	if subject, ok := a.apiKeySubject(token); ok {
+		return subject, nil
+	}
+	if len(a.keys) == 0 {
+		return "", status.Error(codes.Unauthenticated, "invalid API key")
+	}
+
+	c, err := verifyToken(token, a.keys, a.opts.Issuer, a.opts.Audience, a.now())
+	if err != nil {
+		return "", status.Errorf(codes.Unauthenticated, "invalid bearer token: %s", err)
+	}
+	return c.Subject, nil
+}
+
+// apiKeySubject returns the subject of an API key. Keys are compared in constant time.
+func (a *Authenticator) apiKeySubject(key string) (string, bool) {
+	found := ""
+	for k, subject := range a.opts.APIKeys {
+		if subtle.ConstantTimeCompare([]byte(k), []byte(key)) == 1 {
+			found = subject
+		}
+	}
+	return found, found != ""
+}
+
+func splitAuthorization(value string) (string, string) {
+	parts := strings.SplitN(strings.TrimSpace(value), " ", 2)
+	if len(parts) != 2 {
+		return parts[0], ""
+	}
+	return parts[0], strings.TrimSpace(parts[1])
+}
+
+// UnaryInterceptor returns a gRPC server interceptor that rejects requests that aren't authenticated.
+// The subjects of authenticated requests are attached to their contexts with log.NewSubjectContext.
+func (a *Authenticator) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		subject, err := a.Authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(log.NewSubjectContext(ctx, subject), req)
+	}
+}
+
+// StreamInterceptor returns a gRPC server interceptor that rejects streams that aren't authenticated.
+// The subjects of authenticated streams are attached to their contexts with log.NewSubjectContext.
+func (a *Authenticator) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		subject, err := a.Authenticate(ss.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authenticatedStream{
+			ServerStream: ss,
+			ctx:          log.NewSubjectContext(ss.Context(), subject),
+		})
+	}
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,211 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authenticator
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+
+	// Register the hashes used by the supported algorithms.
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+)
+
+// leeway is the clock skew allowed when the validity period of a token is checked.
+const leeway = time.Minute
+
+// header holds the members of a token's JOSE header that are used to verify it.
+type header struct {
+	Algorithm string   `json:"alg"`
+	KeyID     string   `json:"kid"`
+	Critical  []string `json:"crit"`
+}
+
+// claims holds the registered claims of a token that are checked, as described by RFC 7519.
+type claims struct {
+	Issuer    string   `json:"iss"`
+	Subject   string   `json:"sub"`
+	Audience  audience `json:"aud"`
+	Expiry    *float64 `json:"exp"`
+	NotBefore *float64 `json:"nbf"`
+}
+
+// audience is the "aud" claim, which is either a string or an array of strings.
+type audience []string
+
+func (a *audience) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*a = audience{s}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(b, &list); err != nil {
+		return errors.New("aud must be a string or an array of strings")
+	}
+	*a = list
+	return nil
+}
+
+func (a audience) contains(s string) bool {
+	for _, v := range a {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// algorithm verifies signatures of one of the JSON Web Algorithms described by RFC 7518 and RFC 8037.
+type algorithm struct {
+	hash   crypto.Hash
+	verify func(key crypto.PublicKey, hash crypto.Hash, input, sig []byte) bool
+}
+
+var algorithms = map[string]algorithm{
+	"RS256": {crypto.SHA256, verifyPKCS1},
+	"RS384": {crypto.SHA384, verifyPKCS1},
+	"RS512": {crypto.SHA512, verifyPKCS1},
+	"PS256": {crypto.SHA256, verifyPSS},
+	"PS384": {crypto.SHA384, verifyPSS},
+	"PS512": {crypto.SHA512, verifyPSS},
+	"ES256": {crypto.SHA256, verifyECDSA(elliptic.P256())},
+	"ES384": {crypto.SHA384, verifyECDSA(elliptic.P384())},
+	"ES512": {crypto.SHA512, verifyECDSA(elliptic.P521())},
+	"EdDSA": {0, verifyEd25519},
+}
+
+func digest(hash crypto.Hash, input []byte) []byte {
+	h := hash.New()
+	h.Write(input)
+	return h.Sum(nil)
+}
+
+func verifyPKCS1(key crypto.PublicKey, hash crypto.Hash, input, sig []byte) bool {
+	pub, ok := key.(*rsa.PublicKey)
+	return ok && rsa.VerifyPKCS1v15(pub, hash, digest(hash, input), sig) == nil
+}
+
+func verifyPSS(key crypto.PublicKey, hash crypto.Hash, input, sig []byte) bool {
+	pub, ok := key.(*rsa.PublicKey)
+	opts := &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash}
+	return ok && rsa.VerifyPSS(pub, hash, digest(hash, input), sig, opts) == nil
+}
+
+// verifyECDSA verifies signatures made with keys on a curve, which are the concatenated bytes of R and S.
+func verifyECDSA(curve elliptic.Curve) func(crypto.PublicKey, crypto.Hash, []byte, []byte) bool {
+	size := (curve.Params().BitSize + 7) / 8
+	return func(key crypto.PublicKey, hash crypto.Hash, input, sig []byte) bool {
+		pub, ok := key.(*ecdsa.PublicKey)
+		if !ok || pub.Curve != curve || len(sig) != 2*size {
+			return false
+		}
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		return ecdsa.Verify(pub, digest(hash, input), r, s)
+	}
+}
+
+func verifyEd25519(key crypto.PublicKey, hash crypto.Hash, input, sig []byte) bool {
+	pub, ok := key.(ed25519.PublicKey)
+	return ok && ed25519.Verify(pub, input, sig)
+}
+
+// verifyToken returns the claims of a signed JSON Web Token if its signature was made by one of the keys
+// and its issuer, audience and validity period are accepted.
+// The audience isn't checked if it is empty.
+func verifyToken(token string, keys []jwk, issuer, aud string, now time.Time) (claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return claims{}, errors.New("token is not a signed JWT")
+	}
+
+	var h header
+	if err := decodeJSON(parts[0], &h); err != nil {
+		return claims{}, fmt.Errorf("invalid header: %s", err)
+	} else if len(h.Critical) > 0 {
+		return claims{}, fmt.Errorf("unsupported critical header parameters %q", h.Critical)
+	}
+
+	alg, ok := algorithms[h.Algorithm]
+	if !ok {
+		return claims{}, fmt.Errorf("unsupported algorithm %q", h.Algorithm)
+	}
+
+	sig, err := decodeSegment(parts[2])
+	if err != nil {
+		return claims{}, fmt.Errorf("invalid signature: %s", err)
+	}
+
+	input := []byte(parts[0] + "." + parts[1])
+	verified := false
+	for _, k := range keys {
+		if (h.KeyID != "" && k.ID != h.KeyID) || (k.Algorithm != "" && k.Algorithm != h.Algorithm) {
+			continue
+		}
+		if alg.verify(k.Key, alg.hash, input, sig) {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return claims{}, errors.New("signature doesn't match a trusted key")
+	}
+
+	var c claims
+	if err := decodeJSON(parts[1], &c); err != nil {
+		return claims{}, fmt.Errorf("invalid claims: %s", err)
+	}
+
+	switch {
+	case c.Issuer != issuer:
+		return claims{}, fmt.Errorf("untrusted issuer %q", c.Issuer)
+	case aud != "" && !c.Audience.contains(aud):
+		return claims{}, fmt.Errorf("token isn't intended for audience %q", aud)
+	case c.Expiry == nil:
+		return claims{}, errors.New("token has no expiration time")
+	case now.After(unixTime(*c.Expiry).Add(leeway)):
+		return claims{}, errors.New("token has expired")
+	case c.NotBefore != nil && now.Before(unixTime(*c.NotBefore).Add(-leeway)):
+		return claims{}, errors.New("token isn't valid yet")
+	case c.Subject == "":
+		return claims{}, errors.New("token has no subject")
+	}
+
+	return c, nil
+}
+
+func decodeJSON(segment string, v interface{}) error {
+	b, err := decodeSegment(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+func unixTime(seconds float64) time.Time {
+	sec, frac := math.Modf(seconds)
+	return time.Unix(int64(sec), int64(frac*float64(time.Second)))
+}
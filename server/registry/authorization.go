@@ -0,0 +1,379 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/apigee/registry/log"
+	"github.com/apigee/registry/rpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// defaultPrincipalHeader is the request metadata key that identifies callers if the server doesn't configure one.
+const defaultPrincipalHeader = "x-registry-principal"
+
+// authorization configures the checks made by the server's interceptors.
+type authorization struct {
+	enabled bool
+	// header identifies callers that aren't authenticated. If empty, such callers are anonymous.
+	header string
+	admins map[string]bool
+}
+
+func newAuthorization(config Config) authorization {
+	a := authorization{
+		enabled: config.Authorization,
+		admins:  make(map[string]bool, len(config.Admins)),
+	}
+	// Any caller can set a header, so it only identifies callers if a trusted proxy sets it.
+	if config.TrustPrincipalHeader {
+		a.header = strings.ToLower(config.PrincipalHeader)
+		if a.header == "" {
+			a.header = defaultPrincipalHeader
+		}
+	}
+	for _, admin := range config.Admins {
+		a.admins[admin] = true
+	}
+	return a
+}
+
+// policy describes the role that a method requires.
+type policy struct {
+	// Role is the role the caller needs in the projects of the request.
+	// It is unspecified for methods that any caller can call.
+	role rpc.RoleBinding_Role
+	// Registry is true if the role is needed in the whole registry.
+	registry bool
+	// Visible is true for listings that only return the resources that the caller can view.
+	visible bool
+}
+
+const (
+	registryService   = "/google.cloud.apigeeregistry.v1.Registry/"
+	adminService      = "/google.cloud.apigeeregistry.v1.Admin/"
+	operationsService = "/google.longrunning.Operations/"
+	reflectionService = "/grpc.reflection."
+)
+
+var (
+	anyCaller     = policy{}
+	projectViewer = policy{role: rpc.RoleBinding_VIEWER}
+	projectLister = policy{role: rpc.RoleBinding_VIEWER, visible: true}
+	projectEditor = policy{role: rpc.RoleBinding_EDITOR}
+	projectAdmin  = policy{role: rpc.RoleBinding_ADMIN}
+	registryAdmin = policy{role: rpc.RoleBinding_ADMIN, registry: true}
+)
+
+// adminPolicies are the policies of the Admin methods.
+// Callers can list projects without a role in the registry, but they only see the projects that they can view.
+var adminPolicies = map[string]policy{
+	"GetStatus":          anyCaller,
+	"GetStorage":         registryAdmin,
+	"MigrateDatabase":    registryAdmin,
+	"ListProjects":       projectLister,
+	"GetProject":         projectViewer,
+	"CreateProject":      registryAdmin,
+	"UpdateProject":      projectAdmin,
+	"DeleteProject":      projectAdmin,
+	"UndeleteProject":    projectAdmin,
+	"ListOutboxEvents":   registryAdmin,
+	"ReplayOutboxEvents": registryAdmin,
+	"ListRoleBindings":   projectAdmin,
+	"SetRoleBinding":     projectAdmin,
+	"DeleteRoleBinding":  projectAdmin,
+	"ListAuditEvents":    registryAdmin,
+}
+
+// readPrefixes are the prefixes of the Registry methods that only read resources.
+var readPrefixes = []string{"Get", "List", "BatchGet", "Download", "Watch", "Search"}
+
+// methodPolicy returns the policy of a method, or false if callers can't call it when authorization is enabled.
+func methodPolicy(fullMethod string) (policy, bool) {
+	switch {
+	case strings.HasPrefix(fullMethod, registryService):
+		method := strings.TrimPrefix(fullMethod, registryService)
+		for _, prefix := range readPrefixes {
+			if strings.HasPrefix(method, prefix) {
+				return projectViewer, true
+			}
+		}
+		return projectEditor, true
+	case strings.HasPrefix(fullMethod, adminService):
+		p, ok := adminPolicies[strings.TrimPrefix(fullMethod, adminService)]
+		return p, ok
+	case strings.HasPrefix(fullMethod, operationsService):
+		return registryAdmin, true
+	case strings.HasPrefix(fullMethod, reflectionService):
+		return anyCaller, true
+	default:
+		return policy{}, false
+	}
+}
+
+// roles are the roles that have been granted to a caller.
+type roles struct {
+	registry rpc.RoleBinding_Role
+	projects map[string]rpc.RoleBinding_Role
+}
+
+// in returns the role of the caller in a project, which includes its role in the registry.
+func (r roles) in(projectID string) rpc.RoleBinding_Role {
+	if r.projects[projectID] > r.registry {
+		return r.projects[projectID]
+	}
+	return r.registry
+}
+
+// principal returns the authenticated subject of a request or, if requests aren't authenticated
+// and the principal header is trusted, the principal identified by its metadata.
+func (s *RegistryServer) principal(ctx context.Context) string {
+	if subject := log.Subject(ctx); subject != "" {
+		return subject
+	}
+	if s.authorization.header == "" {
+		return ""
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(s.authorization.header); len(vals) > 0 {
+			return vals[0]
+		}
+	}
+	return ""
+}
+
+// roles returns the roles that have been granted to a principal.
+func (s *RegistryServer) roles(ctx context.Context, principal string) (roles, error) {
+	r := roles{projects: make(map[string]rpc.RoleBinding_Role)}
+	if s.authorization.admins[principal] {
+		r.registry = rpc.RoleBinding_ADMIN
+	}
+
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return r, status.Error(codes.Unavailable, err.Error())
+	}
+
+	bindings, err := db.RoleBindings(ctx, principal)
+	if err != nil {
+		return r, err
+	}
+	for _, b := range bindings {
+		if b.ProjectID == "" && b.RoleValue() > r.registry {
+			r.registry = b.RoleValue()
+		} else if b.ProjectID != "" {
+			r.projects[b.ProjectID] = b.RoleValue()
+		}
+	}
+	return r, nil
+}
+
+// authorize returns the policy of a method and the roles that have been granted to the caller.
+func (s *RegistryServer) authorize(ctx context.Context, fullMethod string) (policy, roles, error) {
+	p, ok := methodPolicy(fullMethod)
+	if !ok {
+		return p, roles{}, status.Errorf(codes.PermissionDenied, "method %s can't be called", fullMethod)
+	} else if p == anyCaller {
+		return p, roles{}, nil
+	}
+
+	principal := s.principal(ctx)
+	if principal == "" {
+		return p, roles{}, status.Error(codes.Unauthenticated, "request has no principal")
+	}
+
+	r, err := s.roles(ctx, principal)
+	return p, r, err
+}
+
+// check returns an error if the roles of a caller don't allow a request under a policy.
+// The caller needs the role in every project named by the request, or in the registry
+// if the request names no projects or names a resource that isn't in a single project.
+func (p policy) check(r roles, req proto.Message) error {
+	if p == anyCaller || p.visible {
+		return nil
+	}
+
+	projects, registry := requestProjects(req)
+	if p.registry || registry || len(projects) == 0 {
+		if r.registry < p.role {
+			return status.Errorf(codes.PermissionDenied, "role %s is required in the registry", p.role)
+		}
+		return nil
+	}
+
+	for _, projectID := range projects {
+		if r.in(projectID) < p.role {
+			return status.Errorf(codes.PermissionDenied, "role %s is required in project %q", p.role, projectID)
+		}
+	}
+	return nil
+}
+
+// nameFields are the fields of requests that hold the names of the resources that they read or change.
+var nameFields = map[protoreflect.Name]bool{
+	"name":    true,
+	"names":   true,
+	"parent":  true,
+	"pattern": true,
+	"project": true,
+}
+
+// requestProjects returns the IDs of the projects named by a request and its messages.
+// It returns true if the request names a resource that isn't in a single project.
+func requestProjects(req proto.Message) ([]string, bool) {
+	seen := make(map[string]bool)
+	projects := make([]string, 0, 1)
+	registry := false
+
+	add := func(name string) {
+		parts := strings.SplitN(name, "/", 3)
+		if len(parts) < 2 || parts[0] != "projects" || parts[1] == "" || parts[1] == "-" {
+			registry = true
+		} else if !seen[parts[1]] {
+			seen[parts[1]] = true
+			projects = append(projects, parts[1])
+		}
+	}
+
+	var visit func(m protoreflect.Message)
+	visit = func(m protoreflect.Message) {
+		m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			switch {
+			case fd.IsMap():
+			case fd.IsList() && fd.Kind() == protoreflect.MessageKind:
+				list := v.List()
+				for i := 0; i < list.Len(); i++ {
+					visit(list.Get(i).Message())
+				}
+			case fd.IsList() && fd.Kind() == protoreflect.StringKind && nameFields[fd.Name()]:
+				list := v.List()
+				for i := 0; i < list.Len(); i++ {
+					add(list.Get(i).String())
+				}
+			case fd.IsList():
+			case fd.Kind() == protoreflect.MessageKind:
+				visit(v.Message())
+			case fd.Kind() == protoreflect.StringKind && nameFields[fd.Name()]:
+				add(v.String())
+			}
+			return true
+		})
+	}
+	visit(req.ProtoReflect())
+
+	return projects, registry
+}
+
+// UnaryAuthorizer returns a gRPC server interceptor that checks the roles of callers.
+// It allows all requests if authorization isn't enabled.
+func (s *RegistryServer) UnaryAuthorizer() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !s.authorization.enabled {
+			return handler(ctx, req)
+		}
+
+		p, r, err := s.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		if m, ok := req.(proto.Message); ok {
+			if err := p.check(r, m); err != nil {
+				return nil, err
+			}
+		}
+
+		if p.visible && r.registry < projectViewer.role {
+			ctx = context.WithValue(ctx, visibleProjectsKey{}, r.visibleProjects())
+		}
+		return handler(ctx, req)
+	}
+}
+
+// visibleProjectsKey is the context key of the IDs of the projects that a listing is restricted to.
+type visibleProjectsKey struct{}
+
+// visibleProjects returns the IDs of the projects that a caller can view.
+func (r roles) visibleProjects() []string {
+	ids := make([]string, 0, len(r.projects))
+	for projectID := range r.projects {
+		if r.in(projectID) >= projectViewer.role {
+			ids = append(ids, projectID)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// visibleProjectIDs returns the IDs of the projects that a listing is restricted to,
+// or nil if the caller can view every project.
+func visibleProjectIDs(ctx context.Context) []string {
+	ids, _ := ctx.Value(visibleProjectsKey{}).([]string)
+	return ids
+}
+
+// StreamAuthorizer returns a gRPC server interceptor that checks the roles of callers of streaming methods.
+// Every message received from the caller is checked, and messages that don't name resources
+// are allowed after the first, since they continue the request of the first message.
+// It allows all requests if authorization isn't enabled.
+func (s *RegistryServer) StreamAuthorizer() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !s.authorization.enabled {
+			return handler(srv, ss)
+		}
+
+		p, r, err := s.authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &authorizedStream{
+			ServerStream: ss,
+			policy:       p,
+			roles:        r,
+		})
+	}
+}
+
+type authorizedStream struct {
+	grpc.ServerStream
+	policy   policy
+	roles    roles
+	received bool
+}
+
+func (s *authorizedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	req, ok := m.(proto.Message)
+	if !ok {
+		return nil
+	}
+	if projects, registry := requestProjects(req); s.received && len(projects) == 0 && !registry {
+		return nil
+	}
+	s.received = true
+	return s.policy.check(s.roles, req)
+}
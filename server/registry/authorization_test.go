@@ -0,0 +1,475 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/apigee/registry/log"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/test/seeder"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/genproto/googleapis/longrunning"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// authorizedTestServer returns a server that authorizes requests, with roles granted in projects "a" and "b".
+// The principal "root" is configured as an admin of the registry.
+func authorizedTestServer(ctx context.Context, t *testing.T) *RegistryServer {
+	t.Helper()
+	server := defaultTestServer(t)
+	server.authorization = newAuthorization(Config{Authorization: true, TrustPrincipalHeader: true, Admins: []string{"root"}})
+
+	if err := seeder.SeedProjects(ctx, server, &rpc.Project{Name: "projects/a"}, &rpc.Project{Name: "projects/b"}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+	setRoleBinding(ctx, t, server, "projects/a", "alice", rpc.RoleBinding_VIEWER)
+	setRoleBinding(ctx, t, server, "projects/a", "bob", rpc.RoleBinding_EDITOR)
+	setRoleBinding(ctx, t, server, "projects/a", "carol", rpc.RoleBinding_ADMIN)
+	setRoleBinding(ctx, t, server, "", "dave", rpc.RoleBinding_VIEWER)
+	return server
+}
+
+// callerContext returns a context for requests made by a principal.
+func callerContext(ctx context.Context, principal string) context.Context {
+	if principal == "" {
+		return ctx
+	}
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(defaultPrincipalHeader, principal))
+}
+
+func TestUnaryAuthorizer(t *testing.T) {
+	ctx := context.Background()
+	server := authorizedTestServer(ctx, t)
+
+	const (
+		apiA = "projects/a/locations/global/apis/x"
+		apiB = "projects/b/locations/global/apis/x"
+	)
+
+	tests := []struct {
+		desc      string
+		principal string
+		method    string
+		req       proto.Message
+		want      codes.Code
+	}{
+		{
+			desc:   "status without a principal",
+			method: adminService + "GetStatus",
+			req:    &emptypb.Empty{},
+			want:   codes.OK,
+		},
+		{
+			desc:   "read without a principal",
+			method: registryService + "GetApi",
+			req:    &rpc.GetApiRequest{Name: apiA},
+			want:   codes.Unauthenticated,
+		},
+		{
+			desc:      "viewer reads",
+			principal: "alice",
+			method:    registryService + "GetApi",
+			req:       &rpc.GetApiRequest{Name: apiA},
+			want:      codes.OK,
+		},
+		{
+			desc:      "viewer reads another project",
+			principal: "alice",
+			method:    registryService + "GetApi",
+			req:       &rpc.GetApiRequest{Name: apiB},
+			want:      codes.PermissionDenied,
+		},
+		{
+			desc:      "viewer reads a batch",
+			principal: "alice",
+			method:    registryService + "BatchGetApis",
+			req:       &rpc.BatchGetApisRequest{Parent: "projects/a/locations/global", Names: []string{apiA}},
+			want:      codes.OK,
+		},
+		{
+			desc:      "viewer reads a batch with another project",
+			principal: "alice",
+			method:    registryService + "BatchGetApis",
+			req:       &rpc.BatchGetApisRequest{Parent: "projects/a/locations/global", Names: []string{apiA, apiB}},
+			want:      codes.PermissionDenied,
+		},
+		{
+			desc:      "viewer creates",
+			principal: "alice",
+			method:    registryService + "CreateApi",
+			req:       &rpc.CreateApiRequest{Parent: "projects/a/locations/global", ApiId: "y"},
+			want:      codes.PermissionDenied,
+		},
+		{
+			desc:      "viewer reads all projects",
+			principal: "alice",
+			method:    registryService + "ListApis",
+			req:       &rpc.ListApisRequest{Parent: "projects/-/locations/global"},
+			want:      codes.PermissionDenied,
+		},
+		{
+			desc:      "editor creates",
+			principal: "bob",
+			method:    registryService + "CreateApi",
+			req:       &rpc.CreateApiRequest{Parent: "projects/a/locations/global", ApiId: "y"},
+			want:      codes.OK,
+		},
+		{
+			desc:      "editor updates",
+			principal: "bob",
+			method:    registryService + "UpdateApi",
+			req:       &rpc.UpdateApiRequest{Api: &rpc.Api{Name: apiA}},
+			want:      codes.OK,
+		},
+		{
+			desc:      "editor updates in a batch with another project",
+			principal: "bob",
+			method:    registryService + "BatchUpdateApis",
+			req: &rpc.BatchUpdateApisRequest{
+				Parent: "projects/a/locations/global",
+				Requests: []*rpc.UpdateApiRequest{
+					{Api: &rpc.Api{Name: apiA}},
+					{Api: &rpc.Api{Name: apiB}},
+				},
+			},
+			want: codes.PermissionDenied,
+		},
+		{
+			desc:      "editor deletes project",
+			principal: "bob",
+			method:    adminService + "DeleteProject",
+			req:       &rpc.DeleteProjectRequest{Name: "projects/a"},
+			want:      codes.PermissionDenied,
+		},
+		{
+			desc:      "project admin deletes project",
+			principal: "carol",
+			method:    adminService + "DeleteProject",
+			req:       &rpc.DeleteProjectRequest{Name: "projects/a"},
+			want:      codes.OK,
+		},
+		{
+			desc:      "project admin sets binding in project",
+			principal: "carol",
+			method:    adminService + "SetRoleBinding",
+			req:       &rpc.SetRoleBindingRequest{RoleBinding: &rpc.RoleBinding{Project: "projects/a", Principal: "eve"}},
+			want:      codes.OK,
+		},
+		{
+			desc:      "project admin sets binding in registry",
+			principal: "carol",
+			method:    adminService + "SetRoleBinding",
+			req:       &rpc.SetRoleBindingRequest{RoleBinding: &rpc.RoleBinding{Principal: "eve"}},
+			want:      codes.PermissionDenied,
+		},
+		{
+			desc:      "project admin creates project",
+			principal: "carol",
+			method:    adminService + "CreateProject",
+			req:       &rpc.CreateProjectRequest{ProjectId: "c"},
+			want:      codes.PermissionDenied,
+		},
+		{
+			desc:      "project admin gets storage",
+			principal: "carol",
+			method:    adminService + "GetStorage",
+			req:       &emptypb.Empty{},
+			want:      codes.PermissionDenied,
+		},
+		{
+			desc:      "project admin gets operation",
+			principal: "carol",
+			method:    operationsService + "GetOperation",
+			req:       &longrunning.GetOperationRequest{Name: "operations/x"},
+			want:      codes.PermissionDenied,
+		},
+		{
+			desc:      "registry viewer reads",
+			principal: "dave",
+			method:    registryService + "GetApi",
+			req:       &rpc.GetApiRequest{Name: apiB},
+			want:      codes.OK,
+		},
+		{
+			desc:      "registry viewer reads all projects",
+			principal: "dave",
+			method:    registryService + "ListApis",
+			req:       &rpc.ListApisRequest{Parent: "projects/-/locations/global"},
+			want:      codes.OK,
+		},
+		{
+			desc:      "configured admin creates project",
+			principal: "root",
+			method:    adminService + "CreateProject",
+			req:       &rpc.CreateProjectRequest{ProjectId: "c"},
+			want:      codes.OK,
+		},
+		{
+			desc:      "configured admin migrates database",
+			principal: "root",
+			method:    adminService + "MigrateDatabase",
+			req:       &rpc.MigrateDatabaseRequest{},
+			want:      codes.OK,
+		},
+		{
+			desc:      "configured admin calls unknown method",
+			principal: "root",
+			method:    "/google.example.Service/Method",
+			req:       &emptypb.Empty{},
+			want:      codes.PermissionDenied,
+		},
+	}
+
+	authorizer := server.UnaryAuthorizer()
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				return &emptypb.Empty{}, nil
+			}
+
+			info := &grpc.UnaryServerInfo{FullMethod: test.method}
+			_, err := authorizer(callerContext(ctx, test.principal), test.req, info, handler)
+			if status.Code(err) != test.want {
+				t.Errorf("%s(%+v) by %q returned status code %q, want %q: %v", test.method, test.req, test.principal, status.Code(err), test.want, err)
+			}
+			if called != (test.want == codes.OK) {
+				t.Errorf("%s(%+v) by %q called handler: %t", test.method, test.req, test.principal, called)
+			}
+		})
+	}
+}
+
+func TestUnaryAuthorizerListsVisibleProjects(t *testing.T) {
+	ctx := context.Background()
+	server := authorizedTestServer(ctx, t)
+
+	tests := []struct {
+		principal string
+		want      []string
+	}{
+		{principal: "alice", want: []string{"projects/a"}},
+		{principal: "dave", want: []string{"projects/a", "projects/b"}},
+		{principal: "eve", want: []string{}},
+	}
+
+	authorizer := server.UnaryAuthorizer()
+	info := &grpc.UnaryServerInfo{FullMethod: adminService + "ListProjects"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return server.ListProjects(ctx, req.(*rpc.ListProjectsRequest))
+	}
+	for _, test := range tests {
+		t.Run(test.principal, func(t *testing.T) {
+			resp, err := authorizer(callerContext(ctx, test.principal), &rpc.ListProjectsRequest{}, info, handler)
+			if err != nil {
+				t.Fatalf("ListProjects() by %q returned error: %s", test.principal, err)
+			}
+			got := make([]string, 0)
+			for _, p := range resp.(*rpc.ListProjectsResponse).GetProjects() {
+				got = append(got, p.GetName())
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("ListProjects() by %q returned unexpected diff (-want +got):\n%s", test.principal, diff)
+			}
+		})
+	}
+}
+
+func TestUnaryAuthorizerPaginatesVisibleProjects(t *testing.T) {
+	ctx := context.Background()
+	server := authorizedTestServer(ctx, t)
+	if err := seeder.SeedProjects(ctx, server, &rpc.Project{Name: "projects/c"}, &rpc.Project{Name: "projects/d"}); err != nil {
+		t.Fatalf("Setup/Seeding: Failed to seed registry: %s", err)
+	}
+	setRoleBinding(ctx, t, server, "projects/d", "alice", rpc.RoleBinding_VIEWER)
+
+	authorizer := server.UnaryAuthorizer()
+	info := &grpc.UnaryServerInfo{FullMethod: adminService + "ListProjects"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return server.ListProjects(ctx, req.(*rpc.ListProjectsRequest))
+	}
+
+	// Projects that alice can't view are skipped before pages are filled.
+	req := &rpc.ListProjectsRequest{PageSize: 1}
+	pages := make([][]string, 0)
+	for {
+		resp, err := authorizer(callerContext(ctx, "alice"), req, info, handler)
+		if err != nil {
+			t.Fatalf("ListProjects(%+v) returned error: %s", req, err)
+		}
+		page := make([]string, 0)
+		for _, p := range resp.(*rpc.ListProjectsResponse).GetProjects() {
+			page = append(page, p.GetName())
+		}
+		pages = append(pages, page)
+		if req.PageToken = resp.(*rpc.ListProjectsResponse).GetNextPageToken(); req.PageToken == "" {
+			break
+		}
+	}
+
+	want := [][]string{{"projects/a"}, {"projects/d"}}
+	if diff := cmp.Diff(want, pages); diff != "" {
+		t.Errorf("ListProjects() pages returned unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestUnaryAuthorizerDisabled(t *testing.T) {
+	ctx := context.Background()
+	server := defaultTestServer(t)
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &emptypb.Empty{}, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: adminService + "DeleteProject"}
+	if _, err := server.UnaryAuthorizer()(ctx, &rpc.DeleteProjectRequest{Name: "projects/a"}, info, handler); err != nil {
+		t.Errorf("DeleteProject() without authorization returned error: %s", err)
+	}
+}
+
+func TestUnaryAuthorizerAuthenticatedSubject(t *testing.T) {
+	ctx := context.Background()
+	server := authorizedTestServer(ctx, t)
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &emptypb.Empty{}, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: adminService + "DeleteProject"}
+	req := &rpc.DeleteProjectRequest{Name: "projects/a"}
+
+	// An authenticated subject takes precedence over the principal header.
+	authenticated := log.NewSubjectContext(callerContext(ctx, "carol"), "alice")
+	if _, err := server.UnaryAuthorizer()(authenticated, req, info, handler); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("DeleteProject() by authenticated viewer returned status code %q, want %q", status.Code(err), codes.PermissionDenied)
+	}
+
+	authenticated = log.NewSubjectContext(callerContext(ctx, "alice"), "carol")
+	if _, err := server.UnaryAuthorizer()(authenticated, req, info, handler); err != nil {
+		t.Errorf("DeleteProject() by authenticated admin returned error: %s", err)
+	}
+}
+
+func TestUnaryAuthorizerUntrustedHeader(t *testing.T) {
+	ctx := context.Background()
+	server := authorizedTestServer(ctx, t)
+	server.authorization = newAuthorization(Config{Authorization: true, Admins: []string{"root"}})
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &emptypb.Empty{}, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: adminService + "CreateProject"}
+	req := &rpc.CreateProjectRequest{ProjectId: "c"}
+
+	// Any caller can set the principal header, so it's ignored unless it is trusted.
+	if _, err := server.UnaryAuthorizer()(callerContext(ctx, "root"), req, info, handler); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("CreateProject() with an untrusted principal header returned status code %q, want %q", status.Code(err), codes.Unauthenticated)
+	}
+
+	if _, err := server.UnaryAuthorizer()(log.NewSubjectContext(ctx, "root"), req, info, handler); err != nil {
+		t.Errorf("CreateProject() by authenticated admin returned error: %s", err)
+	}
+}
+
+// messageStream is a server stream that receives a list of messages.
+type messageStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	messages []proto.Message
+}
+
+func (s *messageStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *messageStream) RecvMsg(m interface{}) error {
+	if len(s.messages) == 0 {
+		return io.EOF
+	}
+	proto.Merge(m.(proto.Message), s.messages[0])
+	s.messages = s.messages[1:]
+	return nil
+}
+
+func TestStreamAuthorizer(t *testing.T) {
+	ctx := context.Background()
+	server := authorizedTestServer(ctx, t)
+
+	upload := []proto.Message{
+		&rpc.UploadApiSpecContentsRequest{ApiSpec: &rpc.ApiSpec{Name: "projects/a/locations/global/apis/x/versions/v/specs/s"}},
+		&rpc.UploadApiSpecContentsRequest{Chunk: []byte("contents")},
+	}
+	tests := []struct {
+		desc      string
+		principal string
+		messages  []proto.Message
+		want      codes.Code
+	}{
+		{
+			desc:      "editor uploads",
+			principal: "bob",
+			messages:  upload,
+			want:      codes.OK,
+		},
+		{
+			desc:      "viewer uploads",
+			principal: "alice",
+			messages:  upload,
+			want:      codes.PermissionDenied,
+		},
+		{
+			desc:      "editor uploads to another project",
+			principal: "bob",
+			messages: []proto.Message{
+				upload[0],
+				&rpc.UploadApiSpecContentsRequest{ApiSpec: &rpc.ApiSpec{Name: "projects/b/locations/global/apis/x/versions/v/specs/s"}},
+			},
+			want: codes.PermissionDenied,
+		},
+		{
+			desc:     "upload without a principal",
+			messages: upload,
+			want:     codes.Unauthenticated,
+		},
+	}
+
+	authorizer := server.StreamAuthorizer()
+	info := &grpc.StreamServerInfo{FullMethod: registryService + "UploadApiSpecContents", IsClientStream: true}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			handler := func(srv interface{}, ss grpc.ServerStream) error {
+				for {
+					if err := ss.RecvMsg(new(rpc.UploadApiSpecContentsRequest)); err == io.EOF {
+						return nil
+					} else if err != nil {
+						return err
+					}
+				}
+			}
+
+			stream := &messageStream{ctx: callerContext(ctx, test.principal), messages: test.messages}
+			if err := authorizer(server, stream, info, handler); status.Code(err) != test.want {
+				t.Errorf("UploadApiSpecContents() by %q returned status code %q, want %q: %v", test.principal, status.Code(err), test.want, err)
+			}
+		})
+	}
+}
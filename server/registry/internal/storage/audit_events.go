@@ -0,0 +1,137 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+
+	"github.com/apigee/registry/server/registry/internal/storage/filtering"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SaveAuditEvent records an audit event.
+func (c *Client) SaveAuditEvent(ctx context.Context, v *models.AuditEvent) error {
+	if err := c.db.Create(v).Error; err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// AuditEventList contains a page of audit events.
+type AuditEventList struct {
+	AuditEvents []models.AuditEvent
+	Token       string
+}
+
+var auditEventFields = []filtering.Field{
+	{Name: "id", Type: filtering.Int, Column: "id"},
+	{Name: "time", Type: filtering.Timestamp, Column: "time"},
+	{Name: "principal", Type: filtering.String, Column: "principal"},
+	{Name: "method", Type: filtering.String, Column: "method"},
+	{Name: "resource", Type: filtering.String, Column: "resource"},
+	{Name: "request_id", Type: filtering.String, Column: "request_id"},
+	{Name: "status_code", Type: filtering.String, Column: "status_code"},
+	{Name: "error_message", Type: filtering.String, Column: "error_message"},
+}
+
+// auditEventOrder lists audit events in the order they were recorded.
+var auditEventOrder = []ordering{{Field: "id", Column: "id"}}
+
+// ListAuditEvents returns audit events in the order they were recorded.
+func (c *Client) ListAuditEvents(ctx context.Context, opts PageOptions) (AuditEventList, error) {
+	token, err := decodeToken(opts.Token)
+	if err != nil {
+		return AuditEventList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err.Error())
+	}
+
+	if err := token.ValidateFilter(opts.Filter); err != nil {
+		return AuditEventList{}, status.Errorf(codes.InvalidArgument, "invalid filter %q: %s", opts.Filter, err)
+	} else {
+		token.Filter = opts.Filter
+	}
+
+	filter, err := filtering.NewFilter(opts.Filter, auditEventFields)
+	if err != nil {
+		return AuditEventList{}, err
+	}
+
+	op, verify := c.applyFilter(c.db, filter, opts)
+	op, err = paginate(op, auditEventOrder, token)
+	if err != nil {
+		return AuditEventList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err)
+	}
+
+	lock()
+	var events []models.AuditEvent
+	err = op.Find(&events).Error
+	unlock()
+	if err != nil {
+		return AuditEventList{}, status.Error(codes.Internal, err.Error())
+	}
+
+	response := AuditEventList{
+		AuditEvents: make([]models.AuditEvent, 0, opts.Size),
+	}
+
+	for _, event := range events {
+		eventMap := auditEventMap(event)
+		if verify {
+			match, err := filter.Matches(eventMap)
+			if err != nil {
+				return response, err
+			} else if !match {
+				continue
+			}
+		}
+
+		if len(response.AuditEvents) < int(opts.Size) {
+			response.AuditEvents = append(response.AuditEvents, event)
+			token.Last = position(auditEventOrder, "", eventMap)
+		} else if len(response.AuditEvents) == int(opts.Size) {
+			response.Token, err = encodeToken(token)
+			if err != nil {
+				return response, status.Error(codes.Internal, err.Error())
+			}
+			break
+		}
+	}
+
+	if response.Token == "" {
+		response.Token, err = scanToken(verify, len(events), token, func() ([]interface{}, error) {
+			last := events[len(events)-1]
+			return position(auditEventOrder, "", auditEventMap(last)), nil
+		})
+		if err != nil {
+			return response, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return response, nil
+}
+
+func auditEventMap(event models.AuditEvent) map[string]interface{} {
+	return map[string]interface{}{
+		"id":            event.ID,
+		"time":          event.Time,
+		"principal":     event.Principal,
+		"method":        event.Method,
+		"resource":      event.Resource,
+		"request_id":    event.RequestID,
+		"status_code":   event.StatusCode,
+		"error_message": event.ErrorMessage,
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"github.com/apigee/registry/log"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// BlobStore stores the contents of blobs, which are identified by their hashes.
+// Blob metadata, including reference counts, is always stored in the database.
+type BlobStore interface {
+	// Put stores the contents of a blob.
+	// Putting contents that are already stored must mark them modified.
+	Put(ctx context.Context, hash string, contents []byte) error
+	// Get returns the contents of a blob.
+	Get(ctx context.Context, hash string) ([]byte, error)
+	// Delete removes the contents of a blob unless they were modified after the specified time.
+	// Deleting contents that aren't stored has no effect.
+	Delete(ctx context.Context, hash string, unmodifiedSince time.Time) error
+}
+
+// sqlBlobStore stores the contents of blobs in the blobs table of the database.
+type sqlBlobStore struct {
+	db *gorm.DB
+}
+
+func (s *sqlBlobStore) Put(ctx context.Context, hash string, contents []byte) error {
+	return s.db.Model(&models.Blob{}).Where("hash = ?", hash).UpdateColumn("contents", contents).Error
+}
+
+func (s *sqlBlobStore) Get(ctx context.Context, hash string) ([]byte, error) {
+	var contents []byte
+	if err := s.db.Model(&models.Blob{}).Where("hash = ?", hash).Pluck("contents", &contents).Error; err != nil {
+		return nil, err
+	}
+	return contents, nil
+}
+
+// Delete has no effect because the contents are deleted with the blob.
+func (s *sqlBlobStore) Delete(ctx context.Context, hash string, unmodifiedSince time.Time) error {
+	return nil
+}
+
+// fileBlobStore stores the contents of each blob in a file named by its hash.
+// Files are grouped in subdirectories named by the first two characters of their hashes.
+type fileBlobStore struct {
+	dir string
+}
+
+// blobHash matches the hashes that identify stored blobs.
+var blobHash = regexp.MustCompile("^[0-9a-f]{64}$")
+
+// NewFileBlobStore returns a BlobStore that stores contents in files under a local directory.
+// The directory is created if it doesn't exist.
+func NewFileBlobStore(dir string) (BlobStore, error) {
+	if dir == "" {
+		return nil, errors.New("blob store directory must be set")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &fileBlobStore{dir: dir}, nil
+}
+
+func (s *fileBlobStore) path(hash string) (string, error) {
+	if !blobHash.MatchString(hash) {
+		return "", fmt.Errorf("invalid blob hash %q", hash)
+	}
+	return filepath.Join(s.dir, hash[:2], hash), nil
+}
+
+func (s *fileBlobStore) Put(ctx context.Context, hash string, contents []byte) error {
+	path, err := s.path(hash)
+	if err != nil {
+		return err
+	}
+
+	// Existing files already hold the contents, but they are marked modified so that
+	// they aren't deleted for a blob with the same hash that was released concurrently.
+	if now := time.Now(); os.Chtimes(path, now, now) == nil {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	// Contents are written to a temporary file and renamed so that readers never see partial contents.
+	f, err := os.CreateTemp(filepath.Dir(path), hash+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(contents); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+func (s *fileBlobStore) Get(ctx context.Context, hash string) ([]byte, error) {
+	path, err := s.path(hash)
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadFile(path)
+}
+
+func (s *fileBlobStore) Delete(ctx context.Context, hash string, unmodifiedSince time.Time) error {
+	path, err := s.path(hash)
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	// The contents were put again for a new blob with the same hash.
+	if info.ModTime().After(unmodifiedSince) {
+		return nil
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// blobStore returns the store of blob contents used by the client.
+func (c *Client) blobStore() BlobStore {
+	if c.blobs != nil {
+		return c.blobs
+	}
+	return &sqlBlobStore{db: c.db}
+}
+
+// UseBlobStore configures the client to store the contents of new blobs in a store other than the database.
+// Contents that were previously stored in the database remain readable.
+func (c *Client) UseBlobStore(store BlobStore) {
+	c.blobs = store
+}
+
+// releasedBlobs collects the blobs that are deleted in a transaction.
+// Their contents are deleted from the blob store after the transaction is committed.
+type releasedBlobs struct {
+	since  time.Time
+	hashes []string
+}
+
+// deleteReleasedContents deletes the contents of released blobs that are still deleted.
+// Failures are logged, because the contents of deleted blobs are never read.
+func (c *Client) deleteReleasedContents(ctx context.Context, released *releasedBlobs) {
+	if c.blobs == nil || len(released.hashes) == 0 {
+		return
+	}
+
+	// Blobs with the same contents may have been created since they were released.
+	var existing []string
+	if err := c.db.Model(&models.Blob{}).Where("hash IN ?", released.hashes).Pluck("hash", &existing).Error; err != nil {
+		log.FromContext(ctx).WithError(err).Error("Failed to check released blobs.")
+		return
+	}
+	recreated := make(map[string]bool, len(existing))
+	for _, hash := range existing {
+		recreated[hash] = true
+	}
+
+	for _, hash := range released.hashes {
+		if recreated[hash] {
+			continue
+		}
+		if err := c.blobs.Delete(ctx, hash, released.since); err != nil {
+			log.FromContext(ctx).WithError(err).Errorf("Failed to delete contents of blob %s.", hash)
+		}
+	}
+}
+
+// getBlobContents returns the contents of a blob, which are stored in the database
+// for blobs that were created before another blob store was used.
+func (c *Client) getBlobContents(ctx context.Context, v *models.Blob) error {
+	if len(v.Contents) > 0 || v.SizeInBytes == 0 {
+		return nil
+	}
+
+	contents, err := c.blobStore().Get(ctx, v.Hash)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to read contents of blob %s: %s", v.Hash, err)
+	}
+	v.Contents = contents
+	return nil
+}
@@ -16,35 +16,29 @@ package storage
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+	"strings"
 	"sync"
+	"time"
 
 	_ "github.com/GoogleCloudPlatform/cloudsql-proxy/proxy/dialers/postgres"
-	"github.com/apigee/registry/server/registry/internal/storage/models"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gorm.io/driver/postgres"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
-// A complete list of entities used by the storage system
-// and managed using gorm features.
-var entities = []interface{}{
-	&models.Project{},
-	&models.Api{},
-	&models.Version{},
-	&models.Spec{},
-	&models.SpecRevisionTag{},
-	&models.Deployment{},
-	&models.DeploymentRevisionTag{},
-	&models.Artifact{},
-	&models.Blob{},
-}
-
 // Client represents a connection to a storage provider.
 type Client struct {
 	db *gorm.DB
+	// blobs stores the contents of blobs. If nil, contents are stored in the database.
+	blobs BlobStore
+	// released collects the blobs deleted in the current transaction.
+	released *releasedBlobs
 }
 
 var mutex sync.Mutex
@@ -71,9 +65,12 @@ func NewClient(ctx context.Context, driver, dsn string) (*Client, error) {
 	lock()
 	switch driver {
 	case "sqlite3":
-		db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{
-			Logger: NewGormLogger(ctx),
+		db, err := gorm.Open(sqlite.Open(immediateTransactions(dsn)), &gorm.Config{
+			Logger: NewGormLogger(),
 		})
+		if err == nil {
+			err = registerTracing(db, semconv.DBSystemSqlite)
+		}
 		if err != nil {
 			c := &Client{db: db}
 			c.close()
@@ -90,8 +87,11 @@ func NewClient(ctx context.Context, driver, dsn string) (*Client, error) {
 			DriverName: driver,
 			DSN:        dsn,
 		}), &gorm.Config{
-			Logger: NewGormLogger(ctx),
+			Logger: NewGormLogger(),
 		})
+		if err == nil {
+			err = registerTracing(db, semconv.DBSystemPostgreSQL)
+		}
 		if err != nil {
 			c := &Client{db: db}
 			c.close()
@@ -109,6 +109,97 @@ func NewClient(ctx context.Context, driver, dsn string) (*Client, error) {
 	}
 }
 
+// immediateTransactions configures a SQLite DSN to take the database write lock when transactions begin.
+// Concurrent transactions then wait for each other instead of failing when they begin writing after a read.
+func immediateTransactions(dsn string) string {
+	if strings.Contains(dsn, "_txlock=") {
+		return dsn
+	} else if strings.Contains(dsn, "?") {
+		return dsn + "&_txlock=immediate"
+	}
+	return dsn + "?_txlock=immediate"
+}
+
+// PoolConfig configures the pool of connections held open by a Client.
+// Zero values leave the corresponding database/sql defaults in place.
+type PoolConfig struct {
+	// MaxOpenConns is the maximum number of open connections to the database.
+	MaxOpenConns int
+	// MaxIdleConns is the maximum number of connections kept in the idle pool.
+	MaxIdleConns int
+	// ConnMaxLifetime is the maximum amount of time a connection may be reused.
+	ConnMaxLifetime time.Duration
+}
+
+// ConfigurePool applies connection pool limits to the client's underlying database handle.
+func (c *Client) ConfigurePool(config PoolConfig) error {
+	sqlDB, err := c.db.DB()
+	if err != nil {
+		return err
+	}
+	if config.MaxOpenConns > 0 {
+		sqlDB.SetMaxOpenConns(config.MaxOpenConns)
+	}
+	if config.MaxIdleConns > 0 {
+		sqlDB.SetMaxIdleConns(config.MaxIdleConns)
+	}
+	if config.ConnMaxLifetime > 0 {
+		sqlDB.SetConnMaxLifetime(config.ConnMaxLifetime)
+	}
+	return nil
+}
+
+// PoolStats returns statistics of the client's connection pool.
+func (c *Client) PoolStats() (sql.DBStats, error) {
+	sqlDB, err := c.db.DB()
+	if err != nil {
+		return sql.DBStats{}, err
+	}
+	return sqlDB.Stats(), nil
+}
+
+// WithContext returns a client that shares this client's connection pool
+// and uses ctx for all of its database operations.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	return &Client{db: c.db.WithContext(ctx), blobs: c.blobs}
+}
+
+// ForUpdate returns a client whose reads lock the rows they return until the end of the current transaction.
+// SQLite doesn't support row locks, but it serializes the transactions that write.
+func (c *Client) ForUpdate() *Client {
+	return &Client{
+		db:       c.db.Clauses(clause.Locking{Strength: "UPDATE"}).Session(&gorm.Session{}),
+		blobs:    c.blobs,
+		released: c.released,
+	}
+}
+
+// Transaction calls fn with a client that makes all of its changes in a single database transaction.
+// The transaction is committed if fn returns nil and rolled back otherwise.
+// When called on a client for a transaction, the nested transaction is a savepoint
+// whose changes are only committed with the enclosing transaction.
+func (c *Client) Transaction(ctx context.Context, fn func(context.Context, *Client) error) error {
+	nested := c.released != nil
+	released := c.released
+	if !nested {
+		released = &releasedBlobs{since: time.Now()}
+	}
+	err := c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(ctx, &Client{db: tx, blobs: c.blobs, released: released})
+	})
+	if _, ok := status.FromError(err); !ok {
+		return status.Error(codes.Internal, err.Error())
+	} else if err != nil {
+		return err
+	}
+
+	// Released contents are deleted when the outermost transaction is committed.
+	if !nested {
+		c.WithContext(ctx).deleteReleasedContents(ctx, released)
+	}
+	return nil
+}
+
 // Close closes a database session.
 func (c *Client) Close() {
 	lock()
@@ -132,20 +223,6 @@ func (c *Client) ensureTable(v interface{}) error {
 	return nil
 }
 
-// EnsureTables ensures that all necessary tables exist in the database.
-func (c *Client) EnsureTables() error {
-	for _, entity := range entities {
-		if err := c.ensureTable(entity); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (c *Client) Migrate(kind string) error {
-	return c.db.AutoMigrate(entities...)
-}
-
 func (c *Client) DatabaseName() string {
 	return c.db.Name()
 }
@@ -158,7 +235,8 @@ func (c *Client) TableNames() ([]string, error) {
 			return nil, err
 		}
 	case "sqlite":
-		if err := c.db.Table("sqlite_schema").Where("type = 'table' AND name NOT LIKE 'sqlite_%'").Order("name").Pluck("name", &tableNames).Error; err != nil {
+		// The search index and its shadow tables are internal to SQLite, like the index of search documents in Postgres.
+		if err := c.db.Table("sqlite_schema").Where("type = 'table' AND name NOT LIKE 'sqlite_%' AND name NOT LIKE ?", sqliteSearchIndex+"%").Order("name").Pluck("name", &tableNames).Error; err != nil {
 			return nil, err
 		}
 	default:
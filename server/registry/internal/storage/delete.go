@@ -16,6 +16,7 @@ package storage
 
 import (
 	"context"
+	"time"
 
 	"github.com/apigee/registry/server/registry/internal/storage/models"
 	"github.com/apigee/registry/server/registry/names"
@@ -24,22 +25,26 @@ import (
 	"gorm.io/gorm"
 )
 
+// DeleteProject marks a project and its children deleted at the same time.
+// Deleted resources are hidden until they are restored or purged.
 func (c *Client) DeleteProject(ctx context.Context, name names.Project, cascade bool) error {
+	now := time.Now().Round(time.Microsecond)
 	err := c.db.Transaction(func(tx *gorm.DB) error {
 		var count int64
 		for _, model := range []interface{}{
-			models.Project{},
-			models.Api{},
-			models.Deployment{},
-			models.DeploymentRevisionTag{},
-			models.Version{},
-			models.Spec{},
-			models.SpecRevisionTag{},
-			models.Blob{},
-			models.Artifact{},
+			&models.Project{},
+			&models.Api{},
+			&models.Deployment{},
+			&models.DeploymentRevisionTag{},
+			&models.Version{},
+			&models.Spec{},
+			&models.SpecRevisionTag{},
+			&models.Artifact{},
+			&models.ArtifactRevisionTag{},
 		} {
-			op := tx.Where("project_id = ?", name.ProjectID)
-			if err := op.Delete(model).Error; err != nil {
+			op := tx.Model(model).Where("project_id = ?", name.ProjectID).
+				UpdateColumn("delete_time", now)
+			if err := op.Error; err != nil {
 				return err
 			}
 
@@ -64,21 +69,23 @@ func (c *Client) DeleteProject(ctx context.Context, name names.Project, cascade
 }
 
 func (c *Client) DeleteApi(ctx context.Context, name names.Api, cascade bool) error {
+	now := time.Now().Round(time.Microsecond)
 	err := c.db.Transaction(func(tx *gorm.DB) error {
 		var count int64
 		for _, model := range []interface{}{
-			models.Api{},
-			models.Deployment{},
-			models.DeploymentRevisionTag{},
-			models.Version{},
-			models.Spec{},
-			models.SpecRevisionTag{},
-			models.Blob{},
-			models.Artifact{},
+			&models.Api{},
+			&models.Deployment{},
+			&models.DeploymentRevisionTag{},
+			&models.Version{},
+			&models.Spec{},
+			&models.SpecRevisionTag{},
+			&models.Artifact{},
+			&models.ArtifactRevisionTag{},
 		} {
-			op := tx.Where("project_id = ?", name.ProjectID).
-				Where("api_id = ?", name.ApiID)
-			if err := op.Delete(model).Error; err != nil {
+			op := tx.Model(model).Where("project_id = ?", name.ProjectID).
+				Where("api_id = ?", name.ApiID).
+				UpdateColumn("delete_time", now)
+			if err := op.Error; err != nil {
 				return err
 			}
 
@@ -103,19 +110,21 @@ func (c *Client) DeleteApi(ctx context.Context, name names.Api, cascade bool) er
 }
 
 func (c *Client) DeleteVersion(ctx context.Context, name names.Version, cascade bool) error {
+	now := time.Now().Round(time.Microsecond)
 	err := c.db.Transaction(func(tx *gorm.DB) error {
 		var count int64
 		for _, model := range []interface{}{
-			models.Version{},
-			models.Spec{},
-			models.SpecRevisionTag{},
-			models.Blob{},
-			models.Artifact{},
+			&models.Version{},
+			&models.Spec{},
+			&models.SpecRevisionTag{},
+			&models.Artifact{},
+			&models.ArtifactRevisionTag{},
 		} {
-			op := tx.Where("project_id = ?", name.ProjectID).
+			op := tx.Model(model).Where("project_id = ?", name.ProjectID).
 				Where("api_id = ?", name.ApiID).
-				Where("version_id = ?", name.VersionID)
-			if err := op.Delete(model).Error; err != nil {
+				Where("version_id = ?", name.VersionID).
+				UpdateColumn("delete_time", now)
+			if err := op.Error; err != nil {
 				return err
 			}
 
@@ -140,31 +149,33 @@ func (c *Client) DeleteVersion(ctx context.Context, name names.Version, cascade
 }
 
 func (c *Client) DeleteSpec(ctx context.Context, name names.Spec, cascade bool) error {
+	now := time.Now().Round(time.Microsecond)
 	err := c.db.Transaction(func(tx *gorm.DB) error {
 		for _, model := range []interface{}{
-			models.Spec{},
-			models.SpecRevisionTag{},
-			models.Blob{},
+			&models.Spec{},
+			&models.SpecRevisionTag{},
 		} {
-			op := tx.Where("project_id = ?", name.ProjectID).
+			op := tx.Model(model).Where("project_id = ?", name.ProjectID).
 				Where("api_id = ?", name.ApiID).
 				Where("version_id = ?", name.VersionID).
-				Where("spec_id = ?", name.SpecID)
-			if err := op.Delete(model).Error; err != nil {
+				Where("spec_id = ?", name.SpecID).
+				UpdateColumn("delete_time", now)
+			if err := op.Error; err != nil {
 				return err
 			}
 		}
 
 		var childCount int64
 		for _, model := range []interface{}{
-			models.Artifact{},
-			models.Blob{},
+			&models.Artifact{},
+			&models.ArtifactRevisionTag{},
 		} {
-			op := tx.Where("project_id = ?", name.ProjectID).
+			op := tx.Model(model).Where("project_id = ?", name.ProjectID).
 				Where("api_id = ?", name.ApiID).
 				Where("version_id = ?", name.VersionID).
-				Where("spec_id = ?", name.SpecID)
-			if err := op.Delete(model).Error; err != nil {
+				Where("spec_id = ?", name.SpecID).
+				UpdateColumn("delete_time", now)
+			if err := op.Error; err != nil {
 				return err
 			}
 
@@ -188,52 +199,66 @@ func (c *Client) DeleteSpec(ctx context.Context, name names.Spec, cascade bool)
 	}
 }
 
+// DeleteSpecRevision permanently deletes a spec revision.
 func (c *Client) DeleteSpecRevision(ctx context.Context, name names.SpecRevision) error {
 	name, err := c.unwrapSpecRevisionTag(ctx, name)
 	if err != nil {
 		return err
 	}
 
-	for _, model := range []interface{}{
-		models.Spec{},
-		models.SpecRevisionTag{},
-	} {
-		op := c.db.Where("project_id = ?", name.ProjectID).
-			Where("api_id = ?", name.ApiID).
-			Where("version_id = ?", name.VersionID).
-			Where("spec_id = ?", name.SpecID).
-			Where("revision_id = ?", name.RevisionID)
-		if err := op.Delete(model).Error; err != nil {
-			return status.Error(codes.Internal, err.Error())
+	err = c.db.Transaction(func(tx *gorm.DB) error {
+		for _, model := range []interface{}{
+			models.Spec{},
+			models.SpecRevisionTag{},
+		} {
+			op := tx.Unscoped().Where("project_id = ?", name.ProjectID).
+				Where("api_id = ?", name.ApiID).
+				Where("version_id = ?", name.VersionID).
+				Where("spec_id = ?", name.SpecID).
+				Where("revision_id = ?", name.RevisionID)
+			if err := c.releaseBlobs(op, model); err != nil {
+				return err
+			}
+			if err := op.Delete(model).Error; err != nil {
+				return err
+			}
 		}
+
+		return nil
+	})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
 	}
 
 	return nil
 }
 
 func (c *Client) DeleteDeployment(ctx context.Context, name names.Deployment, cascade bool) error {
+	now := time.Now().Round(time.Microsecond)
 	err := c.db.Transaction(func(tx *gorm.DB) error {
 		for _, model := range []interface{}{
-			models.Deployment{},
-			models.DeploymentRevisionTag{},
+			&models.Deployment{},
+			&models.DeploymentRevisionTag{},
 		} {
-			op := tx.Where("project_id = ?", name.ProjectID).
+			op := tx.Model(model).Where("project_id = ?", name.ProjectID).
 				Where("api_id = ?", name.ApiID).
-				Where("deployment_id = ?", name.DeploymentID)
-			if err := op.Delete(model).Error; err != nil {
+				Where("deployment_id = ?", name.DeploymentID).
+				UpdateColumn("delete_time", now)
+			if err := op.Error; err != nil {
 				return err
 			}
 		}
 
 		var childCount int64
 		for _, model := range []interface{}{
-			models.Artifact{},
-			models.Blob{},
+			&models.Artifact{},
+			&models.ArtifactRevisionTag{},
 		} {
-			op := tx.Where("project_id = ?", name.ProjectID).
+			op := tx.Model(model).Where("project_id = ?", name.ProjectID).
 				Where("api_id = ?", name.ApiID).
-				Where("deployment_id = ?", name.DeploymentID)
-			if err := op.Delete(model).Error; err != nil {
+				Where("deployment_id = ?", name.DeploymentID).
+				UpdateColumn("delete_time", now)
+			if err := op.Error; err != nil {
 				return err
 			}
 
@@ -267,7 +292,7 @@ func (c *Client) DeleteDeploymentRevision(ctx context.Context, name names.Deploy
 		models.Deployment{},
 		models.DeploymentRevisionTag{},
 	} {
-		op := c.db.Where("project_id = ?", name.ProjectID).
+		op := c.db.Unscoped().Where("project_id = ?", name.ProjectID).
 			Where("api_id = ?", name.ApiID).
 			Where("deployment_id = ?", name.DeploymentID).
 			Where("revision_id = ?", name.RevisionID)
@@ -280,19 +305,174 @@ func (c *Client) DeleteDeploymentRevision(ctx context.Context, name names.Deploy
 }
 
 func (c *Client) DeleteArtifact(ctx context.Context, name names.Artifact) error {
-	for _, model := range []interface{}{
-		models.Blob{},
-		models.Artifact{},
-	} {
-		op := c.db.Where("project_id = ?", name.ProjectID()).
-			Where("api_id = ?", name.ApiID()).
-			Where("version_id = ?", name.VersionID()).
-			Where("spec_id = ?", name.SpecID()).
-			Where("artifact_id = ?", name.ArtifactID())
-		if err := op.Delete(model).Error; err != nil {
+	name = name.Normal()
+	now := time.Now().Round(time.Microsecond)
+	err := c.db.Transaction(func(tx *gorm.DB) error {
+		for _, model := range []interface{}{
+			&models.Artifact{},
+			&models.ArtifactRevisionTag{},
+		} {
+			op := tx.Model(model).Where("project_id = ?", name.ProjectID()).
+				Where("api_id = ?", name.ApiID()).
+				Where("version_id = ?", name.VersionID()).
+				Where("spec_id = ?", name.SpecID()).
+				Where("deployment_id = ?", name.DeploymentID()).
+				Where("artifact_id = ?", name.ArtifactID()).
+				UpdateColumn("delete_time", now)
+			if err := op.Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+func (c *Client) DeleteArtifactRevision(ctx context.Context, name names.ArtifactRevision) error {
+	name, err := c.unwrapArtifactRevisionTag(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	artifact := name.Artifact().Normal()
+	err = c.db.Transaction(func(tx *gorm.DB) error {
+		for _, model := range []interface{}{
+			models.Artifact{},
+			models.ArtifactRevisionTag{},
+		} {
+			op := tx.Unscoped().Where("project_id = ?", artifact.ProjectID()).
+				Where("api_id = ?", artifact.ApiID()).
+				Where("version_id = ?", artifact.VersionID()).
+				Where("spec_id = ?", artifact.SpecID()).
+				Where("deployment_id = ?", artifact.DeploymentID()).
+				Where("artifact_id = ?", artifact.ArtifactID()).
+				Where("revision_id = ?", name.RevisionID)
+			if err := c.releaseBlobs(op, model); err != nil {
+				return err
+			}
+			if err := op.Delete(model).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+// PruneArtifactRevisions deletes the oldest revisions of an artifact until at most limit revisions remain.
+// Tagged revisions are never deleted, so more revisions may remain if some of the oldest are tagged.
+func (c *Client) PruneArtifactRevisions(ctx context.Context, name names.Artifact, limit int32) error {
+	if limit <= 0 {
+		return nil
+	}
+
+	name = name.Normal()
+	op := c.db.Where("project_id = ?", name.ProjectID()).
+		Where("api_id = ?", name.ApiID()).
+		Where("version_id = ?", name.VersionID()).
+		Where("spec_id = ?", name.SpecID()).
+		Where("deployment_id = ?", name.DeploymentID()).
+		Where("artifact_id = ?", name.ArtifactID()).
+		// The conditions are shared by the queries of revisions and tags.
+		Session(&gorm.Session{})
+
+	var revisions []models.Artifact
+	if err := op.Order("revision_create_time desc").Find(&revisions).Error; err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	tagged := make(map[string]bool)
+	if len(revisions) > int(limit) {
+		var tags []models.ArtifactRevisionTag
+		if err := op.Find(&tags).Error; err != nil {
 			return status.Error(codes.Internal, err.Error())
 		}
+		for _, tag := range tags {
+			tagged[tag.RevisionID] = true
+		}
 	}
 
+	for i := int(limit); i < len(revisions); i++ {
+		if tagged[revisions[i].RevisionID] {
+			continue
+		}
+		err := c.db.Transaction(func(tx *gorm.DB) error {
+			op := tx.Unscoped().Where("key = ?", revisions[i].Key)
+			if err := c.releaseBlobs(op, models.Artifact{}); err != nil {
+				return err
+			}
+			return op.Delete(models.Artifact{}).Error
+		})
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// releaseBlobs removes the blob references held by the spec and artifact revisions that match a query.
+// Blobs are deleted with their last reference. It should be called before the revisions are deleted
+// and has no effect on models that don't reference blobs.
+// In a transaction, the contents of deleted blobs are deleted from the blob store after it is committed.
+func (c *Client) releaseBlobs(op *gorm.DB, model interface{}) error {
+	switch model.(type) {
+	case models.Spec, models.Artifact:
+	default:
+		return nil
+	}
+
+	var refs []struct {
+		BlobHash string
+		Count    int64
+	}
+	if err := op.Session(&gorm.Session{}).Model(model).Select("blob_hash, count(*) AS count").Group("blob_hash").Scan(&refs).Error; err != nil {
+		return err
+	}
+	if len(refs) == 0 {
+		return nil
+	}
+
+	db := op.Session(&gorm.Session{NewDB: true})
+	hashes := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		err := db.Model(&models.Blob{}).
+			Where("hash = ?", ref.BlobHash).
+			UpdateColumn("ref_count", gorm.Expr("ref_count - ?", ref.Count)).Error
+		if err != nil {
+			return err
+		}
+		hashes = append(hashes, ref.BlobHash)
+	}
+
+	var unreferenced []string
+	if err := db.Model(&models.Blob{}).Where("hash IN ?", hashes).Where("ref_count <= 0").Pluck("hash", &unreferenced).Error; err != nil {
+		return err
+	}
+	if len(unreferenced) == 0 {
+		return nil
+	}
+	if err := db.Where("hash IN ?", unreferenced).Delete(&models.Blob{}).Error; err != nil {
+		return err
+	}
+
+	if c.released != nil {
+		for _, hash := range unreferenced {
+			// Empty contents aren't stored.
+			if hash != "" {
+				c.released.hashes = append(c.released.hashes, hash)
+			}
+		}
+	}
 	return nil
 }
@@ -0,0 +1,203 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/apigee/registry/server/registry/internal/storage/filtering"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ClaimEvents returns up to limit undelivered events that are due for delivery at a given time, in the order they were recorded.
+// Events that have failed maxAttempts times are skipped until they are replayed. The next attempts of the returned events
+// are postponed by lease, so other servers don't claim them while they are delivered. If a server stops before it records
+// the outcome of a delivery, its events are claimed again when the lease expires.
+func (c *Client) ClaimEvents(ctx context.Context, now time.Time, lease time.Duration, maxAttempts int32, limit int) ([]models.Event, error) {
+	var events []models.Event
+	err := c.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		op := tx.Where("delivered = ?", false).
+			Where("attempts < ?", maxAttempts).
+			Where("next_attempt_time <= ?", now.UTC()).
+			Order("id").
+			Limit(limit)
+		// Concurrent claims skip the events that are being claimed instead of waiting for them.
+		// SQLite doesn't support row locks, but it serializes the transactions that write.
+		if c.db.Dialector.Name() == "postgres" {
+			op = op.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"})
+		}
+
+		if err := op.Find(&events).Error; err != nil || len(events) == 0 {
+			return err
+		}
+
+		ids := make([]int64, len(events))
+		for i, e := range events {
+			ids[i] = e.ID
+		}
+		return tx.Model(&models.Event{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_time", now.Add(lease).UTC()).Error
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return events, nil
+}
+
+// EventsAfter returns up to limit events that were recorded after the event with a given ID, in the order they were recorded,
+// followed by the events with the listed IDs. Events are returned whether or not they were delivered.
+func (c *Client) EventsAfter(ctx context.Context, after int64, ids []int64, limit int) ([]models.Event, error) {
+	op := c.db.Where("id > ?", after)
+	if len(ids) > 0 {
+		op = op.Or("id IN ?", ids)
+	}
+	op = op.Order("id").Limit(limit)
+
+	lock()
+	defer unlock()
+
+	var events []models.Event
+	if err := op.Find(&events).Error; err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return events, nil
+}
+
+// LastEventID returns the ID of the most recently recorded event, or zero if there are no events.
+func (c *Client) LastEventID(ctx context.Context) (int64, error) {
+	var id int64
+	lock()
+	err := c.db.Model(&models.Event{}).Select("COALESCE(MAX(id), 0)").Scan(&id).Error
+	unlock()
+	if err != nil {
+		return 0, status.Error(codes.Internal, err.Error())
+	}
+	return id, nil
+}
+
+// DeliverEvents marks events as delivered at a given time.
+func (c *Client) DeliverEvents(ctx context.Context, ids []int64, now time.Time) error {
+	err := c.db.Model(&models.Event{}).
+		Where("id IN ?", ids).
+		Updates(map[string]interface{}{
+			"delivered":    true,
+			"deliver_time": now.UTC(),
+			"last_error":   "",
+		}).Error
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// FailEvent records a failed attempt to deliver an event and schedules the next attempt.
+func (c *Client) FailEvent(ctx context.Context, v *models.Event, reason string, next time.Time) error {
+	v.Attempts++
+	v.LastError = reason
+	v.NextAttemptTime = next.UTC()
+	err := c.db.Model(v).
+		Select("attempts", "last_error", "next_attempt_time").
+		Updates(v).Error
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// ReplayEvents schedules the events that match a filter for immediate delivery and returns the number of events scheduled.
+// Delivered events are only replayed if includeDelivered is true.
+func (c *Client) ReplayEvents(ctx context.Context, filter string, includeDelivered bool) (int64, error) {
+	f, err := filtering.NewFilter(filter, eventFields)
+	if err != nil {
+		return 0, err
+	}
+
+	op := c.db.Model(&models.Event{})
+	if !includeDelivered {
+		op = op.Where("delivered = ?", false)
+	}
+	cond := f.SQL(c.db.Dialector.Name())
+	if cond.Query != "" {
+		op = op.Where(cond.Query, cond.Args...)
+	}
+
+	var events []models.Event
+	lock()
+	err = op.Find(&events).Error
+	unlock()
+	if err != nil {
+		return 0, status.Error(codes.Internal, err.Error())
+	}
+
+	ids := make([]int64, 0, len(events))
+	for _, event := range events {
+		if !cond.Exact {
+			match, err := f.Matches(eventMap(event))
+			if err != nil {
+				return 0, err
+			} else if !match {
+				continue
+			}
+		}
+		ids = append(ids, event.ID)
+	}
+
+	err = c.db.Transaction(func(tx *gorm.DB) error {
+		// Limit the number of parameters in each statement.
+		for start := 0; start < len(ids); start += 1000 {
+			end := start + 1000
+			if end > len(ids) {
+				end = len(ids)
+			}
+
+			err := tx.Model(&models.Event{}).
+				Where("id IN ?", ids[start:end]).
+				Updates(map[string]interface{}{
+					"delivered":         false,
+					"attempts":          0,
+					"last_error":        "",
+					"next_attempt_time": time.Now().UTC(),
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, status.Error(codes.Internal, err.Error())
+	}
+
+	return int64(len(ids)), nil
+}
+
+// PurgeEvents deletes events that were delivered before a given time and returns the number of events deleted.
+// The last recorded event is kept so that the IDs of later events, which servers follow, are never reused.
+func (c *Client) PurgeEvents(ctx context.Context, before time.Time) (int64, error) {
+	op := c.db.Where("delivered = ?", true).
+		Where("deliver_time < ?", before.UTC()).
+		Where("id < (?)", c.db.Model(&models.Event{}).Select("MAX(id)")).
+		Delete(&models.Event{})
+	if err := op.Error; err != nil {
+		return 0, status.Error(codes.Internal, err.Error())
+	}
+	return op.RowsAffected, nil
+}
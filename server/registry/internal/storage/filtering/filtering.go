@@ -36,10 +36,15 @@ const (
 type Field struct {
 	Name string
 	Type FieldType
+	// Column is the database column holding the field value.
+	// Fields without a column are only evaluated in memory.
+	Column string
 }
 
 type Filter struct {
 	program cel.Program
+	expr    *exprpb.Expr
+	fields  map[string]Field
 }
 
 func (f *Filter) Matches(model map[string]interface{}) (bool, error) {
@@ -96,5 +101,10 @@ func NewFilter(filter string, fields []Field) (Filter, error) {
 		return Filter{}, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	return Filter{program: prg}, nil
+	byName := make(map[string]Field, len(fields))
+	for _, field := range fields {
+		byName[field.Name] = field
+	}
+
+	return Filter{program: prg, expr: ast.Expr(), fields: byName}, nil
 }
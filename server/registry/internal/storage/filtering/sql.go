@@ -0,0 +1,350 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filtering
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/cel-go/common/operators"
+	"github.com/google/cel-go/common/overloads"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+)
+
+// Supported SQL dialects. These match the names of the gorm dialectors.
+const (
+	SQLite   = "sqlite"
+	Postgres = "postgres"
+)
+
+// Condition is a SQL condition derived from a filter expression.
+//
+// The condition selects every row that the filter would match, but when
+// Exact is false it may select additional rows, and the filter must still be
+// evaluated against each row that is returned. An empty Query selects all rows.
+type Condition struct {
+	Query string
+	Args  []interface{}
+	Exact bool
+}
+
+// sqlExpr is the translation of a single filter subexpression.
+type sqlExpr struct {
+	query string
+	args  []interface{}
+	exact bool
+}
+
+// SQL translates as much of the filter as possible into a condition for the
+// specified dialect. Only fields with a Column can be translated.
+func (f *Filter) SQL(dialect string) Condition {
+	if f.expr == nil {
+		return Condition{Exact: true}
+	}
+
+	t := translator{dialect: dialect, fields: f.fields}
+	e, ok := t.translate(f.expr)
+	if !ok {
+		return Condition{}
+	}
+
+	return Condition{Query: e.query, Args: e.args, Exact: e.exact}
+}
+
+type translator struct {
+	dialect string
+	fields  map[string]Field
+}
+
+func (t translator) translate(e *exprpb.Expr) (sqlExpr, bool) {
+	switch k := e.GetExprKind().(type) {
+	case *exprpb.Expr_CallExpr:
+		return t.call(k.CallExpr)
+	case *exprpb.Expr_SelectExpr:
+		// has(labels.key)
+		if k.SelectExpr.GetTestOnly() {
+			if field, ok := t.field(k.SelectExpr.GetOperand(), StringMap); ok {
+				return t.hasKey(field, k.SelectExpr.GetField()), true
+			}
+		}
+	}
+
+	return sqlExpr{}, false
+}
+
+func (t translator) call(c *exprpb.Expr_Call) (sqlExpr, bool) {
+	args := c.GetArgs()
+	switch fn := c.GetFunction(); fn {
+	case operators.LogicalAnd:
+		l, lok := t.translate(args[0])
+		r, rok := t.translate(args[1])
+		switch {
+		case lok && rok:
+			return sqlExpr{
+				query: fmt.Sprintf("(%s AND %s)", l.query, r.query),
+				args:  append(l.args, r.args...),
+				exact: l.exact && r.exact,
+			}, true
+		case lok:
+			l.exact = false
+			return l, true
+		case rok:
+			r.exact = false
+			return r, true
+		}
+	case operators.LogicalOr:
+		l, lok := t.translate(args[0])
+		r, rok := t.translate(args[1])
+		if lok && rok {
+			return sqlExpr{
+				query: fmt.Sprintf("(%s OR %s)", l.query, r.query),
+				args:  append(l.args, r.args...),
+				exact: l.exact && r.exact,
+			}, true
+		}
+	case operators.LogicalNot:
+		// The negation of an approximate condition could exclude matching rows.
+		if e, ok := t.translate(args[0]); ok && e.exact {
+			e.query = fmt.Sprintf("NOT (%s)", e.query)
+			return e, true
+		}
+	case operators.Equals, operators.NotEquals,
+		operators.Less, operators.LessEquals,
+		operators.Greater, operators.GreaterEquals:
+		return t.compare(fn, args[0], args[1])
+	case operators.In:
+		// "key" in labels
+		if key, ok := stringConst(args[0]); ok {
+			if field, ok := t.field(args[1], StringMap); ok {
+				return t.hasKey(field, key), true
+			}
+		}
+	case overloads.StartsWith, overloads.Contains:
+		field, ok := t.field(c.GetTarget(), String)
+		if !ok || len(args) != 1 {
+			break
+		}
+		s, ok := stringConst(args[0])
+		if !ok {
+			break
+		}
+		if fn == overloads.StartsWith {
+			return sqlExpr{query: t.position(field.Column) + " = 1", args: []interface{}{s}, exact: true}, true
+		}
+		return sqlExpr{query: t.position(field.Column) + " > 0", args: []interface{}{s}, exact: true}, true
+	}
+
+	return sqlExpr{}, false
+}
+
+// compare translates a comparison between a field and a constant.
+func (t translator) compare(fn string, lhs, rhs *exprpb.Expr) (sqlExpr, bool) {
+	if isConst(lhs) {
+		lhs, rhs = rhs, lhs
+		fn = reversed[fn]
+	}
+
+	// labels.key == "value" or labels["key"] == "value"
+	if field, key, ok := t.mapEntry(lhs); ok {
+		value, ok := stringConst(rhs)
+		if !ok || fn != operators.Equals {
+			return sqlExpr{}, false
+		}
+		return t.hasEntry(field, key, value), true
+	}
+
+	op, ok := comparisons[fn]
+	if !ok {
+		return sqlExpr{}, false
+	}
+
+	ident := lhs.GetIdentExpr()
+	if ident == nil {
+		return sqlExpr{}, false
+	}
+	field, ok := t.fields[ident.GetName()]
+	if !ok || field.Column == "" {
+		return sqlExpr{}, false
+	}
+
+	switch field.Type {
+	case String:
+		s, ok := stringConst(rhs)
+		if !ok {
+			return sqlExpr{}, false
+		}
+		column := field.Column
+		if t.dialect == Postgres && fn != operators.Equals && fn != operators.NotEquals {
+			// Order strings by code point, as the filter does.
+			column += ` COLLATE "C"`
+		}
+		return sqlExpr{query: fmt.Sprintf("%s %s ?", column, op), args: []interface{}{s}, exact: true}, true
+	case Int:
+		i, ok := rhs.GetConstExpr().GetConstantKind().(*exprpb.Constant_Int64Value)
+		if !ok {
+			return sqlExpr{}, false
+		}
+		return sqlExpr{query: fmt.Sprintf("%s %s ?", field.Column, op), args: []interface{}{i.Int64Value}, exact: true}, true
+	case Timestamp:
+		ts, ok := timestampConst(rhs)
+		if !ok {
+			return sqlExpr{}, false
+		}
+		if t.dialect == Postgres {
+			return sqlExpr{query: fmt.Sprintf("%s %s ?", field.Column, op), args: []interface{}{ts}, exact: true}, true
+		}
+		// SQLite stores timestamps as text with arbitrary zone offsets, so they
+		// are compared at the precision of whole seconds, which can only select
+		// additional rows if the comparison is inclusive.
+		if fn == operators.NotEquals {
+			return sqlExpr{}, false
+		}
+		if op == "<" || op == ">" {
+			op += "="
+		}
+		return sqlExpr{
+			query: fmt.Sprintf("CAST(strftime('%%s', %s) AS INTEGER) %s ?", field.Column, op),
+			args:  []interface{}{ts.Unix()},
+		}, true
+	}
+
+	return sqlExpr{}, false
+}
+
+// Labels are stored as encoded rpc.Map messages, so map lookups are
+// approximated by searching for the encoding of an entry in the stored bytes.
+
+// hasKey selects rows with a map entry that has the specified key.
+func (t translator) hasKey(field Field, key string) sqlExpr {
+	return sqlExpr{query: t.position(field.Column) + " > 0", args: []interface{}{keyBytes(key)}}
+}
+
+// hasEntry selects rows with a map entry with the specified key and value.
+// Rows without the key are also selected because a lookup of a missing key
+// is an error that must be reported when the filter is evaluated.
+func (t translator) hasEntry(field Field, key, value string) sqlExpr {
+	inner := protowire.AppendTag(nil, 1, protowire.BytesType)
+	inner = protowire.AppendString(inner, key)
+	inner = protowire.AppendTag(inner, 2, protowire.BytesType)
+	inner = protowire.AppendString(inner, value)
+	entry := protowire.AppendTag(nil, 1, protowire.BytesType)
+	entry = protowire.AppendBytes(entry, inner)
+
+	return sqlExpr{
+		query: fmt.Sprintf("(%s > 0 OR %s = 0)", t.position(field.Column), t.position(field.Column)),
+		args:  []interface{}{entry, keyBytes(key)},
+	}
+}
+
+// keyBytes returns the encoding of a map entry key followed by the tag of its value.
+func keyBytes(key string) []byte {
+	b := protowire.AppendTag(nil, 1, protowire.BytesType)
+	b = protowire.AppendString(b, key)
+	return protowire.AppendTag(b, 2, protowire.BytesType)
+}
+
+// position returns a case-sensitive expression for the index of a substring
+// in the column, or zero if it is not found. The substring is bound as an argument.
+func (t translator) position(column string) string {
+	if t.dialect == Postgres {
+		return fmt.Sprintf("position(? IN %s)", column)
+	}
+	return fmt.Sprintf("instr(%s, ?)", column)
+}
+
+// field returns the field for an identifier expression of the specified type.
+func (t translator) field(e *exprpb.Expr, typ FieldType) (Field, bool) {
+	ident := e.GetIdentExpr()
+	if ident == nil {
+		return Field{}, false
+	}
+	field, ok := t.fields[ident.GetName()]
+	if !ok || field.Type != typ || field.Column == "" {
+		return Field{}, false
+	}
+	return field, true
+}
+
+// mapEntry returns the field and key for expressions like labels.key and labels["key"].
+func (t translator) mapEntry(e *exprpb.Expr) (Field, string, bool) {
+	if sel := e.GetSelectExpr(); sel != nil && !sel.GetTestOnly() {
+		if field, ok := t.field(sel.GetOperand(), StringMap); ok {
+			return field, sel.GetField(), true
+		}
+	}
+	if call := e.GetCallExpr(); call != nil && call.GetFunction() == operators.Index {
+		if field, ok := t.field(call.GetArgs()[0], StringMap); ok {
+			if key, ok := stringConst(call.GetArgs()[1]); ok {
+				return field, key, true
+			}
+		}
+	}
+	return Field{}, "", false
+}
+
+var comparisons = map[string]string{
+	operators.Equals:        "=",
+	operators.NotEquals:     "<>",
+	operators.Less:          "<",
+	operators.LessEquals:    "<=",
+	operators.Greater:       ">",
+	operators.GreaterEquals: ">=",
+}
+
+// reversed maps comparisons to their equivalents with swapped operands.
+var reversed = map[string]string{
+	operators.Equals:        operators.Equals,
+	operators.NotEquals:     operators.NotEquals,
+	operators.Less:          operators.Greater,
+	operators.LessEquals:    operators.GreaterEquals,
+	operators.Greater:       operators.Less,
+	operators.GreaterEquals: operators.LessEquals,
+}
+
+func isConst(e *exprpb.Expr) bool {
+	if e.GetConstExpr() != nil {
+		return true
+	}
+	_, ok := timestampConst(e)
+	return ok
+}
+
+func stringConst(e *exprpb.Expr) (string, bool) {
+	s, ok := e.GetConstExpr().GetConstantKind().(*exprpb.Constant_StringValue)
+	if !ok {
+		return "", false
+	}
+	return s.StringValue, true
+}
+
+// timestampConst returns the value of expressions like timestamp("2021-01-01T00:00:00Z").
+func timestampConst(e *exprpb.Expr) (time.Time, bool) {
+	call := e.GetCallExpr()
+	if call == nil || call.GetFunction() != overloads.TypeConvertTimestamp || len(call.GetArgs()) != 1 {
+		return time.Time{}, false
+	}
+	s, ok := stringConst(call.GetArgs()[0])
+	if !ok {
+		return time.Time{}, false
+	}
+	// Invalid timestamps are left for the filter to report.
+	ts, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return ts, true
+}
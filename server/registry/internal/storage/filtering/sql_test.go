@@ -0,0 +1,130 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filtering
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFilter_SQL(t *testing.T) {
+	fields := []Field{
+		{Name: "s", Type: String, Column: "s_col"},
+		{Name: "i", Type: Int, Column: "i_col"},
+		{Name: "t", Type: Timestamp, Column: "t_col"},
+		{Name: "m", Type: StringMap, Column: "m_col"},
+		{Name: "memory", Type: String},
+	}
+	ts := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		desc    string
+		filter  string
+		dialect string
+		want    Condition
+	}{
+		{
+			desc:    "empty filter",
+			filter:  "",
+			dialect: SQLite,
+			want:    Condition{Exact: true},
+		},
+		{
+			desc:    "equal to String",
+			filter:  `s == "x"`,
+			dialect: SQLite,
+			want:    Condition{Query: "s_col = ?", Args: []interface{}{"x"}, Exact: true},
+		},
+		{
+			desc:    "constant on the left",
+			filter:  `10 < i`,
+			dialect: SQLite,
+			want:    Condition{Query: "i_col > ?", Args: []interface{}{int64(10)}, Exact: true},
+		},
+		{
+			desc:    "less than String on postgres",
+			filter:  `s < "x"`,
+			dialect: Postgres,
+			want:    Condition{Query: `s_col COLLATE "C" < ?`, Args: []interface{}{"x"}, Exact: true},
+		},
+		{
+			desc:    "startsWith and contains",
+			filter:  `s.startsWith("a") || !s.contains("b")`,
+			dialect: SQLite,
+			want:    Condition{Query: "(instr(s_col, ?) = 1 OR NOT (instr(s_col, ?) > 0))", Args: []interface{}{"a", "b"}, Exact: true},
+		},
+		{
+			desc:    "less than Timestamp on postgres",
+			filter:  `t < timestamp("2021-01-01T00:00:00Z")`,
+			dialect: Postgres,
+			want:    Condition{Query: "t_col < ?", Args: []interface{}{ts}, Exact: true},
+		},
+		{
+			desc:    "less than Timestamp on sqlite",
+			filter:  `t < timestamp("2021-01-01T00:00:00Z")`,
+			dialect: SQLite,
+			want:    Condition{Query: "CAST(strftime('%s', t_col) AS INTEGER) <= ?", Args: []interface{}{ts.Unix()}},
+		},
+		{
+			desc:    "key in StringMap",
+			filter:  `"k" in m`,
+			dialect: Postgres,
+			want:    Condition{Query: "position(? IN m_col) > 0", Args: []interface{}{[]byte("\x0a\x01k\x12")}},
+		},
+		{
+			desc:    "equal to StringMap value",
+			filter:  `m.k == "v"`,
+			dialect: SQLite,
+			want: Condition{
+				Query: "(instr(m_col, ?) > 0 OR instr(m_col, ?) = 0)",
+				Args:  []interface{}{[]byte("\x0a\x06\x0a\x01k\x12\x01v"), []byte("\x0a\x01k\x12")},
+			},
+		},
+		{
+			desc:    "conjunction with an untranslated field",
+			filter:  `s == "x" && memory == "y"`,
+			dialect: SQLite,
+			want:    Condition{Query: "s_col = ?", Args: []interface{}{"x"}},
+		},
+		{
+			desc:    "disjunction with an untranslated field",
+			filter:  `s == "x" || memory == "y"`,
+			dialect: SQLite,
+			want:    Condition{},
+		},
+		{
+			desc:    "negation of an inexact condition",
+			filter:  `!has(m.k)`,
+			dialect: SQLite,
+			want:    Condition{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			filter, err := NewFilter(test.filter, fields)
+			if err != nil {
+				t.Fatalf("NewFilter(%q) returned error: %s", test.filter, err)
+			}
+
+			got := filter.SQL(test.dialect)
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("SQL(%q) returned unexpected diff (-want +got):\n%s", test.dialect, diff)
+			}
+		})
+	}
+}
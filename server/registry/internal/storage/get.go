@@ -64,10 +64,10 @@ func (c *Client) GetSpec(ctx context.Context, name names.Spec) (*models.Spec, er
 		Where("api_id = ?", name.ApiID).
 		Where("version_id = ?", name.VersionID).
 		Where("spec_id = ?", name.SpecID).
-		Order("revision_create_time")
+		Order("revision_create_time desc")
 
 	v := new(models.Spec)
-	if err := op.Last(v).Error; err == gorm.ErrRecordNotFound {
+	if err := op.First(v).Error; err == gorm.ErrRecordNotFound {
 		return nil, status.Errorf(codes.NotFound, "%q not found in database", name)
 	} else if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -93,19 +93,12 @@ func (c *Client) GetSpecRevision(ctx context.Context, name names.SpecRevision) (
 }
 
 func (c *Client) GetSpecRevisionContents(ctx context.Context, name names.SpecRevision) (*models.Blob, error) {
-	name, err := c.unwrapSpecRevisionTag(ctx, name)
+	revision, err := c.GetSpecRevision(ctx, name)
 	if err != nil {
 		return nil, err
 	}
 
-	v := new(models.Blob)
-	if err := c.db.First(v, "key = ?", name.String()).Error; err == gorm.ErrRecordNotFound {
-		return nil, status.Errorf(codes.NotFound, "%q not found in database", name)
-	} else if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
-
-	return v, nil
+	return c.getBlob(ctx, revision.BlobHash, name.String())
 }
 
 func (c *Client) GetDeployment(ctx context.Context, name names.Deployment) (*models.Deployment, error) {
@@ -114,10 +107,10 @@ func (c *Client) GetDeployment(ctx context.Context, name names.Deployment) (*mod
 		Where("project_id = ?", name.ProjectID).
 		Where("api_id = ?", name.ApiID).
 		Where("deployment_id = ?", name.DeploymentID).
-		Order("revision_create_time")
+		Order("revision_create_time desc")
 
 	v := new(models.Deployment)
-	if err := op.Last(v).Error; err == gorm.ErrRecordNotFound {
+	if err := op.First(v).Error; err == gorm.ErrRecordNotFound {
 		return nil, status.Errorf(codes.NotFound, "%q not found in database", name)
 	} else if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -143,8 +136,18 @@ func (c *Client) GetDeploymentRevision(ctx context.Context, name names.Deploymen
 }
 
 func (c *Client) GetArtifact(ctx context.Context, name names.Artifact) (*models.Artifact, error) {
+	name = name.Normal()
+	op := c.db.
+		Where("project_id = ?", name.ProjectID()).
+		Where("api_id = ?", name.ApiID()).
+		Where("version_id = ?", name.VersionID()).
+		Where("spec_id = ?", name.SpecID()).
+		Where("deployment_id = ?", name.DeploymentID()).
+		Where("artifact_id = ?", name.ArtifactID()).
+		Order("revision_create_time desc")
+
 	v := new(models.Artifact)
-	if err := c.db.First(v, "key = ?", name.String()).Error; err == gorm.ErrRecordNotFound {
+	if err := op.First(v).Error; err == gorm.ErrRecordNotFound {
 		return nil, status.Errorf(codes.NotFound, "%q not found in database", name)
 	} else if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -153,8 +156,13 @@ func (c *Client) GetArtifact(ctx context.Context, name names.Artifact) (*models.
 	return v, nil
 }
 
-func (c *Client) GetArtifactContents(ctx context.Context, name names.Artifact) (*models.Blob, error) {
-	v := new(models.Blob)
+func (c *Client) GetArtifactRevision(ctx context.Context, name names.ArtifactRevision) (*models.Artifact, error) {
+	name, err := c.unwrapArtifactRevisionTag(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	v := new(models.Artifact)
 	if err := c.db.First(v, "key = ?", name.String()).Error; err == gorm.ErrRecordNotFound {
 		return nil, status.Errorf(codes.NotFound, "%q not found in database", name)
 	} else if err != nil {
@@ -163,3 +171,28 @@ func (c *Client) GetArtifactContents(ctx context.Context, name names.Artifact) (
 
 	return v, nil
 }
+
+func (c *Client) GetArtifactRevisionContents(ctx context.Context, name names.ArtifactRevision) (*models.Blob, error) {
+	revision, err := c.GetArtifactRevision(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.getBlob(ctx, revision.BlobHash, name.String())
+}
+
+// getBlob returns the blob with the specified hash, which holds the contents of the named resource.
+func (c *Client) getBlob(ctx context.Context, hash, name string) (*models.Blob, error) {
+	v := new(models.Blob)
+	if err := c.db.First(v, "hash = ?", hash).Error; err == gorm.ErrRecordNotFound {
+		return nil, status.Errorf(codes.NotFound, "contents of %q not found in database", name)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if err := c.getBlobContents(ctx, v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
@@ -32,12 +32,12 @@ type ProjectList struct {
 }
 
 var projectFields = []filtering.Field{
-	{Name: "name", Type: filtering.String},
-	{Name: "project_id", Type: filtering.String},
-	{Name: "display_name", Type: filtering.String},
-	{Name: "description", Type: filtering.String},
-	{Name: "create_time", Type: filtering.Timestamp},
-	{Name: "update_time", Type: filtering.Timestamp},
+	{Name: "name", Type: filtering.String, Column: "key"},
+	{Name: "project_id", Type: filtering.String, Column: "project_id"},
+	{Name: "display_name", Type: filtering.String, Column: "display_name"},
+	{Name: "description", Type: filtering.String, Column: "description"},
+	{Name: "create_time", Type: filtering.Timestamp, Column: "create_time"},
+	{Name: "update_time", Type: filtering.Timestamp, Column: "update_time"},
 }
 
 func (c *Client) ListProjects(ctx context.Context, opts PageOptions) (ProjectList, error) {
@@ -52,36 +52,59 @@ func (c *Client) ListProjects(ctx context.Context, opts PageOptions) (ProjectLis
 		token.Filter = opts.Filter
 	}
 
+	if err := token.ValidateOrder(opts.Order); err != nil {
+		return ProjectList{}, status.Errorf(codes.InvalidArgument, "invalid order_by %q: %s", opts.Order, err)
+	} else {
+		token.Order = opts.Order
+	}
+
 	filter, err := filtering.NewFilter(opts.Filter, projectFields)
 	if err != nil {
 		return ProjectList{}, err
 	}
 
+	order, err := parseOrder(opts.Order, projectFields)
+	if err != nil {
+		return ProjectList{}, status.Errorf(codes.InvalidArgument, "invalid order_by %q: %s", opts.Order, err)
+	}
+
+	db := c.listDB(opts)
+	if opts.ProjectIDs != nil {
+		// Projects are restricted before pagination, so pages are full when more projects follow them.
+		db = db.Where("project_id IN ?", opts.ProjectIDs)
+	}
+	op, verify := c.applyFilter(db, filter, opts)
+	op, err = paginate(op, order, token)
+	if err != nil {
+		return ProjectList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err)
+	}
+
 	lock()
 	var projects []models.Project
-	_ = c.db.
-		Order("key").
-		Offset(token.Offset).
-		Limit(100000).
-		Find(&projects).Error
+	err = op.Find(&projects).Error
 	unlock()
+	if err != nil {
+		return ProjectList{}, status.Error(codes.Internal, err.Error())
+	}
 
 	response := ProjectList{
 		Projects: make([]models.Project, 0, opts.Size),
 	}
 
 	for _, project := range projects {
-		match, err := filter.Matches(projectMap(project))
-		if err != nil {
-			return response, err
-		} else if !match {
-			token.Offset++
-			continue
+		projectMap := projectMap(project)
+		if verify {
+			match, err := filter.Matches(projectMap)
+			if err != nil {
+				return response, err
+			} else if !match {
+				continue
+			}
 		}
 
 		if len(response.Projects) < int(opts.Size) {
 			response.Projects = append(response.Projects, project)
-			token.Offset++
+			token.Last = position(order, project.Key, projectMap)
 		} else if len(response.Projects) == int(opts.Size) {
 			response.Token, err = encodeToken(token)
 			if err != nil {
@@ -92,9 +115,72 @@ func (c *Client) ListProjects(ctx context.Context, opts PageOptions) (ProjectLis
 
 	}
 
+	if response.Token == "" {
+		response.Token, err = scanToken(verify, len(projects), token, func() ([]interface{}, error) {
+			last := projects[len(projects)-1]
+			return position(order, last.Key, projectMap(last)), nil
+		})
+		if err != nil {
+			return response, status.Error(codes.Internal, err.Error())
+		}
+	}
+
 	return response, nil
 }
 
+// maxFilteredRows is the number of rows that are read for a page of a listing
+// with a filter that the database can't fully evaluate.
+const maxFilteredRows = 100000
+
+// applyFilter adds the parts of a filter that can be evaluated by the database
+// to a query and limits the query to the rows needed for a page of results.
+// It returns true if the rows that are found must still be matched against the filter.
+func (c *Client) applyFilter(op *gorm.DB, filter filtering.Filter, opts PageOptions) (*gorm.DB, bool) {
+	cond := filter.SQL(c.db.Dialector.Name())
+	if cond.Query != "" {
+		op = op.Where(cond.Query, cond.Args...)
+	}
+
+	if !cond.Exact {
+		return op.Limit(maxFilteredRows), true
+	}
+
+	// One more row than the page size shows whether there is another page.
+	return op.Limit(int(opts.Size) + 1), false
+}
+
+// scanToken returns a token for the next page of a filtered listing that read
+// every row it could without filling the page. The next page resumes after the last row that was read.
+func scanToken(verify bool, rows int, t token, last func() ([]interface{}, error)) (string, error) {
+	if !verify || rows < maxFilteredRows {
+		return "", nil
+	}
+	var err error
+	if t.Last, err = last(); err != nil {
+		return "", err
+	}
+	return encodeToken(t)
+}
+
+// listDB returns the database handle for a listing, which finds deleted resources if they are shown.
+func (c *Client) listDB(opts PageOptions) *gorm.DB {
+	if opts.ShowDeleted {
+		return c.db.Unscoped()
+	}
+	return c.db
+}
+
+// withoutDeleted excludes the deleted rows of a table from a query unless they are shown.
+// It is needed for queries of tables that aren't scoped by a model.
+func withoutDeleted(table string, opts PageOptions) func(*gorm.DB) *gorm.DB {
+	return func(op *gorm.DB) *gorm.DB {
+		if opts.ShowDeleted {
+			return op
+		}
+		return op.Where(table + ".delete_time IS NULL")
+	}
+}
+
 func projectMap(p models.Project) map[string]interface{} {
 	return map[string]interface{}{
 		"name":         p.Name(),
@@ -113,16 +199,16 @@ type ApiList struct {
 }
 
 var apiFields = []filtering.Field{
-	{Name: "name", Type: filtering.String},
-	{Name: "project_id", Type: filtering.String},
-	{Name: "api_id", Type: filtering.String},
-	{Name: "display_name", Type: filtering.String},
-	{Name: "description", Type: filtering.String},
-	{Name: "create_time", Type: filtering.Timestamp},
-	{Name: "update_time", Type: filtering.Timestamp},
-	{Name: "availability", Type: filtering.String},
-	{Name: "recommended_version", Type: filtering.String},
-	{Name: "labels", Type: filtering.StringMap},
+	{Name: "name", Type: filtering.String, Column: "key"},
+	{Name: "project_id", Type: filtering.String, Column: "project_id"},
+	{Name: "api_id", Type: filtering.String, Column: "api_id"},
+	{Name: "display_name", Type: filtering.String, Column: "display_name"},
+	{Name: "description", Type: filtering.String, Column: "description"},
+	{Name: "create_time", Type: filtering.Timestamp, Column: "create_time"},
+	{Name: "update_time", Type: filtering.Timestamp, Column: "update_time"},
+	{Name: "availability", Type: filtering.String, Column: "availability"},
+	{Name: "recommended_version", Type: filtering.String, Column: "recommended_version"},
+	{Name: "labels", Type: filtering.StringMap, Column: "labels"},
 }
 
 func (c *Client) ListApis(ctx context.Context, parent names.Project, opts PageOptions) (ApiList, error) {
@@ -137,10 +223,13 @@ func (c *Client) ListApis(ctx context.Context, parent names.Project, opts PageOp
 		token.Filter = opts.Filter
 	}
 
-	op := c.db.
-		Order("key").
-		Offset(token.Offset).
-		Limit(100000)
+	if err := token.ValidateOrder(opts.Order); err != nil {
+		return ApiList{}, status.Errorf(codes.InvalidArgument, "invalid order_by %q: %s", opts.Order, err)
+	} else {
+		token.Order = opts.Order
+	}
+
+	op := c.listDB(opts)
 
 	if parent.ProjectID != "-" {
 		op = op.Where("project_id = ?", parent.ProjectID)
@@ -154,10 +243,24 @@ func (c *Client) ListApis(ctx context.Context, parent names.Project, opts PageOp
 		return ApiList{}, err
 	}
 
+	order, err := parseOrder(opts.Order, apiFields)
+	if err != nil {
+		return ApiList{}, status.Errorf(codes.InvalidArgument, "invalid order_by %q: %s", opts.Order, err)
+	}
+
+	op, verify := c.applyFilter(op, filter, opts)
+	op, err = paginate(op, order, token)
+	if err != nil {
+		return ApiList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err)
+	}
+
 	lock()
 	var apis []models.Api
-	_ = op.Find(&apis).Error
+	err = op.Find(&apis).Error
 	unlock()
+	if err != nil {
+		return ApiList{}, status.Error(codes.Internal, err.Error())
+	}
 
 	response := ApiList{
 		Apis: make([]models.Api, 0, opts.Size),
@@ -169,17 +272,18 @@ func (c *Client) ListApis(ctx context.Context, parent names.Project, opts PageOp
 			return response, status.Error(codes.Internal, err.Error())
 		}
 
-		match, err := filter.Matches(apiMap)
-		if err != nil {
-			return response, err
-		} else if !match {
-			token.Offset++
-			continue
+		if verify {
+			match, err := filter.Matches(apiMap)
+			if err != nil {
+				return response, err
+			} else if !match {
+				continue
+			}
 		}
 
 		if len(response.Apis) < int(opts.Size) {
 			response.Apis = append(response.Apis, api)
-			token.Offset++
+			token.Last = position(order, api.Key, apiMap)
 		} else if len(response.Apis) == int(opts.Size) {
 			response.Token, err = encodeToken(token)
 			if err != nil {
@@ -190,6 +294,17 @@ func (c *Client) ListApis(ctx context.Context, parent names.Project, opts PageOp
 
 	}
 
+	if response.Token == "" {
+		response.Token, err = scanToken(verify, len(apis), token, func() ([]interface{}, error) {
+			last := apis[len(apis)-1]
+			values, err := apiMap(last)
+			return position(order, last.Key, values), err
+		})
+		if err != nil {
+			return response, status.Error(codes.Internal, err.Error())
+		}
+	}
+
 	return response, nil
 }
 
@@ -220,16 +335,16 @@ type VersionList struct {
 }
 
 var versionFields = []filtering.Field{
-	{Name: "name", Type: filtering.String},
-	{Name: "project_id", Type: filtering.String},
-	{Name: "api_id", Type: filtering.String},
-	{Name: "version_id", Type: filtering.String},
-	{Name: "display_name", Type: filtering.String},
-	{Name: "description", Type: filtering.String},
-	{Name: "create_time", Type: filtering.Timestamp},
-	{Name: "update_time", Type: filtering.Timestamp},
-	{Name: "state", Type: filtering.String},
-	{Name: "labels", Type: filtering.StringMap},
+	{Name: "name", Type: filtering.String, Column: "key"},
+	{Name: "project_id", Type: filtering.String, Column: "project_id"},
+	{Name: "api_id", Type: filtering.String, Column: "api_id"},
+	{Name: "version_id", Type: filtering.String, Column: "version_id"},
+	{Name: "display_name", Type: filtering.String, Column: "display_name"},
+	{Name: "description", Type: filtering.String, Column: "description"},
+	{Name: "create_time", Type: filtering.Timestamp, Column: "create_time"},
+	{Name: "update_time", Type: filtering.Timestamp, Column: "update_time"},
+	{Name: "state", Type: filtering.String, Column: "state"},
+	{Name: "labels", Type: filtering.StringMap, Column: "labels"},
 }
 
 func (c *Client) ListVersions(ctx context.Context, parent names.Api, opts PageOptions) (VersionList, error) {
@@ -244,6 +359,12 @@ func (c *Client) ListVersions(ctx context.Context, parent names.Api, opts PageOp
 		token.Filter = opts.Filter
 	}
 
+	if err := token.ValidateOrder(opts.Order); err != nil {
+		return VersionList{}, status.Errorf(codes.InvalidArgument, "invalid order_by %q: %s", opts.Order, err)
+	} else {
+		token.Order = opts.Order
+	}
+
 	if parent.ProjectID != "-" && parent.ApiID != "-" {
 		if _, err := c.GetApi(ctx, parent); err != nil {
 			return VersionList{}, err
@@ -259,10 +380,12 @@ func (c *Client) ListVersions(ctx context.Context, parent names.Api, opts PageOp
 		return VersionList{}, err
 	}
 
-	op := c.db.
-		Order("key").
-		Offset(token.Offset).
-		Limit(100000)
+	order, err := parseOrder(opts.Order, versionFields)
+	if err != nil {
+		return VersionList{}, status.Errorf(codes.InvalidArgument, "invalid order_by %q: %s", opts.Order, err)
+	}
+
+	op := c.listDB(opts)
 
 	if parent.ProjectID != "-" {
 		op = op.Where("project_id = ?", parent.ProjectID)
@@ -271,10 +394,19 @@ func (c *Client) ListVersions(ctx context.Context, parent names.Api, opts PageOp
 		op = op.Where("api_id = ?", parent.ApiID)
 	}
 
+	op, verify := c.applyFilter(op, filter, opts)
+	op, err = paginate(op, order, token)
+	if err != nil {
+		return VersionList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err)
+	}
+
 	lock()
 	var versions []models.Version
-	_ = op.Find(&versions).Error
+	err = op.Find(&versions).Error
 	unlock()
+	if err != nil {
+		return VersionList{}, status.Error(codes.Internal, err.Error())
+	}
 
 	response := VersionList{
 		Versions: make([]models.Version, 0, opts.Size),
@@ -286,17 +418,18 @@ func (c *Client) ListVersions(ctx context.Context, parent names.Api, opts PageOp
 			return response, status.Error(codes.Internal, err.Error())
 		}
 
-		match, err := filter.Matches(versionMap)
-		if err != nil {
-			return response, err
-		} else if !match {
-			token.Offset++
-			continue
+		if verify {
+			match, err := filter.Matches(versionMap)
+			if err != nil {
+				return response, err
+			} else if !match {
+				continue
+			}
 		}
 
 		if len(response.Versions) < int(opts.Size) {
 			response.Versions = append(response.Versions, version)
-			token.Offset++
+			token.Last = position(order, version.Key, versionMap)
 		} else if len(response.Versions) == int(opts.Size) {
 			response.Token, err = encodeToken(token)
 			if err != nil {
@@ -306,6 +439,17 @@ func (c *Client) ListVersions(ctx context.Context, parent names.Api, opts PageOp
 		}
 	}
 
+	if response.Token == "" {
+		response.Token, err = scanToken(verify, len(versions), token, func() ([]interface{}, error) {
+			last := versions[len(versions)-1]
+			values, err := versionMap(last)
+			return position(order, last.Key, values), err
+		})
+		if err != nil {
+			return response, status.Error(codes.Internal, err.Error())
+		}
+	}
+
 	return response, nil
 }
 
@@ -318,6 +462,7 @@ func versionMap(version models.Version) (map[string]interface{}, error) {
 	return map[string]interface{}{
 		"name":         version.Name(),
 		"project_id":   version.ProjectID,
+		"api_id":       version.ApiID,
 		"version_id":   version.VersionID,
 		"display_name": version.DisplayName,
 		"description":  version.Description,
@@ -336,19 +481,19 @@ type SpecList struct {
 
 var specFields = []filtering.Field{
 	{Name: "name", Type: filtering.String},
-	{Name: "project_id", Type: filtering.String},
-	{Name: "api_id", Type: filtering.String},
-	{Name: "version_id", Type: filtering.String},
-	{Name: "spec_id", Type: filtering.String},
-	{Name: "filename", Type: filtering.String},
-	{Name: "description", Type: filtering.String},
-	{Name: "create_time", Type: filtering.Timestamp},
-	{Name: "revision_create_time", Type: filtering.Timestamp},
-	{Name: "revision_update_time", Type: filtering.Timestamp},
-	{Name: "mime_type", Type: filtering.String},
-	{Name: "size_bytes", Type: filtering.Int},
-	{Name: "source_uri", Type: filtering.String},
-	{Name: "labels", Type: filtering.StringMap},
+	{Name: "project_id", Type: filtering.String, Column: "specs.project_id"},
+	{Name: "api_id", Type: filtering.String, Column: "specs.api_id"},
+	{Name: "version_id", Type: filtering.String, Column: "specs.version_id"},
+	{Name: "spec_id", Type: filtering.String, Column: "specs.spec_id"},
+	{Name: "filename", Type: filtering.String, Column: "specs.file_name"},
+	{Name: "description", Type: filtering.String, Column: "specs.description"},
+	{Name: "create_time", Type: filtering.Timestamp, Column: "specs.create_time"},
+	{Name: "revision_create_time", Type: filtering.Timestamp, Column: "specs.revision_create_time"},
+	{Name: "revision_update_time", Type: filtering.Timestamp, Column: "specs.revision_update_time"},
+	{Name: "mime_type", Type: filtering.String, Column: "specs.mime_type"},
+	{Name: "size_bytes", Type: filtering.Int, Column: "specs.size_in_bytes"},
+	{Name: "source_uri", Type: filtering.String, Column: "specs.source_uri"},
+	{Name: "labels", Type: filtering.StringMap, Column: "specs.labels"},
 }
 
 func (c *Client) ListSpecs(ctx context.Context, parent names.Version, opts PageOptions) (SpecList, error) {
@@ -363,6 +508,12 @@ func (c *Client) ListSpecs(ctx context.Context, parent names.Version, opts PageO
 		token.Filter = opts.Filter
 	}
 
+	if err := token.ValidateOrder(opts.Order); err != nil {
+		return SpecList{}, status.Errorf(codes.InvalidArgument, "invalid order_by %q: %s", opts.Order, err)
+	} else {
+		token.Order = opts.Order
+	}
+
 	if parent.ProjectID != "-" && parent.ApiID != "-" && parent.VersionID != "-" {
 		if _, err := c.GetVersion(ctx, parent); err != nil {
 			return SpecList{}, err
@@ -382,6 +533,11 @@ func (c *Client) ListSpecs(ctx context.Context, parent names.Version, opts PageO
 		return SpecList{}, err
 	}
 
+	order, err := parseOrder(opts.Order, specFields)
+	if err != nil {
+		return SpecList{}, status.Errorf(codes.InvalidArgument, "invalid order_by %q: %s", opts.Order, err)
+	}
+
 	// Select all columns from `specs` table specifically.
 	// We do not want to select duplicates from the joined subquery result.
 	op := c.db.Select("specs.*").
@@ -393,10 +549,9 @@ func (c *Client) ListSpecs(ctx context.Context, parent names.Version, opts PageO
 			// See: https://stackoverflow.com/questions/7745609/sql-select-only-rows-with-max-value-on-a-column
 			c.db.Select("project_id, api_id, version_id, spec_id, MAX(revision_create_time) AS recent_create_time").
 				Table("specs").
+				Scopes(withoutDeleted("specs", opts)).
 				Group("project_id, api_id, version_id, spec_id")).
-		Order("key").
-		Offset(token.Offset).
-		Limit(100000)
+		Scopes(withoutDeleted("specs", opts))
 
 	if parent.ProjectID != "-" {
 		op = op.Where("specs.project_id = ?", parent.ProjectID)
@@ -408,10 +563,19 @@ func (c *Client) ListSpecs(ctx context.Context, parent names.Version, opts PageO
 		op = op.Where("specs.version_id = ?", parent.VersionID)
 	}
 
+	op, verify := c.applyFilter(op, filter, opts)
+	op, err = paginate(op, order, token)
+	if err != nil {
+		return SpecList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err)
+	}
+
 	lock()
 	var specs []models.Spec
-	_ = op.Scan(&specs).Error
+	err = op.Scan(&specs).Error
 	unlock()
+	if err != nil {
+		return SpecList{}, status.Error(codes.Internal, err.Error())
+	}
 
 	response := SpecList{
 		Specs: make([]models.Spec, 0, opts.Size),
@@ -423,17 +587,18 @@ func (c *Client) ListSpecs(ctx context.Context, parent names.Version, opts PageO
 			return response, status.Error(codes.Internal, err.Error())
 		}
 
-		match, err := filter.Matches(specMap)
-		if err != nil {
-			return response, err
-		} else if !match {
-			token.Offset++
-			continue
+		if verify {
+			match, err := filter.Matches(specMap)
+			if err != nil {
+				return response, err
+			} else if !match {
+				continue
+			}
 		}
 
 		if len(response.Specs) < int(opts.Size) {
 			response.Specs = append(response.Specs, spec)
-			token.Offset++
+			token.Last = position(order, spec.Key, specMap)
 		} else if len(response.Specs) == int(opts.Size) {
 			response.Token, err = encodeToken(token)
 			if err != nil {
@@ -443,6 +608,17 @@ func (c *Client) ListSpecs(ctx context.Context, parent names.Version, opts PageO
 		}
 	}
 
+	if response.Token == "" {
+		response.Token, err = scanToken(verify, len(specs), token, func() ([]interface{}, error) {
+			last := specs[len(specs)-1]
+			values, err := specMap(last)
+			return position(order, last.Key, values), err
+		})
+		if err != nil {
+			return response, status.Error(codes.Internal, err.Error())
+		}
+	}
+
 	return response, nil
 }
 
@@ -472,6 +648,9 @@ func specMap(spec models.Spec) (map[string]interface{}, error) {
 	}, nil
 }
 
+// revisionOrder orders revisions from newest to oldest.
+var revisionOrder = []ordering{{Field: "revision_create_time", Column: "revision_create_time", Desc: true}, {Column: "key"}}
+
 func (c *Client) ListSpecRevisions(ctx context.Context, parent names.Spec, opts PageOptions) (SpecList, error) {
 	token, err := decodeToken(opts.Token)
 	if err != nil {
@@ -482,22 +661,30 @@ func (c *Client) ListSpecRevisions(ctx context.Context, parent names.Spec, opts
 		Specs: make([]models.Spec, 0, opts.Size),
 	}
 
-	lock()
-	_ = c.db.
+	op := c.db.
 		Where("project_id = ?", parent.ProjectID).
 		Where("api_id = ?", parent.ApiID).
 		Where("version_id = ?", parent.VersionID).
 		Where("spec_id = ?", parent.SpecID).
-		Order("revision_create_time desc").
-		Offset(token.Offset).
-		Limit(int(opts.Size) + 1).
-		Find(&response.Specs).Error
+		Limit(int(opts.Size) + 1)
+
+	op, err = paginate(op, revisionOrder, token)
+	if err != nil {
+		return SpecList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err)
+	}
+
+	lock()
+	err = op.Find(&response.Specs).Error
 	unlock()
+	if err != nil {
+		return SpecList{}, status.Error(codes.Internal, err.Error())
+	}
 
 	// Trim the response and return a page token if too many resources were found.
 	if len(response.Specs) > int(opts.Size) {
-		token.Offset += int(opts.Size)
 		response.Specs = response.Specs[:opts.Size]
+		last := response.Specs[len(response.Specs)-1]
+		token.Last = []interface{}{last.RevisionCreateTime, last.Key}
 		response.Token, err = encodeToken(token)
 		if err != nil {
 			return response, status.Error(codes.Internal, err.Error())
@@ -515,20 +702,20 @@ type DeploymentList struct {
 
 var deploymentFields = []filtering.Field{
 	{Name: "name", Type: filtering.String},
-	{Name: "project_id", Type: filtering.String},
-	{Name: "api_id", Type: filtering.String},
-	{Name: "deployment_id", Type: filtering.String},
-	{Name: "display_name", Type: filtering.String},
-	{Name: "description", Type: filtering.String},
-	{Name: "create_time", Type: filtering.Timestamp},
-	{Name: "revision_create_time", Type: filtering.Timestamp},
-	{Name: "revision_update_time", Type: filtering.Timestamp},
-	{Name: "api_spec_revision", Type: filtering.String},
-	{Name: "endpoint_uri", Type: filtering.String},
-	{Name: "external_channel_uri", Type: filtering.String},
-	{Name: "intended_audience", Type: filtering.String},
-	{Name: "access_guidance", Type: filtering.String},
-	{Name: "labels", Type: filtering.StringMap},
+	{Name: "project_id", Type: filtering.String, Column: "deployments.project_id"},
+	{Name: "api_id", Type: filtering.String, Column: "deployments.api_id"},
+	{Name: "deployment_id", Type: filtering.String, Column: "deployments.deployment_id"},
+	{Name: "display_name", Type: filtering.String, Column: "deployments.display_name"},
+	{Name: "description", Type: filtering.String, Column: "deployments.description"},
+	{Name: "create_time", Type: filtering.Timestamp, Column: "deployments.create_time"},
+	{Name: "revision_create_time", Type: filtering.Timestamp, Column: "deployments.revision_create_time"},
+	{Name: "revision_update_time", Type: filtering.Timestamp, Column: "deployments.revision_update_time"},
+	{Name: "api_spec_revision", Type: filtering.String, Column: "deployments.api_spec_revision"},
+	{Name: "endpoint_uri", Type: filtering.String, Column: "deployments.endpoint_uri"},
+	{Name: "external_channel_uri", Type: filtering.String, Column: "deployments.external_channel_uri"},
+	{Name: "intended_audience", Type: filtering.String, Column: "deployments.intended_audience"},
+	{Name: "access_guidance", Type: filtering.String, Column: "deployments.access_guidance"},
+	{Name: "labels", Type: filtering.StringMap, Column: "deployments.labels"},
 }
 
 func (c *Client) ListDeployments(ctx context.Context, parent names.Api, opts PageOptions) (DeploymentList, error) {
@@ -543,6 +730,12 @@ func (c *Client) ListDeployments(ctx context.Context, parent names.Api, opts Pag
 		token.Filter = opts.Filter
 	}
 
+	if err := token.ValidateOrder(opts.Order); err != nil {
+		return DeploymentList{}, status.Errorf(codes.InvalidArgument, "invalid order_by %q: %s", opts.Order, err)
+	} else {
+		token.Order = opts.Order
+	}
+
 	if parent.ProjectID != "-" && parent.ApiID != "-" {
 		if _, err := c.GetApi(ctx, parent); err != nil {
 			return DeploymentList{}, err
@@ -558,6 +751,11 @@ func (c *Client) ListDeployments(ctx context.Context, parent names.Api, opts Pag
 		return DeploymentList{}, err
 	}
 
+	order, err := parseOrder(opts.Order, deploymentFields)
+	if err != nil {
+		return DeploymentList{}, status.Errorf(codes.InvalidArgument, "invalid order_by %q: %s", opts.Order, err)
+	}
+
 	// Select all columns from `deployments` table specifically.
 	// We do not want to select duplicates from the joined subquery result.
 	op := c.db.Select("deployments.*").
@@ -569,10 +767,9 @@ func (c *Client) ListDeployments(ctx context.Context, parent names.Api, opts Pag
 			// See: https://stackoverflow.com/questions/7745609/sql-select-only-rows-with-max-value-on-a-column
 			c.db.Select("project_id, api_id, deployment_id, MAX(revision_create_time) AS recent_create_time").
 				Table("deployments").
+				Scopes(withoutDeleted("deployments", opts)).
 				Group("project_id, api_id, deployment_id")).
-		Order("key").
-		Offset(token.Offset).
-		Limit(100000)
+		Scopes(withoutDeleted("deployments", opts))
 
 	if parent.ProjectID != "-" {
 		op = op.Where("deployments.project_id = ?", parent.ProjectID)
@@ -581,10 +778,19 @@ func (c *Client) ListDeployments(ctx context.Context, parent names.Api, opts Pag
 		op = op.Where("deployments.api_id = ?", parent.ApiID)
 	}
 
+	op, verify := c.applyFilter(op, filter, opts)
+	op, err = paginate(op, order, token)
+	if err != nil {
+		return DeploymentList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err)
+	}
+
 	lock()
 	var deployments []models.Deployment
-	_ = op.Scan(&deployments).Error
+	err = op.Scan(&deployments).Error
 	unlock()
+	if err != nil {
+		return DeploymentList{}, status.Error(codes.Internal, err.Error())
+	}
 
 	response := DeploymentList{
 		Deployments: make([]models.Deployment, 0, opts.Size),
@@ -596,17 +802,18 @@ func (c *Client) ListDeployments(ctx context.Context, parent names.Api, opts Pag
 			return response, status.Error(codes.Internal, err.Error())
 		}
 
-		match, err := filter.Matches(deploymentMap)
-		if err != nil {
-			return response, err
-		} else if !match {
-			token.Offset++
-			continue
+		if verify {
+			match, err := filter.Matches(deploymentMap)
+			if err != nil {
+				return response, err
+			} else if !match {
+				continue
+			}
 		}
 
 		if len(response.Deployments) < int(opts.Size) {
 			response.Deployments = append(response.Deployments, deployment)
-			token.Offset++
+			token.Last = position(order, deployment.Key, deploymentMap)
 		} else if len(response.Deployments) == int(opts.Size) {
 			response.Token, err = encodeToken(token)
 			if err != nil {
@@ -616,6 +823,17 @@ func (c *Client) ListDeployments(ctx context.Context, parent names.Api, opts Pag
 		}
 	}
 
+	if response.Token == "" {
+		response.Token, err = scanToken(verify, len(deployments), token, func() ([]interface{}, error) {
+			last := deployments[len(deployments)-1]
+			values, err := deploymentMap(last)
+			return position(order, last.Key, values), err
+		})
+		if err != nil {
+			return response, status.Error(codes.Internal, err.Error())
+		}
+	}
+
 	return response, nil
 }
 
@@ -655,21 +873,29 @@ func (c *Client) ListDeploymentRevisions(ctx context.Context, parent names.Deplo
 		Deployments: make([]models.Deployment, 0, opts.Size),
 	}
 
-	lock()
-	_ = c.db.
+	op := c.db.
 		Where("project_id = ?", parent.ProjectID).
 		Where("api_id = ?", parent.ApiID).
 		Where("deployment_id = ?", parent.DeploymentID).
-		Order("revision_create_time desc").
-		Offset(token.Offset).
-		Limit(int(opts.Size) + 1).
-		Find(&response.Deployments).Error
+		Limit(int(opts.Size) + 1)
+
+	op, err = paginate(op, revisionOrder, token)
+	if err != nil {
+		return DeploymentList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err)
+	}
+
+	lock()
+	err = op.Find(&response.Deployments).Error
 	unlock()
+	if err != nil {
+		return DeploymentList{}, status.Error(codes.Internal, err.Error())
+	}
 
 	// Trim the response and return a page token if too many resources were found.
 	if len(response.Deployments) > int(opts.Size) {
-		token.Offset += int(opts.Size)
 		response.Deployments = response.Deployments[:opts.Size]
+		last := response.Deployments[len(response.Deployments)-1]
+		token.Last = []interface{}{last.RevisionCreateTime, last.Key}
 		response.Token, err = encodeToken(token)
 		if err != nil {
 			return response, status.Error(codes.Internal, err.Error())
@@ -687,15 +913,18 @@ type ArtifactList struct {
 
 var artifactFields = []filtering.Field{
 	{Name: "name", Type: filtering.String},
-	{Name: "project_id", Type: filtering.String},
-	{Name: "api_id", Type: filtering.String},
-	{Name: "version_id", Type: filtering.String},
-	{Name: "spec_id", Type: filtering.String},
-	{Name: "artifact_id", Type: filtering.String},
-	{Name: "create_time", Type: filtering.Timestamp},
-	{Name: "update_time", Type: filtering.Timestamp},
-	{Name: "mime_type", Type: filtering.String},
-	{Name: "size_bytes", Type: filtering.Int},
+	{Name: "project_id", Type: filtering.String, Column: "artifacts.project_id"},
+	{Name: "api_id", Type: filtering.String, Column: "artifacts.api_id"},
+	{Name: "version_id", Type: filtering.String, Column: "artifacts.version_id"},
+	{Name: "spec_id", Type: filtering.String, Column: "artifacts.spec_id"},
+	{Name: "artifact_id", Type: filtering.String, Column: "artifacts.artifact_id"},
+	{Name: "create_time", Type: filtering.Timestamp, Column: "artifacts.create_time"},
+	{Name: "revision_create_time", Type: filtering.Timestamp, Column: "artifacts.revision_create_time"},
+	{Name: "update_time", Type: filtering.Timestamp, Column: "artifacts.update_time"},
+	{Name: "mime_type", Type: filtering.String, Column: "artifacts.mime_type"},
+	{Name: "size_bytes", Type: filtering.Int, Column: "artifacts.size_in_bytes"},
+	{Name: "labels", Type: filtering.StringMap, Column: "artifacts.labels"},
+	{Name: "annotations", Type: filtering.StringMap, Column: "artifacts.annotations"},
 }
 
 func (c *Client) ListSpecArtifacts(ctx context.Context, parent names.Spec, opts PageOptions) (ArtifactList, error) {
@@ -710,6 +939,12 @@ func (c *Client) ListSpecArtifacts(ctx context.Context, parent names.Spec, opts
 		token.Filter = opts.Filter
 	}
 
+	if err := token.ValidateOrder(opts.Order); err != nil {
+		return ArtifactList{}, status.Errorf(codes.InvalidArgument, "invalid order_by %q: %s", opts.Order, err)
+	} else {
+		token.Order = opts.Order
+	}
+
 	if parent.ProjectID != "-" && parent.ApiID != "-" && parent.VersionID != "-" && parent.SpecID != "-" {
 		if _, err := c.GetSpec(ctx, parent); err != nil {
 			return ArtifactList{}, err
@@ -728,23 +963,29 @@ func (c *Client) ListSpecArtifacts(ctx context.Context, parent names.Spec, opts
 		}
 	}
 
-	op := c.db.Where(`deployment_id = ''`)
+	op := c.latestArtifacts(opts).Where(`artifacts.deployment_id = ''`)
 	if id := parent.ProjectID; id != "-" {
-		op = op.Where("project_id = ?", id)
+		op = op.Where("artifacts.project_id = ?", id)
+	} else {
+		op = op.Where("artifacts.project_id != ''")
 	}
 	if id := parent.ApiID; id != "-" {
-		op = op.Where("api_id = ?", id)
+		op = op.Where("artifacts.api_id = ?", id)
+	} else {
+		op = op.Where("artifacts.api_id != ''")
 	}
 	if id := parent.VersionID; id != "-" {
-		op = op.Where("version_id = ?", id)
+		op = op.Where("artifacts.version_id = ?", id)
+	} else {
+		op = op.Where("artifacts.version_id != ''")
 	}
 	if id := parent.SpecID; id != "-" {
-		op = op.Where("spec_id = ?", id)
+		op = op.Where("artifacts.spec_id = ?", id)
+	} else {
+		op = op.Where("artifacts.spec_id != ''")
 	}
 
-	return c.listArtifacts(ctx, op, opts, func(a *models.Artifact) bool {
-		return a.ProjectID != "" && a.ApiID != "" && a.VersionID != "" && a.SpecID != ""
-	})
+	return c.listArtifacts(ctx, op, opts)
 }
 
 func (c *Client) ListVersionArtifacts(ctx context.Context, parent names.Version, opts PageOptions) (ArtifactList, error) {
@@ -759,6 +1000,12 @@ func (c *Client) ListVersionArtifacts(ctx context.Context, parent names.Version,
 		token.Filter = opts.Filter
 	}
 
+	if err := token.ValidateOrder(opts.Order); err != nil {
+		return ArtifactList{}, status.Errorf(codes.InvalidArgument, "invalid order_by %q: %s", opts.Order, err)
+	} else {
+		token.Order = opts.Order
+	}
+
 	if parent.ProjectID != "-" && parent.ApiID != "-" && parent.VersionID != "-" {
 		if _, err := c.GetVersion(ctx, parent); err != nil {
 			return ArtifactList{}, err
@@ -773,21 +1020,25 @@ func (c *Client) ListVersionArtifacts(ctx context.Context, parent names.Version,
 		}
 	}
 
-	op := c.db.Where(`deployment_id = ''`).
-		Where(`spec_id = ''`)
+	op := c.latestArtifacts(opts).Where(`artifacts.deployment_id = ''`).
+		Where(`artifacts.spec_id = ''`)
 	if id := parent.ProjectID; id != "-" {
-		op = op.Where("project_id = ?", id)
+		op = op.Where("artifacts.project_id = ?", id)
+	} else {
+		op = op.Where("artifacts.project_id != ''")
 	}
 	if id := parent.ApiID; id != "-" {
-		op = op.Where("api_id = ?", id)
+		op = op.Where("artifacts.api_id = ?", id)
+	} else {
+		op = op.Where("artifacts.api_id != ''")
 	}
 	if id := parent.VersionID; id != "-" {
-		op = op.Where("version_id = ?", id)
+		op = op.Where("artifacts.version_id = ?", id)
+	} else {
+		op = op.Where("artifacts.version_id != ''")
 	}
 
-	return c.listArtifacts(ctx, op, opts, func(a *models.Artifact) bool {
-		return a.ProjectID != "" && a.ApiID != "" && a.VersionID != ""
-	})
+	return c.listArtifacts(ctx, op, opts)
 }
 
 func (c *Client) ListDeploymentArtifacts(ctx context.Context, parent names.Deployment, opts PageOptions) (ArtifactList, error) {
@@ -802,6 +1053,12 @@ func (c *Client) ListDeploymentArtifacts(ctx context.Context, parent names.Deplo
 		token.Filter = opts.Filter
 	}
 
+	if err := token.ValidateOrder(opts.Order); err != nil {
+		return ArtifactList{}, status.Errorf(codes.InvalidArgument, "invalid order_by %q: %s", opts.Order, err)
+	} else {
+		token.Order = opts.Order
+	}
+
 	if parent.ProjectID != "-" && parent.ApiID != "-" && parent.DeploymentID != "-" {
 		if _, err := c.GetDeployment(ctx, parent); err != nil {
 			return ArtifactList{}, err
@@ -816,21 +1073,25 @@ func (c *Client) ListDeploymentArtifacts(ctx context.Context, parent names.Deplo
 		}
 	}
 
-	op := c.db.Where(`version_id = ''`).
-		Where(`spec_id = ''`)
+	op := c.latestArtifacts(opts).Where(`artifacts.version_id = ''`).
+		Where(`artifacts.spec_id = ''`)
 	if id := parent.ProjectID; id != "-" {
-		op = op.Where("project_id = ?", id)
+		op = op.Where("artifacts.project_id = ?", id)
+	} else {
+		op = op.Where("artifacts.project_id != ''")
 	}
 	if id := parent.ApiID; id != "-" {
-		op = op.Where("api_id = ?", id)
+		op = op.Where("artifacts.api_id = ?", id)
+	} else {
+		op = op.Where("artifacts.api_id != ''")
 	}
 	if id := parent.DeploymentID; id != "-" {
-		op = op.Where("deployment_id = ?", id)
+		op = op.Where("artifacts.deployment_id = ?", id)
+	} else {
+		op = op.Where("artifacts.deployment_id != ''")
 	}
 
-	return c.listArtifacts(ctx, op, opts, func(a *models.Artifact) bool {
-		return a.ProjectID != "" && a.ApiID != "" && a.DeploymentID != ""
-	})
+	return c.listArtifacts(ctx, op, opts)
 }
 
 func (c *Client) ListApiArtifacts(ctx context.Context, parent names.Api, opts PageOptions) (ArtifactList, error) {
@@ -845,6 +1106,12 @@ func (c *Client) ListApiArtifacts(ctx context.Context, parent names.Api, opts Pa
 		token.Filter = opts.Filter
 	}
 
+	if err := token.ValidateOrder(opts.Order); err != nil {
+		return ArtifactList{}, status.Errorf(codes.InvalidArgument, "invalid order_by %q: %s", opts.Order, err)
+	} else {
+		token.Order = opts.Order
+	}
+
 	if parent.ProjectID != "-" && parent.ApiID != "-" {
 		if _, err := c.GetApi(ctx, parent); err != nil {
 			return ArtifactList{}, err
@@ -855,19 +1122,21 @@ func (c *Client) ListApiArtifacts(ctx context.Context, parent names.Api, opts Pa
 		}
 	}
 
-	op := c.db.Where(`deployment_id = ''`).
-		Where(`version_id = ''`).
-		Where(`spec_id = ''`)
+	op := c.latestArtifacts(opts).Where(`artifacts.deployment_id = ''`).
+		Where(`artifacts.version_id = ''`).
+		Where(`artifacts.spec_id = ''`)
 	if id := parent.ProjectID; id != "-" {
-		op = op.Where("project_id = ?", id)
+		op = op.Where("artifacts.project_id = ?", id)
+	} else {
+		op = op.Where("artifacts.project_id != ''")
 	}
 	if id := parent.ApiID; id != "-" {
-		op = op.Where("api_id = ?", id)
+		op = op.Where("artifacts.api_id = ?", id)
+	} else {
+		op = op.Where("artifacts.api_id != ''")
 	}
 
-	return c.listArtifacts(ctx, op, opts, func(a *models.Artifact) bool {
-		return a.ProjectID != "" && a.ApiID != ""
-	})
+	return c.listArtifacts(ctx, op, opts)
 }
 
 func (c *Client) ListProjectArtifacts(ctx context.Context, parent names.Project, opts PageOptions) (ArtifactList, error) {
@@ -882,28 +1151,51 @@ func (c *Client) ListProjectArtifacts(ctx context.Context, parent names.Project,
 		token.Filter = opts.Filter
 	}
 
-	op := c.db.Where(`api_id = ''`).
-		Where(`deployment_id = ''`).
-		Where(`version_id = ''`).
-		Where(`spec_id = ''`)
+	if err := token.ValidateOrder(opts.Order); err != nil {
+		return ArtifactList{}, status.Errorf(codes.InvalidArgument, "invalid order_by %q: %s", opts.Order, err)
+	} else {
+		token.Order = opts.Order
+	}
+
+	op := c.latestArtifacts(opts).Where(`artifacts.api_id = ''`).
+		Where(`artifacts.deployment_id = ''`).
+		Where(`artifacts.version_id = ''`).
+		Where(`artifacts.spec_id = ''`)
 	if id := parent.ProjectID; id != "-" {
-		op = op.Where("project_id = ?", id)
+		op = op.Where("artifacts.project_id = ?", id)
 		if _, err := c.GetProject(ctx, parent); err != nil {
 			return ArtifactList{}, err
 		}
+	} else {
+		op = op.Where("artifacts.project_id != ''")
 	}
 
-	return c.listArtifacts(ctx, op, opts, func(a *models.Artifact) bool {
-		return a.ProjectID != ""
-	})
+	return c.listArtifacts(ctx, op, opts)
+}
+
+// latestArtifacts returns a query for the most recent revision of each artifact.
+func (c *Client) latestArtifacts(opts PageOptions) *gorm.DB {
+	// Select all columns from `artifacts` table specifically.
+	// We do not want to select duplicates from the joined subquery result.
+	return c.db.Select("artifacts.*").
+		Table("artifacts").
+		// Join missing columns that couldn't be selected in the subquery.
+		Joins("JOIN (?) AS grp ON artifacts.project_id = grp.project_id AND artifacts.api_id = grp.api_id AND artifacts.version_id = grp.version_id AND artifacts.spec_id = grp.spec_id AND artifacts.deployment_id = grp.deployment_id AND artifacts.artifact_id = grp.artifact_id AND artifacts.revision_create_time = grp.recent_create_time",
+			// Select artifact names and only their most recent revision_create_time.
+			c.db.Select("project_id, api_id, version_id, spec_id, deployment_id, artifact_id, MAX(revision_create_time) AS recent_create_time").
+				Table("artifacts").
+				Scopes(withoutDeleted("artifacts", opts)).
+				Group("project_id, api_id, version_id, spec_id, deployment_id, artifact_id")).
+		Scopes(withoutDeleted("artifacts", opts))
 }
 
-func (c *Client) listArtifacts(ctx context.Context, op *gorm.DB, opts PageOptions, include func(*models.Artifact) bool) (ArtifactList, error) {
+func (c *Client) listArtifacts(ctx context.Context, op *gorm.DB, opts PageOptions) (ArtifactList, error) {
 	token, err := decodeToken(opts.Token)
 	if err != nil {
 		return ArtifactList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err.Error())
 	} else {
 		token.Filter = opts.Filter
+		token.Order = opts.Order
 	}
 
 	filter, err := filtering.NewFilter(opts.Filter, artifactFields)
@@ -911,12 +1203,24 @@ func (c *Client) listArtifacts(ctx context.Context, op *gorm.DB, opts PageOption
 		return ArtifactList{}, err
 	}
 
+	order, err := parseOrder(opts.Order, artifactFields)
+	if err != nil {
+		return ArtifactList{}, status.Errorf(codes.InvalidArgument, "invalid order_by %q: %s", opts.Order, err)
+	}
+
+	op, verify := c.applyFilter(op, filter, opts)
+	op, err = paginate(op, order, token)
+	if err != nil {
+		return ArtifactList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err)
+	}
+
 	lock()
 	var artifacts []models.Artifact
-	_ = op.Offset(token.Offset).
-		Limit(100000).
-		Find(&artifacts).Error
+	err = op.Scan(&artifacts).Error
 	unlock()
+	if err != nil {
+		return ArtifactList{}, status.Error(codes.Internal, err.Error())
+	}
 
 	response := ArtifactList{
 		Artifacts: make([]models.Artifact, 0, opts.Size),
@@ -928,17 +1232,18 @@ func (c *Client) listArtifacts(ctx context.Context, op *gorm.DB, opts PageOption
 			return response, status.Error(codes.Internal, err.Error())
 		}
 
-		match, err := filter.Matches(artifactMap)
-		if err != nil {
-			return response, err
-		} else if !match || !include(&artifact) {
-			token.Offset++
-			continue
+		if verify {
+			match, err := filter.Matches(artifactMap)
+			if err != nil {
+				return response, err
+			} else if !match {
+				continue
+			}
 		}
 
 		if len(response.Artifacts) < int(opts.Size) {
 			response.Artifacts = append(response.Artifacts, artifact)
-			token.Offset++
+			token.Last = position(order, artifact.Key, artifactMap)
 		} else if len(response.Artifacts) == int(opts.Size) {
 			response.Token, err = encodeToken(token)
 			if err != nil {
@@ -948,24 +1253,96 @@ func (c *Client) listArtifacts(ctx context.Context, op *gorm.DB, opts PageOption
 		}
 	}
 
+	if response.Token == "" {
+		response.Token, err = scanToken(verify, len(artifacts), token, func() ([]interface{}, error) {
+			last := artifacts[len(artifacts)-1]
+			values, err := artifactMap(last)
+			return position(order, last.Key, values), err
+		})
+		if err != nil {
+			return response, status.Error(codes.Internal, err.Error())
+		}
+	}
+
 	return response, nil
 }
 
 func artifactMap(artifact models.Artifact) (map[string]interface{}, error) {
+	labels, err := artifact.LabelsMap()
+	if err != nil {
+		return nil, err
+	}
+
+	annotations, err := artifact.AnnotationsMap()
+	if err != nil {
+		return nil, err
+	}
+
 	return map[string]interface{}{
-		"name":        artifact.Name(),
-		"project_id":  artifact.ProjectID,
-		"api_id":      artifact.ApiID,
-		"version_id":  artifact.VersionID,
-		"spec_id":     artifact.SpecID,
-		"artifact_id": artifact.ArtifactID,
-		"create_time": artifact.CreateTime,
-		"update_time": artifact.UpdateTime,
-		"mime_type":   artifact.MimeType,
-		"size_bytes":  artifact.SizeInBytes,
+		"name":                 artifact.Name(),
+		"project_id":           artifact.ProjectID,
+		"api_id":               artifact.ApiID,
+		"version_id":           artifact.VersionID,
+		"spec_id":              artifact.SpecID,
+		"artifact_id":          artifact.ArtifactID,
+		"revision_id":          artifact.RevisionID,
+		"create_time":          artifact.CreateTime,
+		"revision_create_time": artifact.RevisionCreateTime,
+		"update_time":          artifact.UpdateTime,
+		"mime_type":            artifact.MimeType,
+		"size_bytes":           artifact.SizeInBytes,
+		"labels":               labels,
+		"annotations":          annotations,
 	}, nil
 }
 
+// ListArtifactRevisions returns a page of revisions of an artifact.
+func (c *Client) ListArtifactRevisions(ctx context.Context, parent names.Artifact, opts PageOptions) (ArtifactList, error) {
+	token, err := decodeToken(opts.Token)
+	if err != nil {
+		return ArtifactList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err.Error())
+	}
+
+	response := ArtifactList{
+		Artifacts: make([]models.Artifact, 0, opts.Size),
+	}
+
+	parent = parent.Normal()
+	op := c.db.
+		Where("project_id = ?", parent.ProjectID()).
+		Where("api_id = ?", parent.ApiID()).
+		Where("version_id = ?", parent.VersionID()).
+		Where("spec_id = ?", parent.SpecID()).
+		Where("deployment_id = ?", parent.DeploymentID()).
+		Where("artifact_id = ?", parent.ArtifactID()).
+		Limit(int(opts.Size) + 1)
+
+	op, err = paginate(op, revisionOrder, token)
+	if err != nil {
+		return ArtifactList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err)
+	}
+
+	lock()
+	err = op.Find(&response.Artifacts).Error
+	unlock()
+	if err != nil {
+		return ArtifactList{}, status.Error(codes.Internal, err.Error())
+	}
+
+	// Trim the response and return a page token if too many resources were found.
+	if len(response.Artifacts) > int(opts.Size) {
+		response.Artifacts = response.Artifacts[:opts.Size]
+		last := response.Artifacts[len(response.Artifacts)-1]
+		token.Last = []interface{}{last.RevisionCreateTime, last.Key}
+		response.Token, err = encodeToken(token)
+		if err != nil {
+			return response, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return response, nil
+}
+
 func (c *Client) GetSpecTags(ctx context.Context, name names.Spec) ([]models.SpecRevisionTag, error) {
 	op := c.db.Where("project_id = ?", name.ProjectID).
 		Where("api_id = ?", name.ApiID).
@@ -996,3 +1373,126 @@ func (c *Client) GetDeploymentTags(ctx context.Context, name names.Deployment) (
 	_ = op.Limit(100000).Find(&tags)
 	return tags, nil
 }
+
+// EventList contains a page of outbox events.
+type EventList struct {
+	Events []models.Event
+	Token  string
+}
+
+var eventFields = []filtering.Field{
+	{Name: "id", Type: filtering.Int, Column: "id"},
+	{Name: "change", Type: filtering.String, Column: "change"},
+	{Name: "resource", Type: filtering.String, Column: "resource"},
+	{Name: "change_time", Type: filtering.Timestamp, Column: "change_time"},
+	{Name: "attempts", Type: filtering.Int, Column: "attempts"},
+	{Name: "last_error", Type: filtering.String, Column: "last_error"},
+}
+
+// eventOrder lists events in the order they were recorded.
+var eventOrder = []ordering{{Field: "id", Column: "id"}}
+
+// ListEvents returns events in the order they were recorded.
+// Delivered events are only included if includeDelivered is true.
+func (c *Client) ListEvents(ctx context.Context, opts PageOptions, includeDelivered bool) (EventList, error) {
+	token, err := decodeToken(opts.Token)
+	if err != nil {
+		return EventList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err.Error())
+	}
+
+	if err := token.ValidateFilter(opts.Filter); err != nil {
+		return EventList{}, status.Errorf(codes.InvalidArgument, "invalid filter %q: %s", opts.Filter, err)
+	} else {
+		token.Filter = opts.Filter
+	}
+
+	op := c.db
+	if !includeDelivered {
+		op = op.Where("delivered = ?", false)
+	}
+
+	filter, err := filtering.NewFilter(opts.Filter, eventFields)
+	if err != nil {
+		return EventList{}, err
+	}
+
+	op, verify := c.applyFilter(op, filter, opts)
+	op, err = paginate(op, eventOrder, token)
+	if err != nil {
+		return EventList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err)
+	}
+
+	lock()
+	var events []models.Event
+	err = op.Find(&events).Error
+	unlock()
+	if err != nil {
+		return EventList{}, status.Error(codes.Internal, err.Error())
+	}
+
+	response := EventList{
+		Events: make([]models.Event, 0, opts.Size),
+	}
+
+	for _, event := range events {
+		eventMap := eventMap(event)
+		if verify {
+			match, err := filter.Matches(eventMap)
+			if err != nil {
+				return response, err
+			} else if !match {
+				continue
+			}
+		}
+
+		if len(response.Events) < int(opts.Size) {
+			response.Events = append(response.Events, event)
+			token.Last = position(eventOrder, "", eventMap)
+		} else if len(response.Events) == int(opts.Size) {
+			response.Token, err = encodeToken(token)
+			if err != nil {
+				return response, status.Error(codes.Internal, err.Error())
+			}
+			break
+		}
+	}
+
+	if response.Token == "" {
+		response.Token, err = scanToken(verify, len(events), token, func() ([]interface{}, error) {
+			last := events[len(events)-1]
+			return position(eventOrder, "", eventMap(last)), nil
+		})
+		if err != nil {
+			return response, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return response, nil
+}
+
+func eventMap(event models.Event) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          event.ID,
+		"change":      event.Change,
+		"resource":    event.Resource,
+		"change_time": event.ChangeTime,
+		"attempts":    int64(event.Attempts),
+		"last_error":  event.LastError,
+	}
+}
+
+// GetArtifactRevisionTags returns the tags of the artifact revisions with the specified revision IDs.
+func (c *Client) GetArtifactRevisionTags(ctx context.Context, revisionIDs []string) ([]models.ArtifactRevisionTag, error) {
+	tags := make([]models.ArtifactRevisionTag, 0)
+	if len(revisionIDs) == 0 {
+		return tags, nil
+	}
+
+	lock()
+	defer unlock()
+
+	if err := c.db.Where("revision_id IN ?", revisionIDs).Limit(100000).Find(&tags).Error; err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return tags, nil
+}
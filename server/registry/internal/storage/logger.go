@@ -24,13 +24,13 @@ import (
 )
 
 type gormLogger struct {
-	Logger        log.Logger
 	SlowThreshold time.Duration
 }
 
-func NewGormLogger(ctx context.Context) logger.Interface {
+// NewGormLogger returns a gorm logger that writes to the logger attached to
+// the context of each database operation.
+func NewGormLogger() logger.Interface {
 	return gormLogger{
-		Logger:        log.FromContext(ctx),
 		SlowThreshold: 100 * time.Millisecond,
 	}
 }
@@ -53,7 +53,7 @@ func (l gormLogger) Error(context.Context, string, ...interface{}) {
 
 func (l gormLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
 	sql, _ := fc()
-	logger := l.Logger.WithFields(map[string]interface{}{
+	logger := log.FromContext(ctx).WithFields(map[string]interface{}{
 		"query":    sql,
 		"duration": time.Since(begin),
 	})
@@ -0,0 +1,473 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// A migration changes the database schema from one version to the next, and back.
+// Tables are created from the definitions in schema.go rather than from the current models,
+// so each migration creates the same schema whenever it runs.
+type migration struct {
+	description string
+	up          func(context.Context, *Client, *progress) error
+	down        func(context.Context, *Client, *progress) error
+}
+
+// MigrationProgress describes the work done by migrations.
+type MigrationProgress struct {
+	// Version is the schema version after the migrations that have completed.
+	Version int
+	// TablesProcessed is the number of tables that have been created, changed or dropped.
+	TablesProcessed int64
+	// RowsMigrated is the number of rows that have been migrated.
+	RowsMigrated int64
+}
+
+// progress counts the work done by migrations and reports it as it changes.
+type progress struct {
+	MigrationProgress
+	report func(MigrationProgress) error
+}
+
+// add counts processed tables and migrated rows.
+// An error from the report stops the migration and rolls back its transaction.
+func (p *progress) add(tables, rows int64) error {
+	p.TablesProcessed += tables
+	p.RowsMigrated += rows
+	return p.notify()
+}
+
+func (p *progress) notify() error {
+	if p.report == nil {
+		return nil
+	}
+	return p.report(p.MigrationProgress)
+}
+
+// migrations are the changes to the database schema in order.
+// The migration at index i changes the schema from version i to version i+1.
+// Migrations must not be changed or reordered once they are released; new migrations are appended.
+var migrations = []migration{
+	{
+		description: "create tables",
+		up:          migrateBaseline,
+	},
+	{
+		description: "index apis, versions, specs and deployments for search",
+		up:          migrateSearchDocumentsUp,
+		down:        migrateSearchDocumentsDown,
+	},
+	{
+		description: "index undelivered events",
+		up: func(ctx context.Context, c *Client, p *progress) error {
+			if err := c.db.Exec("CREATE INDEX IF NOT EXISTS idx_events_undelivered ON events (delivered, next_attempt_time)").Error; err != nil {
+				return err
+			}
+			return p.add(1, 0)
+		},
+		down: func(ctx context.Context, c *Client, p *progress) error {
+			if err := c.db.Exec("DROP INDEX IF EXISTS idx_events_undelivered").Error; err != nil {
+				return err
+			}
+			return p.add(1, 0)
+		},
+	},
+	{
+		description: "create role bindings",
+		up: func(ctx context.Context, c *Client, p *progress) error {
+			if err := c.ensureTable(&roleBindingV4{}); err != nil {
+				return err
+			}
+			return p.add(1, 0)
+		},
+		down: func(ctx context.Context, c *Client, p *progress) error {
+			if err := c.db.Migrator().DropTable(&roleBindingV4{}); err != nil {
+				return err
+			}
+			return p.add(1, 0)
+		},
+	},
+	{
+		description: "create audit events",
+		up: func(ctx context.Context, c *Client, p *progress) error {
+			if err := c.ensureTable(&auditEventV5{}); err != nil {
+				return err
+			}
+			return p.add(1, 0)
+		},
+		down: func(ctx context.Context, c *Client, p *progress) error {
+			if err := c.db.Migrator().DropTable(&auditEventV5{}); err != nil {
+				return err
+			}
+			return p.add(1, 0)
+		},
+	},
+}
+
+// LatestSchemaVersion is the schema version that the server requires,
+// which is the version of databases with all migrations applied.
+var LatestSchemaVersion = len(migrations)
+
+// BaselineSchemaVersion is the earliest schema version that databases can be migrated to.
+// The baseline migration creates the tables, so it isn't reverted.
+const BaselineSchemaVersion = 1
+
+// schemaLockID identifies the Postgres advisory lock held by transactions that migrate the schema.
+const schemaLockID = 7256308137
+
+// SchemaVersion returns the schema version of the database, which is zero for new databases
+// and for databases created before schema versions were recorded.
+func (c *Client) SchemaVersion(ctx context.Context) (int, error) {
+	if !c.db.Migrator().HasTable(&models.SchemaMigration{}) {
+		return 0, nil
+	}
+	return c.WithContext(ctx).schemaVersion()
+}
+
+func (c *Client) schemaVersion() (int, error) {
+	var version int
+	if err := c.db.Model(&models.SchemaMigration{}).Select("COALESCE(MAX(version), 0)").Scan(&version).Error; err != nil {
+		return 0, status.Error(codes.Internal, err.Error())
+	}
+	return version, nil
+}
+
+// EnsureSchema migrates the database schema to the latest version, which the server requires.
+// It fails if the schema is newer than the server supports, which happens when a server is
+// started against a database that a later release has migrated.
+func (c *Client) EnsureSchema(ctx context.Context) error {
+	version, err := c.SchemaVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if version > LatestSchemaVersion {
+		return incompatibleSchema(version)
+	}
+	// Operations record migrations, so like the record of schema versions, their table is created outside of them.
+	// Columns added to operations are also added outside of them.
+	lock()
+	err = c.db.AutoMigrate(&models.Operation{})
+	unlock()
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return c.migrateTo(ctx, LatestSchemaVersion, nil)
+}
+
+// Migrate migrates the database schema to the given version, or to the latest version if version is zero.
+// Migrating to an earlier version prepares the database for an earlier release of the server.
+// The SQLite search index is also rebuilt, which repairs it after the database is vacuumed.
+//
+// Progress is reported as it changes. Reports during a migration are made in its transaction,
+// so they shouldn't write to SQLite databases, which only allow one transaction to write.
+// The migration stops if a report returns an error.
+func (c *Client) Migrate(ctx context.Context, version int, report func(MigrationProgress) error) error {
+	if version == 0 {
+		version = LatestSchemaVersion
+	}
+	if err := c.migrateTo(ctx, version, report); err != nil {
+		return err
+	}
+	if c.db.Migrator().HasTable(&models.SearchDocument{}) {
+		if err := c.ensureSearchIndex(true); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+	return nil
+}
+
+// migrateTo applies or reverts migrations until the database schema is at the given version.
+// Each migration runs in a transaction that records the resulting version,
+// so a failed migration leaves the schema at the version before it.
+func (c *Client) migrateTo(ctx context.Context, version int, report func(MigrationProgress) error) error {
+	if version < BaselineSchemaVersion || version > LatestSchemaVersion {
+		return status.Errorf(codes.InvalidArgument, "invalid schema version %d: must be between %d and %d", version, BaselineSchemaVersion, LatestSchemaVersion)
+	}
+	if err := c.ensureTable(&models.SchemaMigration{}); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	p := &progress{report: report}
+	for done := false; !done; {
+		next := 0
+		err := c.Transaction(ctx, func(ctx context.Context, tx *Client) error {
+			// The version is read again after locking, because other servers may be migrating the schema.
+			if err := tx.lockSchema(); err != nil {
+				return err
+			}
+			current, err := tx.schemaVersion()
+			if err != nil {
+				return err
+			}
+
+			p.Version = current
+			switch {
+			case current > LatestSchemaVersion:
+				return incompatibleSchema(current)
+			case current < version:
+				next = current + 1
+				return tx.applyMigration(ctx, next, p)
+			case current > version:
+				next = current - 1
+				return tx.revertMigration(ctx, current, p)
+			default:
+				done = true
+				return nil
+			}
+		})
+		if err != nil {
+			return err
+		}
+		if !done {
+			p.Version = next
+			if err := p.notify(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// lockSchema serializes transactions that migrate the schema.
+// SQLite transactions already hold the database write lock.
+func (c *Client) lockSchema() error {
+	if c.db.Name() == "postgres" {
+		return c.db.Exec("SELECT pg_advisory_xact_lock(?)", schemaLockID).Error
+	}
+	return nil
+}
+
+func (c *Client) applyMigration(ctx context.Context, version int, p *progress) error {
+	m := migrations[version-1]
+	if err := m.up(ctx, c, p); err != nil {
+		return migrationError(err, "failed to apply migration %d (%s)", version, m.description)
+	}
+	return c.db.Create(&models.SchemaMigration{
+		Version:     version,
+		Description: m.description,
+		ApplyTime:   time.Now(),
+	}).Error
+}
+
+func (c *Client) revertMigration(ctx context.Context, version int, p *progress) error {
+	m := migrations[version-1]
+	if err := m.down(ctx, c, p); err != nil {
+		return migrationError(err, "failed to revert migration %d (%s)", version, m.description)
+	}
+	return c.db.Delete(&models.SchemaMigration{}, "version = ?", version).Error
+}
+
+// migrationError describes an error of a migration, keeping its status code.
+func migrationError(err error, format string, args ...interface{}) error {
+	code := codes.Internal
+	if s, ok := status.FromError(err); ok {
+		code = s.Code()
+	}
+	return status.Errorf(code, "%s: %s", fmt.Sprintf(format, args...), err)
+}
+
+func incompatibleSchema(version int) error {
+	return status.Errorf(codes.FailedPrecondition, "database schema version %d is newer than version %d supported by this server", version, LatestSchemaVersion)
+}
+
+// baselineTables are the tables created by the baseline migration.
+var baselineTables = []interface{}{
+	&projectV1{},
+	&apiV1{},
+	&versionV1{},
+	&specV1{},
+	&specRevisionTagV1{},
+	&deploymentV1{},
+	&deploymentRevisionTagV1{},
+	&artifactV1{},
+	&artifactRevisionTagV1{},
+	&blobV1{},
+	&eventV1{},
+}
+
+// legacyBlobsTable holds the blobs that were saved for individual revisions while they are migrated.
+const legacyBlobsTable = "legacy_blobs"
+
+// migrateBaseline creates the tables of the baseline schema.
+// Databases created before schema versions were recorded are updated to the baseline schema.
+func migrateBaseline(ctx context.Context, c *Client, p *progress) error {
+	// Blobs were previously keyed by the names of the revisions that saved them,
+	// with columns that identified their resources.
+	if c.db.Migrator().HasTable(&blobV1{}) && c.db.Migrator().HasColumn(&blobV1{}, "project_id") {
+		if err := c.db.Migrator().RenameTable(&blobV1{}, legacyBlobsTable); err != nil {
+			return err
+		}
+	}
+
+	// Tables of databases created before schema versions were recorded may lack columns of the baseline.
+	for _, table := range baselineTables {
+		if err := c.db.AutoMigrate(table); err != nil {
+			return err
+		}
+		if err := p.add(1, 0); err != nil {
+			return err
+		}
+	}
+	if err := c.migrateArtifactRevisions(p); err != nil {
+		return err
+	}
+	return c.migrateBlobs(p)
+}
+
+// migrateSearchDocumentsUp creates the search documents table and its index,
+// and indexes the resources that were saved before it existed.
+func migrateSearchDocumentsUp(ctx context.Context, c *Client, p *progress) error {
+	if err := c.ensureTable(&searchDocumentV2{}); err != nil {
+		return err
+	}
+	if err := c.ensureSearchIndex(false); err != nil {
+		return err
+	}
+	if err := p.add(1, 0); err != nil {
+		return err
+	}
+	return c.indexAll(ctx, p)
+}
+
+// migrateSearchDocumentsDown drops the search documents table and its index.
+func migrateSearchDocumentsDown(ctx context.Context, c *Client, p *progress) error {
+	if c.db.Name() == "sqlite" {
+		if err := c.db.Exec("DROP TABLE IF EXISTS " + sqliteSearchIndex).Error; err != nil {
+			return err
+		}
+	}
+	if err := c.db.Migrator().DropTable(&searchDocumentV2{}); err != nil {
+		return err
+	}
+	return p.add(1, 0)
+}
+
+// migrateArtifactRevisions assigns revisions to artifacts that were saved before artifacts had revisions.
+// Each artifact and its legacy blob are rekeyed with the name of the new revision.
+func (c *Client) migrateArtifactRevisions(p *progress) error {
+	var artifacts []artifactV1
+	if err := c.db.Where("revision_id = '' OR revision_id IS NULL").Find(&artifacts).Error; err != nil {
+		return err
+	}
+
+	legacy := c.db.Migrator().HasTable(legacyBlobsTable)
+	return c.db.Transaction(func(tx *gorm.DB) error {
+		for _, v := range artifacts {
+			// Artifacts without revisions were keyed by their names.
+			revision := v
+			revision.RevisionID = models.NewRevisionID()
+			revision.RevisionCreateTime = v.UpdateTime
+			revision.Key = v.Key + "@" + revision.RevisionID
+
+			if legacy {
+				if err := tx.Table(legacyBlobsTable).Where("key = ?", v.Key).Update("key", revision.Key).Error; err != nil {
+					return err
+				}
+			}
+			if err := tx.Delete(&artifactV1{}, "key = ?", v.Key).Error; err != nil {
+				return err
+			}
+			if err := tx.Create(&revision).Error; err != nil {
+				return err
+			}
+		}
+		return p.add(0, int64(len(artifacts)))
+	})
+}
+
+// migrateBlobs moves the contents of legacy blobs into shared blobs that are referenced by the revisions that saved them.
+// Legacy blobs are deleted as they are moved, and legacy blobs without revisions are discarded.
+func (c *Client) migrateBlobs(p *progress) error {
+	if !c.db.Migrator().HasTable(legacyBlobsTable) {
+		return nil
+	}
+
+	var keys []string
+	if err := c.db.Table(legacyBlobsTable).Pluck("key", &keys).Error; err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		err := c.db.Transaction(func(tx *gorm.DB) error {
+			var legacy struct {
+				Contents []byte
+			}
+			if err := tx.Table(legacyBlobsTable).Select("contents").Where("key = ?", key).Take(&legacy).Error; err != nil {
+				return err
+			}
+
+			hash := models.BlobHash(legacy.Contents)
+			var references int64
+			for _, model := range []interface{}{&specV1{}, &artifactV1{}} {
+				op := tx.Model(model).Where("key = ?", key).Update("blob_hash", hash)
+				if err := op.Error; err != nil {
+					return err
+				}
+				references += op.RowsAffected
+			}
+
+			if references > 0 {
+				if err := c.addBaselineBlobReference(tx, hash, legacy.Contents); err != nil {
+					return err
+				}
+			}
+			return tx.Exec("DELETE FROM "+legacyBlobsTable+" WHERE key = ?", key).Error
+		})
+		if err != nil {
+			return err
+		}
+		if err := p.add(0, 1); err != nil {
+			return err
+		}
+	}
+
+	if err := c.db.Migrator().DropTable(legacyBlobsTable); err != nil {
+		return err
+	}
+	return p.add(1, 0)
+}
+
+// addBaselineBlobReference counts a reference to the blob of some contents in the baseline schema,
+// creating the blob if it doesn't exist.
+func (c *Client) addBaselineBlobReference(tx *gorm.DB, hash string, contents []byte) error {
+	got := tx.Model(&blobV1{}).
+		Where("hash = ?", hash).
+		UpdateColumn("ref_count", gorm.Expr("ref_count + 1"))
+	if err := got.Error; err != nil || got.RowsAffected > 0 {
+		return err
+	}
+
+	err := tx.Create(&blobV1{
+		Hash:        hash,
+		SizeInBytes: int64(len(contents)),
+		RefCount:    1,
+		CreateTime:  time.Now().Round(time.Microsecond),
+	}).Error
+	if err != nil || len(contents) == 0 {
+		return err
+	}
+
+	db := &Client{db: tx, blobs: c.blobs}
+	return db.blobStore().Put(tx.Statement.Context, hash, contents)
+}
@@ -21,21 +21,23 @@ import (
 	"github.com/apigee/registry/server/registry/names"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 // Api is the storage-side representation of an API.
 type Api struct {
-	Key                string    `gorm:"primaryKey"`
-	ProjectID          string    // Uniquely identifies a project.
-	ApiID              string    // Uniquely identifies an api within a project.
-	DisplayName        string    // A human-friendly name.
-	Description        string    // A detailed description.
-	CreateTime         time.Time // Creation time.
-	UpdateTime         time.Time // Time of last change.
-	Availability       string    // Availability of the API.
-	RecommendedVersion string    // Recommended API version.
-	Labels             []byte    // Serialized labels.
-	Annotations        []byte    // Serialized annotations.
+	Key                string         `gorm:"primaryKey"`
+	ProjectID          string         // Uniquely identifies a project.
+	ApiID              string         // Uniquely identifies an api within a project.
+	DisplayName        string         // A human-friendly name.
+	Description        string         // A detailed description.
+	CreateTime         time.Time      // Creation time.
+	UpdateTime         time.Time      // Time of last change.
+	Availability       string         // Availability of the API.
+	RecommendedVersion string         // Recommended API version.
+	Labels             []byte         // Serialized labels.
+	Annotations        []byte         // Serialized annotations.
+	DeleteTime         gorm.DeletedAt `gorm:"index"` // Deletion time, if deleted and not yet purged.
 }
 
 // NewApi initializes a new resource.
@@ -73,6 +75,11 @@ func (api *Api) Name() string {
 	}.String()
 }
 
+// ETag returns a checksum of the stored api.
+func (api *Api) ETag() string {
+	return etag(api)
+}
+
 // Message returns a message representing an api.
 func (api *Api) Message() (message *rpc.Api, err error) {
 	message = &rpc.Api{
@@ -83,6 +90,8 @@ func (api *Api) Message() (message *rpc.Api, err error) {
 		RecommendedVersion: api.RecommendedVersion,
 		CreateTime:         timestamppb.New(api.CreateTime),
 		UpdateTime:         timestamppb.New(api.UpdateTime),
+		DeleteTime:         deleteTime(api.DeleteTime),
+		Etag:               api.ETag(),
 	}
 
 	message.Labels, err = api.LabelsMap()
@@ -23,38 +23,58 @@ import (
 	"github.com/apigee/registry/server/registry/names"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 // Artifact is the storage-side representation of an artifact.
 type Artifact struct {
-	Key          string    `gorm:"primaryKey"`
-	ProjectID    string    // Project associated with artifact (required).
-	ApiID        string    // Api associated with artifact (if appropriate).
-	VersionID    string    // Version associated with artifact (if appropriate).
-	SpecID       string    // Spec associated with artifact (if appropriate).
-	DeploymentID string    // Deployment associated with artifact (if appropriate).
-	ArtifactID   string    // Artifact identifier (required).
-	CreateTime   time.Time // Creation time.
-	UpdateTime   time.Time // Time of last change.
-	MimeType     string    // MIME type of artifact
-	SizeInBytes  int32     // Size of the spec.
-	Hash         string    // A hash of the spec.
+	Key                string         `gorm:"primaryKey"`
+	ProjectID          string         // Project associated with artifact (required).
+	ApiID              string         // Api associated with artifact (if appropriate).
+	VersionID          string         // Version associated with artifact (if appropriate).
+	SpecID             string         // Spec associated with artifact (if appropriate).
+	DeploymentID       string         // Deployment associated with artifact (if appropriate).
+	ArtifactID         string         // Artifact identifier (required).
+	RevisionID         string         // Uniquely identifies a revision of an artifact.
+	CreateTime         time.Time      // Creation time.
+	RevisionCreateTime time.Time      // Revision creation time.
+	UpdateTime         time.Time      // Time of last change.
+	MimeType           string         // MIME type of artifact
+	SizeInBytes        int64          // Size of the artifact.
+	Hash               string         // A hash of the spec.
+	BlobHash           string         // A hash of the stored contents, which identifies their blob.
+	Labels             []byte         // Serialized labels.
+	Annotations        []byte         // Serialized annotations.
+	DeleteTime         gorm.DeletedAt `gorm:"index"` // Deletion time, if deleted and not yet purged.
 }
 
 // NewArtifact initializes a new resource.
 func NewArtifact(name names.Artifact, body *rpc.Artifact) (artifact *Artifact, err error) {
 	now := time.Now().Round(time.Microsecond)
 	artifact = &Artifact{
-		ProjectID:    name.ProjectID(),
-		ApiID:        name.ApiID(),
-		VersionID:    name.VersionID(),
-		SpecID:       name.SpecID(),
-		DeploymentID: name.DeploymentID(),
-		ArtifactID:   name.ArtifactID(),
-		CreateTime:   now,
-		UpdateTime:   now,
-		MimeType:     body.GetMimeType(),
+		ProjectID:          name.ProjectID(),
+		ApiID:              name.ApiID(),
+		VersionID:          name.VersionID(),
+		SpecID:             name.SpecID(),
+		DeploymentID:       name.DeploymentID(),
+		ArtifactID:         name.ArtifactID(),
+		RevisionID:         NewRevisionID(),
+		CreateTime:         now,
+		RevisionCreateTime: now,
+		UpdateTime:         now,
+		MimeType:           body.GetMimeType(),
+	}
+
+	artifact.Labels, err = bytesForMap(body.GetLabels())
+	if err != nil {
+		return nil, err
+	}
+
+	artifact.Annotations, err = bytesForMap(body.GetAnnotations())
+	if err != nil {
+		return nil, err
 	}
 
 	if body.GetContents() != nil {
@@ -66,13 +86,59 @@ func NewArtifact(name names.Artifact, body *rpc.Artifact) (artifact *Artifact, e
 				return nil, status.Error(codes.InvalidArgument, err.Error())
 			}
 		}
-		artifact.SizeInBytes = int32(len(contents))
+		artifact.SizeInBytes = int64(len(contents))
 		artifact.Hash = hashForBytes(contents)
+		artifact.BlobHash = hashForBytes(body.GetContents())
 	}
 
 	return artifact, nil
 }
 
+// NewRevision returns a new revision based on the artifact.
+func (artifact *Artifact) NewRevision() *Artifact {
+	now := time.Now().Round(time.Microsecond)
+	return &Artifact{
+		ProjectID:          artifact.ProjectID,
+		ApiID:              artifact.ApiID,
+		VersionID:          artifact.VersionID,
+		SpecID:             artifact.SpecID,
+		DeploymentID:       artifact.DeploymentID,
+		ArtifactID:         artifact.ArtifactID,
+		RevisionID:         NewRevisionID(),
+		CreateTime:         artifact.CreateTime,
+		RevisionCreateTime: now,
+		UpdateTime:         now,
+		MimeType:           artifact.MimeType,
+		SizeInBytes:        artifact.SizeInBytes,
+		Hash:               artifact.Hash,
+		BlobHash:           artifact.BlobHash,
+		Labels:             artifact.Labels,
+		Annotations:        artifact.Annotations,
+	}
+}
+
+// Update modifies the labels and annotations of an artifact in the mask.
+// Other fields are ignored, so the revision and its contents don't change.
+func (artifact *Artifact) Update(message *rpc.Artifact, mask *fieldmaskpb.FieldMask) error {
+	artifact.UpdateTime = time.Now().Round(time.Microsecond)
+	for _, field := range mask.Paths {
+		switch field {
+		case "labels":
+			var err error
+			if artifact.Labels, err = bytesForMap(message.GetLabels()); err != nil {
+				return err
+			}
+		case "annotations":
+			var err error
+			if artifact.Annotations, err = bytesForMap(message.GetAnnotations()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // Name returns the resource name of the artifact.
 func (artifact *Artifact) Name() string {
 	switch {
@@ -96,14 +162,107 @@ func (artifact *Artifact) Name() string {
 	}
 }
 
+// RevisionName generates the resource name of the artifact revision.
+func (artifact *Artifact) RevisionName() string {
+	return fmt.Sprintf("%s@%s", artifact.Name(), artifact.RevisionID)
+}
+
+// ETag returns a checksum of the stored artifact revision.
+func (artifact *Artifact) ETag() string {
+	return etag(artifact)
+}
+
 // Message returns an RPC message representing the artifact.
-func (artifact *Artifact) Message() *rpc.Artifact {
-	return &rpc.Artifact{
-		Name:       artifact.Name(),
-		MimeType:   artifact.MimeType,
-		SizeBytes:  artifact.SizeInBytes,
-		Hash:       artifact.Hash,
-		CreateTime: timestamppb.New(artifact.CreateTime),
-		UpdateTime: timestamppb.New(artifact.UpdateTime),
+func (artifact *Artifact) Message(name string, tags []string) (message *rpc.Artifact, err error) {
+	message = &rpc.Artifact{
+		Name:               name,
+		MimeType:           artifact.MimeType,
+		SizeBytes:          artifact.SizeInBytes,
+		Hash:               artifact.Hash,
+		RevisionId:         artifact.RevisionID,
+		RevisionTags:       tags,
+		CreateTime:         timestamppb.New(artifact.CreateTime),
+		RevisionCreateTime: timestamppb.New(artifact.RevisionCreateTime),
+		UpdateTime:         timestamppb.New(artifact.UpdateTime),
+		DeleteTime:         deleteTime(artifact.DeleteTime),
+		Etag:               artifact.ETag(),
+	}
+
+	message.Labels, err = artifact.LabelsMap()
+	if err != nil {
+		return nil, err
 	}
+
+	message.Annotations, err = artifact.AnnotationsMap()
+	if err != nil {
+		return nil, err
+	}
+
+	return message, nil
+}
+
+// LabelsMap returns a map representation of stored labels.
+func (artifact *Artifact) LabelsMap() (map[string]string, error) {
+	return mapForBytes(artifact.Labels)
+}
+
+// AnnotationsMap returns a map representation of stored annotations.
+func (artifact *Artifact) AnnotationsMap() (map[string]string, error) {
+	return mapForBytes(artifact.Annotations)
+}
+
+// ArtifactRevisionTag is the storage-side representation of an artifact revision tag.
+type ArtifactRevisionTag struct {
+	Key          string         `gorm:"primaryKey"`
+	ProjectID    string         // Project associated with artifact (required).
+	ApiID        string         // Api associated with artifact (if appropriate).
+	VersionID    string         // Version associated with artifact (if appropriate).
+	SpecID       string         // Spec associated with artifact (if appropriate).
+	DeploymentID string         // Deployment associated with artifact (if appropriate).
+	ArtifactID   string         // Artifact identifier (required).
+	RevisionID   string         // Uniquely identifies a revision of an artifact.
+	Tag          string         // The tag to use for the revision.
+	CreateTime   time.Time      // Creation time.
+	UpdateTime   time.Time      // Time of last change.
+	DeleteTime   gorm.DeletedAt `gorm:"index"` // Deletion time, if deleted and not yet purged.
+}
+
+// NewArtifactRevisionTag initializes a new revision tag from a given revision name and tag string.
+func NewArtifactRevisionTag(name names.ArtifactRevision, tag string) *ArtifactRevisionTag {
+	now := time.Now().Round(time.Microsecond)
+	artifact := name.Artifact()
+	return &ArtifactRevisionTag{
+		ProjectID:    artifact.ProjectID(),
+		ApiID:        artifact.ApiID(),
+		VersionID:    artifact.VersionID(),
+		SpecID:       artifact.SpecID(),
+		DeploymentID: artifact.DeploymentID(),
+		ArtifactID:   artifact.ArtifactID(),
+		RevisionID:   name.RevisionID,
+		Tag:          tag,
+		CreateTime:   now,
+		UpdateTime:   now,
+	}
+}
+
+// artifact returns the tagged artifact.
+func (t *ArtifactRevisionTag) artifact() *Artifact {
+	return &Artifact{
+		ProjectID:    t.ProjectID,
+		ApiID:        t.ApiID,
+		VersionID:    t.VersionID,
+		SpecID:       t.SpecID,
+		DeploymentID: t.DeploymentID,
+		ArtifactID:   t.ArtifactID,
+		RevisionID:   t.RevisionID,
+	}
+}
+
+// RevisionName returns the resource name of the tagged revision.
+func (t *ArtifactRevisionTag) RevisionName() string {
+	return t.artifact().RevisionName()
+}
+
+func (t *ArtifactRevisionTag) String() string {
+	return fmt.Sprintf("%s@%s", t.artifact().Name(), t.Tag)
 }
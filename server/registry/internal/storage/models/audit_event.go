@@ -0,0 +1,68 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AuditEvent is the storage-side representation of a record of a call that changed, or attempted to change, the registry.
+type AuditEvent struct {
+	ID           int64     `gorm:"primaryKey"`
+	Time         time.Time `gorm:"index"` // Time the call finished.
+	Principal    string    `gorm:"index"` // Principal that made the call.
+	Method       string    // Name of the method that was called.
+	Resource     string    `gorm:"index"` // Resource that was changed.
+	RequestID    string    // ID of the call in call logs.
+	StatusCode   string    // Status code of the call.
+	ErrorMessage string    // Error message of a failed call.
+	Fields       string    // Comma-separated fields that were changed.
+}
+
+// NewAuditEvent initializes a new audit event from a message.
+func NewAuditEvent(message *rpc.AuditEvent) *AuditEvent {
+	return &AuditEvent{
+		Time:         message.GetTime().AsTime().Round(time.Microsecond),
+		Principal:    message.GetPrincipal(),
+		Method:       message.GetMethod(),
+		Resource:     message.GetResource(),
+		RequestID:    message.GetRequestId(),
+		StatusCode:   message.GetStatusCode(),
+		ErrorMessage: message.GetErrorMessage(),
+		Fields:       strings.Join(message.GetFields(), ","),
+	}
+}
+
+// Message returns a message representing an audit event.
+func (e *AuditEvent) Message() *rpc.AuditEvent {
+	message := &rpc.AuditEvent{
+		Id:           e.ID,
+		Time:         timestamppb.New(e.Time),
+		Principal:    e.Principal,
+		Method:       e.Method,
+		Resource:     e.Resource,
+		RequestId:    e.RequestID,
+		StatusCode:   e.StatusCode,
+		ErrorMessage: e.ErrorMessage,
+	}
+	if e.Fields != "" {
+		message.Fields = strings.Split(e.Fields, ",")
+	}
+	return message
+}
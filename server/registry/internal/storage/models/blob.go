@@ -17,53 +17,29 @@ package models
 import "time"
 
 // Blob is the storage-side representation of a blob.
+// Blobs are identified by the hash of their contents and shared by all spec
+// and artifact revisions with the same contents.
 type Blob struct {
-	Key          string    `gorm:"primaryKey"`
-	ProjectID    string    // Uniquely identifies a project.
-	ApiID        string    // Uniquely identifies an API within a project.
-	VersionID    string    // Uniquely identifies a version of an API.
-	SpecID       string    // Uniquely identifies a spec of a version.
-	RevisionID   string    // Uniquely identifies a revision of a spec.
-	DeploymentID string    // Uniquely identifies a deployment of an API.
-	ArtifactID   string    // Uniquely identifies an artifact on a resource.
-	Hash         string    // Hash of the blob contents.
-	SizeInBytes  int32     // Size of the blob contents.
-	Contents     []byte    // The contents of the blob.
-	CreateTime   time.Time // Creation time.
-	UpdateTime   time.Time // Time of last change.
+	Hash        string    `gorm:"primaryKey"` // SHA-256 hash of the blob contents.
+	SizeInBytes int64     // Size of the blob contents.
+	Contents    []byte    // The contents of the blob, if they are stored in the database.
+	RefCount    int64     // Number of spec and artifact revisions that reference the blob.
+	CreateTime  time.Time // Creation time.
 }
 
-// NewBlobForSpec creates a new Blob object to store spec contents.
-func NewBlobForSpec(spec *Spec, contents []byte) *Blob {
-	now := time.Now().Round(time.Microsecond)
+// NewBlob creates a new Blob object to store contents with the specified hash.
+// The contents are moved to the configured blob store when the blob is saved.
+func NewBlob(hash string, contents []byte) *Blob {
 	return &Blob{
-		ProjectID:   spec.ProjectID,
-		ApiID:       spec.ApiID,
-		VersionID:   spec.VersionID,
-		SpecID:      spec.SpecID,
-		RevisionID:  spec.RevisionID,
-		Hash:        spec.Hash,
-		SizeInBytes: spec.SizeInBytes,
+		Hash:        hash,
+		SizeInBytes: int64(len(contents)),
 		Contents:    contents,
-		CreateTime:  now,
-		UpdateTime:  now,
+		RefCount:    1,
+		CreateTime:  time.Now().Round(time.Microsecond),
 	}
 }
 
-// NewBlobForArtifact creates a new Blob object to store artifact contents.
-func NewBlobForArtifact(artifact *Artifact, contents []byte) *Blob {
-	now := time.Now().Round(time.Microsecond)
-	return &Blob{
-		ProjectID:    artifact.ProjectID,
-		ApiID:        artifact.ApiID,
-		VersionID:    artifact.VersionID,
-		SpecID:       artifact.SpecID,
-		DeploymentID: artifact.DeploymentID,
-		ArtifactID:   artifact.ArtifactID,
-		Hash:         artifact.Hash,
-		SizeInBytes:  artifact.SizeInBytes,
-		Contents:     contents,
-		CreateTime:   now,
-		UpdateTime:   now,
-	}
+// BlobHash returns the hash that identifies the blob of the specified contents.
+func BlobHash(contents []byte) string {
+	return hashForBytes(contents)
 }
@@ -22,27 +22,29 @@ import (
 	"github.com/apigee/registry/server/registry/names"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 // Deployment is the storage-side representation of a deployment.
 type Deployment struct {
-	Key                string    `gorm:"primaryKey"`
-	ProjectID          string    // Uniquely identifies a project.
-	ApiID              string    // Uniquely identifies an api within a project.
-	DeploymentID       string    // Uniquely identifies a deployment within an api.
-	RevisionID         string    // Uniquely identifies a revision of a deployment.
-	DisplayName        string    // A human-friendly name.
-	Description        string    // A detailed description.
-	CreateTime         time.Time // Creation time.
-	RevisionCreateTime time.Time // Revision creation time.
-	RevisionUpdateTime time.Time // Time of last change.
-	ApiSpecRevision    string    // The spec being served by the deployment.
-	EndpointURI        string    // The address where the deployment is serving.
-	ExternalChannelURI string    // The address of the external channel of the API.
-	IntendedAudience   string    // The intended audience of the API.
-	AccessGuidance     string    // A brief description of how to access the endpoint.
-	Labels             []byte    // Serialized labels.
-	Annotations        []byte    // Serialized annotations.
+	Key                string         `gorm:"primaryKey"`
+	ProjectID          string         // Uniquely identifies a project.
+	ApiID              string         // Uniquely identifies an api within a project.
+	DeploymentID       string         // Uniquely identifies a deployment within an api.
+	RevisionID         string         // Uniquely identifies a revision of a deployment.
+	DisplayName        string         // A human-friendly name.
+	Description        string         // A detailed description.
+	CreateTime         time.Time      // Creation time.
+	RevisionCreateTime time.Time      // Revision creation time.
+	RevisionUpdateTime time.Time      // Time of last change.
+	ApiSpecRevision    string         // The spec being served by the deployment.
+	EndpointURI        string         // The address where the deployment is serving.
+	ExternalChannelURI string         // The address of the external channel of the API.
+	IntendedAudience   string         // The intended audience of the API.
+	AccessGuidance     string         // A brief description of how to access the endpoint.
+	Labels             []byte         // Serialized labels.
+	Annotations        []byte         // Serialized annotations.
+	DeleteTime         gorm.DeletedAt `gorm:"index"` // Deletion time, if deleted and not yet purged.
 }
 
 // NewDeployment initializes a new resource.
@@ -52,7 +54,7 @@ func NewDeployment(name names.Deployment, body *rpc.ApiDeployment) (deployment *
 		ProjectID:          name.ProjectID,
 		ApiID:              name.ApiID,
 		DeploymentID:       name.DeploymentID,
-		RevisionID:         newRevisionID(),
+		RevisionID:         NewRevisionID(),
 		DisplayName:        body.GetDisplayName(),
 		Description:        body.GetDescription(),
 		CreateTime:         now,
@@ -85,7 +87,7 @@ func (s *Deployment) NewRevision() *Deployment {
 		ProjectID:          s.ProjectID,
 		ApiID:              s.ApiID,
 		DeploymentID:       s.DeploymentID,
-		RevisionID:         newRevisionID(),
+		RevisionID:         NewRevisionID(),
 		DisplayName:        s.DisplayName,
 		Description:        s.Description,
 		CreateTime:         s.CreateTime,
@@ -114,6 +116,11 @@ func (s *Deployment) RevisionName() string {
 		s.ProjectID, names.Location, s.ApiID, s.DeploymentID, s.RevisionID)
 }
 
+// ETag returns a checksum of the stored deployment revision.
+func (s *Deployment) ETag() string {
+	return etag(s)
+}
+
 // BasicMessage returns the basic view of the deployment resource as an RPC message.
 func (s *Deployment) BasicMessage(name string, tags []string) (message *rpc.ApiDeployment, err error) {
 	message = &rpc.ApiDeployment{
@@ -130,6 +137,8 @@ func (s *Deployment) BasicMessage(name string, tags []string) (message *rpc.ApiD
 		ExternalChannelUri: s.ExternalChannelURI,
 		IntendedAudience:   s.IntendedAudience,
 		AccessGuidance:     s.AccessGuidance,
+		DeleteTime:         deleteTime(s.DeleteTime),
+		Etag:               s.ETag(),
 	}
 
 	message.Labels, err = mapForBytes(s.Labels)
@@ -183,7 +192,7 @@ func (s *Deployment) Update(message *rpc.ApiDeployment, mask *fieldmaskpb.FieldM
 			}
 		}
 		if needsNewRevision {
-			s.RevisionID = newRevisionID()
+			s.RevisionID = NewRevisionID()
 			now := time.Now().Round(time.Microsecond)
 			s.RevisionCreateTime = now
 			s.RevisionUpdateTime = now
@@ -200,14 +209,15 @@ func (s *Deployment) LabelsMap() (map[string]string, error) {
 
 // DeploymentRevisionTag is the storage-side representation of a deployment revision tag.
 type DeploymentRevisionTag struct {
-	Key          string    `gorm:"primaryKey"`
-	ProjectID    string    // Uniquely identifies a project.
-	ApiID        string    // Uniquely identifies an api within a project.
-	DeploymentID string    // Uniquely identifies a deployment within an api.
-	RevisionID   string    // Uniquely identifies a revision of a deployment.
-	Tag          string    // The tag to use for the revision.
-	CreateTime   time.Time // Creation time.
-	UpdateTime   time.Time // Time of last change.
+	Key          string         `gorm:"primaryKey"`
+	ProjectID    string         // Uniquely identifies a project.
+	ApiID        string         // Uniquely identifies an api within a project.
+	DeploymentID string         // Uniquely identifies a deployment within an api.
+	RevisionID   string         // Uniquely identifies a revision of a deployment.
+	Tag          string         // The tag to use for the revision.
+	CreateTime   time.Time      // Creation time.
+	UpdateTime   time.Time      // Time of last change.
+	DeleteTime   gorm.DeletedAt `gorm:"index"` // Deletion time, if deleted and not yet purged.
 }
 
 // NewDeploymentRevisionTag initializes a new revision tag from a given revision name and tag string.
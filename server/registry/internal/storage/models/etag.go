@@ -0,0 +1,53 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"reflect"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// etag returns a checksum of the stored columns of a row, which changes whenever the row is changed.
+// The key is excluded because it is derived from other columns and only set when the row is saved.
+func etag(row interface{}) string {
+	h := sha256.New()
+	v := reflect.Indirect(reflect.ValueOf(row))
+	for i := 0; i < v.NumField(); i++ {
+		if v.Type().Field(i).Name == "Key" {
+			continue
+		}
+
+		// Times are written in a form that doesn't depend on how the database returns them.
+		switch f := v.Field(i).Interface().(type) {
+		case time.Time:
+			fmt.Fprintf(h, "%d;", f.UnixNano())
+		case gorm.DeletedAt:
+			if f.Valid {
+				fmt.Fprintf(h, "%d;", f.Time.UnixNano())
+			} else {
+				fmt.Fprint(h, ";")
+			}
+		case []byte:
+			fmt.Fprintf(h, "%x;", f)
+		default:
+			fmt.Fprintf(h, "%v;", f)
+		}
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)[:8])
+}
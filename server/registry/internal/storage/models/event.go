@@ -0,0 +1,83 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Event is the storage-side representation of a notification in the outbox.
+// Events are recorded in the transactions that make changes and delivered after they commit.
+type Event struct {
+	ID              int64     `gorm:"primaryKey"`
+	Change          string    // The type of change.
+	Resource        string    // The resource affected by the change.
+	ChangeTime      time.Time // Time of the change.
+	Notification    []byte    // The serialized notification.
+	Attempts        int32     // Number of failed delivery attempts.
+	LastError       string    // Error from the last failed delivery attempt.
+	NextAttemptTime time.Time // Earliest time of the next delivery attempt, or the end of the claim of a delivery in progress, in UTC.
+	Delivered       bool      // True if the notification was delivered.
+	DeliverTime     time.Time // Time of delivery, in UTC.
+}
+
+// NewEvent initializes a new event for a notification.
+func NewEvent(n *rpc.Notification) (*Event, error) {
+	b, err := proto.Marshal(n)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Event{
+		Change:          n.GetChange().String(),
+		Resource:        n.GetResource(),
+		ChangeTime:      n.GetChangeTime().AsTime().Round(time.Microsecond),
+		Notification:    b,
+		NextAttemptTime: n.GetChangeTime().AsTime().UTC().Round(time.Microsecond),
+	}, nil
+}
+
+// NotificationMessage returns the notification that the event delivers.
+func (e *Event) NotificationMessage() (*rpc.Notification, error) {
+	n := new(rpc.Notification)
+	if err := proto.Unmarshal(e.Notification, n); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// Message returns a message representing an event.
+func (e *Event) Message() (*rpc.OutboxEvent, error) {
+	n, err := e.NotificationMessage()
+	if err != nil {
+		return nil, err
+	}
+
+	message := &rpc.OutboxEvent{
+		Id:              e.ID,
+		Notification:    n,
+		Attempts:        e.Attempts,
+		LastError:       e.LastError,
+		NextAttemptTime: timestamppb.New(e.NextAttemptTime),
+	}
+	if e.Delivered {
+		message.DeliverTime = timestamppb.New(e.DeliverTime)
+	}
+	return message, nil
+}
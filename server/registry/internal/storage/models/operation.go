@@ -0,0 +1,114 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"time"
+
+	"google.golang.org/genproto/googleapis/longrunning"
+	statuspb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// Operation is the storage-side representation of a long-running operation.
+type Operation struct {
+	Key             string    `gorm:"primaryKey"` // Operation name.
+	Done            bool      // True if the operation has finished.
+	CancelRequested bool      // True if cancellation of the operation has been requested.
+	Metadata        []byte    // The serialized metadata of the operation.
+	Response        []byte    // The serialized response of an operation that succeeded.
+	Error           []byte    // The serialized status of an operation that failed.
+	CreateTime      time.Time // Creation time.
+	UpdateTime      time.Time // Time of last change.
+	Owner           string    // The server that runs the operation.
+	HeartbeatTime   time.Time // Time the owner last reported that the operation is running, in UTC.
+}
+
+// NewOperation initializes a new operation with its initial metadata, to be run by the specified server.
+func NewOperation(name, owner string, metadata proto.Message) (*Operation, error) {
+	now := time.Now().Round(time.Microsecond)
+	op := &Operation{
+		Key:           name,
+		CreateTime:    now,
+		UpdateTime:    now,
+		Owner:         owner,
+		HeartbeatTime: now.UTC(),
+	}
+	return op, op.SetMetadata(metadata)
+}
+
+// SetMetadata sets the metadata of an operation.
+func (o *Operation) SetMetadata(metadata proto.Message) error {
+	b, err := marshalAny(metadata)
+	if err != nil {
+		return err
+	}
+	o.Metadata = b
+	o.UpdateTime = time.Now().Round(time.Microsecond)
+	return nil
+}
+
+// Finish marks an operation as done with an error status, or with a response if the status is nil.
+func (o *Operation) Finish(response proto.Message, s *statuspb.Status) error {
+	o.Done = true
+	o.UpdateTime = time.Now().Round(time.Microsecond)
+	var err error
+	if s != nil {
+		o.Error, err = proto.Marshal(s)
+	} else {
+		o.Response, err = marshalAny(response)
+	}
+	return err
+}
+
+// Message returns a message representing an operation.
+func (o *Operation) Message() (*longrunning.Operation, error) {
+	message := &longrunning.Operation{
+		Name: o.Key,
+		Done: o.Done,
+	}
+
+	if len(o.Metadata) > 0 {
+		message.Metadata = new(anypb.Any)
+		if err := proto.Unmarshal(o.Metadata, message.Metadata); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(o.Error) > 0 {
+		s := new(statuspb.Status)
+		if err := proto.Unmarshal(o.Error, s); err != nil {
+			return nil, err
+		}
+		message.Result = &longrunning.Operation_Error{Error: s}
+	} else if len(o.Response) > 0 {
+		response := new(anypb.Any)
+		if err := proto.Unmarshal(o.Response, response); err != nil {
+			return nil, err
+		}
+		message.Result = &longrunning.Operation_Response{Response: response}
+	}
+
+	return message, nil
+}
+
+func marshalAny(m proto.Message) ([]byte, error) {
+	a, err := anypb.New(m)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(a)
+}
@@ -21,27 +21,31 @@ import (
 	"github.com/apigee/registry/server/registry/names"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 // Project is the storage-side representation of a project.
 type Project struct {
-	Key         string    `gorm:"primaryKey"`
-	ProjectID   string    // Uniquely identifies a project.
-	DisplayName string    // A human-friendly name.
-	Description string    // A detailed description.
-	CreateTime  time.Time // Creation time.
-	UpdateTime  time.Time // Time of last change.
+	Key                   string         `gorm:"primaryKey"`
+	ProjectID             string         // Uniquely identifies a project.
+	DisplayName           string         // A human-friendly name.
+	Description           string         // A detailed description.
+	CreateTime            time.Time      // Creation time.
+	UpdateTime            time.Time      // Time of last change.
+	ArtifactRevisionLimit int32          // Maximum number of revisions retained for each artifact, or zero for no limit.
+	DeleteTime            gorm.DeletedAt `gorm:"index"` // Deletion time, if deleted and not yet purged.
 }
 
 // NewProject initializes a new resource.
 func NewProject(name names.Project, body *rpc.Project) *Project {
 	now := time.Now().Round(time.Microsecond)
 	return &Project{
-		ProjectID:   name.ProjectID,
-		Description: body.GetDescription(),
-		DisplayName: body.GetDisplayName(),
-		CreateTime:  now,
-		UpdateTime:  now,
+		ProjectID:             name.ProjectID,
+		Description:           body.GetDescription(),
+		DisplayName:           body.GetDisplayName(),
+		CreateTime:            now,
+		UpdateTime:            now,
+		ArtifactRevisionLimit: body.GetArtifactRevisionLimit(),
 	}
 }
 
@@ -52,15 +56,31 @@ func (p *Project) Name() string {
 	}.String()
 }
 
+// ETag returns a checksum of the stored project.
+func (p *Project) ETag() string {
+	return etag(p)
+}
+
 // Message returns a message representing a project.
 func (p *Project) Message() *rpc.Project {
 	return &rpc.Project{
-		Name:        p.Name(),
-		DisplayName: p.DisplayName,
-		Description: p.Description,
-		CreateTime:  timestamppb.New(p.CreateTime),
-		UpdateTime:  timestamppb.New(p.UpdateTime),
+		Name:                  p.Name(),
+		DisplayName:           p.DisplayName,
+		Description:           p.Description,
+		CreateTime:            timestamppb.New(p.CreateTime),
+		UpdateTime:            timestamppb.New(p.UpdateTime),
+		ArtifactRevisionLimit: p.ArtifactRevisionLimit,
+		DeleteTime:            deleteTime(p.DeleteTime),
+		Etag:                  p.ETag(),
+	}
+}
+
+// deleteTime returns the deletion time of a resource, or nil if it isn't deleted.
+func deleteTime(t gorm.DeletedAt) *timestamppb.Timestamp {
+	if !t.Valid {
+		return nil
 	}
+	return timestamppb.New(t.Time)
 }
 
 // Update modifies a project using the contents of a message.
@@ -72,6 +92,8 @@ func (p *Project) Update(message *rpc.Project, mask *fieldmaskpb.FieldMask) {
 			p.DisplayName = message.GetDisplayName()
 		case "description":
 			p.Description = message.GetDescription()
+		case "artifact_revision_limit":
+			p.ArtifactRevisionLimit = message.GetArtifactRevisionLimit()
 		}
 	}
 }
@@ -0,0 +1,68 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/names"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// RoleBinding is the storage-side representation of a role granted to a principal.
+type RoleBinding struct {
+	ProjectID  string    `gorm:"primaryKey"` // Empty for roles granted in the whole registry.
+	Principal  string    `gorm:"primaryKey"` // Principal that is granted the role.
+	Role       string    // Name of the role.
+	CreateTime time.Time // Creation time.
+	UpdateTime time.Time // Time of last change.
+}
+
+// NewRoleBinding initializes a new binding from a message.
+func NewRoleBinding(projectID string, message *rpc.RoleBinding) *RoleBinding {
+	now := time.Now().Round(time.Microsecond)
+	return &RoleBinding{
+		ProjectID:  projectID,
+		Principal:  message.GetPrincipal(),
+		Role:       message.GetRole().String(),
+		CreateTime: now,
+		UpdateTime: now,
+	}
+}
+
+// Project returns the name of the project that the role is granted in, or an empty string for the whole registry.
+func (b *RoleBinding) Project() string {
+	if b.ProjectID == "" {
+		return ""
+	}
+	return names.Project{ProjectID: b.ProjectID}.String()
+}
+
+// RoleValue returns the role that is granted.
+func (b *RoleBinding) RoleValue() rpc.RoleBinding_Role {
+	return rpc.RoleBinding_Role(rpc.RoleBinding_Role_value[b.Role])
+}
+
+// Message returns a message representing a binding.
+func (b *RoleBinding) Message() *rpc.RoleBinding {
+	return &rpc.RoleBinding{
+		Project:    b.Project(),
+		Principal:  b.Principal,
+		Role:       b.RoleValue(),
+		CreateTime: timestamppb.New(b.CreateTime),
+		UpdateTime: timestamppb.New(b.UpdateTime),
+	}
+}
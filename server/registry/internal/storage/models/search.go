@@ -0,0 +1,362 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package models
+
+import (
+	"archive/zip"
+	"bytes"
+	"io/ioutil"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Kinds of resources that are indexed for search.
+const (
+	ApiKind        = "api"
+	VersionKind    = "version"
+	SpecKind       = "spec"
+	DeploymentKind = "deployment"
+)
+
+// MaxSearchContentsSize is the maximum number of bytes of spec contents that are indexed.
+// Identifiers extracted from the contents precede their text, so they are kept when long contents are truncated.
+const MaxSearchContentsSize = 512 << 10
+
+// SearchDocument is the text of a resource that is indexed for search.
+// Spec and deployment documents describe the current revision.
+type SearchDocument struct {
+	Key          string    `gorm:"primaryKey"` // Resource name.
+	Kind         string    // Kind of the resource.
+	ProjectID    string    `gorm:"index"` // Uniquely identifies a project.
+	ApiID        string    // Uniquely identifies an api within a project.
+	VersionID    string    // Uniquely identifies a version within an api, if the resource is in a version.
+	SpecID       string    // Uniquely identifies a spec within a version, if the resource is a spec.
+	DeploymentID string    // Uniquely identifies a deployment within an api, if the resource is a deployment.
+	DisplayName  string    // A human-friendly name.
+	Description  string    // A detailed description.
+	Labels       string    // Label keys and values.
+	Annotations  string    // Annotation keys and values.
+	Contents     string    // Identifiers and text of spec contents.
+	UpdateTime   time.Time // Time the document was indexed.
+}
+
+// NewApiSearchDocument returns the search document of an api.
+func NewApiSearchDocument(api *Api) (*SearchDocument, error) {
+	doc := &SearchDocument{
+		Key:         api.Name(),
+		Kind:        ApiKind,
+		ProjectID:   api.ProjectID,
+		ApiID:       api.ApiID,
+		DisplayName: api.DisplayName,
+		Description: api.Description,
+	}
+	return doc, doc.setMaps(api.Labels, api.Annotations)
+}
+
+// NewVersionSearchDocument returns the search document of a version.
+func NewVersionSearchDocument(version *Version) (*SearchDocument, error) {
+	doc := &SearchDocument{
+		Key:         version.Name(),
+		Kind:        VersionKind,
+		ProjectID:   version.ProjectID,
+		ApiID:       version.ApiID,
+		VersionID:   version.VersionID,
+		DisplayName: version.DisplayName,
+		Description: version.Description,
+	}
+	return doc, doc.setMaps(version.Labels, version.Annotations)
+}
+
+// NewSpecSearchDocument returns the search document of a spec revision with the given contents.
+// Specs don't have display names, so their filenames are used instead.
+func NewSpecSearchDocument(spec *Spec, contents []byte) (*SearchDocument, error) {
+	doc := &SearchDocument{
+		Key:         spec.Name(),
+		Kind:        SpecKind,
+		ProjectID:   spec.ProjectID,
+		ApiID:       spec.ApiID,
+		VersionID:   spec.VersionID,
+		SpecID:      spec.SpecID,
+		DisplayName: spec.FileName,
+		Description: spec.Description,
+		Contents:    specText(spec.MimeType, contents),
+	}
+	return doc, doc.setMaps(spec.Labels, spec.Annotations)
+}
+
+// NewDeploymentSearchDocument returns the search document of a deployment revision.
+func NewDeploymentSearchDocument(deployment *Deployment) (*SearchDocument, error) {
+	doc := &SearchDocument{
+		Key:          deployment.Name(),
+		Kind:         DeploymentKind,
+		ProjectID:    deployment.ProjectID,
+		ApiID:        deployment.ApiID,
+		DeploymentID: deployment.DeploymentID,
+		DisplayName:  deployment.DisplayName,
+		Description:  strings.Join([]string{deployment.Description, deployment.IntendedAudience, deployment.AccessGuidance}, "\n"),
+	}
+	return doc, doc.setMaps(deployment.Labels, deployment.Annotations)
+}
+
+func (doc *SearchDocument) setMaps(labels, annotations []byte) error {
+	doc.UpdateTime = time.Now().Round(time.Microsecond)
+	var err error
+	if doc.Labels, err = mapText(labels); err != nil {
+		return err
+	}
+	doc.Annotations, err = mapText(annotations)
+	return err
+}
+
+// mapText returns the keys and values of a serialized map, with identifiers split into words.
+func mapText(b []byte) (string, error) {
+	m, err := mapForBytes(b)
+	if err != nil {
+		return "", err
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var text strings.Builder
+	for _, k := range keys {
+		writeIdentifier(&text, k)
+		writeIdentifier(&text, m[k])
+	}
+	return text.String(), nil
+}
+
+// SearchTerm is a lowercase word of a search query.
+// Words that are identifiers like "listInvoices" also match the words they contain.
+type SearchTerm struct {
+	Word  string
+	Parts []string
+}
+
+// SearchTerms returns the terms of a search query.
+func SearchTerms(query string) []SearchTerm {
+	terms := make([]SearchTerm, 0)
+	seen := make(map[string]bool)
+	for _, field := range strings.FieldsFunc(query, isSeparator) {
+		term := SearchTerm{Word: strings.ToLower(field)}
+		if seen[term.Word] {
+			continue
+		}
+		seen[term.Word] = true
+
+		for _, w := range identifierWords(field) {
+			term.Parts = append(term.Parts, strings.ToLower(w))
+		}
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+var (
+	protoIdentifier  = regexp.MustCompile(`\b(?:service|rpc|message|enum)\s+([A-Za-z_][A-Za-z0-9_]*)`)
+	protoFile        = regexp.MustCompile(`\.proto$`)
+	textFile         = regexp.MustCompile(`\.(proto|ya?ml|json|graphql|txt|md)$`)
+	openAPIOperation = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+)
+
+// specText returns the text of spec contents that is indexed for search.
+// Operation IDs and schema names of OpenAPI descriptions and the services, methods,
+// messages and enums of Protocol Buffer descriptions precede the text of the contents.
+func specText(mimeType string, contents []byte) string {
+	if strings.Contains(mimeType, "+gzip") {
+		var err error
+		if contents, err = GUnzippedBytes(contents); err != nil {
+			return ""
+		}
+	}
+
+	files := map[string][]byte{"": contents}
+	if strings.Contains(mimeType, "+zip") {
+		files = unzippedTextFiles(contents)
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var identifiers, text strings.Builder
+	for _, name := range names {
+		b := files[name]
+		if !utf8.Valid(b) || bytes.IndexByte(b, 0) >= 0 {
+			continue
+		}
+
+		if protoFile.MatchString(name) || (name == "" && strings.Contains(mimeType, "protobuf")) {
+			for _, m := range protoIdentifier.FindAllSubmatch(b, -1) {
+				writeIdentifier(&identifiers, string(m[1]))
+			}
+		} else {
+			for _, id := range openAPIIdentifiers(b) {
+				writeIdentifier(&identifiers, id)
+			}
+		}
+
+		if text.Len() < MaxSearchContentsSize {
+			text.Write(b)
+			text.WriteString("\n")
+		}
+	}
+
+	s := identifiers.String() + text.String()
+	if len(s) > MaxSearchContentsSize {
+		s = strings.ToValidUTF8(s[:MaxSearchContentsSize], "")
+	}
+	return s
+}
+
+// openAPIIdentifiers returns the operation IDs and schema names of an OpenAPI description.
+// It returns nil if the contents aren't an OpenAPI description.
+// Contents are walked as YAML nodes, which avoids the cost of checking large mappings for duplicate keys.
+func openAPIIdentifiers(b []byte) []string {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(b, &doc); err != nil || len(doc.Content) == 0 {
+		return nil
+	}
+	root := doc.Content[0]
+	if yamlValue(root, "openapi") == nil && yamlValue(root, "swagger") == nil {
+		return nil
+	}
+
+	ids := make([]string, 0)
+	for _, item := range yamlValues(yamlValue(root, "paths")) {
+		for _, method := range openAPIOperation {
+			if id := yamlValue(yamlValue(item, method), "operationId"); id != nil && id.Kind == yaml.ScalarNode {
+				ids = append(ids, id.Value)
+			}
+		}
+	}
+	ids = append(ids, yamlKeys(yamlValue(root, "definitions"))...)
+	ids = append(ids, yamlKeys(yamlValue(yamlValue(root, "components"), "schemas"))...)
+	sort.Strings(ids)
+	return ids
+}
+
+// yamlValue returns the value of a key in a YAML mapping, or nil if the node isn't a mapping or doesn't have the key.
+func yamlValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// yamlKeys returns the keys of a YAML mapping.
+func yamlKeys(node *yaml.Node) []string {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	keys := make([]string, 0, len(node.Content)/2)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keys = append(keys, node.Content[i].Value)
+	}
+	return keys
+}
+
+// yamlValues returns the values of a YAML mapping.
+func yamlValues(node *yaml.Node) []*yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	values := make([]*yaml.Node, 0, len(node.Content)/2)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		values = append(values, node.Content[i+1])
+	}
+	return values
+}
+
+// unzippedTextFiles returns the text files of a zip archive by name.
+func unzippedTextFiles(contents []byte) map[string][]byte {
+	files := make(map[string][]byte)
+	r, err := zip.NewReader(bytes.NewReader(contents), int64(len(contents)))
+	if err != nil {
+		return files
+	}
+
+	for _, f := range r.File {
+		if !textFile.MatchString(path.Base(f.Name)) {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			continue
+		}
+		b, err := ioutil.ReadAll(rc)
+		rc.Close()
+		if err == nil {
+			files[f.Name] = b
+		}
+	}
+	return files
+}
+
+// writeIdentifier writes an identifier followed by its words, so that searches for
+// the words of identifiers like "listInvoices" and "invoice_id" find them.
+func writeIdentifier(text *strings.Builder, id string) {
+	text.WriteString(id)
+	for _, w := range identifierWords(id) {
+		text.WriteString(" ")
+		text.WriteString(w)
+	}
+	text.WriteString("\n")
+}
+
+// identifierWords returns the words of an identifier that has more than one,
+// splitting it at separators and changes of case.
+func identifierWords(id string) []string {
+	words := make([]string, 0)
+	for _, part := range strings.FieldsFunc(id, isSeparator) {
+		runes := []rune(part)
+		start := 0
+		for i := 1; i < len(runes); i++ {
+			lowerToUpper := unicode.IsLower(runes[i-1]) && unicode.IsUpper(runes[i])
+			acronymEnd := i+1 < len(runes) && unicode.IsUpper(runes[i-1]) && unicode.IsUpper(runes[i]) && unicode.IsLower(runes[i+1])
+			letterToDigit := unicode.IsLetter(runes[i-1]) != unicode.IsLetter(runes[i])
+			if lowerToUpper || acronymEnd || letterToDigit {
+				words = append(words, string(runes[start:i]))
+				start = i
+			}
+		}
+		words = append(words, string(runes[start:]))
+	}
+	if len(words) < 2 {
+		return nil
+	}
+	return words
+}
+
+func isSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
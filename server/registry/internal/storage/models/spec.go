@@ -27,27 +27,30 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 // Spec is the storage-side representation of a spec.
 type Spec struct {
-	Key                string    `gorm:"primaryKey"`
-	ProjectID          string    // Uniquely identifies a project.
-	ApiID              string    // Uniquely identifies an api within a project.
-	VersionID          string    // Uniquely identifies a version within a api.
-	SpecID             string    // Uniquely identifies a spec within a version.
-	RevisionID         string    // Uniquely identifies a revision of a spec.
-	Description        string    // A detailed description.
-	CreateTime         time.Time // Creation time.
-	RevisionCreateTime time.Time // Revision creation time.
-	RevisionUpdateTime time.Time // Time of last change.
-	MimeType           string    // Spec format.
-	SizeInBytes        int32     // Size of the spec.
-	Hash               string    // A hash of the spec.
-	FileName           string    // Name of spec file.
-	SourceURI          string    // The original source URI of the spec.
-	Labels             []byte    // Serialized labels.
-	Annotations        []byte    // Serialized annotations.
+	Key                string         `gorm:"primaryKey"`
+	ProjectID          string         // Uniquely identifies a project.
+	ApiID              string         // Uniquely identifies an api within a project.
+	VersionID          string         // Uniquely identifies a version within a api.
+	SpecID             string         // Uniquely identifies a spec within a version.
+	RevisionID         string         // Uniquely identifies a revision of a spec.
+	Description        string         // A detailed description.
+	CreateTime         time.Time      // Creation time.
+	RevisionCreateTime time.Time      // Revision creation time.
+	RevisionUpdateTime time.Time      // Time of last change.
+	MimeType           string         // Spec format.
+	SizeInBytes        int64          // Size of the spec.
+	Hash               string         // A hash of the spec.
+	BlobHash           string         // A hash of the stored contents, which identifies their blob.
+	FileName           string         // Name of spec file.
+	SourceURI          string         // The original source URI of the spec.
+	Labels             []byte         // Serialized labels.
+	Annotations        []byte         // Serialized annotations.
+	DeleteTime         gorm.DeletedAt `gorm:"index"` // Deletion time, if deleted and not yet purged.
 }
 
 // NewSpec initializes a new resource.
@@ -65,7 +68,7 @@ func NewSpec(name names.Spec, body *rpc.ApiSpec) (spec *Spec, err error) {
 		CreateTime:         now,
 		RevisionCreateTime: now,
 		RevisionUpdateTime: now,
-		RevisionID:         newRevisionID(),
+		RevisionID:         NewRevisionID(),
 	}
 
 	spec.Labels, err = bytesForMap(body.GetLabels())
@@ -87,8 +90,9 @@ func NewSpec(name names.Spec, body *rpc.ApiSpec) (spec *Spec, err error) {
 				return nil, status.Error(codes.InvalidArgument, err.Error())
 			}
 		}
-		spec.SizeInBytes = int32(len(contents))
+		spec.SizeInBytes = int64(len(contents))
 		spec.Hash = hashForBytes(contents)
+		spec.BlobHash = hashForBytes(body.GetContents())
 	}
 
 	return spec, nil
@@ -107,11 +111,12 @@ func (s *Spec) NewRevision() *Spec {
 		MimeType:           s.MimeType,
 		SizeInBytes:        s.SizeInBytes,
 		Hash:               s.Hash,
+		BlobHash:           s.BlobHash,
 		SourceURI:          s.SourceURI,
 		CreateTime:         s.CreateTime,
 		RevisionCreateTime: now,
 		RevisionUpdateTime: now,
-		RevisionID:         newRevisionID(),
+		RevisionID:         NewRevisionID(),
 	}
 }
 
@@ -131,6 +136,11 @@ func (s *Spec) RevisionName() string {
 		s.ProjectID, names.Location, s.ApiID, s.VersionID, s.SpecID, s.RevisionID)
 }
 
+// ETag returns a checksum of the stored spec revision.
+func (s *Spec) ETag() string {
+	return etag(s)
+}
+
 // BasicMessage returns the basic view of the spec resource as an RPC message.
 func (s *Spec) BasicMessage(name string, tags []string) (message *rpc.ApiSpec, err error) {
 	message = &rpc.ApiSpec{
@@ -146,6 +156,8 @@ func (s *Spec) BasicMessage(name string, tags []string) (message *rpc.ApiSpec, e
 		CreateTime:         timestamppb.New(s.CreateTime),
 		RevisionCreateTime: timestamppb.New(s.RevisionCreateTime),
 		RevisionUpdateTime: timestamppb.New(s.RevisionUpdateTime),
+		DeleteTime:         deleteTime(s.DeleteTime),
+		Etag:               s.ETag(),
 	}
 
 	message.Labels, err = mapForBytes(s.Labels)
@@ -180,7 +192,7 @@ func (s *Spec) Update(message *rpc.ApiSpec, mask *fieldmaskpb.FieldMask) error {
 					return status.Error(codes.InvalidArgument, err.Error())
 				}
 			}
-			s.updateContents(contents)
+			s.updateContents(contents, message.GetContents())
 		case "mime_type":
 			s.MimeType = message.GetMimeType()
 		case "source_uri":
@@ -201,11 +213,14 @@ func (s *Spec) Update(message *rpc.ApiSpec, mask *fieldmaskpb.FieldMask) error {
 	return nil
 }
 
-func (s *Spec) updateContents(contents []byte) {
+// updateContents starts a new revision if the contents changed.
+// The stored contents may be compressed, but the hash and size describe the uncompressed contents.
+func (s *Spec) updateContents(contents, stored []byte) {
 	if hash := hashForBytes(contents); hash != s.Hash {
 		s.Hash = hash
-		s.RevisionID = newRevisionID()
-		s.SizeInBytes = int32(len(contents))
+		s.BlobHash = hashForBytes(stored)
+		s.RevisionID = NewRevisionID()
+		s.SizeInBytes = int64(len(contents))
 
 		now := time.Now().Round(time.Microsecond)
 		s.RevisionCreateTime = now
@@ -218,7 +233,8 @@ func (s *Spec) LabelsMap() (map[string]string, error) {
 	return mapForBytes(s.Labels)
 }
 
-func newRevisionID() string {
+// NewRevisionID returns a new random revision ID.
+func NewRevisionID() string {
 	s := uuid.New().String()
 	return s[len(s)-8:]
 }
@@ -236,15 +252,16 @@ func hashForBytes(b []byte) string {
 
 // SpecRevisionTag is the storage-side representation of a spec revision tag.
 type SpecRevisionTag struct {
-	Key        string    `gorm:"primaryKey"`
-	ProjectID  string    // Uniquely identifies a project.
-	ApiID      string    // Uniquely identifies an api within a project.
-	VersionID  string    // Uniquely identifies a version within a api.
-	SpecID     string    // Uniquely identifies a spec within a version.
-	RevisionID string    // Uniquely identifies a revision of a spec.
-	Tag        string    // The tag to use for the revision.
-	CreateTime time.Time // Creation time.
-	UpdateTime time.Time // Time of last change.
+	Key        string         `gorm:"primaryKey"`
+	ProjectID  string         // Uniquely identifies a project.
+	ApiID      string         // Uniquely identifies an api within a project.
+	VersionID  string         // Uniquely identifies a version within a api.
+	SpecID     string         // Uniquely identifies a spec within a version.
+	RevisionID string         // Uniquely identifies a revision of a spec.
+	Tag        string         // The tag to use for the revision.
+	CreateTime time.Time      // Creation time.
+	UpdateTime time.Time      // Time of last change.
+	DeleteTime gorm.DeletedAt `gorm:"index"` // Deletion time, if deleted and not yet purged.
 }
 
 // NewSpecRevisionTag initializes a new revision tag from a given revision name and tag string.
@@ -21,21 +21,23 @@ import (
 	"github.com/apigee/registry/server/registry/names"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 )
 
 // Version is the storage-side representation of a version.
 type Version struct {
-	Key         string    `gorm:"primaryKey"`
-	ProjectID   string    // Uniquely identifies a project.
-	ApiID       string    // Uniquely identifies an api within a project.
-	VersionID   string    // Uniquely identifies a version wihtin a api.
-	DisplayName string    // A human-friendly name.
-	Description string    // A detailed description.
-	CreateTime  time.Time // Creation time.
-	UpdateTime  time.Time // Time of last change.
-	State       string    // Lifecycle stage.
-	Labels      []byte    // Serialized labels.
-	Annotations []byte    // Serialized annotations.
+	Key         string         `gorm:"primaryKey"`
+	ProjectID   string         // Uniquely identifies a project.
+	ApiID       string         // Uniquely identifies an api within a project.
+	VersionID   string         // Uniquely identifies a version wihtin a api.
+	DisplayName string         // A human-friendly name.
+	Description string         // A detailed description.
+	CreateTime  time.Time      // Creation time.
+	UpdateTime  time.Time      // Time of last change.
+	State       string         // Lifecycle stage.
+	Labels      []byte         // Serialized labels.
+	Annotations []byte         // Serialized annotations.
+	DeleteTime  gorm.DeletedAt `gorm:"index"` // Deletion time, if deleted and not yet purged.
 }
 
 // NewVersion initializes a new resource.
@@ -74,6 +76,11 @@ func (v *Version) Name() string {
 	}.String()
 }
 
+// ETag returns a checksum of the stored version.
+func (v *Version) ETag() string {
+	return etag(v)
+}
+
 // Message returns a message representing a version.
 func (v *Version) Message() (message *rpc.ApiVersion, err error) {
 	message = &rpc.ApiVersion{
@@ -83,6 +90,8 @@ func (v *Version) Message() (message *rpc.ApiVersion, err error) {
 		State:       v.State,
 		CreateTime:  timestamppb.New(v.CreateTime),
 		UpdateTime:  timestamppb.New(v.UpdateTime),
+		DeleteTime:  deleteTime(v.DeleteTime),
+		Etag:        v.ETag(),
 	}
 
 	message.Labels, err = v.LabelsMap()
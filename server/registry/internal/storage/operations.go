@@ -0,0 +1,218 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/apigee/registry/server/registry/internal/storage/filtering"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// CreateOperation saves a new operation.
+func (c *Client) CreateOperation(ctx context.Context, v *models.Operation) error {
+	if err := c.db.Create(v).Error; err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// UpdateOperation saves the metadata and result of an operation.
+// Requests to cancel the operation are kept.
+func (c *Client) UpdateOperation(ctx context.Context, v *models.Operation) error {
+	err := c.db.Model(v).
+		Select("done", "metadata", "response", "error", "update_time").
+		Updates(v).Error
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// GetOperation returns the operation with the specified name.
+func (c *Client) GetOperation(ctx context.Context, name string) (*models.Operation, error) {
+	v := new(models.Operation)
+	if err := c.db.First(v, "key = ?", name).Error; err == gorm.ErrRecordNotFound {
+		return nil, status.Errorf(codes.NotFound, "%q not found in database", name)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return v, nil
+}
+
+// RenewOperations records that the unfinished operations of a server are still running at a given time.
+func (c *Client) RenewOperations(ctx context.Context, owner string, now time.Time) error {
+	err := c.db.Model(&models.Operation{}).
+		Where("owner = ? AND done = ?", owner, false).
+		Update("heartbeat_time", now.UTC()).Error
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// StaleOperations returns the operations that aren't done and that their servers haven't renewed since a given time.
+func (c *Client) StaleOperations(ctx context.Context, before time.Time) ([]models.Operation, error) {
+	lock()
+	defer unlock()
+
+	var operations []models.Operation
+	if err := c.db.Where("done = ? AND heartbeat_time < ?", false, before.UTC()).Find(&operations).Error; err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return operations, nil
+}
+
+// FinishStaleOperation saves the result of an operation that its server hasn't renewed since a given time.
+// It returns false without saving the result if the operation was renewed or finished in the meantime.
+func (c *Client) FinishStaleOperation(ctx context.Context, v *models.Operation, before time.Time) (bool, error) {
+	op := c.db.Model(v).
+		Where("done = ? AND heartbeat_time < ?", false, before.UTC()).
+		Select("done", "metadata", "response", "error", "update_time").
+		Updates(v)
+	if err := op.Error; err != nil {
+		return false, status.Error(codes.Internal, err.Error())
+	}
+	return op.RowsAffected > 0, nil
+}
+
+// CancelOperation requests cancellation of an operation.
+// The request is recorded for servers that run the operation, and has no effect on operations that are done.
+func (c *Client) CancelOperation(ctx context.Context, name string) error {
+	if _, err := c.GetOperation(ctx, name); err != nil {
+		return err
+	}
+	err := c.db.Model(&models.Operation{}).
+		Where("key = ? AND done = ?", name, false).
+		Update("cancel_requested", true).Error
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// DeleteOperation deletes the record of an operation that is done.
+// Operations that are running can't be deleted, because their servers still save their progress.
+func (c *Client) DeleteOperation(ctx context.Context, name string) error {
+	op := c.db.Delete(&models.Operation{}, "key = ? AND done = ?", name, true)
+	if err := op.Error; err != nil {
+		return status.Error(codes.Internal, err.Error())
+	} else if op.RowsAffected == 0 {
+		if _, err := c.GetOperation(ctx, name); err != nil {
+			return err
+		}
+		return status.Errorf(codes.FailedPrecondition, "operation %q is running, cancel it before deleting it", name)
+	}
+	return nil
+}
+
+// OperationList contains a page of operations.
+type OperationList struct {
+	Operations []models.Operation
+	Token      string
+}
+
+var operationFields = []filtering.Field{
+	{Name: "name", Type: filtering.String, Column: "key"},
+	{Name: "create_time", Type: filtering.Timestamp, Column: "create_time"},
+	{Name: "update_time", Type: filtering.Timestamp, Column: "update_time"},
+}
+
+// operationOrder lists operations in the order they were created.
+var operationOrder = []ordering{{Field: "create_time", Column: "create_time"}, {Column: "key"}}
+
+// ListOperations returns operations in the order they were created.
+func (c *Client) ListOperations(ctx context.Context, opts PageOptions) (OperationList, error) {
+	token, err := decodeToken(opts.Token)
+	if err != nil {
+		return OperationList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err.Error())
+	}
+
+	if err := token.ValidateFilter(opts.Filter); err != nil {
+		return OperationList{}, status.Errorf(codes.InvalidArgument, "invalid filter %q: %s", opts.Filter, err)
+	} else {
+		token.Filter = opts.Filter
+	}
+
+	filter, err := filtering.NewFilter(opts.Filter, operationFields)
+	if err != nil {
+		return OperationList{}, err
+	}
+
+	op, verify := c.applyFilter(c.db, filter, opts)
+	op, err = paginate(op, operationOrder, token)
+	if err != nil {
+		return OperationList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err)
+	}
+
+	lock()
+	var operations []models.Operation
+	err = op.Find(&operations).Error
+	unlock()
+	if err != nil {
+		return OperationList{}, status.Error(codes.Internal, err.Error())
+	}
+
+	response := OperationList{
+		Operations: make([]models.Operation, 0, opts.Size),
+	}
+
+	for _, operation := range operations {
+		operationMap := operationMap(operation)
+		if verify {
+			match, err := filter.Matches(operationMap)
+			if err != nil {
+				return response, err
+			} else if !match {
+				continue
+			}
+		}
+
+		if len(response.Operations) < int(opts.Size) {
+			response.Operations = append(response.Operations, operation)
+			token.Last = position(operationOrder, operation.Key, operationMap)
+		} else if len(response.Operations) == int(opts.Size) {
+			response.Token, err = encodeToken(token)
+			if err != nil {
+				return response, status.Error(codes.Internal, err.Error())
+			}
+			break
+		}
+	}
+
+	if response.Token == "" {
+		response.Token, err = scanToken(verify, len(operations), token, func() ([]interface{}, error) {
+			last := operations[len(operations)-1]
+			return position(operationOrder, last.Key, operationMap(last)), nil
+		})
+		if err != nil {
+			return response, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return response, nil
+}
+
+func operationMap(operation models.Operation) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        operation.Key,
+		"create_time": operation.CreateTime,
+		"update_time": operation.UpdateTime,
+	}
+}
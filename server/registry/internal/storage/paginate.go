@@ -18,9 +18,21 @@ import (
 	"bytes"
 	"encoding/base64"
 	"encoding/gob"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
+
+	"github.com/apigee/registry/server/registry/internal/storage/filtering"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+func init() {
+	// Timestamps are stored in tokens that page through revisions.
+	gob.Register(time.Time{})
+}
+
 // PageOptions contains custom arguments for listing requests.
 type PageOptions struct {
 	// Size is the maximum number of resources to include in the response.
@@ -28,31 +40,50 @@ type PageOptions struct {
 	Size int32
 	// Filter is the filter string for this listing request, as described at https://google.aip.dev/160.
 	Filter string
+	// Order is the ordering string for this listing request, as described at https://google.aip.dev/132#ordering.
+	// If unspecified, resources are ordered by name.
+	Order string
 	// Token is a value returned from with a previous page in a series of listing requests.
 	// If specified, listing will continue from the end of the previous page. Otherwise,
 	// the first page in a listing series will be returned.
 	Token string
+	// ShowDeleted includes resources that were deleted and not yet purged.
+	ShowDeleted bool
+	// ProjectIDs restricts listings of projects to the projects with these IDs. If nil, all projects are listed.
+	ProjectIDs []string
 }
 
 // token contains information to share between sequential page iterators.
 type token struct {
-	// Offset is the number of resources that should be skipped before the page begins.
-	// It should be set to the number of resources already returned.
-	Offset int
+	// Last contains the values of the ordering columns for the last resource returned.
+	// The next page begins with the first resource that is ordered after these values.
+	Last []interface{}
 	// Filter is the filter string for this listing request. It should be consistent between sequential pages.
 	Filter string
+	// Order is the ordering string for this listing request. It should be consistent between sequential pages.
+	Order string
 }
 
 // ValidateFilter returns an error if the new filter doesn't match the token's encoded filter.
 // When the token represents the first page, any filter is valid and no error will be returned.
 func (t token) ValidateFilter(newFilter string) error {
-	if t.Offset > 0 && newFilter != t.Filter {
+	if len(t.Last) > 0 && newFilter != t.Filter {
 		return fmt.Errorf("new filter does not match previous filter %q", t.Filter)
 	}
 
 	return nil
 }
 
+// ValidateOrder returns an error if the new ordering doesn't match the token's encoded ordering.
+// When the token represents the first page, any ordering is valid and no error will be returned.
+func (t token) ValidateOrder(newOrder string) error {
+	if len(t.Last) > 0 && newOrder != t.Order {
+		return fmt.Errorf("new ordering does not match previous ordering %q", t.Order)
+	}
+
+	return nil
+}
+
 // encodeToken converts a token struct into an opaque string that can be converted back into struct form using decodeToken().
 func encodeToken(o token) (string, error) {
 	var encoding bytes.Buffer
@@ -85,3 +116,124 @@ func decodeToken(encoded string) (token, error) {
 
 	return opts, nil
 }
+
+// ordering is a column used to order the resources in a listing.
+type ordering struct {
+	// Field is the name of the filter field holding the column value.
+	// It is empty for the primary key.
+	Field  string
+	Column string
+	Desc   bool
+}
+
+// keyOrder orders resources by their primary key.
+var keyOrder = []ordering{{Column: "key"}}
+
+// parseOrder returns the ordering described by an order_by string.
+// Fields must have a column and can't be maps. Resources are always ordered
+// by their primary key after the requested fields, so every resource has a distinct position.
+func parseOrder(orderBy string, fields []filtering.Field) ([]ordering, error) {
+	if strings.TrimSpace(orderBy) == "" {
+		return keyOrder, nil
+	}
+
+	order := make([]ordering, 0)
+	seen := make(map[string]bool)
+	for _, term := range strings.Split(orderBy, ",") {
+		parts := strings.Fields(term)
+		if len(parts) == 0 || len(parts) > 2 {
+			return nil, fmt.Errorf("invalid term %q", strings.TrimSpace(term))
+		}
+
+		name, desc := parts[0], false
+		if len(parts) == 2 {
+			switch parts[1] {
+			case "asc":
+			case "desc":
+				desc = true
+			default:
+				return nil, fmt.Errorf("invalid direction %q for field %q", parts[1], name)
+			}
+		}
+
+		if seen[name] {
+			return nil, fmt.Errorf("field %q is repeated", name)
+		}
+		seen[name] = true
+
+		// Names are unique, so no other fields are needed to order resources.
+		if name == "name" {
+			return append(order, ordering{Column: "key", Desc: desc}), nil
+		}
+
+		field, ok := findField(fields, name)
+		if !ok {
+			return nil, fmt.Errorf("unknown field %q", name)
+		} else if field.Column == "" || field.Type == filtering.StringMap {
+			return nil, fmt.Errorf("field %q can't be used for ordering", name)
+		}
+
+		order = append(order, ordering{Field: field.Name, Column: field.Column, Desc: desc})
+	}
+
+	return append(order, keyOrder...), nil
+}
+
+func findField(fields []filtering.Field, name string) (filtering.Field, bool) {
+	for _, f := range fields {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return filtering.Field{}, false
+}
+
+// position returns the values of the ordering columns for a resource.
+// Values other than the key are read from the map used to filter the resource.
+func position(order []ordering, key string, values map[string]interface{}) []interface{} {
+	last := make([]interface{}, len(order))
+	for i, o := range order {
+		if o.Field == "" {
+			last[i] = key
+		} else {
+			last[i] = values[o.Field]
+		}
+	}
+	return last
+}
+
+// paginate orders a query and restricts it to the rows that follow the position of the token.
+// The last column of the ordering must be unique so that every row has a distinct position.
+func paginate(op *gorm.DB, order []ordering, t token) (*gorm.DB, error) {
+	for _, o := range order {
+		op = op.Order(clause.OrderByColumn{Column: clause.Column{Name: o.Column}, Desc: o.Desc})
+	}
+
+	if len(t.Last) == 0 {
+		return op, nil
+	} else if len(t.Last) != len(order) {
+		return nil, errors.New("token does not match the order of this listing")
+	}
+
+	// Rows follow the position if they are ordered after it by the first column,
+	// or if they are equal in the first column and ordered after it by the second, and so on.
+	terms := make([]string, 0, len(order))
+	args := make([]interface{}, 0)
+	for i, o := range order {
+		conds := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			conds = append(conds, order[j].Column+" = ?")
+			args = append(args, t.Last[j])
+		}
+
+		if o.Desc {
+			conds = append(conds, o.Column+" < ?")
+		} else {
+			conds = append(conds, o.Column+" > ?")
+		}
+		args = append(args, t.Last[i])
+		terms = append(terms, "("+strings.Join(conds, " AND ")+")")
+	}
+
+	return op.Where(strings.Join(terms, " OR "), args...), nil
+}
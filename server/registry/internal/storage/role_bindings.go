@@ -0,0 +1,185 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+
+	"github.com/apigee/registry/server/registry/internal/storage/filtering"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// SaveRoleBinding saves a binding, replacing the role of an existing binding for the same project and principal.
+// Existing bindings keep their creation time.
+func (c *Client) SaveRoleBinding(ctx context.Context, v *models.RoleBinding) error {
+	err := c.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "project_id"}, {Name: "principal"}},
+		DoUpdates: clause.AssignmentColumns([]string{"role", "update_time"}),
+	}).Create(v).Error
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// GetRoleBinding returns the binding of a principal in a project, or in the whole registry if projectID is empty.
+func (c *Client) GetRoleBinding(ctx context.Context, projectID, principal string) (*models.RoleBinding, error) {
+	v := new(models.RoleBinding)
+	if err := c.db.Take(v, "project_id = ? AND principal = ?", projectID, principal).Error; err == gorm.ErrRecordNotFound {
+		return nil, status.Errorf(codes.NotFound, "binding of %q not found in database", principal)
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return v, nil
+}
+
+// RoleBindings returns all of the bindings of a principal.
+func (c *Client) RoleBindings(ctx context.Context, principal string) ([]models.RoleBinding, error) {
+	lock()
+	defer unlock()
+
+	var bindings []models.RoleBinding
+	if err := c.db.Where("principal = ?", principal).Find(&bindings).Error; err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return bindings, nil
+}
+
+// DeleteRoleBinding deletes the binding of a principal in a project, or in the whole registry if projectID is empty.
+func (c *Client) DeleteRoleBinding(ctx context.Context, projectID, principal string) error {
+	op := c.db.Delete(&models.RoleBinding{}, "project_id = ? AND principal = ?", projectID, principal)
+	if err := op.Error; err != nil {
+		return status.Error(codes.Internal, err.Error())
+	} else if op.RowsAffected == 0 {
+		return status.Errorf(codes.NotFound, "binding of %q not found in database", principal)
+	}
+	return nil
+}
+
+// RoleBindingList contains a page of role bindings.
+type RoleBindingList struct {
+	RoleBindings []models.RoleBinding
+	Token        string
+}
+
+var roleBindingFields = []filtering.Field{
+	{Name: "project_id", Type: filtering.String, Column: "project_id"},
+	{Name: "principal", Type: filtering.String, Column: "principal"},
+	{Name: "role", Type: filtering.String, Column: "role"},
+	{Name: "create_time", Type: filtering.Timestamp, Column: "create_time"},
+	{Name: "update_time", Type: filtering.Timestamp, Column: "update_time"},
+}
+
+// roleBindingOrder lists bindings by project and principal.
+// Bindings in the whole registry have an empty project ID, so they are listed first.
+var roleBindingOrder = []ordering{{Field: "project_id", Column: "project_id"}, {Column: "principal"}}
+
+// ListRoleBindings returns the bindings in a project, or all bindings if projectID is empty.
+func (c *Client) ListRoleBindings(ctx context.Context, projectID string, opts PageOptions) (RoleBindingList, error) {
+	token, err := decodeToken(opts.Token)
+	if err != nil {
+		return RoleBindingList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err.Error())
+	}
+
+	if err := token.ValidateFilter(opts.Filter); err != nil {
+		return RoleBindingList{}, status.Errorf(codes.InvalidArgument, "invalid filter %q: %s", opts.Filter, err)
+	} else {
+		token.Filter = opts.Filter
+	}
+
+	filter, err := filtering.NewFilter(opts.Filter, roleBindingFields)
+	if err != nil {
+		return RoleBindingList{}, err
+	}
+
+	op := c.db
+	if projectID != "" {
+		op = op.Where("project_id = ?", projectID)
+	}
+	op, verify := c.applyFilter(op, filter, opts)
+	op, err = paginate(op, roleBindingOrder, token)
+	if err != nil {
+		return RoleBindingList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err)
+	}
+
+	lock()
+	var bindings []models.RoleBinding
+	err = op.Find(&bindings).Error
+	unlock()
+	if err != nil {
+		return RoleBindingList{}, status.Error(codes.Internal, err.Error())
+	}
+
+	response := RoleBindingList{
+		RoleBindings: make([]models.RoleBinding, 0, opts.Size),
+	}
+
+	for _, binding := range bindings {
+		bindingMap := roleBindingMap(binding)
+		if verify {
+			match, err := filter.Matches(bindingMap)
+			if err != nil {
+				return response, err
+			} else if !match {
+				continue
+			}
+		}
+
+		if len(response.RoleBindings) < int(opts.Size) {
+			response.RoleBindings = append(response.RoleBindings, binding)
+			token.Last = position(roleBindingOrder, binding.Principal, bindingMap)
+		} else if len(response.RoleBindings) == int(opts.Size) {
+			response.Token, err = encodeToken(token)
+			if err != nil {
+				return response, status.Error(codes.Internal, err.Error())
+			}
+			break
+		}
+	}
+
+	if response.Token == "" {
+		response.Token, err = scanToken(verify, len(bindings), token, func() ([]interface{}, error) {
+			last := bindings[len(bindings)-1]
+			return position(roleBindingOrder, last.Principal, roleBindingMap(last)), nil
+		})
+		if err != nil {
+			return response, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return response, nil
+}
+
+func roleBindingMap(binding models.RoleBinding) map[string]interface{} {
+	return map[string]interface{}{
+		"project_id":  binding.ProjectID,
+		"principal":   binding.Principal,
+		"role":        binding.Role,
+		"create_time": binding.CreateTime,
+		"update_time": binding.UpdateTime,
+	}
+}
+
+// deletePurgedRoleBindings deletes the bindings in projects that were purged.
+func (c *Client) deletePurgedRoleBindings(projectIDs []string) error {
+	if len(projectIDs) == 0 {
+		return nil
+	}
+	return c.db.Where("project_id IN ?", projectIDs).Delete(&models.RoleBinding{}).Error
+}
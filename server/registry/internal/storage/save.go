@@ -21,6 +21,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 func (c *Client) SaveProject(ctx context.Context, v *models.Project) error {
@@ -43,10 +44,10 @@ func (c *Client) SaveSpecRevision(ctx context.Context, v *models.Spec) error {
 	return c.save(v)
 }
 
+// SaveSpecRevisionContents adds a reference from a spec revision to the blob that stores its contents.
+// It should be called once for each new revision.
 func (c *Client) SaveSpecRevisionContents(ctx context.Context, spec *models.Spec, contents []byte) error {
-	v := models.NewBlobForSpec(spec, contents)
-	v.Key = spec.RevisionName()
-	return c.save(v)
+	return c.addBlobReference(ctx, models.NewBlob(spec.BlobHash, contents))
 }
 
 func (c *Client) SaveSpecRevisionTag(ctx context.Context, v *models.SpecRevisionTag) error {
@@ -64,34 +65,91 @@ func (c *Client) SaveDeploymentRevisionTag(ctx context.Context, v *models.Deploy
 	return c.save(v)
 }
 
-func (c *Client) SaveArtifact(ctx context.Context, v *models.Artifact) error {
-	v.Key = v.Name()
+func (c *Client) SaveArtifactRevision(ctx context.Context, v *models.Artifact) error {
+	v.Key = v.RevisionName()
 	return c.save(v)
 }
 
-func (c *Client) SaveArtifactContents(ctx context.Context, artifact *models.Artifact, contents []byte) error {
-	v := models.NewBlobForArtifact(artifact, contents)
-	v.Key = artifact.Name()
+// SaveArtifactRevisionContents adds a reference from an artifact revision to the blob that stores its contents.
+// It should be called once for each new revision.
+func (c *Client) SaveArtifactRevisionContents(ctx context.Context, artifact *models.Artifact, contents []byte) error {
+	return c.addBlobReference(ctx, models.NewBlob(artifact.BlobHash, contents))
+}
+
+func (c *Client) SaveArtifactRevisionTag(ctx context.Context, v *models.ArtifactRevisionTag) error {
+	v.Key = v.String()
 	return c.save(v)
 }
 
+// SaveEvent adds a notification to the outbox.
+// Events should be saved in the same transaction as the changes they describe.
+func (c *Client) SaveEvent(ctx context.Context, v *models.Event) error {
+	if err := c.db.Create(v).Error; err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// addBlobReference increments the reference count of a blob, creating it if it doesn't exist.
+// The contents are only written to the blob store when the blob is created.
+func (c *Client) addBlobReference(ctx context.Context, v *models.Blob) error {
+	contents := v.Contents
+	v.Contents = nil
+	err := c.db.Transaction(func(tx *gorm.DB) error {
+		got := tx.Model(&models.Blob{}).
+			Where("hash = ?", v.Hash).
+			UpdateColumn("ref_count", gorm.Expr("ref_count + 1"))
+		if err := got.Error; err != nil {
+			return err
+		}
+
+		if got.RowsAffected > 0 {
+			return nil
+		}
+
+		// A concurrent transaction may create the same blob, which is counted as a reference instead.
+		err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "hash"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{"ref_count": gorm.Expr("blobs.ref_count + 1")}),
+		}).Create(v).Error
+		if err != nil || len(contents) == 0 {
+			return err
+		}
+
+		db := &Client{db: tx, blobs: c.blobs}
+		return db.blobStore().Put(ctx, v.Hash, contents)
+	})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
 func (c *Client) save(v interface{}) error {
 	err := c.db.Transaction(func(tx *gorm.DB) error {
 		// Update all fields from model: https://gorm.io/docs/update.html#Update-Selected-Fields
-		got := tx.Model(v).Select("*").Updates(v)
+		// Deleted rows are included so that recreated resources replace them.
+		got := tx.Unscoped().Model(v).Select("*").Updates(v)
 		if err := got.Error; err != nil {
 			return got.Error
 		}
 
 		if got.RowsAffected == 0 {
-			if err := tx.Create(v).Error; err != nil {
+			// A concurrent transaction may have created the same row since it was updated.
+			created := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(v)
+			if err := created.Error; err != nil {
 				return err
+			} else if created.RowsAffected == 0 {
+				return status.Error(codes.AlreadyExists, "row was created by a concurrent transaction")
 			}
 		}
 
 		return nil
 	})
-	if err != nil {
+	if status.Code(err) == codes.AlreadyExists {
+		return err
+	} else if err != nil {
 		return status.Error(codes.Internal, err.Error())
 	}
 
@@ -0,0 +1,256 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// The tables created by migrations are defined here as they were when each migration was released,
+// so that changes to the models don't change the schemas that earlier migrations create.
+// A model that changes after its table is created needs a migration that makes the change.
+// The suffix of each definition is the schema version of the migration that creates its table.
+
+type projectV1 struct {
+	Key                   string `gorm:"primaryKey"`
+	ProjectID             string
+	DisplayName           string
+	Description           string
+	CreateTime            time.Time
+	UpdateTime            time.Time
+	ArtifactRevisionLimit int32
+	DeleteTime            gorm.DeletedAt `gorm:"index"`
+}
+
+func (projectV1) TableName() string { return "projects" }
+
+type apiV1 struct {
+	Key                string `gorm:"primaryKey"`
+	ProjectID          string
+	ApiID              string
+	DisplayName        string
+	Description        string
+	CreateTime         time.Time
+	UpdateTime         time.Time
+	Availability       string
+	RecommendedVersion string
+	Labels             []byte
+	Annotations        []byte
+	DeleteTime         gorm.DeletedAt `gorm:"index"`
+}
+
+func (apiV1) TableName() string { return "apis" }
+
+type versionV1 struct {
+	Key         string `gorm:"primaryKey"`
+	ProjectID   string
+	ApiID       string
+	VersionID   string
+	DisplayName string
+	Description string
+	CreateTime  time.Time
+	UpdateTime  time.Time
+	State       string
+	Labels      []byte
+	Annotations []byte
+	DeleteTime  gorm.DeletedAt `gorm:"index"`
+}
+
+func (versionV1) TableName() string { return "versions" }
+
+type specV1 struct {
+	Key                string `gorm:"primaryKey"`
+	ProjectID          string
+	ApiID              string
+	VersionID          string
+	SpecID             string
+	RevisionID         string
+	Description        string
+	CreateTime         time.Time
+	RevisionCreateTime time.Time
+	RevisionUpdateTime time.Time
+	MimeType           string
+	SizeInBytes        int64
+	Hash               string
+	BlobHash           string
+	FileName           string
+	SourceURI          string
+	Labels             []byte
+	Annotations        []byte
+	DeleteTime         gorm.DeletedAt `gorm:"index"`
+}
+
+func (specV1) TableName() string { return "specs" }
+
+type specRevisionTagV1 struct {
+	Key        string `gorm:"primaryKey"`
+	ProjectID  string
+	ApiID      string
+	VersionID  string
+	SpecID     string
+	RevisionID string
+	Tag        string
+	CreateTime time.Time
+	UpdateTime time.Time
+	DeleteTime gorm.DeletedAt `gorm:"index"`
+}
+
+func (specRevisionTagV1) TableName() string { return "spec_revision_tags" }
+
+type deploymentV1 struct {
+	Key                string `gorm:"primaryKey"`
+	ProjectID          string
+	ApiID              string
+	DeploymentID       string
+	RevisionID         string
+	DisplayName        string
+	Description        string
+	CreateTime         time.Time
+	RevisionCreateTime time.Time
+	RevisionUpdateTime time.Time
+	ApiSpecRevision    string
+	EndpointURI        string
+	ExternalChannelURI string
+	IntendedAudience   string
+	AccessGuidance     string
+	Labels             []byte
+	Annotations        []byte
+	DeleteTime         gorm.DeletedAt `gorm:"index"`
+}
+
+func (deploymentV1) TableName() string { return "deployments" }
+
+type deploymentRevisionTagV1 struct {
+	Key          string `gorm:"primaryKey"`
+	ProjectID    string
+	ApiID        string
+	DeploymentID string
+	RevisionID   string
+	Tag          string
+	CreateTime   time.Time
+	UpdateTime   time.Time
+	DeleteTime   gorm.DeletedAt `gorm:"index"`
+}
+
+func (deploymentRevisionTagV1) TableName() string { return "deployment_revision_tags" }
+
+type artifactV1 struct {
+	Key                string `gorm:"primaryKey"`
+	ProjectID          string
+	ApiID              string
+	VersionID          string
+	SpecID             string
+	DeploymentID       string
+	ArtifactID         string
+	RevisionID         string
+	CreateTime         time.Time
+	RevisionCreateTime time.Time
+	UpdateTime         time.Time
+	MimeType           string
+	SizeInBytes        int64
+	Hash               string
+	BlobHash           string
+	Labels             []byte
+	Annotations        []byte
+	DeleteTime         gorm.DeletedAt `gorm:"index"`
+}
+
+func (artifactV1) TableName() string { return "artifacts" }
+
+type artifactRevisionTagV1 struct {
+	Key          string `gorm:"primaryKey"`
+	ProjectID    string
+	ApiID        string
+	VersionID    string
+	SpecID       string
+	DeploymentID string
+	ArtifactID   string
+	RevisionID   string
+	Tag          string
+	CreateTime   time.Time
+	UpdateTime   time.Time
+	DeleteTime   gorm.DeletedAt `gorm:"index"`
+}
+
+func (artifactRevisionTagV1) TableName() string { return "artifact_revision_tags" }
+
+type blobV1 struct {
+	Hash        string `gorm:"primaryKey"`
+	SizeInBytes int64
+	Contents    []byte
+	RefCount    int64
+	CreateTime  time.Time
+}
+
+func (blobV1) TableName() string { return "blobs" }
+
+type eventV1 struct {
+	ID              int64 `gorm:"primaryKey"`
+	Change          string
+	Resource        string
+	ChangeTime      time.Time
+	Notification    []byte
+	Attempts        int32
+	LastError       string
+	NextAttemptTime time.Time
+	Delivered       bool
+	DeliverTime     time.Time
+}
+
+func (eventV1) TableName() string { return "events" }
+
+type searchDocumentV2 struct {
+	Key          string `gorm:"primaryKey"`
+	Kind         string
+	ProjectID    string `gorm:"index"`
+	ApiID        string
+	VersionID    string
+	SpecID       string
+	DeploymentID string
+	DisplayName  string
+	Description  string
+	Labels       string
+	Annotations  string
+	Contents     string
+	UpdateTime   time.Time
+}
+
+func (searchDocumentV2) TableName() string { return "search_documents" }
+
+type roleBindingV4 struct {
+	ProjectID  string `gorm:"primaryKey"`
+	Principal  string `gorm:"primaryKey"`
+	Role       string
+	CreateTime time.Time
+	UpdateTime time.Time
+}
+
+func (roleBindingV4) TableName() string { return "role_bindings" }
+
+type auditEventV5 struct {
+	ID           int64     `gorm:"primaryKey"`
+	Time         time.Time `gorm:"index"`
+	Principal    string    `gorm:"index"`
+	Method       string
+	Resource     string `gorm:"index"`
+	RequestID    string
+	StatusCode   string
+	ErrorMessage string
+	Fields       string
+}
+
+func (auditEventV5) TableName() string { return "audit_events" }
@@ -0,0 +1,439 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/apigee/registry/server/registry/names"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Search documents are indexed by the database. Postgres indexes a weighted tsvector of their text,
+// and SQLite maintains an FTS4 table with triggers on the documents table.
+const (
+	// postgresSearchVector weights text as display names, labels and annotations, descriptions, and contents.
+	postgresSearchVector = `setweight(to_tsvector('english', display_name), 'A') || ` +
+		`setweight(to_tsvector('english', labels || ' ' || annotations), 'B') || ` +
+		`setweight(to_tsvector('english', description), 'C') || ` +
+		`setweight(to_tsvector('english', contents), 'D')`
+
+	// sqliteSearchIndex is an FTS4 table of the text of search documents, which it reads from the documents table.
+	// It is keyed by the rowids of documents, which the triggers keep in sync.
+	sqliteSearchIndex = "search_index"
+)
+
+// sqliteSearchColumns are the indexed columns of search documents, with their weights in rankings.
+// The weights match the default weights of the Postgres ts_rank function.
+var sqliteSearchColumns = []struct {
+	Name   string
+	Weight float64
+}{
+	{Name: "display_name", Weight: 1.0},
+	{Name: "labels", Weight: 0.4},
+	{Name: "annotations", Weight: 0.4},
+	{Name: "description", Weight: 0.2},
+	{Name: "contents", Weight: 0.1},
+}
+
+// searchOrder orders search results by descending score.
+var searchOrder = []ordering{{Column: "score", Desc: true}, {Column: "key"}}
+
+// ensureSearchIndex creates the database index of search documents if it doesn't exist.
+// If rebuild is true, the SQLite index is rebuilt from the documents table.
+func (c *Client) ensureSearchIndex(rebuild bool) error {
+	lock()
+	defer unlock()
+	switch c.db.Name() {
+	case "postgres":
+		return c.db.Exec("CREATE INDEX IF NOT EXISTS idx_search_documents_text ON search_documents USING GIN ((" + postgresSearchVector + "))").Error
+	case "sqlite":
+		columns := make([]string, len(sqliteSearchColumns))
+		for i, col := range sqliteSearchColumns {
+			columns[i] = col.Name
+		}
+		cols := strings.Join(columns, ", ")
+		newCols := "new." + strings.Join(columns, ", new.")
+		for _, stmt := range []string{
+			fmt.Sprintf(`CREATE VIRTUAL TABLE IF NOT EXISTS %s USING fts4(content="search_documents", %s, tokenize=porter)`, sqliteSearchIndex, cols),
+			fmt.Sprintf(`CREATE TRIGGER IF NOT EXISTS search_documents_bu BEFORE UPDATE ON search_documents BEGIN DELETE FROM %s WHERE docid = old.rowid; END`, sqliteSearchIndex),
+			fmt.Sprintf(`CREATE TRIGGER IF NOT EXISTS search_documents_bd BEFORE DELETE ON search_documents BEGIN DELETE FROM %s WHERE docid = old.rowid; END`, sqliteSearchIndex),
+			fmt.Sprintf(`CREATE TRIGGER IF NOT EXISTS search_documents_au AFTER UPDATE ON search_documents BEGIN INSERT INTO %s(docid, %s) VALUES (new.rowid, %s); END`, sqliteSearchIndex, cols, newCols),
+			fmt.Sprintf(`CREATE TRIGGER IF NOT EXISTS search_documents_ai AFTER INSERT ON search_documents BEGIN INSERT INTO %s(docid, %s) VALUES (new.rowid, %s); END`, sqliteSearchIndex, cols, newCols),
+		} {
+			if err := c.db.Exec(stmt).Error; err != nil {
+				return err
+			}
+		}
+		// Rowids of tables without integer primary keys can change when the database is vacuumed,
+		// which is repaired by rebuilding the index.
+		if rebuild {
+			return c.db.Exec(fmt.Sprintf(`INSERT INTO %[1]s(%[1]s) VALUES ('rebuild')`, sqliteSearchIndex)).Error
+		}
+		return nil
+	default:
+		return status.Errorf(codes.Internal, "unsupported database %s", c.db.Name())
+	}
+}
+
+// indexAll saves the search documents of all apis, versions, specs and deployments.
+// It is used to index resources that were saved before they were indexed on write.
+func (c *Client) indexAll(ctx context.Context, p *progress) error {
+	opts := PageOptions{Size: 1000}
+	for opts.Token = ""; ; {
+		list, err := c.ListApis(ctx, names.Project{ProjectID: "-"}, opts)
+		if err != nil {
+			return err
+		}
+		for i := range list.Apis {
+			if err := c.saveSearchDocument(models.NewApiSearchDocument(&list.Apis[i])); err != nil {
+				return err
+			}
+		}
+		if err := p.add(0, int64(len(list.Apis))); err != nil {
+			return err
+		}
+		if opts.Token = list.Token; opts.Token == "" {
+			break
+		}
+	}
+
+	for opts.Token = ""; ; {
+		list, err := c.ListVersions(ctx, names.Api{ProjectID: "-", ApiID: "-"}, opts)
+		if err != nil {
+			return err
+		}
+		for i := range list.Versions {
+			if err := c.saveSearchDocument(models.NewVersionSearchDocument(&list.Versions[i])); err != nil {
+				return err
+			}
+		}
+		if err := p.add(0, int64(len(list.Versions))); err != nil {
+			return err
+		}
+		if opts.Token = list.Token; opts.Token == "" {
+			break
+		}
+	}
+
+	for opts.Token = ""; ; {
+		list, err := c.ListSpecs(ctx, names.Version{ProjectID: "-", ApiID: "-", VersionID: "-"}, opts)
+		if err != nil {
+			return err
+		}
+		for i := range list.Specs {
+			if err := c.indexSpecRevision(ctx, &list.Specs[i]); err != nil {
+				return err
+			}
+		}
+		if err := p.add(0, int64(len(list.Specs))); err != nil {
+			return err
+		}
+		if opts.Token = list.Token; opts.Token == "" {
+			break
+		}
+	}
+
+	for opts.Token = ""; ; {
+		list, err := c.ListDeployments(ctx, names.Api{ProjectID: "-", ApiID: "-"}, opts)
+		if err != nil {
+			return err
+		}
+		for i := range list.Deployments {
+			if err := c.saveSearchDocument(models.NewDeploymentSearchDocument(&list.Deployments[i])); err != nil {
+				return err
+			}
+		}
+		if err := p.add(0, int64(len(list.Deployments))); err != nil {
+			return err
+		}
+		if opts.Token = list.Token; opts.Token == "" {
+			break
+		}
+	}
+
+	return nil
+}
+
+// IndexApi updates the search document of an api.
+func (c *Client) IndexApi(ctx context.Context, name names.Api) error {
+	api, err := c.GetApi(ctx, name)
+	if err != nil {
+		return err
+	}
+	return c.saveSearchDocument(models.NewApiSearchDocument(api))
+}
+
+// IndexVersion updates the search document of a version.
+func (c *Client) IndexVersion(ctx context.Context, name names.Version) error {
+	version, err := c.GetVersion(ctx, name)
+	if err != nil {
+		return err
+	}
+	return c.saveSearchDocument(models.NewVersionSearchDocument(version))
+}
+
+// IndexSpec updates the search document of a spec with its current revision.
+func (c *Client) IndexSpec(ctx context.Context, name names.Spec) error {
+	spec, err := c.GetSpec(ctx, name)
+	if err != nil {
+		return err
+	}
+	return c.indexSpecRevision(ctx, spec)
+}
+
+func (c *Client) indexSpecRevision(ctx context.Context, spec *models.Spec) error {
+	blob, err := c.getBlob(ctx, spec.BlobHash, spec.RevisionName())
+	if err != nil {
+		return err
+	}
+	return c.saveSearchDocument(models.NewSpecSearchDocument(spec, blob.Contents))
+}
+
+// IndexDeployment updates the search document of a deployment with its current revision.
+func (c *Client) IndexDeployment(ctx context.Context, name names.Deployment) error {
+	deployment, err := c.GetDeployment(ctx, name)
+	if err != nil {
+		return err
+	}
+	return c.saveSearchDocument(models.NewDeploymentSearchDocument(deployment))
+}
+
+func (c *Client) saveSearchDocument(v *models.SearchDocument, err error) error {
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return c.save(v)
+}
+
+// searchDocumentExists is a condition that matches the search documents of resources that exist.
+// Deleted resources that haven't been purged are included if deleted is true.
+func searchDocumentExists(table string, deleted bool) string {
+	notDeleted := " AND r.delete_time IS NULL"
+	if deleted {
+		notDeleted = ""
+	}
+	return fmt.Sprintf(`((%[1]s.kind = 'api' AND EXISTS (SELECT 1 FROM apis r WHERE r.project_id = %[1]s.project_id AND r.api_id = %[1]s.api_id%[2]s)) OR `+
+		`(%[1]s.kind = 'version' AND EXISTS (SELECT 1 FROM versions r WHERE r.project_id = %[1]s.project_id AND r.api_id = %[1]s.api_id AND r.version_id = %[1]s.version_id%[2]s)) OR `+
+		`(%[1]s.kind = 'spec' AND EXISTS (SELECT 1 FROM specs r WHERE r.project_id = %[1]s.project_id AND r.api_id = %[1]s.api_id AND r.version_id = %[1]s.version_id AND r.spec_id = %[1]s.spec_id%[2]s)) OR `+
+		`(%[1]s.kind = 'deployment' AND EXISTS (SELECT 1 FROM deployments r WHERE r.project_id = %[1]s.project_id AND r.api_id = %[1]s.api_id AND r.deployment_id = %[1]s.deployment_id%[2]s)))`,
+		table, notDeleted)
+}
+
+// deleteUnusedSearchDocuments deletes the search documents of resources that were purged.
+func (c *Client) deleteUnusedSearchDocuments() error {
+	return c.db.Where("NOT " + searchDocumentExists("search_documents", true)).Delete(&models.SearchDocument{}).Error
+}
+
+// SearchResult is a resource that matches a search query.
+type SearchResult struct {
+	Key         string
+	DisplayName string
+	Description string
+	Score       float64
+}
+
+// SearchResultList is a page of search results, ordered by descending score.
+type SearchResultList struct {
+	Results []SearchResult
+	Token   string
+}
+
+// Search returns the resources of a project that match a query, ordered by their relevance to it.
+// Deleted resources are never included.
+func (c *Client) Search(ctx context.Context, parent names.Project, query string, opts PageOptions) (SearchResultList, error) {
+	token, err := decodeToken(opts.Token)
+	if err != nil {
+		return SearchResultList{}, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err.Error())
+	}
+
+	if err := token.ValidateFilter(query); err != nil {
+		return SearchResultList{}, status.Errorf(codes.InvalidArgument, "invalid query %q: %s", query, err)
+	} else {
+		token.Filter = query
+	}
+
+	terms := models.SearchTerms(query)
+	if len(terms) == 0 {
+		return SearchResultList{}, status.Errorf(codes.InvalidArgument, "invalid query %q: must contain words to search for", query)
+	}
+
+	if _, err := c.GetProject(ctx, parent); err != nil {
+		return SearchResultList{}, err
+	}
+
+	var results []SearchResult
+	switch c.db.Name() {
+	case "postgres":
+		results, err = c.searchPostgres(parent, terms, token, opts)
+	case "sqlite":
+		results, err = c.searchSQLite(parent, terms, token, opts)
+	default:
+		return SearchResultList{}, status.Errorf(codes.Internal, "unsupported database %s", c.db.Name())
+	}
+	if err != nil {
+		return SearchResultList{}, err
+	}
+
+	response := SearchResultList{
+		Results: make([]SearchResult, 0, opts.Size),
+	}
+
+	for _, result := range results {
+		if len(response.Results) < int(opts.Size) {
+			response.Results = append(response.Results, result)
+			token.Last = []interface{}{result.Score, result.Key}
+		} else {
+			response.Token, err = encodeToken(token)
+			if err != nil {
+				return response, status.Error(codes.Internal, err.Error())
+			}
+			break
+		}
+	}
+
+	return response, nil
+}
+
+// searchPostgres returns a page of the results of a search, and the first result of the next page if there is one.
+func (c *Client) searchPostgres(parent names.Project, terms []models.SearchTerm, t token, opts PageOptions) ([]SearchResult, error) {
+	// Terms only contain letters and digits, so they can't include tsquery operators.
+	alternatives := make([]string, len(terms))
+	for i, term := range terms {
+		if len(term.Parts) == 0 {
+			alternatives[i] = term.Word
+		} else {
+			alternatives[i] = fmt.Sprintf("(%s | (%s))", term.Word, strings.Join(term.Parts, " & "))
+		}
+	}
+	query := strings.Join(alternatives, " & ")
+
+	lock()
+	defer unlock()
+	matches := c.db.Table("search_documents").
+		Select("key, display_name, description, ts_rank("+postgresSearchVector+", to_tsquery('english', ?)) AS score", query).
+		Where(postgresSearchVector+" @@ to_tsquery('english', ?)", query).
+		Where("project_id = ?", parent.ProjectID).
+		Where(searchDocumentExists("search_documents", false))
+
+	op, err := paginate(c.db.Table("(?) AS results", matches), searchOrder, t)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page token %q: %s", opts.Token, err)
+	}
+
+	var results []SearchResult
+	if err := op.Limit(int(opts.Size) + 1).Scan(&results).Error; err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return results, nil
+}
+
+// searchSQLite returns a page of the results of a search, and the first result of the next page if there is one.
+// FTS4 doesn't rank matches, so they are scored with the statistics returned by its matchinfo function.
+func (c *Client) searchSQLite(parent names.Project, terms []models.SearchTerm, t token, opts PageOptions) ([]SearchResult, error) {
+	// Terms only contain letters and digits, so quoting them ensures they are matched as words.
+	alternatives := make([]string, len(terms))
+	for i, term := range terms {
+		if len(term.Parts) == 0 {
+			alternatives[i] = fmt.Sprintf("%q", term.Word)
+		} else {
+			alternatives[i] = fmt.Sprintf("(%q OR (%q))", term.Word, strings.Join(term.Parts, `" AND "`))
+		}
+	}
+	query := strings.Join(alternatives, " AND ")
+
+	if len(t.Last) != 0 && len(t.Last) != len(searchOrder) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page token %q: token does not match the order of this listing", opts.Token)
+	}
+
+	var matches []struct {
+		SearchResult
+		Info []byte
+	}
+	lock()
+	err := c.db.Table(sqliteSearchIndex).
+		Select("d.key, d.display_name, d.description, matchinfo("+sqliteSearchIndex+", 'pcx') AS info").
+		Joins("JOIN search_documents AS d ON d.rowid = "+sqliteSearchIndex+".docid").
+		Where(sqliteSearchIndex+" MATCH ?", query).
+		Where("d.project_id = ?", parent.ProjectID).
+		Where(searchDocumentExists("d", false)).
+		Limit(100000).
+		Scan(&matches).Error
+	unlock()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	results := make([]SearchResult, len(matches))
+	for i, m := range matches {
+		results[i] = m.SearchResult
+		results[i].Score = sqliteScore(m.Info)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return resultBefore(results[i], results[j])
+	})
+
+	// Results that follow the last result of the previous page are returned.
+	if len(t.Last) > 0 {
+		score, _ := t.Last[0].(float64)
+		key, _ := t.Last[1].(string)
+		last := SearchResult{Key: key, Score: score}
+		results = results[sort.Search(len(results), func(i int) bool {
+			return resultBefore(last, results[i])
+		}):]
+	}
+	if len(results) > int(opts.Size)+1 {
+		results = results[:opts.Size+1]
+	}
+	return results, nil
+}
+
+func resultBefore(a, b SearchResult) bool {
+	if a.Score != b.Score {
+		return a.Score > b.Score
+	}
+	return a.Key < b.Key
+}
+
+// sqliteScore returns the score of a match from the array of unsigned integers returned by matchinfo with the "pcx" format:
+// the number of phrases, the number of columns, and the number of hits of each phrase in each column of the match and of all rows.
+// Hits are weighted by the column, and hits of phrases that are common in all rows count less.
+func sqliteScore(info []byte) float64 {
+	value := func(i int) float64 {
+		// The integers use the byte order of the machine, which is little-endian on supported platforms.
+		if (i+1)*4 > len(info) {
+			return 0
+		}
+		return float64(binary.LittleEndian.Uint32(info[i*4:]))
+	}
+
+	phrases, columns := int(value(0)), int(value(1))
+	var score float64
+	for p := 0; p < phrases; p++ {
+		for c := 0; c < columns && c < len(sqliteSearchColumns); c++ {
+			i := 2 + 3*(p*columns+c)
+			if hits, allHits := value(i), value(i+1); hits > 0 && allHits > 0 {
+				score += sqliteSearchColumns[c].Weight * hits / allHits
+			}
+		}
+	}
+	return score
+}
@@ -45,3 +45,14 @@ func (c *Client) unwrapDeploymentRevisionTag(ctx context.Context, name names.Dep
 
 	return name.Deployment().Revision(v.RevisionID), nil
 }
+
+func (c *Client) unwrapArtifactRevisionTag(ctx context.Context, name names.ArtifactRevision) (names.ArtifactRevision, error) {
+	v := new(models.ArtifactRevisionTag)
+	if err := c.db.First(v, "key = ?", name.String()).Error; err == gorm.ErrRecordNotFound {
+		return name, nil
+	} else if err != nil {
+		return names.ArtifactRevision{}, status.Error(codes.Internal, err.Error())
+	}
+
+	return name.Artifact().Revision(v.RevisionID), nil
+}
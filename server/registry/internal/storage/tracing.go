@@ -0,0 +1,93 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const (
+	tracerName = "github.com/apigee/registry/server/registry/internal/storage"
+	spanKey    = "registry:span"
+)
+
+// registerTracing adds callbacks to a database session that record a span for each database operation.
+// Spans are children of the span in the context of the operation and are exported by the global tracer provider.
+func registerTracing(db *gorm.DB, system attribute.KeyValue) error {
+	cb := db.Callback()
+	for _, err := range []error{
+		cb.Create().Before("gorm:create").Register("tracing:before_create", startSpan("gorm.Create", system)),
+		cb.Create().After("gorm:create").Register("tracing:after_create", endSpan),
+		cb.Query().Before("gorm:query").Register("tracing:before_query", startSpan("gorm.Query", system)),
+		cb.Query().After("gorm:query").Register("tracing:after_query", endSpan),
+		cb.Update().Before("gorm:update").Register("tracing:before_update", startSpan("gorm.Update", system)),
+		cb.Update().After("gorm:update").Register("tracing:after_update", endSpan),
+		cb.Delete().Before("gorm:delete").Register("tracing:before_delete", startSpan("gorm.Delete", system)),
+		cb.Delete().After("gorm:delete").Register("tracing:after_delete", endSpan),
+		cb.Row().Before("gorm:row").Register("tracing:before_row", startSpan("gorm.Row", system)),
+		cb.Row().After("gorm:row").Register("tracing:after_row", endSpan),
+		cb.Raw().Before("gorm:raw").Register("tracing:before_raw", startSpan("gorm.Raw", system)),
+		cb.Raw().After("gorm:raw").Register("tracing:after_raw", endSpan),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// startSpan returns a callback that starts a span for an operation of a traced request.
+// Operations without a span in their context, like the polling of background tasks, aren't traced.
+// The span isn't added to the context of the operation, so later operations of the same statement aren't nested in it.
+func startSpan(name string, system attribute.KeyValue) func(*gorm.DB) {
+	return func(tx *gorm.DB) {
+		if !trace.SpanContextFromContext(tx.Statement.Context).IsValid() {
+			return
+		}
+		// The tracer is looked up for each span so that it follows changes to the global tracer provider.
+		_, span := otel.Tracer(tracerName).Start(tx.Statement.Context, name,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(system))
+		tx.InstanceSet(spanKey, span)
+	}
+}
+
+// endSpan ends the span of an operation, recording the query, its table and its outcome.
+func endSpan(tx *gorm.DB) {
+	v, ok := tx.InstanceGet(spanKey)
+	if !ok {
+		return
+	}
+	span := v.(trace.Span)
+	defer span.End()
+
+	// Statements are recorded with placeholders, so the values of queries aren't exported.
+	span.SetAttributes(
+		semconv.DBStatementKey.String(tx.Statement.SQL.String()),
+		attribute.Int64("db.rows_affected", tx.Statement.RowsAffected),
+	)
+	if tx.Statement.Table != "" {
+		span.SetAttributes(semconv.DBSQLTableKey.String(tx.Statement.Table))
+	}
+	if err := tx.Error; err != nil && err != gorm.ErrRecordNotFound {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
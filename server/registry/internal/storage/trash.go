@@ -0,0 +1,205 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/apigee/registry/server/registry/names"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+func (c *Client) UndeleteProject(ctx context.Context, name names.Project) error {
+	return c.undelete(name.String(), func(op *gorm.DB) *gorm.DB {
+		return op.Where("project_id = ?", name.ProjectID)
+	},
+		&models.Project{},
+		&models.Api{},
+		&models.Deployment{},
+		&models.DeploymentRevisionTag{},
+		&models.Version{},
+		&models.Spec{},
+		&models.SpecRevisionTag{},
+		&models.Artifact{},
+		&models.ArtifactRevisionTag{},
+	)
+}
+
+func (c *Client) UndeleteApi(ctx context.Context, name names.Api) error {
+	return c.undelete(name.String(), func(op *gorm.DB) *gorm.DB {
+		return op.Where("project_id = ?", name.ProjectID).
+			Where("api_id = ?", name.ApiID)
+	},
+		&models.Api{},
+		&models.Deployment{},
+		&models.DeploymentRevisionTag{},
+		&models.Version{},
+		&models.Spec{},
+		&models.SpecRevisionTag{},
+		&models.Artifact{},
+		&models.ArtifactRevisionTag{},
+	)
+}
+
+func (c *Client) UndeleteVersion(ctx context.Context, name names.Version) error {
+	return c.undelete(name.String(), func(op *gorm.DB) *gorm.DB {
+		return op.Where("project_id = ?", name.ProjectID).
+			Where("api_id = ?", name.ApiID).
+			Where("version_id = ?", name.VersionID)
+	},
+		&models.Version{},
+		&models.Spec{},
+		&models.SpecRevisionTag{},
+		&models.Artifact{},
+		&models.ArtifactRevisionTag{},
+	)
+}
+
+func (c *Client) UndeleteSpec(ctx context.Context, name names.Spec) error {
+	return c.undelete(name.String(), func(op *gorm.DB) *gorm.DB {
+		return op.Where("project_id = ?", name.ProjectID).
+			Where("api_id = ?", name.ApiID).
+			Where("version_id = ?", name.VersionID).
+			Where("spec_id = ?", name.SpecID)
+	},
+		&models.Spec{},
+		&models.SpecRevisionTag{},
+		&models.Artifact{},
+		&models.ArtifactRevisionTag{},
+	)
+}
+
+func (c *Client) UndeleteDeployment(ctx context.Context, name names.Deployment) error {
+	return c.undelete(name.String(), func(op *gorm.DB) *gorm.DB {
+		return op.Where("project_id = ?", name.ProjectID).
+			Where("api_id = ?", name.ApiID).
+			Where("deployment_id = ?", name.DeploymentID)
+	},
+		&models.Deployment{},
+		&models.DeploymentRevisionTag{},
+		&models.Artifact{},
+		&models.ArtifactRevisionTag{},
+	)
+}
+
+func (c *Client) UndeleteArtifact(ctx context.Context, name names.Artifact) error {
+	name = name.Normal()
+	return c.undelete(name.String(), func(op *gorm.DB) *gorm.DB {
+		return op.Where("project_id = ?", name.ProjectID()).
+			Where("api_id = ?", name.ApiID()).
+			Where("version_id = ?", name.VersionID()).
+			Where("spec_id = ?", name.SpecID()).
+			Where("deployment_id = ?", name.DeploymentID()).
+			Where("artifact_id = ?", name.ArtifactID())
+	},
+		&models.Artifact{},
+		&models.ArtifactRevisionTag{},
+	)
+}
+
+// undelete restores a deleted resource and the children that were deleted with it.
+// The resource rows are those of the first model that match the query, and the other models hold its children.
+// If a resource with the same name was deleted more than once, its most recent deletion is undone.
+func (c *Client) undelete(name string, where func(*gorm.DB) *gorm.DB, resource interface{}, children ...interface{}) error {
+	err := c.db.Transaction(func(tx *gorm.DB) error {
+		// A resource with the same name may have been created since the deletion.
+		var count int64
+		if err := where(tx.Model(resource)).Count(&count).Error; err != nil {
+			return err
+		} else if count > 0 {
+			return status.Errorf(codes.AlreadyExists, "%q already exists", name)
+		}
+
+		var deleted []time.Time
+		if err := where(tx.Unscoped().Model(resource)).
+			Where("delete_time IS NOT NULL").
+			Order("delete_time desc").
+			Limit(1).
+			Pluck("delete_time", &deleted).Error; err != nil {
+			return err
+		} else if len(deleted) == 0 {
+			return status.Errorf(codes.NotFound, "deleted %q not found in database", name)
+		}
+
+		// Children that were deleted before the resource remain deleted.
+		for _, model := range append([]interface{}{resource}, children...) {
+			err := where(tx.Unscoped().Model(model)).
+				Where("delete_time >= ?", deleted[0]).
+				UpdateColumn("delete_time", nil).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.NotFound, codes.AlreadyExists:
+		return err
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// PurgeDeletedResources permanently removes the resources that were deleted before a given time
+// and returns the number of rows removed. The blobs of purged revisions are released,
+// and the role bindings in purged projects are deleted.
+func (c *Client) PurgeDeletedResources(ctx context.Context, before time.Time) (int64, error) {
+	var count int64
+	err := c.Transaction(ctx, func(ctx context.Context, db *Client) error {
+		var projectIDs []string
+		if err := db.db.Unscoped().Model(&models.Project{}).Where("delete_time < ?", before).Pluck("project_id", &projectIDs).Error; err != nil {
+			return err
+		}
+		if err := db.deletePurgedRoleBindings(projectIDs); err != nil {
+			return err
+		}
+
+		for _, model := range []interface{}{
+			models.Project{},
+			models.Api{},
+			models.Deployment{},
+			models.DeploymentRevisionTag{},
+			models.Version{},
+			models.Spec{},
+			models.SpecRevisionTag{},
+			models.Artifact{},
+			models.ArtifactRevisionTag{},
+		} {
+			op := db.db.Unscoped().Where("delete_time < ?", before)
+			if err := db.releaseBlobs(op, model); err != nil {
+				return err
+			}
+			deleted := op.Delete(model)
+			if err := deleted.Error; err != nil {
+				return err
+			}
+			count += deleted.RowsAffected
+		}
+		return db.deleteUnusedSearchDocuments()
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
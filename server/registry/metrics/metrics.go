@@ -0,0 +1,186 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metrics collects Prometheus metrics of the registry server.
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/apigee/registry/log"
+	"github.com/apigee/registry/rpc"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// DefaultRefreshInterval is the time between refreshes of table row counts if no interval is set.
+const DefaultRefreshInterval = time.Minute
+
+// Storage is the source of the database metrics of a server.
+type Storage interface {
+	// DBStats returns statistics of the database connection pool.
+	DBStats() sql.DBStats
+	// GetStorage returns the row counts of the database tables.
+	GetStorage(context.Context, *emptypb.Empty) (*rpc.Storage, error)
+}
+
+// Metrics holds the metrics of a server and serves them to Prometheus.
+type Metrics struct {
+	registry *prometheus.Registry
+	handled  *prometheus.CounterVec
+	handling *prometheus.HistogramVec
+	rows     *prometheus.GaugeVec
+}
+
+// New returns metrics that include the Go runtime and process metrics of the server.
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		handled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_server_handled_total",
+			Help: "Total number of RPCs completed on the server, regardless of success or failure.",
+		}, []string{"grpc_service", "grpc_method", "grpc_code"}),
+		handling: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "grpc_server_handling_seconds",
+			Help:    "Histogram of response latency (seconds) of RPCs that had been handled by the server.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"grpc_service", "grpc_method"}),
+		rows: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "registry_table_rows",
+			Help: "Number of rows in each database table, as of the last refresh.",
+		}, []string{"table"}),
+	}
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.handled,
+		m.handling,
+		m.rows,
+	)
+	return m
+}
+
+// Handler returns an HTTP handler that serves the metrics in the Prometheus exposition format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// splitMethod returns the service and method names of a full gRPC method name like "/package.Service/Method".
+func splitMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.Index(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
+
+func (m *Metrics) observe(fullMethod string, start time.Time, err error) {
+	service, method := splitMethod(fullMethod)
+	m.handled.WithLabelValues(service, method, status.Code(err).String()).Inc()
+	m.handling.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+}
+
+// UnaryInterceptor returns a gRPC server interceptor that counts calls by status code and observes their latency.
+// It should be the first interceptor, so that calls rejected by other interceptors are counted.
+func (m *Metrics) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		m.observe(info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// StreamInterceptor returns a gRPC server interceptor that counts streams by status code and observes their duration.
+func (m *Metrics) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		m.observe(info.FullMethod, start, err)
+		return err
+	}
+}
+
+// RegisterStorage adds the connection pool statistics of a server's database to the metrics.
+// Pool statistics are read when metrics are served. Table row counts are refreshed every interval until ctx is done,
+// because counting rows can be expensive. If interval is zero, DefaultRefreshInterval is used.
+func (m *Metrics) RegisterStorage(ctx context.Context, s Storage, interval time.Duration) {
+	gauge := func(name, help string, value func(sql.DBStats) int64) prometheus.Collector {
+		return prometheus.NewGaugeFunc(prometheus.GaugeOpts{Name: name, Help: help},
+			func() float64 { return float64(value(s.DBStats())) })
+	}
+	counter := func(name, help string, value func(sql.DBStats) int64) prometheus.Collector {
+		return prometheus.NewCounterFunc(prometheus.CounterOpts{Name: name, Help: help},
+			func() float64 { return float64(value(s.DBStats())) })
+	}
+	m.registry.MustRegister(
+		gauge("registry_db_max_open_connections", "Maximum number of open connections to the database.",
+			func(s sql.DBStats) int64 { return int64(s.MaxOpenConnections) }),
+		gauge("registry_db_open_connections", "The number of established connections, both in use and idle.",
+			func(s sql.DBStats) int64 { return int64(s.OpenConnections) }),
+		gauge("registry_db_in_use_connections", "The number of connections currently in use.",
+			func(s sql.DBStats) int64 { return int64(s.InUse) }),
+		gauge("registry_db_idle_connections", "The number of idle connections.",
+			func(s sql.DBStats) int64 { return int64(s.Idle) }),
+		counter("registry_db_wait_count_total", "The total number of connections waited for.",
+			func(s sql.DBStats) int64 { return s.WaitCount }),
+		counter("registry_db_max_idle_closed_total", "The total number of connections closed due to the idle connection limit.",
+			func(s sql.DBStats) int64 { return s.MaxIdleClosed }),
+		counter("registry_db_max_lifetime_closed_total", "The total number of connections closed due to the connection lifetime limit.",
+			func(s sql.DBStats) int64 { return s.MaxLifetimeClosed }),
+		prometheus.NewCounterFunc(prometheus.CounterOpts{
+			Name: "registry_db_wait_duration_seconds_total",
+			Help: "The total time blocked waiting for a new connection.",
+		}, func() float64 { return s.DBStats().WaitDuration.Seconds() }),
+	)
+
+	if interval <= 0 {
+		interval = DefaultRefreshInterval
+	}
+	m.refreshRows(ctx, s)
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				m.refreshRows(ctx, s)
+			}
+		}
+	}()
+}
+
+// refreshRows replaces the table row counts with the current counts. Tables that no longer exist are removed.
+func (m *Metrics) refreshRows(ctx context.Context, s Storage) {
+	storage, err := s.GetStorage(ctx, &emptypb.Empty{})
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to count table rows for metrics.")
+		return
+	}
+
+	m.rows.Reset()
+	for _, c := range storage.GetCollections() {
+		m.rows.WithLabelValues(c.GetName()).Set(float64(c.GetCount()))
+	}
+}
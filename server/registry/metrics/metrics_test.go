@@ -0,0 +1,191 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const getProject = "/google.cloud.apigeeregistry.v1.Admin/GetProject"
+
+func TestUnaryInterceptor(t *testing.T) {
+	m := New()
+	interceptor := m.UnaryInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: getProject}
+
+	codes := []codes.Code{codes.OK, codes.OK, codes.NotFound}
+	for _, code := range codes {
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(code, "")
+		}
+		if _, err := interceptor(context.Background(), nil, info, handler); status.Code(err) != code {
+			t.Errorf("UnaryInterceptor() returned status code %q, want %q", status.Code(err), code)
+		}
+	}
+
+	tests := []struct {
+		code string
+		want float64
+	}{
+		{code: "OK", want: 2},
+		{code: "NotFound", want: 1},
+		{code: "Internal", want: 0},
+	}
+	for _, test := range tests {
+		counter := m.handled.WithLabelValues("google.cloud.apigeeregistry.v1.Admin", "GetProject", test.code)
+		if got := testutil.ToFloat64(counter); got != test.want {
+			t.Errorf("grpc_server_handled_total for code %s is %v, want %v", test.code, got, test.want)
+		}
+	}
+
+	if got := testutil.CollectAndCount(m.handling); got != 1 {
+		t.Errorf("grpc_server_handling_seconds has %d series, want 1", got)
+	}
+}
+
+// stream is a server stream without messages.
+type stream struct {
+	grpc.ServerStream
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	m := New()
+	info := &grpc.StreamServerInfo{FullMethod: "/google.cloud.apigeeregistry.v1.Registry/WatchResources"}
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		return status.Error(codes.Canceled, "")
+	}
+	_ = m.StreamInterceptor()(nil, &stream{}, info, handler)
+
+	counter := m.handled.WithLabelValues("google.cloud.apigeeregistry.v1.Registry", "WatchResources", "Canceled")
+	if got := testutil.ToFloat64(counter); got != 1 {
+		t.Errorf("grpc_server_handled_total is %v, want 1", got)
+	}
+}
+
+// fakeStorage reports fixed pool statistics and the row counts of a changing set of tables.
+type fakeStorage struct {
+	mu          sync.Mutex
+	collections []*rpc.Storage_Collection
+	err         error
+}
+
+func (s *fakeStorage) DBStats() sql.DBStats {
+	return sql.DBStats{MaxOpenConnections: 10, OpenConnections: 3, InUse: 1, Idle: 2, WaitCount: 4, WaitDuration: 2 * time.Second}
+}
+
+func (s *fakeStorage) GetStorage(context.Context, *emptypb.Empty) (*rpc.Storage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return &rpc.Storage{Collections: s.collections}, s.err
+}
+
+func (s *fakeStorage) set(err error, collections ...*rpc.Storage_Collection) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.collections = collections
+	s.err = err
+}
+
+func TestRegisterStorage(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := &fakeStorage{}
+	s.set(nil, &rpc.Storage_Collection{Name: "apis", Count: 5}, &rpc.Storage_Collection{Name: "specs", Count: 7})
+
+	m := New()
+	m.RegisterStorage(ctx, s, 10*time.Millisecond)
+
+	want := `
+# HELP registry_table_rows Number of rows in each database table, as of the last refresh.
+# TYPE registry_table_rows gauge
+registry_table_rows{table="apis"} 5
+registry_table_rows{table="specs"} 7
+`
+	if err := testutil.CollectAndCompare(m.rows, strings.NewReader(want)); err != nil {
+		t.Errorf("registry_table_rows after registration: %s", err)
+	}
+
+	// Counts are kept when they can't be refreshed, and tables that no longer exist are removed.
+	s.set(errors.New("unavailable"))
+	time.Sleep(50 * time.Millisecond)
+	if err := testutil.CollectAndCompare(m.rows, strings.NewReader(want)); err != nil {
+		t.Errorf("registry_table_rows after a failed refresh: %s", err)
+	}
+
+	s.set(nil, &rpc.Storage_Collection{Name: "apis", Count: 6})
+	want = `
+# HELP registry_table_rows Number of rows in each database table, as of the last refresh.
+# TYPE registry_table_rows gauge
+registry_table_rows{table="apis"} 6
+`
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		err := testutil.CollectAndCompare(m.rows, strings.NewReader(want))
+		if err == nil {
+			break
+		} else if time.Now().After(deadline) {
+			t.Fatalf("registry_table_rows wasn't refreshed: %s", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	m := New()
+	m.RegisterStorage(ctx, &fakeStorage{}, time.Hour)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &emptypb.Empty{}, nil
+	}
+	_, _ = m.UnaryInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: getProject}, handler)
+
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body, err := ioutil.ReadAll(rec.Result().Body)
+	if err != nil {
+		t.Fatalf("Failed to read metrics: %s", err)
+	}
+
+	for _, want := range []string{
+		`grpc_server_handled_total{grpc_code="OK",grpc_method="GetProject",grpc_service="google.cloud.apigeeregistry.v1.Admin"} 1`,
+		`grpc_server_handling_seconds_count{grpc_method="GetProject",grpc_service="google.cloud.apigeeregistry.v1.Admin"} 1`,
+		`registry_db_open_connections 3`,
+		`registry_db_wait_count_total 4`,
+		`registry_db_wait_duration_seconds_total 2`,
+		`go_goroutines`,
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("Handler() response doesn't include %q", want)
+		}
+	}
+}
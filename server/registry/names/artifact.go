@@ -153,6 +153,22 @@ func (a Artifact) String() string {
 	return normalize(a.name.String())
 }
 
+// Normal returns the artifact name with normalized identifiers.
+func (a Artifact) Normal() Artifact {
+	if n, err := ParseArtifact(a.String()); err == nil {
+		return n
+	}
+	return a
+}
+
+// Revision returns an artifact revision with the provided ID and this resource as its parent.
+func (a Artifact) Revision(id string) ArtifactRevision {
+	return ArtifactRevision{
+		artifact:   a,
+		RevisionID: id,
+	}
+}
+
 // ParseArtifact parses the name of an artifact.
 func ParseArtifact(name string) (Artifact, error) {
 	if n, err := parseSpecArtifact(name); err == nil {
@@ -0,0 +1,58 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package names
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var artifactRevisionIDRegexp = regexp.MustCompile(fmt.Sprintf("^%s$", revisionTag))
+
+// ArtifactRevision represents a resource name for an artifact revision.
+type ArtifactRevision struct {
+	artifact   Artifact
+	RevisionID string
+}
+
+// Artifact returns the parent artifact for this resource.
+func (a ArtifactRevision) Artifact() Artifact {
+	return a.artifact
+}
+
+func (a ArtifactRevision) String() string {
+	return normalize(fmt.Sprintf("%s@%s", a.artifact, a.RevisionID))
+}
+
+// ParseArtifactRevision parses the name of an artifact revision.
+func ParseArtifactRevision(name string) (ArtifactRevision, error) {
+	i := strings.LastIndex(name, "@")
+	if i < 0 {
+		return ArtifactRevision{}, fmt.Errorf("invalid artifact revision name %q: must include a revision ID", name)
+	}
+
+	artifact, err := ParseArtifact(name[:i])
+	if err != nil {
+		return ArtifactRevision{}, fmt.Errorf("invalid artifact revision name %q: %s", name, err)
+	}
+
+	id := name[i+1:]
+	if !artifactRevisionIDRegexp.MatchString(id) {
+		return ArtifactRevision{}, fmt.Errorf("invalid artifact revision name %q: revision ID must match %q", name, artifactRevisionIDRegexp)
+	}
+
+	return artifact.Revision(id), nil
+}
@@ -17,64 +17,75 @@ package registry
 import (
 	"context"
 
-	"cloud.google.com/go/pubsub"
 	"github.com/apigee/registry/log"
 	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/apigee/registry/server/registry/notifier"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-const TopicName = "registry-events"
+// TopicName is the Pub/Sub topic that notifications are published to.
+const TopicName = notifier.TopicName
 
-func (s *RegistryServer) notify(ctx context.Context, change rpc.Notification_Change, resource string) {
-	if !s.notifyEnabled {
-		return
+// notificationOption adds details of a change to a notification.
+type notificationOption func(*rpc.Notification) error
+
+// withSnapshot includes the state of a resource after the change.
+func withSnapshot(m proto.Message) notificationOption {
+	return func(n *rpc.Notification) (err error) {
+		n.Snapshot, err = anypb.New(m)
+		return err
 	}
+}
 
-	logger := log.FromContext(ctx)
-	if s.projectID == "" {
-		logger.Warn("Notifications are enabled but project ID is not set. Skipping notification.")
-		return
+// withPreviousRevision includes the ID of the revision that was current before the change.
+func withPreviousRevision(id string) notificationOption {
+	return func(n *rpc.Notification) error {
+		n.PreviousRevisionId = id
+		return nil
 	}
+}
 
-	client, err := pubsub.NewClient(ctx, s.projectID)
-	if err != nil {
-		logger.WithError(err).Error("Failed to create PubSub client.")
-		return
+// withUpdateMask includes the fields that were changed by an update.
+func withUpdateMask(mask *fieldmaskpb.FieldMask) notificationOption {
+	return func(n *rpc.Notification) error {
+		n.UpdateMask = mask
+		return nil
 	}
-	defer client.Close()
+}
 
-	if _, err := client.CreateTopic(ctx, TopicName); err != nil && status.Code(err) != codes.AlreadyExists {
-		logger.WithError(err).Error("Failed to create PubSub topic.")
-		return
+// notify records a notification of a change in the outbox.
+// It should be called with the client of the transaction that makes the change,
+// so that the notification is delivered if and only if the change is committed.
+// The caller is the authenticated subject of the request, if there is one.
+// Every change is recorded here, so the search index is also updated with it.
+func (s *RegistryServer) notify(ctx context.Context, db *storage.Client, change rpc.Notification_Change, resource string, opts ...notificationOption) error {
+	if err := s.index(ctx, db, resource); err != nil {
+		return err
 	}
 
-	notification := &rpc.Notification{
+	n := &rpc.Notification{
 		Change:     change,
 		Resource:   resource,
 		ChangeTime: timestamppb.Now(),
+		Caller:     log.Subject(ctx),
 	}
-
-	msg, err := protojson.Marshal(notification)
-	if err != nil {
-		logger.WithError(err).Errorf("Failed to serialize notification: %v", notification)
-		return
+	for _, opt := range opts {
+		if err := opt(n); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
 	}
 
-	topic := client.Topic(TopicName)
-	defer topic.Stop()
-
-	result := topic.Publish(ctx, &pubsub.Message{
-		Data: msg,
-	})
-
-	id, err := result.Get(ctx)
+	event, err := models.NewEvent(n)
 	if err != nil {
-		logger.WithError(err).Error("Failed to publish notification.")
-		return
+		return status.Error(codes.Internal, err.Error())
 	}
 
-	logger.Infof("Published notification with message ID: %s", id)
+	return db.SaveEvent(ctx, event)
 }
@@ -0,0 +1,136 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Format is the encoding of published notifications.
+type Format string
+
+const (
+	// JSON encodes each notification as the JSON representation of an rpc.Notification.
+	JSON Format = "json"
+	// CloudEvents encodes each notification as a CloudEvents 1.0 event in the structured JSON format.
+	// The data of each event is the JSON representation of the rpc.Notification.
+	CloudEvents Format = "cloudevents"
+)
+
+const (
+	// cloudEventsSource is the prefix of the source of CloudEvents, which is followed by the project of the changed resource.
+	cloudEventsSource = "//apigeeregistry.googleapis.com"
+	// cloudEventsTypePrefix is the prefix of the type of CloudEvents, which is followed by the lowercase change.
+	cloudEventsTypePrefix = "google.cloud.apigeeregistry.v1.resource."
+)
+
+// validate returns an error if the format is not supported.
+// The empty format is treated as JSON.
+func (f Format) validate() error {
+	switch f {
+	case "", JSON, CloudEvents:
+		return nil
+	default:
+		return fmt.Errorf("unsupported notification format %q: must be one of [%s, %s]", f, JSON, CloudEvents)
+	}
+}
+
+// contentType returns the media type of a single encoded notification.
+func (f Format) contentType() string {
+	if f == CloudEvents {
+		return "application/cloudevents+json"
+	}
+	return "application/json"
+}
+
+// batchContentType returns the media type of a JSON array of encoded notifications.
+func (f Format) batchContentType() string {
+	if f == CloudEvents {
+		return "application/cloudevents-batch+json"
+	}
+	return "application/json"
+}
+
+// encode returns the encoding of a single notification.
+func (f Format) encode(n *rpc.Notification) ([]byte, error) {
+	data, err := protojson.Marshal(n)
+	if err != nil {
+		return nil, err
+	}
+	if f != CloudEvents {
+		return data, nil
+	}
+	return json.Marshal(newCloudEvent(n, data))
+}
+
+// encodeBatch returns the encoding of notifications as a JSON array.
+func (f Format) encodeBatch(notifications []*rpc.Notification) ([]byte, error) {
+	batch := make([]json.RawMessage, 0, len(notifications))
+	for _, n := range notifications {
+		b, err := f.encode(n)
+		if err != nil {
+			return nil, err
+		}
+		batch = append(batch, b)
+	}
+	return json.Marshal(batch)
+}
+
+// cloudEvent is the structured JSON representation of a CloudEvents 1.0 event.
+type cloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject"`
+	Time            string          `json:"time,omitempty"`
+	DataContentType string          `json:"datacontenttype"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// newCloudEvent returns the event describing a notification with its encoded data.
+// Event IDs are derived from the change they describe, so redelivered events can be recognized by subscribers.
+func newCloudEvent(n *rpc.Notification, data []byte) *cloudEvent {
+	e := &cloudEvent{
+		SpecVersion:     "1.0",
+		Source:          cloudEventsSource,
+		Type:            cloudEventsTypePrefix + strings.ToLower(n.GetChange().String()),
+		Subject:         n.GetResource(),
+		DataContentType: "application/json",
+		Data:            data,
+	}
+
+	if parts := strings.SplitN(n.GetResource(), "/", 3); len(parts) >= 2 && parts[0] == "projects" {
+		e.Source += "/projects/" + parts[1]
+	}
+
+	var changeTime string
+	if n.GetChangeTime() != nil {
+		changeTime = n.GetChangeTime().AsTime().Format(time.RFC3339Nano)
+		e.Time = changeTime
+	}
+
+	id := sha256.Sum256([]byte(strings.Join([]string{n.GetChange().String(), n.GetResource(), changeTime}, "\n")))
+	e.ID = hex.EncodeToString(id[:16])
+	return e
+}
@@ -0,0 +1,121 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCloudEventsWebhook(t *testing.T) {
+	snapshot, err := anypb.New(&rpc.Api{Name: "projects/p/locations/global/apis/a", DisplayName: "A"})
+	if err != nil {
+		t.Fatalf("Setup: anypb.New() returned error: %s", err)
+	}
+	want := &rpc.Notification{
+		Change:             rpc.Notification_UPDATED,
+		Resource:           "projects/p/locations/global/apis/a",
+		ChangeTime:         timestamppb.New(time.Date(2022, 3, 4, 5, 6, 7, 0, time.UTC)),
+		Snapshot:           snapshot,
+		PreviousRevisionId: "abc",
+		UpdateMask:         &fieldmaskpb.FieldMask{Paths: []string{"display_name"}},
+		Caller:             "client-1",
+	}
+
+	var (
+		contentType string
+		events      []cloudEvent
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &events); err != nil {
+			t.Errorf("Webhook received invalid body %q: %s", body, err)
+		}
+	}))
+	defer server.Close()
+
+	n, err := NewWebhook(WebhookOptions{URL: server.URL, Format: CloudEvents})
+	if err != nil {
+		t.Fatalf("NewWebhook() returned error: %s", err)
+	}
+	defer n.Close()
+
+	if err := n.Publish(context.Background(), []*rpc.Notification{want}); err != nil {
+		t.Fatalf("Publish() returned error: %s", err)
+	}
+
+	if contentType != "application/cloudevents-batch+json" {
+		t.Errorf("Webhook received Content-Type %q, want %q", contentType, "application/cloudevents-batch+json")
+	}
+	if len(events) != 1 {
+		t.Fatalf("Webhook received %d events, want 1", len(events))
+	}
+
+	wantEvent := cloudEvent{
+		SpecVersion:     "1.0",
+		Source:          "//apigeeregistry.googleapis.com/projects/p",
+		Type:            "google.cloud.apigeeregistry.v1.resource.updated",
+		Subject:         "projects/p/locations/global/apis/a",
+		Time:            "2022-03-04T05:06:07Z",
+		DataContentType: "application/json",
+	}
+	if diff := cmp.Diff(wantEvent, events[0], cmpopts.IgnoreFields(cloudEvent{}, "ID", "Data")); diff != "" {
+		t.Errorf("Webhook received unexpected event (-want +got):\n%s", diff)
+	}
+	if events[0].ID == "" {
+		t.Errorf("Webhook received event without ID")
+	}
+
+	got := new(rpc.Notification)
+	if err := protojson.Unmarshal(events[0].Data, got); err != nil {
+		t.Fatalf("Webhook received invalid event data %q: %s", events[0].Data, err)
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("Webhook received unexpected event data (-want +got):\n%s", diff)
+	}
+}
+
+func TestCloudEventIDs(t *testing.T) {
+	a := &rpc.Notification{Change: rpc.Notification_CREATED, Resource: "projects/a", ChangeTime: timestamppb.Now()}
+	b := &rpc.Notification{Change: rpc.Notification_DELETED, Resource: "projects/a", ChangeTime: a.GetChangeTime()}
+
+	if newCloudEvent(a, nil).ID != newCloudEvent(a, nil).ID {
+		t.Errorf("Events for the same notification have different IDs")
+	}
+	if newCloudEvent(a, nil).ID == newCloudEvent(b, nil).ID {
+		t.Errorf("Events for different notifications have the same ID")
+	}
+}
+
+func TestInvalidFormat(t *testing.T) {
+	if _, err := NewWebhook(WebhookOptions{URL: "http://localhost", Format: "xml"}); err == nil {
+		t.Errorf("NewWebhook() with invalid format succeeded, expected error")
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/apigee/registry/log"
+	"github.com/apigee/registry/rpc"
+)
+
+// logNotifier writes notifications as structured log entries.
+type logNotifier struct{}
+
+// NewLog returns a notifier that writes notifications to the logger from the context of each batch.
+func NewLog() Notifier {
+	return logNotifier{}
+}
+
+func (logNotifier) Publish(ctx context.Context, notifications []*rpc.Notification) error {
+	logger := log.FromContext(ctx)
+	for _, n := range notifications {
+		fields := map[string]interface{}{
+			"change":      n.GetChange().String(),
+			"resource":    n.GetResource(),
+			"change_time": n.GetChangeTime().AsTime().Format(time.RFC3339Nano),
+		}
+		if id := n.GetPreviousRevisionId(); id != "" {
+			fields["previous_revision_id"] = id
+		}
+		if mask := n.GetUpdateMask(); mask != nil {
+			fields["update_mask"] = strings.Join(mask.GetPaths(), ",")
+		}
+		if caller := n.GetCaller(); caller != "" {
+			fields["caller"] = caller
+		}
+		logger.WithFields(fields).Info("Notification")
+	}
+	return nil
+}
+
+func (logNotifier) Close() error {
+	return nil
+}
@@ -0,0 +1,31 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package notifier publishes notifications of changes to registry resources.
+package notifier
+
+import (
+	"context"
+
+	"github.com/apigee/registry/rpc"
+)
+
+// Notifier publishes notifications to subscribers.
+type Notifier interface {
+	// Publish delivers a batch of notifications.
+	// Notifications of changes to the same resource are delivered in the order they were made.
+	Publish(ctx context.Context, notifications []*rpc.Notification) error
+	// Close releases any resources held by the notifier.
+	Close() error
+}
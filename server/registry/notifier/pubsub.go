@@ -0,0 +1,98 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"context"
+	"errors"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/apigee/registry/rpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TopicName is the Pub/Sub topic that notifications are published to.
+const TopicName = "registry-events"
+
+// pubsubNotifier publishes notifications to a Pub/Sub topic.
+type pubsubNotifier struct {
+	client *pubsub.Client
+	topic  *pubsub.Topic
+	format Format
+}
+
+// NewPubsub returns a notifier that publishes each notification as a message in the registry-events topic of a project.
+// The topic is created if it doesn't exist. CloudEvents are published in the structured content mode
+// of the CloudEvents Pub/Sub binding. Messages are ordered by the name of the changed resource,
+// so subscriptions that enable message ordering receive the changes to a resource in the order they were made.
+func NewPubsub(ctx context.Context, projectID string, format Format) (Notifier, error) {
+	if projectID == "" {
+		return nil, errors.New("project ID is required for Pub/Sub notifications")
+	}
+	if err := format.validate(); err != nil {
+		return nil, err
+	}
+
+	client, err := pubsub.NewClient(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := client.CreateTopic(ctx, TopicName); err != nil && status.Code(err) != codes.AlreadyExists {
+		client.Close()
+		return nil, err
+	}
+
+	topic := client.Topic(TopicName)
+	topic.EnableMessageOrdering = true
+	return &pubsubNotifier{
+		client: client,
+		topic:  topic,
+		format: format,
+	}, nil
+}
+
+func (n *pubsubNotifier) Publish(ctx context.Context, notifications []*rpc.Notification) error {
+	results := make([]*pubsub.PublishResult, 0, len(notifications))
+	for _, notification := range notifications {
+		data, err := n.format.encode(notification)
+		if err != nil {
+			return err
+		}
+		msg := &pubsub.Message{Data: data, OrderingKey: notification.GetResource()}
+		if n.format == CloudEvents {
+			msg.Attributes = map[string]string{"content-type": n.format.contentType()}
+		}
+		results = append(results, n.topic.Publish(ctx, msg))
+	}
+
+	var failed error
+	for i, result := range results {
+		if _, err := result.Get(ctx); err != nil {
+			// Publishing is paused for a key after a failure, so resume it for the batch to be retried.
+			n.topic.ResumePublish(notifications[i].GetResource())
+			if failed == nil {
+				failed = err
+			}
+		}
+	}
+	return failed
+}
+
+func (n *pubsubNotifier) Close() error {
+	n.topic.Stop()
+	return n.client.Close()
+}
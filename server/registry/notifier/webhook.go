@@ -0,0 +1,133 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/apigee/registry/log"
+	"github.com/apigee/registry/rpc"
+)
+
+// WebhookOptions configures a webhook notifier.
+type WebhookOptions struct {
+	// URL is the address that notifications are posted to.
+	URL string
+	// MaxAttempts is the number of times a batch is posted before it is dropped.
+	// If unset or zero, batches are posted at most 5 times.
+	MaxAttempts int
+	// Timeout is the maximum duration of each post.
+	// If unset or zero, posts time out after 10 seconds.
+	Timeout time.Duration
+	// Backoff is the delay before the first retry, which doubles after each attempt.
+	// If unset or zero, the first retry is made after 100 milliseconds.
+	Backoff time.Duration
+	// Format is the encoding of the notifications in each batch.
+	// If unset, notifications are encoded as JSON.
+	Format Format
+}
+
+// webhook posts notifications to an HTTP endpoint.
+type webhook struct {
+	opts   WebhookOptions
+	client *http.Client
+}
+
+// NewWebhook returns a notifier that posts each batch of notifications to a URL as a JSON array.
+// Batches of CloudEvents are posted in the batched content mode of the CloudEvents HTTP binding.
+// Posts that fail with network errors or retryable status codes are retried with exponential backoff.
+func NewWebhook(opts WebhookOptions) (Notifier, error) {
+	if opts.URL == "" {
+		return nil, errors.New("webhook URL is required")
+	}
+	if err := opts.Format.validate(); err != nil {
+		return nil, err
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = 5
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = 10 * time.Second
+	}
+	if opts.Backoff <= 0 {
+		opts.Backoff = 100 * time.Millisecond
+	}
+
+	return &webhook{
+		opts:   opts,
+		client: &http.Client{Timeout: opts.Timeout},
+	}, nil
+}
+
+func (n *webhook) Publish(ctx context.Context, notifications []*rpc.Notification) error {
+	body, err := n.opts.Format.encodeBatch(notifications)
+	if err != nil {
+		return err
+	}
+
+	backoff := n.opts.Backoff
+	for attempt := 1; ; attempt++ {
+		retry, err := n.post(ctx, body)
+		if err == nil {
+			return nil
+		} else if !retry || attempt >= n.opts.MaxAttempts {
+			return fmt.Errorf("failed to post notifications after %d attempts: %w", attempt, err)
+		}
+
+		log.FromContext(ctx).WithError(err).Debugf("Retrying webhook in %s.", backoff)
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// post sends a request to the webhook and reports whether a failed request can be retried.
+func (n *webhook) post(ctx context.Context, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.opts.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", n.opts.Format.batchContentType())
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return ctx.Err() == nil, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests, resp.StatusCode >= 500:
+		return true, fmt.Errorf("webhook returned %s", resp.Status)
+	default:
+		return false, fmt.Errorf("webhook returned %s", resp.Status)
+	}
+}
+
+func (n *webhook) Close() error {
+	n.client.CloseIdleConnections()
+	return nil
+}
@@ -0,0 +1,135 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func notifications(resources ...string) []*rpc.Notification {
+	result := make([]*rpc.Notification, 0, len(resources))
+	for _, r := range resources {
+		result = append(result, &rpc.Notification{Change: rpc.Notification_CREATED, Resource: r})
+	}
+	return result
+}
+
+func TestWebhook(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		attempts int
+		received []*rpc.Notification
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var batch []json.RawMessage
+		if err := json.Unmarshal(body, &batch); err != nil {
+			t.Errorf("Webhook received invalid body %q: %s", body, err)
+		}
+		for _, b := range batch {
+			n := new(rpc.Notification)
+			if err := protojson.Unmarshal(b, n); err != nil {
+				t.Errorf("Webhook received invalid notification %q: %s", b, err)
+			}
+			received = append(received, n)
+		}
+	}))
+	defer server.Close()
+
+	n, err := NewWebhook(WebhookOptions{URL: server.URL, Backoff: time.Millisecond})
+	if err != nil {
+		t.Fatalf("NewWebhook() returned error: %s", err)
+	}
+	defer n.Close()
+
+	want := notifications("projects/a", "projects/b")
+	if err := n.Publish(context.Background(), want); err != nil {
+		t.Fatalf("Publish() returned error: %s", err)
+	}
+	if attempts != 2 {
+		t.Errorf("Publish() made %d attempts, want 2", attempts)
+	}
+	if diff := cmp.Diff(want, received, protocmp.Transform()); diff != "" {
+		t.Errorf("Webhook received unexpected notifications (-want +got):\n%s", diff)
+	}
+}
+
+func TestWebhookErrors(t *testing.T) {
+	tests := []struct {
+		desc     string
+		status   int
+		attempts int
+	}{
+		{
+			desc:     "retryable status",
+			status:   http.StatusTooManyRequests,
+			attempts: 3,
+		},
+		{
+			desc:     "permanent status",
+			status:   http.StatusBadRequest,
+			attempts: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			var (
+				mu       sync.Mutex
+				attempts int
+			)
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				attempts++
+				w.WriteHeader(test.status)
+			}))
+			defer server.Close()
+
+			n, err := NewWebhook(WebhookOptions{URL: server.URL, MaxAttempts: 3, Backoff: time.Millisecond})
+			if err != nil {
+				t.Fatalf("NewWebhook() returned error: %s", err)
+			}
+			defer n.Close()
+
+			if err := n.Publish(context.Background(), notifications("projects/a")); err == nil {
+				t.Errorf("Publish() succeeded, expected error")
+			}
+			if attempts != test.attempts {
+				t.Errorf("Publish() made %d attempts, want %d", attempts, test.attempts)
+			}
+		})
+	}
+}
@@ -0,0 +1,258 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/apigee/registry/log"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/google/uuid"
+	statuspb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// operationFunc runs an operation and returns its response.
+// It reports progress by calling update with the latest metadata of the operation.
+type operationFunc func(ctx context.Context, update operationUpdate) (proto.Message, error)
+
+// operationUpdate sets the metadata of a running operation.
+// Metadata is saved to the database if save is true, which also checks for requests
+// to cancel the operation that were made to other servers. It is otherwise only
+// visible to requests to this server.
+type operationUpdate func(metadata proto.Message, save bool) error
+
+const (
+	// operationHeartbeatInterval is the time between renewals of the operations that are running on a server.
+	operationHeartbeatInterval = 30 * time.Second
+	// operationLease is how long an operation is considered running after its server last renewed it.
+	// Operations that aren't renewed for longer were left unfinished by a server that stopped.
+	operationLease = 2 * time.Minute
+)
+
+// operations runs long-running operations in the background.
+// Operations are recorded in the database, and the latest state of
+// the operations that are running on this server is kept in memory.
+// Servers periodically renew the operations they run, so that other
+// servers that share the database can tell when a server stopped.
+type operations struct {
+	db *storage.Client
+	// owner identifies this server process in the operations it runs.
+	owner string
+
+	mu      sync.Mutex
+	running map[string]*runningOperation
+	closing bool
+	wg      sync.WaitGroup
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+type runningOperation struct {
+	op     *models.Operation
+	cancel context.CancelFunc
+}
+
+func newOperations(db *storage.Client) *operations {
+	o := &operations{
+		db:      db,
+		owner:   uuid.New().String(),
+		running: make(map[string]*runningOperation),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	o.abortStale(context.Background())
+	go o.heartbeat(context.Background())
+	return o
+}
+
+// heartbeat renews the operations of this server and aborts the operations of servers that stopped.
+func (o *operations) heartbeat(ctx context.Context) {
+	defer close(o.done)
+
+	ticker := time.NewTicker(operationHeartbeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := o.db.RenewOperations(ctx, o.owner, time.Now()); err != nil {
+				log.FromContext(ctx).WithError(err).Error("Failed to renew running operations.")
+			}
+			o.abortStale(ctx)
+		case <-o.stop:
+			return
+		}
+	}
+}
+
+// abortStale fails the operations that their servers stopped renewing, which happens when a server stops
+// before its operations finish. Operations can't be resumed, so they would otherwise never finish.
+func (o *operations) abortStale(ctx context.Context) {
+	before := time.Now().Add(-operationLease)
+	stale, err := o.db.StaleOperations(ctx, before)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Error("Failed to read stale operations.")
+		return
+	}
+
+	s := status.New(codes.Aborted, "server stopped before the operation finished").Proto()
+	for i := range stale {
+		op := &stale[i]
+		if err := op.Finish(nil, s); err != nil {
+			log.FromContext(ctx).WithError(err).Errorf("Failed to finish operation %s.", op.Key)
+		} else if aborted, err := o.db.FinishStaleOperation(ctx, op, before); err != nil {
+			log.FromContext(ctx).WithError(err).Errorf("Failed to save operation %s.", op.Key)
+		} else if aborted {
+			log.FromContext(ctx).Warnf("Aborted operation %s, which was running on server %s when it stopped.", op.Key, op.Owner)
+		}
+	}
+}
+
+// start records a new operation with a name that begins with prefix and runs it in the background.
+// Operations with the same prefix change the same state, so only one of them runs on a server at a time.
+func (o *operations) start(ctx context.Context, prefix string, metadata proto.Message, run operationFunc) (*models.Operation, error) {
+	prefix = "operations/" + prefix
+	op, err := models.NewOperation(prefix+uuid.New().String(), o.owner, metadata)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.closing {
+		return nil, status.Error(codes.Unavailable, "server is stopping")
+	}
+	for name := range o.running {
+		if strings.HasPrefix(name, prefix) {
+			return nil, status.Errorf(codes.FailedPrecondition, "operation %s is already running", name)
+		}
+	}
+	if err := o.db.CreateOperation(ctx, op); err != nil {
+		return nil, err
+	}
+
+	// Operations outlive the requests that start them.
+	runCtx, cancel := context.WithCancel(context.Background())
+	o.running[op.Key] = &runningOperation{op: op, cancel: cancel}
+	o.wg.Add(1)
+	go o.run(runCtx, op.Key, run)
+	return copyOperation(op), nil
+}
+
+func (o *operations) run(ctx context.Context, name string, run operationFunc) {
+	defer o.wg.Done()
+	response, err := run(ctx, func(metadata proto.Message, save bool) error {
+		return o.update(ctx, name, metadata, save)
+	})
+
+	o.mu.Lock()
+	r := o.running[name]
+	finished := copyOperation(r.op)
+	closing := o.closing
+	o.mu.Unlock()
+
+	var s *statuspb.Status
+	if err != nil {
+		if ctx.Err() != nil && closing {
+			err = status.Error(codes.Aborted, "server stopped before the operation finished")
+		} else if ctx.Err() != nil {
+			err = status.Error(codes.Canceled, "operation was cancelled")
+		}
+		log.FromContext(ctx).WithError(err).Errorf("Operation %s failed.", name)
+		s = status.Convert(err).Proto()
+	}
+	r.cancel()
+
+	// The operation is saved before it stops running, so requests never see an earlier state.
+	if err := finished.Finish(response, s); err != nil {
+		log.FromContext(ctx).WithError(err).Errorf("Failed to finish operation %s.", name)
+	} else if err := o.db.UpdateOperation(context.Background(), finished); err != nil {
+		log.FromContext(ctx).WithError(err).Errorf("Failed to save operation %s.", name)
+	}
+
+	o.mu.Lock()
+	delete(o.running, name)
+	o.mu.Unlock()
+}
+
+func (o *operations) update(ctx context.Context, name string, metadata proto.Message, save bool) error {
+	o.mu.Lock()
+	r := o.running[name]
+	err := r.op.SetMetadata(metadata)
+	saved := copyOperation(r.op)
+	o.mu.Unlock()
+	if err != nil || !save {
+		return err
+	}
+
+	if err := o.db.UpdateOperation(ctx, saved); err != nil {
+		return err
+	}
+	if v, err := o.db.GetOperation(ctx, name); err != nil {
+		return err
+	} else if v.CancelRequested {
+		r.cancel()
+		return status.Error(codes.Canceled, "operation was cancelled")
+	} else if v.Done {
+		// Another server aborted the operation because this server didn't renew it in time.
+		r.cancel()
+		return status.Error(codes.Aborted, "operation was aborted")
+	}
+	return nil
+}
+
+// get returns the latest state of an operation that is running on this server.
+func (o *operations) get(name string) (*models.Operation, bool) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if r, ok := o.running[name]; ok {
+		return copyOperation(r.op), true
+	}
+	return nil, false
+}
+
+// cancel cancels an operation if it is running on this server.
+func (o *operations) cancel(name string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if r, ok := o.running[name]; ok {
+		r.cancel()
+	}
+}
+
+// close cancels running operations and waits for them to be saved.
+func (o *operations) close() {
+	o.mu.Lock()
+	o.closing = true
+	for _, r := range o.running {
+		r.cancel()
+	}
+	o.mu.Unlock()
+	o.wg.Wait()
+	close(o.stop)
+	<-o.done
+}
+
+func copyOperation(op *models.Operation) *models.Operation {
+	v := *op
+	return &v
+}
@@ -0,0 +1,273 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"time"
+
+	"github.com/apigee/registry/log"
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/apigee/registry/server/registry/internal/storage/models"
+	"github.com/apigee/registry/server/registry/notifier"
+)
+
+const (
+	// outboxPurgeInterval is the time between deletions of expired events.
+	outboxPurgeInterval = time.Hour
+	// outboxMaxRetryDelay is the maximum delay between attempts to deliver an event.
+	outboxMaxRetryDelay = 10 * time.Minute
+	// outboxLease is how long the events claimed by a server are reserved for its delivery attempt.
+	outboxLease = time.Minute
+	// outboxGapTimeout is how long followed events that are missing from the sequence of IDs are waited for.
+	// Transactions can commit in a different order than their events were numbered,
+	// and the IDs of events that were rolled back are never used.
+	outboxGapTimeout = time.Minute
+	// outboxMaxGaps is the maximum number of missing events that are waited for.
+	outboxMaxGaps = 1000
+)
+
+// outboxConfig configures the delivery of events from the outbox.
+type outboxConfig struct {
+	batchSize   int
+	interval    time.Duration
+	maxAttempts int32
+	retention   time.Duration
+}
+
+// outbox delivers the notifications that are recorded in the events table with the changes they describe.
+// Events are claimed by one server at a time and marked delivered once the notifier accepts them,
+// so a notification is delivered at least once even if a server stops or the notifier fails.
+// Every server also follows all recorded events and publishes them to its own watchers,
+// whichever server made the change or delivers its notification.
+type outbox struct {
+	db       *storage.Client
+	notifier notifier.Notifier
+	watches  *watchHub
+	config   outboxConfig
+
+	wakeup chan struct{}
+	stop   chan struct{}
+	done   chan struct{}
+	// following is true once lastID holds the ID of the last event published to watchers.
+	following bool
+	lastID    int64
+	// gaps holds the IDs before lastID that haven't been seen and the times they were first missed.
+	gaps map[int64]time.Time
+}
+
+func newOutbox(db *storage.Client, n notifier.Notifier, watches *watchHub, config outboxConfig) *outbox {
+	if config.batchSize <= 0 {
+		config.batchSize = 100
+	}
+	if config.interval <= 0 {
+		config.interval = time.Second
+	}
+	if config.maxAttempts <= 0 {
+		config.maxAttempts = 10
+	}
+	if config.retention <= 0 {
+		config.retention = 7 * 24 * time.Hour
+	}
+
+	return &outbox{
+		db:       db,
+		notifier: n,
+		watches:  watches,
+		config:   config,
+		wakeup:   make(chan struct{}, 1),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+		gaps:     make(map[int64]time.Time),
+	}
+}
+
+// start begins delivering events in the background.
+// Watchers are sent the events recorded after it is called.
+func (o *outbox) start(ctx context.Context) {
+	o.startFollowing(ctx)
+	go o.run(ctx)
+}
+
+// wake starts a delivery without waiting for the next interval.
+// It should be called after a transaction that records events is committed.
+func (o *outbox) wake() {
+	select {
+	case o.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// close delivers the pending events and stops the outbox.
+func (o *outbox) close() {
+	close(o.stop)
+	<-o.done
+}
+
+func (o *outbox) run(ctx context.Context) {
+	defer close(o.done)
+
+	ticker := time.NewTicker(o.config.interval)
+	defer ticker.Stop()
+
+	var lastPurge time.Time
+	for {
+		o.follow(ctx)
+		o.dispatch(ctx)
+
+		if time.Since(lastPurge) > outboxPurgeInterval {
+			lastPurge = time.Now()
+			if _, err := o.db.PurgeEvents(ctx, lastPurge.Add(-o.config.retention)); err != nil {
+				log.FromContext(ctx).WithError(err).Error("Failed to purge delivered events.")
+			}
+		}
+
+		select {
+		case <-o.wakeup:
+		case <-ticker.C:
+		case <-o.stop:
+			o.dispatch(ctx)
+			return
+		}
+	}
+}
+
+// dispatch delivers batches of events until none are due or a delivery fails.
+func (o *outbox) dispatch(ctx context.Context) {
+	for {
+		events, err := o.db.ClaimEvents(ctx, time.Now(), outboxLease, o.config.maxAttempts, o.config.batchSize)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Error("Failed to read pending events.")
+			return
+		}
+
+		if !o.deliver(ctx, events) || len(events) < o.config.batchSize {
+			return
+		}
+	}
+}
+
+// deliver publishes a batch of events and returns false if they couldn't be delivered.
+func (o *outbox) deliver(ctx context.Context, events []models.Event) bool {
+	valid := make([]models.Event, 0, len(events))
+	notifications := make([]*rpc.Notification, 0, len(events))
+	ids := make([]int64, 0, len(events))
+	for _, e := range events {
+		n, err := e.NotificationMessage()
+		if err != nil {
+			o.fail(ctx, e, err)
+			continue
+		}
+
+		valid = append(valid, e)
+		notifications = append(notifications, n)
+		ids = append(ids, e.ID)
+	}
+
+	if o.notifier != nil && len(notifications) > 0 {
+		if err := o.notifier.Publish(ctx, notifications); err != nil {
+			log.FromContext(ctx).WithError(err).Errorf("Failed to publish %d notifications.", len(notifications))
+			for _, e := range valid {
+				o.fail(ctx, e, err)
+			}
+			return false
+		}
+	}
+
+	if len(ids) == 0 {
+		return true
+	}
+	if err := o.db.DeliverEvents(ctx, ids, time.Now()); err != nil {
+		log.FromContext(ctx).WithError(err).Error("Failed to mark events delivered.")
+		return false
+	}
+	return true
+}
+
+// fail records a failed attempt to deliver an event and schedules a retry with exponential backoff.
+func (o *outbox) fail(ctx context.Context, e models.Event, reason error) {
+	delay := outboxMaxRetryDelay
+	if e.Attempts < 20 && o.config.interval<<e.Attempts < delay {
+		delay = o.config.interval << e.Attempts
+	}
+
+	if err := o.db.FailEvent(ctx, &e, reason.Error(), time.Now().Add(delay)); err != nil {
+		log.FromContext(ctx).WithError(err).Error("Failed to record failed event delivery.")
+		return
+	}
+
+	if e.Attempts >= o.config.maxAttempts {
+		log.FromContext(ctx).Warnf("Abandoned notification %d for %s after %d attempts. It can be replayed with ReplayOutboxEvents.", e.ID, e.Resource, e.Attempts)
+	}
+}
+
+// startFollowing starts following the events recorded after the last recorded event.
+func (o *outbox) startFollowing(ctx context.Context) {
+	id, err := o.db.LastEventID(ctx)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Error("Failed to read the last event.")
+		return
+	}
+	o.lastID = id
+	o.following = true
+}
+
+// follow publishes the events recorded since the last call to the watchers of this server.
+func (o *outbox) follow(ctx context.Context) {
+	if !o.following {
+		o.startFollowing(ctx)
+		return
+	}
+
+	for {
+		missing := make([]int64, 0, len(o.gaps))
+		for id, since := range o.gaps {
+			if time.Since(since) > outboxGapTimeout {
+				delete(o.gaps, id)
+			} else {
+				missing = append(missing, id)
+			}
+		}
+
+		events, err := o.db.EventsAfter(ctx, o.lastID, missing, o.config.batchSize)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Error("Failed to read recorded events.")
+			return
+		}
+
+		for _, e := range events {
+			if e.ID > o.lastID {
+				for id := o.lastID + 1; id < e.ID && len(o.gaps) < outboxMaxGaps; id++ {
+					o.gaps[id] = time.Now()
+				}
+				o.lastID = e.ID
+			} else {
+				delete(o.gaps, e.ID)
+			}
+
+			n, err := e.NotificationMessage()
+			if err != nil {
+				log.FromContext(ctx).WithError(err).Errorf("Failed to read notification %d.", e.ID)
+				continue
+			}
+			o.watches.publish(e.ID, n)
+		}
+
+		if len(events) < o.config.batchSize {
+			return
+		}
+	}
+}
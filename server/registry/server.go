@@ -16,63 +16,248 @@ package registry
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/apigee/registry/rpc"
 	"github.com/apigee/registry/server/registry/internal/storage"
+	"github.com/apigee/registry/server/registry/notifier"
 
+	"google.golang.org/genproto/googleapis/longrunning"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 // Config configures the registry server.
 type Config struct {
-	Database  string
-	DBConfig  string
-	LogLevel  string
-	LogFormat string
-	Notify    bool
-	ProjectID string
+	Database          string
+	DBConfig          string
+	DBMaxOpenConns    int
+	DBMaxIdleConns    int
+	DBConnMaxLifetime time.Duration
+	LogLevel          string
+	LogFormat         string
+	// BlobStore selects where the contents of specs and artifacts are stored.
+	// Values: [ sql, filesystem ]. If unset, contents are stored in the database.
+	BlobStore string
+	// BlobDirectory is the directory that contains stored contents when BlobStore is filesystem.
+	BlobDirectory string
+	// MaxUploadSize is the maximum size in bytes of the contents received by upload streams,
+	// which are held in memory until they are saved. If unset or zero, uploads are limited to 1 GiB.
+	MaxUploadSize int64
+	// Notifier publishes notifications of changes to resources.
+	// If nil, notifications are only sent to WatchResources streams.
+	// The server closes the notifier when it is closed.
+	Notifier notifier.Notifier
+	// OutboxBatchSize is the maximum number of notifications delivered together.
+	// If unset or zero, batches contain at most 100 notifications.
+	OutboxBatchSize int
+	// OutboxInterval is the time between checks for notifications that are due for delivery.
+	// If unset or zero, the outbox is checked every second.
+	OutboxInterval time.Duration
+	// OutboxMaxAttempts is the number of failed deliveries after which a notification is abandoned.
+	// Abandoned notifications can be delivered with ReplayOutboxEvents.
+	// If unset or zero, notifications are abandoned after 10 attempts.
+	OutboxMaxAttempts int
+	// OutboxRetention is how long delivered notifications are kept for replay.
+	// If unset or zero, they are kept for 7 days.
+	OutboxRetention time.Duration
+	// DeleteRetention is how long deleted resources can be restored before they are purged.
+	// If unset or zero, deleted resources are kept for 30 days.
+	DeleteRetention time.Duration
+	// PurgeInterval is the time between purges of deleted resources.
+	// If unset or zero, deleted resources are purged every hour.
+	PurgeInterval time.Duration
+	// Authorization enables the checks of the roles of callers made by UnaryAuthorizer and StreamAuthorizer.
+	Authorization bool
+	// TrustPrincipalHeader identifies callers that aren't authenticated by PrincipalHeader.
+	// Any client can set the header, so it must only be set when a trusted proxy in front of the server
+	// sets the header and removes it from client requests. If false, only authenticated callers are identified.
+	TrustPrincipalHeader bool
+	// PrincipalHeader is the request metadata key that identifies callers when TrustPrincipalHeader is set.
+	// If unset, callers are identified by "x-registry-principal".
+	PrincipalHeader string
+	// Admins are principals that are admins of the whole registry, in addition to the principals
+	// that are granted the role by role bindings.
+	Admins []string
 }
 
 // RegistryServer implements a Registry server.
 type RegistryServer struct {
-	database      string
-	dbConfig      string
-	notifyEnabled bool
-	projectID     string
+	db         *storage.Client
+	notifier   notifier.Notifier
+	watches    *watchHub
+	outbox     *outbox
+	purger     *purger
+	operations *operations
+
+	authorization authorization
+	maxUploadSize int64
 
 	rpc.UnimplementedRegistryServer
 	rpc.UnimplementedAdminServer
+	longrunning.UnimplementedOperationsServer
 }
 
 func New(config Config) (*RegistryServer, error) {
 	s := &RegistryServer{
-		database:      config.Database,
-		dbConfig:      config.DBConfig,
-		notifyEnabled: config.Notify,
-		projectID:     config.ProjectID,
+		notifier:      config.Notifier,
+		watches:       newWatchHub(),
+		authorization: newAuthorization(config),
+		maxUploadSize: config.MaxUploadSize,
+	}
+	if s.maxUploadSize <= 0 {
+		s.maxUploadSize = defaultMaxUploadSize
 	}
 
-	if s.database == "" {
-		s.database = "sqlite3"
-		s.dbConfig = "/tmp/registry.db"
+	if config.Database == "" {
+		config.Database = "sqlite3"
+		config.DBConfig = "/tmp/registry.db"
 	}
 
-	db, err := storage.NewClient(context.Background(), s.database, s.dbConfig)
+	db, err := storage.NewClient(context.Background(), config.Database, config.DBConfig)
 	if err != nil {
 		return nil, err
 	}
-	defer db.Close()
-	if err := db.EnsureTables(); err != nil {
+	if err := db.ConfigurePool(storage.PoolConfig{
+		MaxOpenConns:    config.DBMaxOpenConns,
+		MaxIdleConns:    config.DBMaxIdleConns,
+		ConnMaxLifetime: config.DBConnMaxLifetime,
+	}); err != nil {
+		db.Close()
 		return nil, err
 	}
+	if err := db.EnsureSchema(context.Background()); err != nil {
+		db.Close()
+		return nil, err
+	}
+	switch config.BlobStore {
+	case "", "sql":
+	case "filesystem":
+		store, err := storage.NewFileBlobStore(config.BlobDirectory)
+		if err != nil {
+			db.Close()
+			return nil, err
+		}
+		db.UseBlobStore(store)
+	default:
+		db.Close()
+		return nil, fmt.Errorf("unsupported blob store %q", config.BlobStore)
+	}
+	s.db = db
+	s.outbox = newOutbox(db, s.notifier, s.watches, outboxConfig{
+		batchSize:   config.OutboxBatchSize,
+		interval:    config.OutboxInterval,
+		maxAttempts: int32(config.OutboxMaxAttempts),
+		retention:   config.OutboxRetention,
+	})
+	s.outbox.start(context.Background())
+	s.purger = newPurger(db, config.DeleteRetention, config.PurgeInterval)
+	s.purger.start(context.Background())
+	s.operations = newOperations(db)
 	return s, nil
 }
 
+// StopWatches ends all WatchResources streams, which otherwise continue until clients disconnect.
+// Servers should stop watches before waiting for in-flight requests to finish.
+func (s *RegistryServer) StopWatches() {
+	s.watches.stop()
+}
+
+// Close stops running operations, delivers pending notifications and releases the database connections held by the server.
+func (s *RegistryServer) Close() {
+	if s.operations != nil {
+		s.operations.close()
+		s.operations = nil
+	}
+	if s.purger != nil {
+		s.purger.close()
+		s.purger = nil
+	}
+	if s.outbox != nil {
+		s.outbox.close()
+		s.outbox = nil
+	}
+	if s.notifier != nil {
+		s.notifier.Close()
+		s.notifier = nil
+	}
+	if s.db != nil {
+		s.db.Close()
+		s.db = nil
+	}
+}
+
 func (s *RegistryServer) getStorageClient(ctx context.Context) (*storage.Client, error) {
-	return storage.NewClient(ctx, s.database, s.dbConfig)
+	if s.db == nil {
+		return nil, errors.New("storage client is closed")
+	}
+	// Requests in a batch use the transaction of the batch.
+	if db, ok := ctx.Value(batchTransactionKey{}).(*storage.Client); ok {
+		return db, nil
+	}
+	return s.db.WithContext(ctx), nil
+}
+
+// transaction calls fn with a storage client that makes all of its changes in a single transaction.
+// Notifications recorded by fn are delivered after the transaction is committed.
+func (s *RegistryServer) transaction(ctx context.Context, fn func(context.Context, *storage.Client) error) error {
+	db, err := s.getStorageClient(ctx)
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	if err := db.Transaction(ctx, fn); err != nil {
+		return err
+	}
+
+	s.outbox.wake()
+	return nil
+}
+
+// upsert calls fn in a transaction that creates a resource if it doesn't exist and updates it otherwise.
+// When concurrent requests create the same resource, the ones that lose the race fail with ALREADY_EXISTS
+// and are retried once, which then finds and updates the created resource.
+func (s *RegistryServer) upsert(ctx context.Context, fn func(context.Context, *storage.Client) error) error {
+	err := s.transaction(ctx, fn)
+	if status.Code(err) == codes.AlreadyExists {
+		return s.transaction(ctx, fn)
+	}
+	return err
 }
 
 func isNotFound(err error) bool {
 	return status.Code(err) == codes.NotFound
 }
+
+// etagged is a stored resource with an etag.
+type etagged interface {
+	ETag() string
+}
+
+// checkETag returns an ABORTED error if a resource doesn't have the etag that a client sent with a request.
+// Requests without etags are applied unconditionally. When called in a transaction, get should lock
+// the resource so that it can't change before the transaction is committed.
+func checkETag(name, etag string, get func() (etagged, error)) error {
+	if etag == "" {
+		return nil
+	}
+
+	current, err := get()
+	if err != nil {
+		return err
+	}
+	if current.ETag() != etag {
+		return status.Errorf(codes.Aborted, "%q has changed: etag %q doesn't match its current etag", name, etag)
+	}
+	return nil
+}
+
+// undeleteParentError returns the error for a restored resource whose parent couldn't be found.
+func undeleteParentError(err error, name string) error {
+	if isNotFound(err) {
+		return status.Errorf(codes.FailedPrecondition, "cannot undelete %q: %s", name, status.Convert(err).Message())
+	}
+	return err
+}
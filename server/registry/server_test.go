@@ -46,6 +46,7 @@ func defaultTestServer(t *testing.T) *RegistryServer {
 		if server, err := serverWithSQLite(t); err != nil {
 			t.Fatalf("Setup: failed to get server with SQLite: %s", err)
 		} else {
+			t.Cleanup(server.Close)
 			return server
 		}
 	}
@@ -57,10 +58,12 @@ func defaultTestServer(t *testing.T) *RegistryServer {
 		if server, err := serverWithSQLite(t); err != nil {
 			t.Fatalf("Setup: failed to get server with SQLite: %s", err)
 		} else {
+			t.Cleanup(server.Close)
 			return server
 		}
 	}
 
+	t.Cleanup(server.Close)
 	return server
 }
 
@@ -0,0 +1,102 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/apigee/registry/rpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// recordSpans records the spans of the global tracer provider until the test ends.
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(trace.NewNoopTracerProvider()) })
+	return recorder
+}
+
+// spanAttribute returns the value of an attribute of a span, or an empty string if it isn't set.
+func spanAttribute(span sdktrace.ReadOnlySpan, key attribute.Key) string {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value.Emit()
+		}
+	}
+	return ""
+}
+
+func TestStorageSpans(t *testing.T) {
+	server := defaultTestServer(t)
+	recorder := recordSpans(t)
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "request")
+	if _, err := server.CreateProject(ctx, &rpc.CreateProjectRequest{ProjectId: "traced", Project: &rpc.Project{}}); err != nil {
+		t.Fatalf("CreateProject() returned error: %s", err)
+	}
+	if _, err := server.GetProject(ctx, &rpc.GetProjectRequest{Name: "projects/missing"}); err == nil {
+		t.Fatalf("GetProject() of a missing project returned no error")
+	}
+	parent.End()
+
+	var created, queried bool
+	for _, span := range recorder.Ended() {
+		if span.Name() == "request" {
+			continue
+		}
+		if !strings.HasPrefix(span.Name(), "gorm.") {
+			t.Errorf("Unexpected span %q", span.Name())
+			continue
+		}
+		if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+			t.Errorf("Span %q isn't a child of the span of the request", span.Name())
+		}
+		if got := spanAttribute(span, "db.system"); got != "sqlite" && got != "postgresql" {
+			t.Errorf("Span %q has db.system %q, want sqlite or postgresql", span.Name(), got)
+		}
+		// Queries for missing resources aren't errors of the database.
+		if span.Status().Code == codes.Error {
+			t.Errorf("Span %q has error status: %s", span.Name(), span.Status().Description)
+		}
+
+		table := spanAttribute(span, "db.sql.table")
+		statement := spanAttribute(span, "db.statement")
+		switch {
+		case span.Name() == "gorm.Create" && table == "projects":
+			created = true
+			if strings.Contains(statement, "traced") {
+				t.Errorf("Span %q records query values: %s", span.Name(), statement)
+			}
+		case span.Name() == "gorm.Query" && table == "projects":
+			queried = true
+		}
+	}
+
+	if !created {
+		t.Errorf("No span recorded the creation of the project")
+	}
+	if !queried {
+		t.Errorf("No span recorded the query for the missing project")
+	}
+}
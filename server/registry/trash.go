@@ -0,0 +1,88 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"context"
+	"time"
+
+	"github.com/apigee/registry/log"
+	"github.com/apigee/registry/server/registry/internal/storage"
+)
+
+// purger permanently removes deleted resources once their retention period has passed.
+type purger struct {
+	db        *storage.Client
+	retention time.Duration
+	interval  time.Duration
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func newPurger(db *storage.Client, retention, interval time.Duration) *purger {
+	if retention <= 0 {
+		retention = 30 * 24 * time.Hour
+	}
+	if interval <= 0 {
+		interval = time.Hour
+	}
+
+	return &purger{
+		db:        db,
+		retention: retention,
+		interval:  interval,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+// start begins purging deleted resources in the background.
+func (p *purger) start(ctx context.Context) {
+	go p.run(ctx)
+}
+
+// close stops the purger and waits for a purge in progress to finish.
+func (p *purger) close() {
+	close(p.stop)
+	<-p.done
+}
+
+func (p *purger) run(ctx context.Context) {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.purge(ctx)
+
+		select {
+		case <-ticker.C:
+		case <-p.stop:
+			return
+		}
+	}
+}
+
+// purge removes the resources that were deleted before the retention period.
+func (p *purger) purge(ctx context.Context) {
+	count, err := p.db.PurgeDeletedResources(ctx, time.Now().Add(-p.retention))
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Error("Failed to purge deleted resources.")
+	} else if count > 0 {
+		log.FromContext(ctx).Infof("Purged %d deleted rows.", count)
+	}
+}
@@ -0,0 +1,208 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/apigee/registry/rpc"
+	"github.com/apigee/registry/server/registry/names"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// watchBacklogLimit is the maximum number of recorded notifications that are sent to a watcher that resumes.
+	watchBacklogLimit = 1000
+	// watchBufferSize is the number of notifications that can be queued for a watcher
+	// before it is disconnected for falling behind.
+	watchBufferSize = 100
+)
+
+var (
+	errResumeTokenExpired = errors.New("resume token has expired")
+	errWatcherFellBehind  = errors.New("watcher fell behind")
+	errWatchesStopped     = errors.New("server is shutting down")
+)
+
+// watchHub distributes notifications to the watchers of this server.
+type watchHub struct {
+	mu       sync.Mutex
+	watchers map[*watcher]bool
+	stopped  bool
+}
+
+// watcher receives the notifications sent to a watchHub until it is closed.
+type watcher struct {
+	notifications chan *rpc.Notification
+	// done is closed when the watcher is removed from the hub.
+	done chan struct{}
+	// err is the reason the watcher was removed, if it didn't remove itself.
+	err error
+}
+
+func newWatchHub() *watchHub {
+	return &watchHub{
+		watchers: make(map[*watcher]bool),
+	}
+}
+
+// publish sends the notification of a recorded event to every watcher.
+// Its resume token is the ID of the event, so watchers can resume from it on any server that shares the database.
+func (h *watchHub) publish(id int64, n *rpc.Notification) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	n = proto.Clone(n).(*rpc.Notification)
+	n.ResumeToken = resumeToken(id)
+
+	for w := range h.watchers {
+		select {
+		case w.notifications <- n:
+		default:
+			h.remove(w, errWatcherFellBehind)
+		}
+	}
+}
+
+// watch returns a new watcher that is sent the notifications published after it is added.
+func (h *watchHub) watch() (*watcher, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.stopped {
+		return nil, errWatchesStopped
+	}
+
+	w := &watcher{
+		notifications: make(chan *rpc.Notification, watchBufferSize),
+		done:          make(chan struct{}),
+	}
+	h.watchers[w] = true
+	return w, nil
+}
+
+// unwatch removes a watcher from the hub.
+func (h *watchHub) unwatch(w *watcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.remove(w, nil)
+}
+
+// stop removes all watchers from the hub and prevents new watchers from being added.
+func (h *watchHub) stop() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.stopped = true
+	for w := range h.watchers {
+		h.remove(w, errWatchesStopped)
+	}
+}
+
+func (h *watchHub) remove(w *watcher, err error) {
+	if h.watchers[w] {
+		delete(h.watchers, w)
+		w.err = err
+		close(w.done)
+	}
+}
+
+// resumeToken returns the resume token of the notification of a recorded event.
+func resumeToken(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
+
+// parseResumeToken returns the ID of the event identified by a resume token.
+func parseResumeToken(token string) (int64, error) {
+	id, err := strconv.ParseInt(token, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid resume token %q", token)
+	}
+	return id, nil
+}
+
+// resourcePattern matches resource names with "-" in place of any resource ID.
+type resourcePattern []string
+
+func parseResourcePattern(pattern string) (resourcePattern, error) {
+	valid := func(_ interface{}, err error) bool { return err == nil }
+	switch {
+	case valid(names.ParseProject(pattern)),
+		valid(names.ParseApi(pattern)),
+		valid(names.ParseVersion(pattern)),
+		valid(names.ParseSpec(pattern)),
+		valid(names.ParseSpecRevision(pattern)),
+		valid(names.ParseDeployment(pattern)),
+		valid(names.ParseDeploymentRevision(pattern)),
+		valid(names.ParseArtifact(pattern)):
+	default:
+		return nil, fmt.Errorf("invalid pattern %q: must be the name of a resource or revision", pattern)
+	}
+
+	return strings.Split(strings.ToLower(pattern), "/"), nil
+}
+
+// Matches returns true if the resource name matches the pattern.
+// Revisions match patterns for the names of their resources.
+func (p resourcePattern) Matches(resource string) bool {
+	segments := strings.Split(strings.ToLower(resource), "/")
+	if len(segments) != len(p) {
+		return false
+	}
+
+	for i, s := range segments {
+		if i == len(segments)-1 && !strings.Contains(p[i], "@") {
+			s = strings.SplitN(s, "@", 2)[0]
+		}
+		if p[i] != "-" && p[i] != s {
+			return false
+		}
+	}
+
+	return true
+}
+
+// filter returns a filter expression that matches the resource names that the pattern matches.
+// The prefix of the pattern before its first "-" allows the condition to be checked in the database.
+func (p resourcePattern) filter() string {
+	prefix := make([]string, 0, len(p))
+	segments := make([]string, len(p))
+	wildcard := false
+	for i, s := range p {
+		wildcard = wildcard || s == "-"
+		if !wildcard {
+			prefix = append(prefix, s)
+		}
+
+		switch {
+		case s == "-":
+			segments[i] = "[^/]+"
+		case i == len(p)-1 && !strings.Contains(s, "@"):
+			segments[i] = regexp.QuoteMeta(s) + "(@[^/]+)?"
+		default:
+			segments[i] = regexp.QuoteMeta(s)
+		}
+	}
+
+	start := strings.Join(prefix, "/")
+	if wildcard {
+		start += "/"
+	}
+	return fmt.Sprintf("resource.startsWith(%q) && resource.matches(%q)", start, "^"+strings.Join(segments, "/")+"$")
+}
@@ -292,7 +292,7 @@ func hashForBytes(b []byte) string {
 func testArtifacts(ctx context.Context, registryClient connection.Client, t *testing.T, parent string) {
 	messageContents := []byte("hello")
 	messageHash := hashForBytes(messageContents)
-	messageLength := int32(len(messageContents))
+	messageLength := int64(len(messageContents))
 	messageMimeType := "text/plain"
 	// Set the artifact.
 	{
@@ -0,0 +1,154 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tracing exports the OpenTelemetry traces of the registry server and its clients.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+)
+
+// Exporters that spans can be sent to.
+const (
+	// ExporterNone disables tracing.
+	ExporterNone = "none"
+	// ExporterOTLP sends spans to an OpenTelemetry collector with the OTLP gRPC protocol.
+	ExporterOTLP = "otlp"
+	// ExporterFile appends spans to a local file, one JSON object per line.
+	ExporterFile = "file"
+)
+
+// Config configures the export of traces.
+type Config struct {
+	// ServiceName identifies the program that records the spans.
+	ServiceName string
+	// Exporter is one of [ none, otlp, file ]. If empty, tracing is disabled.
+	Exporter string
+	// Endpoint is the address of the OTLP collector, e.g. "localhost:4317".
+	// If empty, the OTEL_EXPORTER_OTLP_ENDPOINT environment variable or "localhost:4317" is used.
+	Endpoint string
+	// Insecure disables TLS for connections to the OTLP collector.
+	Insecure bool
+	// File is the path of the file that spans are appended to by the file exporter.
+	File string
+	// SampleRatio is the fraction of traces that are sampled when they start in this program.
+	// Traces continued from callers are sampled when they were sampled by the caller.
+	// If zero, all traces are sampled.
+	SampleRatio float64
+}
+
+// Enabled returns true if the config exports spans.
+func (c Config) Enabled() bool {
+	return c.Exporter != "" && c.Exporter != ExporterNone
+}
+
+// Validate returns an error if the config can't be used to export spans.
+func (c Config) Validate() error {
+	switch c.Exporter {
+	case "", ExporterNone, ExporterOTLP:
+	case ExporterFile:
+		if c.File == "" {
+			return fmt.Errorf("file must be set for the file exporter")
+		}
+	default:
+		return fmt.Errorf("invalid exporter %q: must be one of [none, otlp, file]", c.Exporter)
+	}
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		return fmt.Errorf("invalid sample ratio %g: must be between 0 and 1", c.SampleRatio)
+	}
+	return nil
+}
+
+// Start installs a global tracer provider that exports spans as configured, and a propagator that
+// sends and receives trace contexts in W3C Trace Context headers. It returns a function that
+// flushes pending spans and stops exporting them. If the config doesn't enable tracing,
+// nothing is installed and the returned function does nothing.
+func Start(ctx context.Context, c Config) (func(context.Context) error, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	if !c.Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := newExporter(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	ratio := c.SampleRatio
+	if ratio == 0 {
+		ratio = 1
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL,
+			semconv.ServiceNameKey.String(c.ServiceName))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return provider.Shutdown, nil
+}
+
+func newExporter(ctx context.Context, c Config) (sdktrace.SpanExporter, error) {
+	switch c.Exporter {
+	case ExporterOTLP:
+		var opts []otlptracegrpc.Option
+		if c.Endpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(c.Endpoint))
+		}
+		if c.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		return otlptracegrpc.New(ctx, opts...)
+	case ExporterFile:
+		f, err := os.OpenFile(c.File, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return nil, err
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		return &fileExporter{SpanExporter: exporter, file: f}, nil
+	default:
+		return nil, fmt.Errorf("unsupported exporter %q", c.Exporter)
+	}
+}
+
+// fileExporter closes the file that spans are written to when it is shut down.
+type fileExporter struct {
+	sdktrace.SpanExporter
+	file *os.File
+}
+
+func (e *fileExporter) Shutdown(ctx context.Context) error {
+	err := e.SpanExporter.Shutdown(ctx)
+	if cerr := e.file.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
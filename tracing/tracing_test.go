@@ -0,0 +1,129 @@
+// Copyright 2022 Google LLC. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		desc   string
+		config Config
+		valid  bool
+	}{
+		{desc: "disabled", config: Config{}, valid: true},
+		{desc: "none", config: Config{Exporter: ExporterNone}, valid: true},
+		{desc: "otlp", config: Config{Exporter: ExporterOTLP, Endpoint: "localhost:4317", SampleRatio: 0.5}, valid: true},
+		{desc: "file", config: Config{Exporter: ExporterFile, File: "traces.json"}, valid: true},
+		{desc: "file without path", config: Config{Exporter: ExporterFile}},
+		{desc: "unknown exporter", config: Config{Exporter: "zipkin"}},
+		{desc: "negative sample ratio", config: Config{Exporter: ExporterOTLP, SampleRatio: -0.1}},
+		{desc: "sample ratio above one", config: Config{Exporter: ExporterOTLP, SampleRatio: 2}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			if err := test.config.Validate(); (err == nil) != test.valid {
+				t.Errorf("Validate() returned %v, want valid %t", err, test.valid)
+			}
+		})
+	}
+}
+
+func TestStartDisabled(t *testing.T) {
+	provider := otel.GetTracerProvider()
+	stop, err := Start(context.Background(), Config{Exporter: ExporterNone})
+	if err != nil {
+		t.Fatalf("Start() returned error: %s", err)
+	}
+	if err := stop(context.Background()); err != nil {
+		t.Errorf("stop() returned error: %s", err)
+	}
+	if otel.GetTracerProvider() != provider {
+		t.Errorf("Start() installed a tracer provider when tracing is disabled")
+	}
+}
+
+func TestStartFile(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "traces.json")
+	stop, err := Start(ctx, Config{ServiceName: "test", Exporter: ExporterFile, File: path})
+	if err != nil {
+		t.Fatalf("Start() returned error: %s", err)
+	}
+	t.Cleanup(func() { otel.SetTracerProvider(trace.NewNoopTracerProvider()) })
+
+	ctx, parent := otel.Tracer("test").Start(ctx, "parent")
+	_, child := otel.Tracer("test").Start(ctx, "child")
+	child.End()
+	parent.End()
+	if err := stop(ctx); err != nil {
+		t.Fatalf("stop() returned error: %s", err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Failed to open traces: %s", err)
+	}
+	defer f.Close()
+
+	// Each line of the file is a span.
+	type span struct {
+		Name        string
+		SpanContext struct{ TraceID string }
+		Resource    []struct {
+			Key   string
+			Value struct{ Value interface{} }
+		}
+	}
+	var spans []span
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var s span
+		if err := json.Unmarshal(scanner.Bytes(), &s); err != nil {
+			t.Fatalf("Failed to parse span %q: %s", scanner.Text(), err)
+		}
+		spans = append(spans, s)
+	}
+
+	if len(spans) != 2 {
+		t.Fatalf("Exported %d spans, want 2", len(spans))
+	}
+	if spans[0].Name != "child" || spans[1].Name != "parent" {
+		t.Errorf("Exported spans %q and %q, want child and parent", spans[0].Name, spans[1].Name)
+	}
+	if spans[0].SpanContext.TraceID != spans[1].SpanContext.TraceID {
+		t.Errorf("Exported spans have different trace IDs")
+	}
+
+	service := ""
+	for _, kv := range spans[0].Resource {
+		if kv.Key == "service.name" {
+			service, _ = kv.Value.Value.(string)
+		}
+	}
+	if service != "test" {
+		t.Errorf("Exported span of service %q, want test", service)
+	}
+}